@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"stock-bot/domain/model"
 	"stock-bot/internal/agent"
 	"stock-bot/internal/app"
 	"stock-bot/internal/config"
@@ -31,6 +32,18 @@ import (
 	order "stock-bot/gen/order"
 	positiongen "stock-bot/gen/position" // New import
 	pricegen "stock-bot/gen/price" // New import
+	signalsvr "stock-bot/gen/http/signal/server"
+	signalgen "stock-bot/gen/signal"
+	newssvr "stock-bot/gen/http/news/server"
+	newsgen "stock-bot/gen/news"
+	marginsvr "stock-bot/gen/http/margin/server"
+	margingen "stock-bot/gen/margin"
+	portfoliosvr "stock-bot/gen/http/portfolio/server"
+	portfoliogen "stock-bot/gen/portfolio"
+	tradesvr "stock-bot/gen/http/trade/server"
+	tradegen "stock-bot/gen/trade"
+	reportsvr "stock-bot/gen/http/report/server"
+	reportgen "stock-bot/gen/report"
 
 	goahttp "goa.design/goa/v3/http"
 	"goa.design/goa/v3/http/middleware"
@@ -100,22 +113,111 @@ func main() {
 	// 4-2. リポジトリを初期化
 	orderRepo := repository_impl.NewOrderRepository(db)
 	masterRepo := repository_impl.NewMasterRepository(db)
+	signalRepo := repository_impl.NewSignalRepository(db)
+	newsRepo := repository_impl.NewNewsRepository(db)
+	fundamentalRepo := repository_impl.NewFundamentalRepository(db)
+	marginInfoRepo := repository_impl.NewMarginInfoRepository(db)
+	balanceRepo := repository_impl.NewBalanceRepository(db)
+	tradingEventRepo := repository_impl.NewTradingEventRepository(db)
+	positionRepo := repository_impl.NewPositionRepository(db)
 
 	// 4-3. ユースケースを初期化
-	orderUsecase := app.NewOrderUseCaseImpl(tachibanaClient, orderRepo)
-	balanceUsecase := app.NewBalanceUseCaseImpl(tachibanaClient)
+	tickService := app.NewTickServiceImpl(masterRepo)
+	balanceUsecase := app.NewBalanceUseCaseImpl(tachibanaClient, balanceRepo)
+
+	// 残高の定期記録と委託保証金率の監視 (追証の水準に近い場合は新規信用建を停止する)
+	var balanceMonitor *app.BalanceMonitor
+	var marginGuard app.MarginEntryGuard
+	if cfg.BalanceSnapshotInterval > 0 {
+		balanceMonitor, err = app.NewBalanceMonitor(balanceUsecase, appSession, cfg.BalanceSnapshotInterval, cfg.MarginAlertRate)
+		if err != nil {
+			slog.Default().Error("failed to create balance monitor", slog.Any("error", err))
+			os.Exit(1)
+		}
+		marginGuard = balanceMonitor
+	}
+	// 売却可能数量の予約は API とエージェントの売り注文で共有する
+	sellReserver := app.NewSellQuantityReserver(tachibanaClient, app.DefaultSellReservationHold)
+	orderUsecase := app.NewOrderUseCaseImpl(tachibanaClient, orderRepo, masterRepo, tickService, marginGuard, sellReserver)
 	positionUsecase := app.NewPositionUseCaseImpl(tachibanaClient)
-	masterUsecase := app.NewMasterUseCaseImpl(tachibanaClient, masterRepo)
+	portfolioUsecase := app.NewPortfolioUseCaseImpl(positionUsecase, masterRepo, orderRepo)
+	tradeUsecase := app.NewTradeUseCaseImpl(tachibanaClient, orderRepo, positionRepo, appSession)
+	reportUsecase := app.NewReportUseCaseImpl(signalRepo, orderRepo, tradingEventRepo, balanceRepo, tradeUsecase, positionUsecase, appSession, app.ReportConfig{
+		Dir:             cfg.ReportDir,
+		MarginAlertRate: cfg.MarginAlertRate,
+	})
+	masterUsecase := app.NewMasterUseCaseImpl(tachibanaClient, masterRepo, app.MasterSyncConfig{
+		Scope:          app.MasterSyncScope(cfg.MasterSyncScope),
+		WatchedSymbols: cfg.WatchedStocks,
+	})
 	priceUsecase := app.NewPriceUseCaseImpl(tachibanaClient, appSession)
+	fundamentalsUsecase := app.NewFundamentalsUseCaseImpl(tachibanaClient, tachibanaClient, fundamentalRepo)
+	marginUsecase := app.NewMarginUseCaseImpl(tachibanaClient, marginInfoRepo, appSession, cfg.WatchedStocks)
+	newsUsecase := app.NewNewsUseCaseImpl(tachibanaClient, newsRepo, appSession, app.NewsPollConfig{
+		Symbols: cfg.WatchedStocks,
+	})
+
+	// 4-X. マスタデータの定期同期 (営業日ごと)
+	var masterSyncScheduler *app.MasterSyncScheduler
+	if cfg.MasterSyncTime != "off" {
+		masterSyncScheduler, err = app.NewMasterSyncScheduler(masterUsecase, appSession, cfg.MasterSyncTime)
+		if err != nil {
+			slog.Default().Error("failed to create master sync scheduler", slog.Any("error", err))
+			os.Exit(1)
+		}
+		// 営業日ごとに監視銘柄の投資指標のスナップショットを保存する
+		masterSyncScheduler.AfterSync(func(ctx context.Context) {
+			snapshotFundamentals(ctx, fundamentalsUsecase, appSession, cfg.WatchedStocks)
+		})
+		// 営業日ごとに監視銘柄の証金残・信用残・逆日歩を保存する
+		masterSyncScheduler.AfterSync(func(ctx context.Context) {
+			collectMarginInfo(ctx, marginUsecase)
+		})
+	}
+
+	// 4-X. 監視銘柄のニュースの定期取得
+	var newsPoller *app.NewsPoller
+	if cfg.NewsPollInterval > 0 {
+		newsPoller, err = app.NewNewsPoller(newsUsecase, cfg.NewsPollInterval)
+		if err != nil {
+			slog.Default().Error("failed to create news poller", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}
+
+	// 4-X. 注文の約定の定期取得 (実現損益の計算に使う)
+	var executionPoller *app.ExecutionPoller
+	if cfg.ExecutionPollInterval > 0 {
+		executionPoller, err = app.NewExecutionPoller(tradeUsecase, cfg.ExecutionPollInterval)
+		if err != nil {
+			slog.Default().Error("failed to create execution poller", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}
+
+	// 4-X. 日次レポートの定期作成 (平日ごと)
+	var dailyReportScheduler *app.DailyReportScheduler
+	if cfg.ReportTime != "off" {
+		dailyReportScheduler, err = app.NewDailyReportScheduler(reportUsecase, cfg.ReportTime)
+		if err != nil {
+			slog.Default().Error("failed to create daily report scheduler", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}
 
 	if !*skipSync {
 		slog.Default().Info("Starting initial master data synchronization...")
-		err = masterUsecase.DownloadAndStoreMasterData(context.Background(), appSession)
+		summary, err := masterUsecase.DownloadAndStoreMasterData(context.Background(), appSession, model.MasterSyncTriggerStartup)
 		if err != nil {
 			slog.Default().Error("failed to download and store master data on startup", slog.Any("error", err))
 			os.Exit(1)
 		}
+		if masterSyncScheduler != nil {
+			masterSyncScheduler.UpdateCalendar(summary)
+		}
 		slog.Default().Info("Initial master data synchronization completed successfully.")
+		snapshotFundamentals(context.Background(), fundamentalsUsecase, appSession, cfg.WatchedStocks)
+		collectMarginInfo(context.Background(), marginUsecase)
 	} else {
 		slog.Default().Info("Skipping initial master data synchronization.")
 	}
@@ -126,16 +228,33 @@ func main() {
 		tachibanaClient, // tachibanaClient は OrderClient インターフェースを実装
 		tachibanaClient, // tachibanaClient は PriceInfoClient インターフェースを実装
 		orderRepo,
+		tickService,  // tickService は TickRounder インターフェースを実装
+		sellReserver, // sellReserver は SellReserver インターフェースを実装
 		appSession,
 		slog.Default(),
 	)
 
+	// 4-Z. エージェントの初期化 (HTTP経由のシグナル受付時に通知するため、サービスより先に生成する)
+	agentConfigPath := "agent_config.yaml" // TODO: コマンドライン引数で渡せるようにする
+	stockAgent, err := agent.NewAgent(agentConfigPath, goaTradeService, signalRepo, masterRepo, newsRepo, tradingEventRepo)
+	if err != nil {
+		slog.Default().Error("failed to create agent", "config", agentConfigPath, slog.Any("error", err))
+		os.Exit(1)
+	}
+	signalUsecase := app.NewSignalUseCaseImpl(signalRepo, masterRepo, stockAgent)
+
 	// 5. Goaサービスの実装を初期化
 	orderSvc := web.NewOrderService(orderUsecase, slog.Default(), appSession)
 	balanceSvc := web.NewBalanceService(balanceUsecase, slog.Default(), appSession)
 	positionSvc := web.NewPositionService(positionUsecase, slog.Default(), appSession)
-	masterSvc := web.NewMasterService(masterUsecase, slog.Default(), appSession)
+	masterSvc := web.NewMasterService(masterUsecase, fundamentalsUsecase, slog.Default(), appSession)
 	priceSvc := web.NewPriceService(priceUsecase, slog.Default(), appSession)
+	signalSvc := web.NewSignalService(signalUsecase, slog.Default())
+	newsSvc := web.NewNewsService(newsUsecase, slog.Default())
+	marginSvc := web.NewMarginService(marginUsecase, slog.Default())
+	portfolioSvc := web.NewPortfolioService(portfolioUsecase, slog.Default(), appSession)
+	tradeSvc := web.NewTradeService(tradeUsecase, slog.Default())
+	reportSvc := web.NewReportService(reportUsecase, slog.Default())
 
 	// 6. GoaのエンドポイントとHTTPハンドラを構築
 	wg := &sync.WaitGroup{}
@@ -146,6 +265,12 @@ func main() {
 	positionEndpoints := positiongen.NewEndpoints(positionSvc)
 	masterEndpoints := mastergen.NewEndpoints(masterSvc)
 	priceEndpoints := pricegen.NewEndpoints(priceSvc)
+	signalEndpoints := signalgen.NewEndpoints(signalSvc)
+	newsEndpoints := newsgen.NewEndpoints(newsSvc)
+	marginEndpoints := margingen.NewEndpoints(marginSvc)
+	portfolioEndpoints := portfoliogen.NewEndpoints(portfolioSvc)
+	tradeEndpoints := tradegen.NewEndpoints(tradeSvc)
+	reportEndpoints := reportgen.NewEndpoints(reportSvc)
 
 	mux := goahttp.NewMuxer()
 
@@ -154,12 +279,24 @@ func main() {
 	positionserver := positionsvr.New(positionEndpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil)
 	masterserver := mastersvr.New(masterEndpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil)
 	priceserver := pricesvr.New(priceEndpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil)
+	signalserver := signalsvr.New(signalEndpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil)
+	newsserver := newssvr.New(newsEndpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil)
+	marginserver := marginsvr.New(marginEndpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil)
+	portfolioserver := portfoliosvr.New(portfolioEndpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil)
+	tradeserver := tradesvr.New(tradeEndpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil)
+	reportserver := reportsvr.New(reportEndpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil)
 
 	ordersvr.Mount(mux, server)
 	balancesvr.Mount(mux, balanceserver)
 	positionsvr.Mount(mux, positionserver)
 	mastersvr.Mount(mux, masterserver)
 	pricesvr.Mount(mux, priceserver)
+	signalsvr.Mount(mux, signalserver)
+	newssvr.Mount(mux, newsserver)
+	marginsvr.Mount(mux, marginserver)
+	portfoliosvr.Mount(mux, portfolioserver)
+	tradesvr.Mount(mux, tradeserver)
+	reportsvr.Mount(mux, reportserver)
 
 	fs := http.FileServer(http.Dir("./gen/http/openapi"))
 	mux.Handle("GET", "/swagger/", http.HandlerFunc(http.StripPrefix("/swagger/", fs).ServeHTTP))
@@ -172,20 +309,59 @@ func main() {
 		os.Exit(1)
 	}
 
-	// 7-1. エージェントの初期化と起動
-	agentConfigPath := "agent_config.yaml" // TODO: コマンドライン引数で渡せるようにする
-	stockAgent, err := agent.NewAgent(agentConfigPath, goaTradeService)
-	if err != nil {
-		slog.Default().Error("failed to create agent", "config", agentConfigPath, slog.Any("error", err))
-		os.Exit(1)
-	}
+	// 7-1. エージェントの起動
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		stockAgent.Start()
 	}()
 
-	// 7-2. HTTPサーバーの起動
+	// 7-2. マスタデータの定期同期の起動
+	if masterSyncScheduler != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			masterSyncScheduler.Run(ctx)
+		}()
+	}
+
+	// 7-3. ニュースの定期取得の起動
+	if newsPoller != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			newsPoller.Run(ctx)
+		}()
+	}
+
+	// 7-4. 残高の定期記録の起動
+	if balanceMonitor != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			balanceMonitor.Run(ctx)
+		}()
+	}
+
+	// 7-5. 約定の定期取得の起動
+	if executionPoller != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			executionPoller.Run(ctx)
+		}()
+	}
+
+	// 7-6. 日次レポートの定期作成の起動
+	if dailyReportScheduler != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dailyReportScheduler.Run(ctx)
+		}()
+	}
+
+	// 7-7. HTTPサーバーの起動
 	srv := &http.Server{
 		Addr:    u.Host,
 		Handler: middleware.Log(goaLogger)(mux),
@@ -211,8 +387,9 @@ func main() {
 		slog.Default().Info(fmt.Sprintf("received signal %s, shutting down", sig))
 	}
 
-	// エージェントを停止
+	// エージェントとマスタデータの定期同期、ニュースの定期取得を停止
 	stockAgent.Stop()
+	cancel()
 
 	// サーバーを停止
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -224,3 +401,26 @@ func main() {
 	wg.Wait()
 	slog.Default().Info("shutdown complete")
 }
+
+// snapshotFundamentals は監視銘柄の投資指標のスナップショットを保存する。失敗してもログに出力して続行する
+func snapshotFundamentals(ctx context.Context, fundamentalsUsecase app.FundamentalsUseCase, session *client.Session, symbols []string) {
+	if len(symbols) == 0 {
+		return
+	}
+	stored, err := fundamentalsUsecase.SnapshotFundamentals(ctx, session, symbols)
+	if err != nil {
+		slog.Default().Error("failed to snapshot fundamentals", slog.Int("stored", stored), slog.Any("error", err))
+		return
+	}
+	slog.Default().Info("fundamentals snapshot completed", slog.Int("stored", stored))
+}
+
+// collectMarginInfo は監視銘柄の証金残・信用残・逆日歩を保存する。失敗してもログに出力して続行する
+func collectMarginInfo(ctx context.Context, marginUsecase app.MarginUseCase) {
+	summary, err := marginUsecase.CollectMarginInfo(ctx)
+	if err != nil {
+		slog.Default().Error("failed to collect margin info", slog.Any("error", err))
+		return
+	}
+	slog.Default().Info("margin info collection completed", slog.Int("securities_finance", summary.SecuritiesFinance), slog.Int("credit", summary.Credit), slog.Int("premiums", summary.Premiums), slog.Int("malformed_premiums", summary.MalformedPremiums))
+}
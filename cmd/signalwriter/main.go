@@ -0,0 +1,155 @@
+// cmd/signalwriter/main.go
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"stock-bot/internal/agent"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// signalwriter はエージェントが読み込むシグナルファイルを書き出すツール
+//
+// 入力CSVの列: symbol,side,limit_price,stop_price,target_price,weight,valid_until
+//   - side は BUY または SELL
+//   - limit_price 以降は省略可能 (空欄は指定なし)
+//   - valid_until は RFC3339 形式
+//
+// 使用例:
+//
+//	go run ./cmd/signalwriter -in signals.csv -out ./signals/signal_20251222.bin
+//	go run ./cmd/signalwriter -dummy -version 1
+func main() {
+	in := flag.String("in", "", "入力CSVファイル (\"-\" の場合は標準入力)")
+	out := flag.String("out", filepath.Join("signals", "test_signal.bin"), "出力するシグナルファイルのパス")
+	version := flag.Int("version", agent.SignalFormatV2, "出力フォーマットのバージョン (1 または 2)")
+	generatedAt := flag.String("generated-at", "", "シグナルの生成日時 (RFC3339, 省略時は現在時刻)")
+	dummy := flag.Bool("dummy", false, "動作確認用のダミーシグナルを書き出す")
+	flag.Parse()
+
+	var records []*agent.SignalRecord
+	switch {
+	case *dummy:
+		records = dummyRecords()
+	case *in != "":
+		var err error
+		records, err = readRecords(*in)
+		if err != nil {
+			log.Fatalf("Failed to read input: %v", err)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	genAt := time.Now()
+	if *generatedAt != "" {
+		t, err := time.Parse(time.RFC3339, *generatedAt)
+		if err != nil {
+			log.Fatalf("Invalid -generated-at: %v", err)
+		}
+		genAt = t
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*out), 0755); err != nil {
+		log.Fatalf("Failed to create output directory: %v", err)
+	}
+	if err := agent.WriteSignalFile(*out, *version, genAt, records); err != nil {
+		log.Fatalf("Failed to write signal file: %v", err)
+	}
+
+	fmt.Printf("Successfully wrote %d signals to %s (format v%d)\n", len(records), *out, *version)
+}
+
+// dummyRecords は動作確認用のダミーシグナルを返す
+func dummyRecords() []*agent.SignalRecord {
+	return []*agent.SignalRecord{
+		{Symbol: "7203", Signal: agent.BuySignal},  // トヨタ BUY
+		{Symbol: "9984", Signal: agent.SellSignal}, // ソフトバンクG SELL
+		{Symbol: "6758", Signal: agent.BuySignal},  // ソニー BUY
+	}
+}
+
+// readRecords はCSVからシグナルレコードを読み込む
+func readRecords(path string) ([]*agent.SignalRecord, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var records []*agent.SignalRecord
+	for line := 1; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// ヘッダー行は読み飛ばす
+		if line == 1 && strings.EqualFold(row[0], "symbol") {
+			continue
+		}
+		record, err := parseRecord(row)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// parseRecord はCSVの1行をシグナルレコードに変換する
+func parseRecord(row []string) (*agent.SignalRecord, error) {
+	if len(row) < 2 {
+		return nil, fmt.Errorf("expected at least symbol and side, got %d columns", len(row))
+	}
+
+	record := &agent.SignalRecord{Symbol: strings.ToUpper(strings.TrimSpace(row[0]))}
+	switch strings.ToUpper(strings.TrimSpace(row[1])) {
+	case "BUY":
+		record.Signal = agent.BuySignal
+	case "SELL":
+		record.Signal = agent.SellSignal
+	default:
+		return nil, fmt.Errorf("invalid side %q", row[1])
+	}
+
+	floats := []*float64{&record.LimitPrice, &record.StopPrice, &record.TargetPrice, &record.Weight}
+	for i, dst := range floats {
+		col := i + 2
+		if col >= len(row) || strings.TrimSpace(row[col]) == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(row[col]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q in column %d: %w", row[col], col+1, err)
+		}
+		*dst = v
+	}
+
+	if len(row) > 6 && strings.TrimSpace(row[6]) != "" {
+		t, err := time.Parse(time.RFC3339, strings.TrimSpace(row[6]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid valid_until %q: %w", row[6], err)
+		}
+		record.ValidUntil = t
+	}
+	return record, nil
+}
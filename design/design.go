@@ -50,10 +50,15 @@ var _ = Service("order", func() {
             Required("order_id")
         })
 
+        Error("invalid_order", ErrorResult, "注文内容が不正 (値幅制限の範囲外など)")
+        Error("margin_entry_blocked", ErrorResult, "委託保証金率が追証の水準に近いため新規信用建を停止中")
+
         // HTTPプロトコルとのマッピング
         HTTP(func() {
             POST("/order")
             Response(StatusCreated)
+            Response("invalid_order", StatusBadRequest)
+            Response("margin_entry_blocked", StatusConflict)
         })
     })
 })
@@ -66,15 +71,106 @@ var BalanceResult = ResultType("application/vnd.stockbot.balance", func() {
     Attribute("margin_maintenance_rate", Float64, "委託保証金率(%)")
     Attribute("withdrawable_cash", Float64, "出金可能額")
     Attribute("has_margin_call", Boolean, "追証発生フラグ (1:発生, 0:未発生)")
+    Attribute("margin_delivery_capacity", Float64, "信用現引可能額")
+    Attribute("nisa_growth_capacity", Float64, "NISA成長投資可能額")
+    Attribute("shortfall", Float64, "不足額 (入金請求額)")
+    Attribute("has_advance", Boolean, "立替金が発生しているかどうか")
+    Attribute("updated_at", String, "証券会社側の更新日時 (RFC3339)")
     Required(
         "available_cash_for_stock",
         "available_margin_for_new_position",
         "margin_maintenance_rate",
         "withdrawable_cash",
         "has_margin_call",
+        "margin_delivery_capacity",
+        "nisa_growth_capacity",
+        "shortfall",
+        "has_advance",
     )
 })
 
+// Goa Type for a business day of the balance history (可能額推移)
+var BalanceDayResult = Type("BalanceDayResult", func() {
+    Description("The balance of a business day reported by the broker.")
+    Attribute("date", String, "日付 (YYYYMMDD)")
+    Attribute("cash_buying_power", Float64, "現物株式買付可能額")
+    Attribute("margin_buying_power", Float64, "信用新規建可能額")
+    Attribute("withdrawable_cash", Float64, "出金可能額")
+    Attribute("deposited_margin", Float64, "受入保証金")
+    Attribute("required_margin", Float64, "必要保証金")
+    Attribute("maintenance_rate", Float64, "委託保証金率(%)")
+    Attribute("margin_call_surplus", Float64, "追証余力")
+    Attribute("shortfall", Float64, "追証/立替金/保証金不足額")
+    Required("date", "cash_buying_power", "margin_buying_power", "withdrawable_cash", "deposited_margin", "required_margin", "maintenance_rate", "margin_call_surplus", "shortfall")
+})
+
+// Goa Type for a stored balance snapshot
+var BalanceSnapshotResult = Type("BalanceSnapshotResult", func() {
+    Description("A balance snapshot taken by the balance monitor.")
+    Attribute("taken_at", String, "取得した日時 (RFC3339)")
+    Attribute("cash_buying_power", Float64, "現物株式買付可能額")
+    Attribute("margin_buying_power", Float64, "信用新規建可能額")
+    Attribute("withdrawable_cash", Float64, "出金可能額")
+    Attribute("deposited_margin", Float64, "受入保証金")
+    Attribute("position_value", Float64, "建株代金")
+    Attribute("valuation_profit_loss", Float64, "評価損益")
+    Attribute("maintenance_rate", Float64, "委託保証金率(%) (リアルタイム)")
+    Attribute("margin_call_surplus", Float64, "追証余力")
+    Attribute("has_margin_call", Boolean, "追証が発生しているかどうか")
+    Required("taken_at", "cash_buying_power", "margin_buying_power", "withdrawable_cash", "deposited_margin", "position_value", "valuation_profit_loss", "maintenance_rate", "margin_call_surplus", "has_margin_call")
+})
+
+// Goa Type for the balance history
+var BalanceHistoryResult = ResultType("application/vnd.stockbot.balance-history", func() {
+    Description("The daily balance history of the last 6 business days and the stored snapshots, newest first.")
+    Attribute("daily", ArrayOf(BalanceDayResult), "可能額推移 (過去6営業日)")
+    Attribute("snapshots", ArrayOf(BalanceSnapshotResult), "保存済みの残高スナップショット")
+    Required("daily", "snapshots")
+})
+
+// Goa Type for the cash buying power details of a business day (現物株式買付可能額詳細)
+var BuyingPowerResult = ResultType("application/vnd.stockbot.buying-power", func() {
+    Description("The breakdown of the cash buying power on a business day.")
+    Attribute("day", Int, "営業日インデックス (0:T+0 〜 5:T+5)")
+    Attribute("date", String, "日付 (YYYYMMDD)")
+    Attribute("cash_buying_power", Float64, "現物株式買付可能額")
+    Attribute("margin_buying_power", Float64, "保証金からの現物株式買付可能額")
+    Attribute("deposit_balance", Float64, "預り金")
+    Attribute("order_reserved", Float64, "発注済み注文充当金")
+    Attribute("day_trade_restricted", Float64, "日計り拘束金")
+    Attribute("other_restricted", Float64, "その他拘束金")
+    Attribute("cash_margin", Float64, "現金保証金")
+    Attribute("required_cash_margin", Float64, "必要現金保証金")
+    Attribute("collateral_value", Float64, "代用証券評価額")
+    Attribute("position_profit_loss", Float64, "建株評価損益")
+    Attribute("deposited_margin", Float64, "受入保証金")
+    Attribute("required_margin", Float64, "必要保証金")
+    Attribute("margin_surplus", Float64, "保証金余力")
+    Required("day", "date", "cash_buying_power", "margin_buying_power", "deposit_balance", "order_reserved", "day_trade_restricted", "other_restricted", "cash_margin", "required_cash_margin", "collateral_value", "position_profit_loss", "deposited_margin", "required_margin", "margin_surplus")
+})
+
+// Goa Type for the margin buying power details of a business day (信用新規建可能額詳細)
+var MarginCapacityResult = ResultType("application/vnd.stockbot.margin-capacity", func() {
+    Description("The breakdown of the buying power for new margin positions on a business day.")
+    Attribute("day", Int, "営業日インデックス (0:T+0 〜 5:T+5)")
+    Attribute("date", String, "日付 (YYYYMMDD)")
+    Attribute("margin_buying_power", Float64, "信用新規建可能額")
+    Attribute("deposited_margin", Float64, "受入保証金")
+    Attribute("required_margin", Float64, "必要保証金")
+    Attribute("margin_surplus", Float64, "保証金余力")
+    Attribute("collection_rate", Float64, "保証金徴収率(%)")
+    Attribute("deposit_balance", Float64, "預り金")
+    Attribute("order_reserved", Float64, "発注済み注文充当金")
+    Attribute("cash_margin", Float64, "現金保証金")
+    Attribute("collateral_value", Float64, "代用証券評価額")
+    Attribute("position_value", Float64, "建株代金")
+    Attribute("order_position_value", Float64, "発注分建株代金")
+    Attribute("position_profit_loss", Float64, "建株評価損益")
+    Attribute("maintenance_rate", Float64, "委託保証金率(%)")
+    Attribute("today_profit_loss", Float64, "本日決済損益合計")
+    Required("day", "date", "margin_buying_power", "deposited_margin", "required_margin", "margin_surplus", "collection_rate", "deposit_balance", "order_reserved", "cash_margin", "collateral_value", "position_value", "order_position_value", "position_profit_loss", "maintenance_rate", "today_profit_loss")
+})
+
 // 残高サービス(Balance)の定義
 var _ = Service("balance", func() {
     Description("The balance service provides account balance information.")
@@ -90,6 +186,67 @@ var _ = Service("balance", func() {
             Response(StatusOK)
         })
     })
+
+    // GET /balance/history
+    Method("history", func() {
+        Description("Get the daily balance history from the broker and the stored balance snapshots.")
+        Payload(func() {
+            Attribute("since", String, "この日時以降のスナップショットに絞り込む (RFC3339)", func() {
+                Format(FormatDateTime)
+            })
+            Attribute("limit", Int, "スナップショットの取得件数", func() {
+                Minimum(1)
+                Maximum(1000)
+                Default(100)
+            })
+        })
+        Result(BalanceHistoryResult)
+
+        HTTP(func() {
+            GET("/balance/history")
+            Param("since")
+            Param("limit")
+            Response(StatusOK)
+        })
+    })
+
+    // GET /balance/buying-power
+    Method("buying_power", func() {
+        Description("Get the breakdown of the cash buying power on a business day.")
+        Payload(func() {
+            Attribute("day", Int, "営業日インデックス (0:T+0 〜 5:T+5)", func() {
+                Minimum(0)
+                Maximum(5)
+                Default(0)
+            })
+        })
+        Result(BuyingPowerResult)
+
+        HTTP(func() {
+            GET("/balance/buying-power")
+            Param("day")
+            Response(StatusOK)
+        })
+    })
+
+    // GET /balance/margin-capacity
+    Method("margin_capacity", func() {
+        Description("Get the breakdown of the buying power for new margin positions on a business day.")
+        Payload(func() {
+            Attribute("day", Int, "営業日インデックス (0:T+0 〜 5:T+5)", func() {
+                Minimum(0)
+                Maximum(5)
+                Default(0)
+            })
+        })
+        Result(MarginCapacityResult)
+
+        HTTP(func() {
+            GET("/balance/margin-capacity")
+            Param("day")
+            Response(StatusOK)
+        })
+    })
 })
 
 // Goa Type for Stock Price Result
@@ -134,6 +291,7 @@ var PositionResult = Type("PositionResult", func() {
     Attribute("unrealized_pl", Float64, "評価損益")
     Attribute("unrealized_pl_rate", Float64, "評価損益率(%)")
     Attribute("opened_date", String, "建日 (信用取引の場合 YYYYMMDD)")
+    Attribute("parse_errors", ArrayOf(String), "解析できなかった値 (該当する項目は 0)")
 
     Required("symbol", "position_type", "quantity", "average_cost")
 })
@@ -169,6 +327,72 @@ var _ = Service("position", func() {
     })
 })
 
+// Goa Type for the totals of the portfolio
+var PortfolioTotalsResult = Type("PortfolioTotalsResult", func() {
+    Description("The aggregate of all positions.")
+    Attribute("position_count", Int, "ポジション数")
+    Attribute("market_value", Float64, "評価額の合計 (買建と売建の合計)")
+    Attribute("long_value", Float64, "買いポジションの評価額")
+    Attribute("short_value", Float64, "売建の評価額")
+    Attribute("net_exposure", Float64, "買いポジションの評価額 - 売建の評価額")
+    Attribute("cost_basis", Float64, "取得金額の合計")
+    Attribute("unrealized_pl", Float64, "評価損益の合計")
+    Required("position_count", "market_value", "long_value", "short_value", "net_exposure", "cost_basis", "unrealized_pl")
+})
+
+// Goa Type for the exposure of a group of positions
+var PortfolioExposureResult = Type("PortfolioExposureResult", func() {
+    Description("The exposure of a position type or an industry.")
+    Attribute("code", String, "ポジション種別または業種コード (業種が不明な場合は空)")
+    Attribute("name", String, "名称")
+    Attribute("position_count", Int, "ポジション数")
+    Attribute("market_value", Float64, "評価額")
+    Attribute("weight", Float64, "評価額の合計に占める割合 (0〜1)")
+    Attribute("unrealized_pl", Float64, "評価損益")
+    Required("code", "name", "position_count", "market_value", "weight", "unrealized_pl")
+})
+
+// Goa Type for the concentration metrics of the portfolio
+var PortfolioConcentrationResult = Type("PortfolioConcentrationResult", func() {
+    Description("How concentrated the portfolio is by symbol.")
+    Attribute("largest_symbol", String, "評価額が最も大きい銘柄")
+    Attribute("largest_weight", Float64, "評価額が最も大きい銘柄の割合 (0〜1)")
+    Attribute("top5_weight", Float64, "評価額の上位5銘柄の割合 (0〜1)")
+    Attribute("hhi", Float64, "銘柄ごとの割合のハーフィンダール・ハーシュマン指数 (0〜1)")
+    Required("largest_symbol", "largest_weight", "top5_weight", "hhi")
+})
+
+// Goa Type for the portfolio analytics
+var PortfolioResult = ResultType("application/vnd.stockbot.portfolio", func() {
+    Description("Portfolio analytics built from the broker's positions and the stored executions.")
+    Attribute("positions", ArrayOf(PositionResult), "保有ポジションのリスト")
+    Attribute("totals", PortfolioTotalsResult, "合計")
+    Attribute("by_type", ArrayOf(PortfolioExposureResult), "現物・信用買・信用売ごとの評価額")
+    Attribute("by_industry", ArrayOf(PortfolioExposureResult), "業種ごとの評価額 (評価額の大きい順)")
+    Attribute("concentration", PortfolioConcentrationResult, "銘柄の集中度")
+    Attribute("realized_pl", Float64, "保存済みの約定から移動平均法で計算した実現損益 (手数料控除後)")
+    Attribute("realized_pl_today", Float64, "本日の実現損益")
+    Attribute("parse_errors", ArrayOf(String), "解析できなかった値 (合計では 0 として扱う)")
+    Required("positions", "totals", "by_type", "by_industry", "concentration", "realized_pl", "realized_pl_today", "parse_errors")
+})
+
+// ポートフォリオ分析サービス(Portfolio)の定義
+var _ = Service("portfolio", func() {
+    Description("The portfolio service provides analytics over the current holdings.")
+
+    // GET /portfolio
+    Method("get", func() {
+        Description("Get the totals, exposures, concentration and realized P&L of the portfolio.")
+        Payload(Empty)
+        Result(PortfolioResult)
+
+        HTTP(func() {
+            GET("/portfolio")
+            Response(StatusOK)
+        })
+    })
+})
+
 // Goa Type for Stock Master Data (simplified)
 var StockMasterResult = ResultType("application/vnd.stockbot.stock-master", func() {
     Description("Basic master data for a single stock.")
@@ -178,10 +402,112 @@ var StockMasterResult = ResultType("application/vnd.stockbot.stock-master", func
     Attribute("market", String, "優先市場")
     Attribute("industry_code", String, "業種コード")
     Attribute("industry_name", String, "業種コード名")
+    Attribute("upper_limit", Float64, "値幅上限 (ストップ高)")
+    Attribute("lower_limit", Float64, "値幅下限 (ストップ安)")
+    Attribute("trading_unit", Int, "売買単位")
 
     Required("symbol", "name", "market") // Minimal required fields
 })
 
+// Goa Type for the fundamentals of a stock
+var StockFundamentalsResult = ResultType("application/vnd.stockbot.stock-fundamentals", func() {
+    Description("A daily fundamentals snapshot of a stock and the ratios derived from the latest price. Values that are not reported are omitted.")
+    Attribute("symbol", String, "銘柄コード")
+    Attribute("snapshot_date", String, "スナップショットの日付 (YYYYMMDD)")
+    Attribute("fetched_at", String, "スナップショットを取得した日時 (RFC3339)")
+    Attribute("bps", Float64, "一株資産 (実績・連結)")
+    Attribute("eps", Float64, "一株利益 (予想・通期連結)")
+    Attribute("roe", Float64, "ROE (予想, %)")
+    Attribute("dividend_per_share", Float64, "一株配当 (予想, 配当利回りから算出)")
+    Attribute("reported_per", Float64, "PER (予想, 取得時点)")
+    Attribute("reported_pbr", Float64, "PBR (実績, 取得時点)")
+    Attribute("reported_dividend_yield", Float64, "配当利回り (予想, %, 取得時点)")
+    Attribute("earnings_yield", Float64, "株式益回り (予想, %)")
+    Attribute("ex_dividend_date", String, "配当権利落日 (本決算, YYYYMMDD)")
+    Attribute("interim_ex_dividend_date", String, "中間配当権利落日 (YYYYMMDD)")
+    Attribute("last_ex_rights_date", String, "最終落日 (決算期以外, YYYYMMDD)")
+    Attribute("year_high", Float64, "年初来高値")
+    Attribute("year_high_date", String, "年初来高値の更新日 (YYYYMMDD)")
+    Attribute("year_low", Float64, "年初来安値")
+    Attribute("year_low_date", String, "年初来安値の更新日 (YYYYMMDD)")
+    Attribute("price", Float64, "指標の算出に使用した現在値 (取得できない場合は省略)")
+    Attribute("per", Float64, "現在値による PER")
+    Attribute("pbr", Float64, "現在値による PBR")
+    Attribute("dividend_yield", Float64, "現在値による配当利回り (%)")
+
+    Required("symbol", "snapshot_date", "fetched_at")
+})
+
+// Goa Type for a page of stock master search results
+var StockMasterPage = ResultType("application/vnd.stockbot.stock-master-page", func() {
+    Description("A page of stock master search results.")
+    Attribute("stocks", ArrayOf(StockMasterResult), "銘柄マスタのリスト")
+    Attribute("total", Int64, "検索条件に一致する銘柄の総数")
+    Attribute("offset", Int, "取得開始位置")
+    Attribute("limit", Int, "取得件数")
+    Required("stocks", "total", "offset", "limit")
+})
+
+// Goa Type for an industry with the number of stocks
+var IndustryResult = Type("IndustryResult", func() {
+    Description("An industry and the number of stocks in it.")
+    Attribute("industry_code", String, "業種コード")
+    Attribute("industry_name", String, "業種コード名")
+    Attribute("count", Int64, "銘柄数")
+    Required("industry_code", "industry_name", "count")
+})
+
+// Goa Type for the list of industries
+var IndustryCollection = ResultType("application/vnd.stockbot.industry-collection", func() {
+    Description("A collection of industries.")
+    Attribute("industries", ArrayOf(IndustryResult), "業種のリスト")
+    Required("industries")
+})
+
+// Goa Type for the number of records changed in a master table
+var MasterSyncCounts = Type("MasterSyncCounts", func() {
+    Description("The number of records a master data sync changed in one table.")
+    Attribute("inserted", Int, "新規に追加した件数")
+    Attribute("updated", Int, "更新した件数")
+    Attribute("unchanged", Int, "変更がなかった件数")
+    Attribute("deleted", Int, "論理削除した件数")
+    Required("inserted", "updated", "unchanged", "deleted")
+})
+
+// Goa Type for the summary of a master data sync
+var MasterSyncSummary = ResultType("application/vnd.stockbot.master-sync-summary", func() {
+    Description("The changes made by a master data sync.")
+    Attribute("run_id", UInt, "同期の実行履歴ID")
+    Attribute("scope", String, "同期範囲 (watched, full)")
+    Attribute("stocks", MasterSyncCounts, "銘柄マスタ")
+    Attribute("stock_markets", MasterSyncCounts, "株式銘柄市場マスタ")
+    Attribute("tick_rules", MasterSyncCounts, "呼値")
+    Attribute("margin_masters", MasterSyncCounts, "保証金マスタ")
+    Attribute("regulations", MasterSyncCounts, "銘柄別・市場別規制")
+    Attribute("operation_statuses", MasterSyncCounts, "運用ステータス")
+    Required("run_id", "scope", "stocks", "stock_markets", "tick_rules", "margin_masters", "regulations", "operation_statuses")
+})
+
+// Goa Type for a recorded master data sync run
+var MasterSyncRun = Type("MasterSyncRun", func() {
+    Description("A recorded master data sync run.")
+    Attribute("id", UInt, "同期の実行履歴ID")
+    Attribute("trigger", String, "同期の契機 (startup, scheduled, manual)")
+    Attribute("status", String, "同期の状態 (running, succeeded, failed)")
+    Attribute("started_at", String, "開始日時 (RFC3339)")
+    Attribute("finished_at", String, "終了日時 (RFC3339, 実行中は省略)")
+    Attribute("error", String, "失敗した場合のエラー内容")
+    Attribute("summary", MasterSyncSummary, "反映した件数 (失敗した場合は失敗するまでに反映した件数)")
+    Required("id", "trigger", "status", "started_at", "summary")
+})
+
+// Goa Type for the list of master data sync runs
+var MasterSyncRunCollection = ResultType("application/vnd.stockbot.master-sync-run-collection", func() {
+    Description("A collection of master data sync runs, newest first.")
+    Attribute("runs", ArrayOf(MasterSyncRun), "同期の実行履歴のリスト")
+    Required("runs")
+})
+
 // マスタデータサービス(Master)の定義
 var _ = Service("master", func() {
     Description("The master service provides master data.")
@@ -201,15 +527,605 @@ var _ = Service("master", func() {
         })
     })
 
+    // GET /master/stocks/{symbol}/fundamentals
+    Method("get_fundamentals", func() {
+        Description("Get today's fundamentals snapshot (BPS, EPS, dividends) of a stock with PER, PBR and dividend yield derived from the latest price.")
+        Payload(func() {
+            Attribute("symbol", String, "銘柄コード")
+            Required("symbol")
+        })
+        Result(StockFundamentalsResult)
+
+        Error("not_found", ErrorResult, "投資指標が見つからない")
+
+        HTTP(func() {
+            GET("/master/stocks/{symbol}/fundamentals")
+            Response(StatusOK)
+            Response("not_found", StatusNotFound)
+        })
+    })
+
+    // GET /master/stocks
+    Method("list_stocks", func() {
+        Description("Search stock master data with filters and paging, ordered by symbol.")
+        Payload(func() {
+            Attribute("market", String, "優先市場コードで絞り込む")
+            Attribute("industry_code", String, "業種コードで絞り込む")
+            Attribute("q", String, "銘柄名・銘柄名（カナ）の部分一致で絞り込む")
+            Attribute("trading_unit", Int, "売買単位で絞り込む", func() {
+                Minimum(1)
+            })
+            Attribute("offset", Int, "取得開始位置", func() {
+                Minimum(0)
+                Default(0)
+            })
+            Attribute("limit", Int, "取得件数", func() {
+                Minimum(1)
+                Maximum(1000)
+                Default(100)
+            })
+        })
+        Result(StockMasterPage)
+
+        HTTP(func() {
+            GET("/master/stocks")
+            Param("market")
+            Param("industry_code")
+            Param("q")
+            Param("trading_unit")
+            Param("offset")
+            Param("limit")
+            Response(StatusOK)
+        })
+    })
+
+    // GET /master/industries
+    Method("list_industries", func() {
+        Description("List industries with the number of stocks in each.")
+        Payload(Empty)
+        Result(IndustryCollection)
+
+        HTTP(func() {
+            GET("/master/industries")
+            Response(StatusOK)
+        })
+    })
+
     // POST /master/update
     Method("update", func() {
-        Description("Trigger a manual update of the master data.")
+        Description("Trigger a manual update of the master data and report the changes.")
         Payload(Empty)
-        Result(Empty) // 成功したかどうかはHTTPステータスで判断
+        Result(MasterSyncSummary)
 
         HTTP(func() {
             POST("/master/update")
             Response(StatusAccepted) // 処理を受け付けたことを示す
         })
     })
+
+    // GET /master/sync-runs
+    Method("list_sync_runs", func() {
+        Description("List the most recent master data sync runs, newest first.")
+        Payload(func() {
+            Attribute("limit", Int, "取得件数", func() {
+                Minimum(1)
+                Maximum(100)
+                Default(20)
+            })
+        })
+        Result(MasterSyncRunCollection)
+
+        HTTP(func() {
+            GET("/master/sync-runs")
+            Param("limit")
+            Response(StatusOK)
+        })
+    })
+})
+
+// Goa Type for a single incoming signal
+var SignalInput = Type("SignalInput", func() {
+    Description("A single trading signal to ingest.")
+    Attribute("symbol", String, "銘柄コード", func() {
+        MinLength(1)
+        MaxLength(16)
+    })
+    Attribute("side", String, "売買区分 (BUY/SELL)", func() {
+        Enum("BUY", "SELL")
+    })
+    Attribute("limit_price", Float64, "指値 (省略時は成行)", func() {
+        Minimum(0)
+    })
+    Attribute("stop_price", Float64, "損切り価格", func() {
+        Minimum(0)
+    })
+    Attribute("target_price", Float64, "利確目標価格", func() {
+        Minimum(0)
+    })
+    Attribute("weight", Float64, "資金配分の重み (0〜1、省略時は1)", func() {
+        Minimum(0)
+        Maximum(1)
+    })
+    Attribute("valid_until", String, "有効期限 (RFC3339)", func() {
+        Format(FormatDateTime)
+    })
+    Attribute("rationale", String, "シグナルの根拠")
+
+    Required("symbol", "side")
+})
+
+// Goa Type for a rejected signal
+var SignalRejection = Type("SignalRejection", func() {
+    Description("A signal that was not accepted.")
+    Attribute("index", Int, "リクエスト内での位置 (0始まり)")
+    Attribute("symbol", String, "銘柄コード")
+    Attribute("reason", String, "却下理由")
+
+    Required("index", "symbol", "reason")
+})
+
+// Goa Type for the result of a signal ingestion
+var SignalIngestResult = ResultType("application/vnd.stockbot.signal-ingest", func() {
+    Description("The result of a signal ingestion.")
+    Attribute("accepted", Int, "受け付けたシグナル数")
+    Attribute("signal_ids", ArrayOf(UInt), "受け付けたシグナルのID")
+    Attribute("rejected", ArrayOf(SignalRejection), "却下されたシグナル")
+
+    Required("accepted", "signal_ids", "rejected")
+})
+
+// Goa Type for a stored signal
+var SignalResult = Type("SignalResult", func() {
+    Description("A stored trading signal.")
+    Attribute("id", UInt, "シグナルID")
+    Attribute("symbol", String, "銘柄コード")
+    Attribute("side", String, "売買区分 (BUY/SELL)")
+    Attribute("generated_at", String, "シグナル生成日時 (RFC3339)")
+    Attribute("rationale", String, "シグナルの根拠")
+    Attribute("limit_price", Float64, "指値")
+    Attribute("stop_price", Float64, "損切り価格")
+    Attribute("target_price", Float64, "利確目標価格")
+    Attribute("weight", Float64, "資金配分の重み")
+    Attribute("valid_until", String, "有効期限 (RFC3339)")
+    Attribute("source", String, "取り込み元 (FILE/HTTP)")
+    Attribute("source_file", String, "取り込み元ファイル")
+    Attribute("consumed_at", String, "エージェントが処理した日時 (RFC3339)")
+
+    Required("id", "symbol", "side", "generated_at", "source")
+})
+
+// Goa Type for a collection of Signals
+var SignalCollection = ResultType("application/vnd.stockbot.signal-collection", func() {
+    Description("A collection of trading signals.")
+    Attribute("signals", ArrayOf(SignalResult), "シグナルのリスト")
+    Required("signals")
+})
+
+// シグナルサービス(Signal)の定義
+var _ = Service("signal", func() {
+    Description("The signal service ingests trading signals and exposes their history.")
+
+    // POST /signals
+    Method("create", func() {
+        Description("Ingest a batch of trading signals.")
+        Payload(func() {
+            Attribute("signals", ArrayOf(SignalInput), "シグナルのリスト", func() {
+                MinLength(1)
+                MaxLength(1000)
+            })
+            Attribute("generated_at", String, "シグナル生成日時 (RFC3339, 省略時は受信日時)", func() {
+                Format(FormatDateTime)
+            })
+            Required("signals")
+        })
+        Result(SignalIngestResult)
+
+        HTTP(func() {
+            POST("/signals")
+            Response(StatusCreated)
+        })
+    })
+
+    // GET /signals
+    Method("list", func() {
+        Description("List received signals, newest first.")
+        Payload(func() {
+            Attribute("symbol", String, "銘柄コードで絞り込む")
+            Attribute("limit", Int, "取得件数", func() {
+                Minimum(1)
+                Maximum(1000)
+                Default(100)
+            })
+        })
+        Result(SignalCollection)
+
+        HTTP(func() {
+            GET("/signals")
+            Param("symbol")
+            Param("limit")
+            Response(StatusOK)
+        })
+    })
+})
+
+// Goa Type for a news item
+var NewsResult = Type("NewsResult", func() {
+    Description("A news item (including timely disclosures).")
+    Attribute("id", String, "ニュースID")
+    Attribute("published_at", String, "ニュース日時 (RFC3339)")
+    Attribute("categories", ArrayOf(String), "ニュースカテゴリ")
+    Attribute("genres", ArrayOf(String), "ニュースジャンル")
+    Attribute("symbols", ArrayOf(String), "関連銘柄コード")
+    Attribute("headline", String, "ヘッドライン")
+    Attribute("body", String, "本文 (未取得の場合は省略)")
+
+    Required("id", "published_at", "categories", "genres", "symbols", "headline")
+})
+
+// Goa Type for a collection of news items
+var NewsCollection = ResultType("application/vnd.stockbot.news-collection", func() {
+    Description("A collection of news items.")
+    Attribute("news", ArrayOf(NewsResult), "ニュースのリスト")
+    Required("news")
+})
+
+// ニュースサービス(News)の定義
+var _ = Service("news", func() {
+    Description("The news service exposes news headlines and bodies polled for the watched stocks.")
+
+    // GET /news
+    Method("list", func() {
+        Description("List stored news, newest first. The body is not included.")
+        Payload(func() {
+            Attribute("symbol", String, "関連銘柄コードで絞り込む")
+            Attribute("since", String, "この日時以降のニュースに絞り込む (RFC3339)", func() {
+                Format(FormatDateTime)
+            })
+            Attribute("limit", Int, "取得件数", func() {
+                Minimum(1)
+                Maximum(500)
+                Default(50)
+            })
+        })
+        Result(NewsCollection)
+
+        HTTP(func() {
+            GET("/news")
+            Param("symbol")
+            Param("since")
+            Param("limit")
+            Response(StatusOK)
+        })
+    })
+
+    // GET /news/{id}
+    Method("get", func() {
+        Description("Get a news item with its body. The body is fetched from the broker if it has not been stored yet.")
+        Payload(func() {
+            Attribute("id", String, "ニュースID")
+            Required("id")
+        })
+        Result(NewsResult)
+
+        Error("not_found", ErrorResult, "ニュースが見つからない")
+
+        HTTP(func() {
+            GET("/news/{id}")
+            Response(StatusOK)
+            Response("not_found", StatusNotFound)
+        })
+    })
+})
+
+// Goa Type for a securities finance balance (証金残)
+var SecuritiesFinanceBalanceResult = Type("SecuritiesFinanceBalanceResult", func() {
+    Description("Daily balance at the securities finance company (証金残).")
+    Attribute("date", String, "証金更新日 (YYYYMMDD)")
+    Attribute("preliminary", Boolean, "速報の場合は true、確報の場合は false")
+    Attribute("loan_balance", Int64, "融資残")
+    Attribute("loan_change", Int64, "融資前日比")
+    Attribute("stock_loan_balance", Int64, "貸株残")
+    Attribute("stock_loan_change", Int64, "貸株前日比")
+    Attribute("net_balance", Int64, "差引残")
+    Attribute("net_change", Int64, "差引残前日比")
+    Attribute("loan_ratio", Float64, "貸借倍率")
+    Attribute("turnover_days", Float64, "回転日数")
+
+    Required("date", "preliminary", "loan_balance", "loan_change", "stock_loan_balance", "stock_loan_change", "net_balance", "net_change", "loan_ratio", "turnover_days")
+})
+
+// Goa Type for a credit balance (信用残)
+var CreditBalanceResult = Type("CreditBalanceResult", func() {
+    Description("Weekly margin trading balance (信用残, 制度と一般の合算).")
+    Attribute("date", String, "信用残日付 (YYYYMMDD)")
+    Attribute("long_balance", Int64, "買残")
+    Attribute("long_change", Int64, "買残前週比")
+    Attribute("short_balance", Int64, "売残")
+    Attribute("short_change", Int64, "売残前週比")
+    Attribute("ratio", Float64, "信用倍率")
+
+    Required("date", "long_balance", "long_change", "short_balance", "short_change", "ratio")
+})
+
+// Goa Type for a margin premium (逆日歩)
+var MarginPremiumResult = Type("MarginPremiumResult", func() {
+    Description("Daily margin premium (逆日歩).")
+    Attribute("date", String, "取得した日 (YYYYMMDD)")
+    Attribute("premium", Float64, "逆日歩 (1株あたり・円)")
+
+    Required("date", "premium")
+})
+
+// Goa Type for the margin data of a stock
+var MarginInfoResult = ResultType("application/vnd.stockbot.margin-info", func() {
+    Description("Margin and short-interest time series of a stock, newest first.")
+    Attribute("symbol", String, "銘柄コード")
+    Attribute("securities_finance", ArrayOf(SecuritiesFinanceBalanceResult), "証金残")
+    Attribute("credit", ArrayOf(CreditBalanceResult), "信用残")
+    Attribute("premiums", ArrayOf(MarginPremiumResult), "逆日歩")
+
+    Required("symbol", "securities_finance", "credit", "premiums")
+})
+
+// Goa Type for the result of a margin data collection
+var MarginCollectResult = ResultType("application/vnd.stockbot.margin-collect", func() {
+    Description("The number of records stored by a margin data collection.")
+    Attribute("securities_finance", Int, "保存した証金残の件数")
+    Attribute("credit", Int, "保存した信用残の件数")
+    Attribute("premiums", Int, "保存した逆日歩の件数")
+
+    Required("securities_finance", "credit", "premiums")
+})
+
+// 信用取引情報サービス(Margin)の定義
+var _ = Service("margin", func() {
+    Description("The margin service exposes the margin and short-interest data (証金残, 信用残, 逆日歩) collected for the watched stocks.")
+
+    // GET /margin/{symbol}
+    Method("get", func() {
+        Description("Get the stored margin time series of a stock, newest first.")
+        Payload(func() {
+            Attribute("symbol", String, "銘柄コード")
+            Attribute("since", String, "この日付以降のデータに絞り込む (YYYYMMDD)", func() {
+                Pattern(`^\d{8}$`)
+            })
+            Attribute("limit", Int, "系列ごとの取得件数", func() {
+                Minimum(1)
+                Maximum(500)
+                Default(30)
+            })
+            Required("symbol")
+        })
+        Result(MarginInfoResult)
+
+        HTTP(func() {
+            GET("/margin/{symbol}")
+            Param("since")
+            Param("limit")
+            Response(StatusOK)
+        })
+    })
+
+    // POST /margin/collect
+    Method("collect", func() {
+        Description("Collect the margin data of the watched stocks now.")
+        Payload(Empty)
+        Result(MarginCollectResult)
+
+        HTTP(func() {
+            POST("/margin/collect")
+            Response(StatusAccepted)
+        })
+    })
+})
+
+// Goa Type for a round-trip trade
+var TradeResult = Type("TradeResult", func() {
+    Description("A round-trip trade matched from the stored executions (entry → exit).")
+    Attribute("symbol", String, "銘柄コード")
+    Attribute("is_margin", Boolean, "信用取引の場合は true")
+    Attribute("side", String, "方向 (LONG: 買い→売り, SHORT: 売建→買戻し)", func() {
+        Enum("LONG", "SHORT")
+    })
+    Attribute("quantity", Int, "数量")
+    Attribute("entry_order_id", String, "建てた注文のID (移動平均法では最初に建てた注文)")
+    Attribute("exit_order_id", String, "決済した注文のID")
+    Attribute("entry_time", String, "建てた日時 (RFC3339)")
+    Attribute("exit_time", String, "決済した日時 (RFC3339)")
+    Attribute("entry_price", Float64, "取得単価 (移動平均法では平均単価)")
+    Attribute("exit_price", Float64, "決済単価")
+    Attribute("commission", Float64, "この取引の数量に按分した建てと決済の手数料")
+    Attribute("gross_profit_loss", Float64, "手数料控除前の損益")
+    Attribute("net_profit_loss", Float64, "手数料控除後の損益")
+
+    Required("symbol", "is_margin", "side", "quantity", "entry_order_id", "exit_order_id", "entry_time", "exit_time", "entry_price", "exit_price", "commission", "gross_profit_loss", "net_profit_loss")
+})
+
+// Goa Type for a collection of round-trip trades
+var TradeCollection = ResultType("application/vnd.stockbot.trade-collection", func() {
+    Description("A collection of round-trip trades, newest exit first.")
+    Attribute("trades", ArrayOf(TradeResult), "往復取引のリスト")
+    Required("trades")
+})
+
+// Goa Type for the realized P&L of a day
+var DailyProfitLossResult = Type("DailyProfitLossResult", func() {
+    Description("The realized P&L of the trades closed on a day (Japan time).")
+    Attribute("date", String, "決済日 (YYYY-MM-DD)")
+    Attribute("trades", Int, "決済した取引の件数")
+    Attribute("wins", Int, "手数料控除後の損益がプラスの取引の件数")
+    Attribute("losses", Int, "手数料控除後の損益がマイナスの取引の件数")
+    Attribute("gross_profit_loss", Float64, "手数料控除前の損益")
+    Attribute("commission", Float64, "手数料")
+    Attribute("net_profit_loss", Float64, "手数料控除後の損益")
+
+    Required("date", "trades", "wins", "losses", "gross_profit_loss", "commission", "net_profit_loss")
+})
+
+// Goa Type for the daily realized P&L
+var DailyProfitLossCollection = ResultType("application/vnd.stockbot.daily-pnl-collection", func() {
+    Description("The realized P&L by day, newest first.")
+    Attribute("days", ArrayOf(DailyProfitLossResult), "日ごとの実現損益")
+    Required("days")
+})
+
+// Goa Type for the result of an execution sync
+var ExecutionSyncResult = ResultType("application/vnd.stockbot.execution-sync", func() {
+    Description("The outcome of an execution sync.")
+    Attribute("orders", Int, "約定のあった注文の件数")
+    Attribute("imported", Int, "ボット以外から発注され、新たに保存した注文の件数")
+    Attribute("executions", Int, "保存した約定の件数")
+    Attribute("lots", Int, "約定から作成し直したポジション台帳の未決済の建玉 (ロット) の件数")
+    Attribute("closed", Int, "証券会社で取消・失効・受付エラーになり、完了にした注文の件数 (注文一覧にない前日以前の注文の失効を含む)")
+
+    Attribute("skipped", Int, "約定があるものの、内容を変換できずに保存しなかった注文の件数")
+
+    Required("orders", "imported", "executions", "lots", "closed", "skipped")
+})
+
+// 取引履歴サービス(Trade)の定義
+var _ = Service("trade", func() {
+    Description("The trade service exposes the round-trip trades and the realized P&L computed from the stored executions.")
+
+    // GET /trades
+    Method("list", func() {
+        Description("List the round-trip trades closed in a period, newest exit first.")
+        Payload(func() {
+            Attribute("symbol", String, "銘柄コードで絞り込む")
+            Attribute("method", String, "取得単価の計算方法 (FIFO: 先入先出法, AVERAGE: 移動平均法)", func() {
+                Enum("FIFO", "AVERAGE")
+                Default("FIFO")
+            })
+            Attribute("since", String, "この日時以降に決済した取引に絞り込む (RFC3339)", func() {
+                Format(FormatDateTime)
+            })
+            Attribute("until", String, "この日時より前に決済した取引に絞り込む (RFC3339)", func() {
+                Format(FormatDateTime)
+            })
+            Attribute("limit", Int, "取得件数", func() {
+                Minimum(1)
+                Maximum(1000)
+                Default(100)
+            })
+        })
+        Result(TradeCollection)
+
+        HTTP(func() {
+            GET("/trades")
+            Param("symbol")
+            Param("method")
+            Param("since")
+            Param("until")
+            Param("limit")
+            Response(StatusOK)
+        })
+    })
+
+    // GET /pnl/daily
+    Method("daily_pnl", func() {
+        Description("Get the realized P&L by the exit date (Japan time), newest first.")
+        Payload(func() {
+            Attribute("method", String, "取得単価の計算方法 (FIFO: 先入先出法, AVERAGE: 移動平均法)", func() {
+                Enum("FIFO", "AVERAGE")
+                Default("FIFO")
+            })
+            Attribute("from", String, "この日以降の決済に絞り込む (YYYY-MM-DD, 日本時間)", func() {
+                Format(FormatDate)
+            })
+            Attribute("to", String, "この日までの決済に絞り込む (YYYY-MM-DD, 日本時間, この日を含む)", func() {
+                Format(FormatDate)
+            })
+        })
+        Result(DailyProfitLossCollection)
+
+        HTTP(func() {
+            GET("/pnl/daily")
+            Param("method")
+            Param("from")
+            Param("to")
+            Response(StatusOK)
+        })
+    })
+
+    // POST /trades/sync
+    Method("sync", func() {
+        Description("Fetch the executions of today's orders from the broker and store them now.")
+        Payload(Empty)
+        Result(ExecutionSyncResult)
+
+        HTTP(func() {
+            POST("/trades/sync")
+            Response(StatusOK)
+        })
+    })
+})
+
+// Goa Type for the summary of a generated daily report
+var DailyReportSummaryResult = ResultType("application/vnd.stockbot.daily-report-summary", func() {
+    Description("The summary of a generated daily report. The full report is served by GET /reports/daily/{date}.")
+    Attribute("date", String, "レポートの日付 (YYYY-MM-DD, 日本時間)")
+    Attribute("generated_at", String, "作成日時 (RFC3339)")
+    Attribute("signals_consumed", Int, "エージェントが処理したシグナルの件数")
+    Attribute("orders_placed", Int, "発注した注文の件数")
+    Attribute("orders_rejected", Int, "拒否された発注の件数")
+    Attribute("fills", Int, "約定の件数")
+    Attribute("risk_events", Int, "リスクイベントの件数")
+    Attribute("realized_profit_loss", Float64, "この日に決済した取引の実現損益 (手数料控除後)")
+    Attribute("unrealized_profit_loss", Float64, "作成時点の建玉の評価損益 (当日のレポートのみ)")
+    Attribute("warnings", ArrayOf(String), "取得できずにレポートに含まれていない項目")
+
+    Required("date", "generated_at", "signals_consumed", "orders_placed", "orders_rejected", "fills", "risk_events", "realized_profit_loss", "warnings")
+})
+
+// 日次レポートサービス(Report)の定義
+var _ = Service("report", func() {
+    Description("The report service serves the end-of-day reports of the bot's activity.")
+
+    // GET /reports/daily/{date}
+    Method("daily", func() {
+        Description("Get the stored daily report as JSON or Markdown.")
+        Payload(func() {
+            Attribute("date", String, "レポートの日付 (YYYY-MM-DD, 日本時間)", func() {
+                Format(FormatDate)
+            })
+            Attribute("format", String, "出力形式", func() {
+                Enum("json", "markdown")
+                Default("json")
+            })
+            Required("date")
+        })
+        Result(func() {
+            Attribute("content_type", String, "レポートの Content-Type")
+            Required("content_type")
+        })
+
+        Error("not_found", ErrorResult, "この日のレポートが作成されていない")
+
+        HTTP(func() {
+            GET("/reports/daily/{date}")
+            Param("format")
+            SkipResponseBodyEncodeDecode()
+            Response(StatusOK, func() {
+                Header("content_type:Content-Type")
+            })
+            Response("not_found", StatusNotFound)
+        })
+    })
+
+    // POST /reports/daily/{date}
+    Method("generate", func() {
+        Description("Generate the daily report now, replacing the stored report of the day. The unrealized P&L is that of the current positions.")
+        Payload(func() {
+            Attribute("date", String, "レポートの日付 (YYYY-MM-DD, 日本時間)", func() {
+                Format(FormatDate)
+            })
+            Required("date")
+        })
+        Result(DailyReportSummaryResult)
+
+        HTTP(func() {
+            POST("/reports/daily/{date}")
+            Response(StatusOK)
+        })
+    })
 })
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// AccountBalance は可能額サマリー (CLMZanKaiSummary) から得られる口座の余力と状態を表すモデル
+// HTTP API の残高とエージェントの発注数量の計算は、どちらもこのモデルを元にする
+type AccountBalance struct {
+	UpdatedAt              time.Time // 証券会社側の更新日時 (不明な場合はゼロ値)
+	CashBuyingPower        float64   // 株式現物買付可能額
+	MarginBuyingPower      float64   // 信用新規建可能額
+	MarginDeliveryCapacity float64   // 信用現引可能額
+	MaintenanceRate        float64   // 委託保証金率 (%)
+	WithdrawableCash       float64   // 出金可能額
+	NisaGrowthCapacity     float64   // NISA成長投資可能額
+	Shortfall              float64   // 不足額 (入金請求額)
+	HasMarginCall          bool      // 追証が発生している場合は true
+	HasAdvance             bool      // 立替金が発生している場合は true
+}
@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// BalanceSnapshot は、口座の余力と委託保証金率を定期的に記録したスナップショットを表すモデル
+// 可能額サマリー (CLMZanKaiSummary) とリアル保証金率 (CLMZanRealHosyoukinRitu) の値を組み合わせて保存する
+type BalanceSnapshot struct {
+	ID                  uint      `gorm:"primaryKey"`
+	TakenAt             time.Time `gorm:"index"` // 取得した日時
+	CashBuyingPower     float64   // 株式現物買付可能額
+	MarginBuyingPower   float64   // 信用新規建可能額
+	WithdrawableCash    float64   // 出金可能額
+	DepositedMargin     float64   // 受入保証金
+	PositionValue       float64   // 建株代金
+	ValuationProfitLoss float64   // 評価損益
+	MaintenanceRate     float64   // 委託保証金率 (%)
+	MarginCallSurplus   float64   // 追証余力
+	HasMarginCall       bool      // 追証が発生している場合は true
+	CreatedAt           time.Time
+}
+
+// HasMarginPositions は信用建玉があるかどうかを返す
+// 建玉がない場合の委託保証金率は意味を持たないため、監視の対象外とする
+func (s *BalanceSnapshot) HasMarginPositions() bool {
+	return s.PositionValue > 0
+}
+
+// BelowMaintenanceRate は追証が発生しているか、信用建玉があり委託保証金率が rate を下回っているかどうかを返す
+func (s *BalanceSnapshot) BelowMaintenanceRate(rate float64) bool {
+	if s.HasMarginCall {
+		return true
+	}
+	return s.HasMarginPositions() && s.MaintenanceRate < rate
+}
@@ -0,0 +1,64 @@
+// domain/model/margin_info.go
+package model
+
+import "time"
+
+// 証金残・信用残・逆日歩の時系列データ
+// 銘柄・日付ごとに1件保存し、同じ日付のデータは最新の取得内容で上書きする
+// 数値項目は 0 の場合は未設定 (または 0) とする
+
+// SecuritiesFinanceBalance は、証券金融会社の貸借残高 (証金残) を表すモデル
+// 証金残情報問合取得 (CLMMfdsGetSyoukinZan) の情報に対応
+type SecuritiesFinanceBalance struct {
+	ID               uint      `gorm:"primaryKey"`
+	IssueCode        string    `gorm:"size:255;uniqueIndex:idx_securities_finance_balances_issue_code_record_date"` // 銘柄コード
+	RecordDate       string    `gorm:"size:8;uniqueIndex:idx_securities_finance_balances_issue_code_record_date"`   // 証金更新日 (YYYYMMDD)
+	Preliminary      bool      // 速報の場合は true、確報の場合は false
+	LoanBalance      int64     // 融資残
+	LoanChange       int64     // 融資前日比
+	LoanNew          int64     // 融資・新規
+	LoanRepaid       int64     // 融資・返済
+	StockLoanBalance int64     // 貸株残
+	StockLoanChange  int64     // 貸株前日比
+	StockLoanNew     int64     // 貸株・新規
+	StockLoanRepaid  int64     // 貸株・返済
+	NetBalance       int64     // 差引残
+	NetChange        int64     // 差引残前日比
+	LoanRatio        float64   // 貸借倍率
+	TurnoverDays     float64   // 回転日数
+	FetchedAt        time.Time // 取得した日時
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// CreditBalance は、信用取引の残高 (信用残) を表すモデル (週次)
+// 信用残情報問合取得 (CLMMfdsGetShinyouZan) の合算の値に対応し、一般・制度の内訳も保持する
+type CreditBalance struct {
+	ID                     uint      `gorm:"primaryKey"`
+	IssueCode              string    `gorm:"size:255;uniqueIndex:idx_credit_balances_issue_code_record_date"` // 銘柄コード
+	RecordDate             string    `gorm:"size:8;uniqueIndex:idx_credit_balances_issue_code_record_date"`   // 信用残日付 (YYYYMMDD)
+	LongBalance            int64     // 買残 (合算)
+	LongChange             int64     // 買残前週比 (合算)
+	ShortBalance           int64     // 売残 (合算)
+	ShortChange            int64     // 売残前週比 (合算)
+	Ratio                  float64   // 信用倍率 (合算)
+	StandardLongBalance    int64     // 買残 (制度)
+	StandardShortBalance   int64     // 売残 (制度)
+	NegotiableLongBalance  int64     // 買残 (一般)
+	NegotiableShortBalance int64     // 売残 (一般)
+	FetchedAt              time.Time // 取得した日時
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+}
+
+// MarginPremiumRecord は、逆日歩 (品貸料) を表すモデル
+// 逆日歩情報問合取得 (CLMMfdsGetHibuInfo) には日付が含まれないため、取得した日 (日本時間) を日付とする
+type MarginPremiumRecord struct {
+	ID         uint      `gorm:"primaryKey"`
+	IssueCode  string    `gorm:"size:255;uniqueIndex:idx_margin_premium_records_issue_code_record_date"` // 銘柄コード
+	RecordDate string    `gorm:"size:8;uniqueIndex:idx_margin_premium_records_issue_code_record_date"`   // 取得した日 (YYYYMMDD)
+	Premium    float64   // 逆日歩 (1株あたり・円)
+	FetchedAt  time.Time // 取得した日時
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -0,0 +1,13 @@
+// domain/model/master_margin.go
+package model
+
+// MarginMaster は、保証金マスタの情報を表すモデル
+// 銘柄・市場ごとの保証金率を保持、 CLMHosyoukinMst の情報に対応
+type MarginMaster struct {
+	MasterBase
+	IssueCode      string  `gorm:"primaryKey;size:255"` // 銘柄コード (複合主キー)
+	ListingMarket  string  `gorm:"primaryKey;size:255"` // 上場市場 (複合主キー)
+	ChangeDate     string  `gorm:"size:255"`            // 変更日 (YYYYMMDD)
+	CollateralRate float64 // 代用保証金率 (%)
+	CashMarginRate float64 // 現金保証金率 (%)
+}
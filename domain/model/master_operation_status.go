@@ -0,0 +1,17 @@
+// domain/model/master_operation_status.go
+package model
+
+// OperationStatus は、運用ステータス別状態の情報を表すモデル
+// CLMUnyouStatus / CLMUnyouStatusKabu / CLMUnyouStatusHasei の情報に対応し、Kind に機能IDを保持する
+type OperationStatus struct {
+	MasterBase
+	Kind              string `gorm:"primaryKey;size:64"`  // 機能ID (複合主キー)
+	OperationCategory string `gorm:"primaryKey;size:255"` // 運用カテゴリ (複合主キー)
+	OperationUnit     string `gorm:"primaryKey;size:255"` // 運用単位 (複合主キー)
+	TargetBusiness    string `gorm:"primaryKey;size:255"` // 対象業務 (複合主キー)
+	BusinessDayFlag   string `gorm:"size:255"`            // 営業日区分
+	Status            string `gorm:"size:255"`            // 運用ステータス
+	BusinessStatus    string `gorm:"size:255"`            // 業務別状態
+	EventName         string `gorm:"size:255"`            // イベント名
+	EstimatedTime     string `gorm:"size:255"`            // 目安時刻
+}
@@ -1,6 +1,11 @@
 // domain/model/stock_master.go
 package model
 
+import (
+	"errors"
+	"fmt"
+)
+
 // StockMaster は、株式銘柄マスタの情報を表すモデル
 // 株式銘柄の基本情報 CLMIssueMstKabu の情報に対応
 type StockMaster struct {
@@ -17,5 +22,64 @@ type StockMaster struct {
 	ListedSharesOutstanding int64   // 上場発行株数
 	UpperLimit              float64 // 値幅上限
 	LowerLimit              float64 // 値幅下限
+	TickUnitNumber          string  `gorm:"size:255"` // 呼値の単位番号
+	NextTickUnitNumber      string  `gorm:"size:255"` // 呼値の単位番号 (翌営業日)
 	TickRules               []TickRule `gorm:"-"` // 呼値 (一旦リレーションを無視)
 }
+
+// PriceLimitPolicy は値幅制限の範囲外の価格の扱い
+type PriceLimitPolicy string
+
+const (
+	PriceLimitReject PriceLimitPolicy = "REJECT" // 範囲外の価格はエラーとする
+	PriceLimitClamp  PriceLimitPolicy = "CLAMP"  // 範囲外の価格は値幅上限・下限に丸める
+)
+
+// ErrPriceOutsideLimits は価格が値幅制限の範囲外の場合に返される
+var ErrPriceOutsideLimits = errors.New("price is outside the daily price limits")
+
+// ErrOddLotQuantity は数量が売買単位の整数倍でない場合に返される (単元未満株の注文は未対応)
+var ErrOddLotQuantity = errors.New("quantity is not a multiple of the trading unit")
+
+// HasPriceLimits は値幅制限が設定されているかどうかを返す
+func (s *StockMaster) HasPriceLimits() bool {
+	return s.UpperLimit > 0 && s.LowerLimit > 0
+}
+
+// IsLimitUp は価格がストップ高 (値幅上限) に達しているかどうかを返す
+func (s *StockMaster) IsLimitUp(price float64) bool {
+	return s.HasPriceLimits() && price >= s.UpperLimit
+}
+
+// IsLimitDown は価格がストップ安 (値幅下限) に達しているかどうかを返す
+func (s *StockMaster) IsLimitDown(price float64) bool {
+	return s.HasPriceLimits() && price > 0 && price <= s.LowerLimit
+}
+
+// ApplyPriceLimits は価格を値幅制限と照合する
+// 範囲外の価格は、policy に応じてエラーとするか値幅上限・下限に丸める
+// 値幅制限が設定されていない場合は価格をそのまま返す
+func (s *StockMaster) ApplyPriceLimits(price float64, policy PriceLimitPolicy) (float64, error) {
+	if !s.HasPriceLimits() || (price >= s.LowerLimit && price <= s.UpperLimit) {
+		return price, nil
+	}
+	if policy == PriceLimitClamp {
+		if price > s.UpperLimit {
+			return s.UpperLimit, nil
+		}
+		return s.LowerLimit, nil
+	}
+	return 0, fmt.Errorf("%w: %s price %v, limits %v-%v", ErrPriceOutsideLimits, s.IssueCode, price, s.LowerLimit, s.UpperLimit)
+}
+
+// ValidateQuantity は数量が売買単位の整数倍かどうかを確認する
+// 売買単位が設定されていない場合は、正の数量であればよいものとする
+func (s *StockMaster) ValidateQuantity(quantity int) error {
+	if quantity <= 0 {
+		return fmt.Errorf("quantity must be positive: %d", quantity)
+	}
+	if s.TradingUnit > 0 && quantity%s.TradingUnit != 0 {
+		return fmt.Errorf("%w: %s quantity %d, trading unit %d (odd-lot orders are not supported)", ErrOddLotQuantity, s.IssueCode, quantity, s.TradingUnit)
+	}
+	return nil
+}
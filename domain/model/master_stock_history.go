@@ -0,0 +1,49 @@
+// domain/model/master_stock_history.go
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+// StockMasterHistory は、マスタデータ同期で変わった銘柄マスタの項目の履歴を表すモデル
+type StockMasterHistory struct {
+	ID        uint      `gorm:"primaryKey"`
+	SyncRunID uint      `gorm:"index"`          // 変更を反映したマスタデータ同期の ID
+	IssueCode string    `gorm:"size:255;index"` // 銘柄コード
+	Field     string    `gorm:"size:255"`       // 変わった項目 (カラム名)
+	OldValue  string    `gorm:"size:255"`       // 変更前の値
+	NewValue  string    `gorm:"size:255"`       // 変更後の値
+	ChangedAt time.Time `gorm:"index"`          // 変更を反映した日時
+}
+
+// StockMasterChanges は履歴を残す項目 (名称・市場・売買単位・値幅制限・呼値の単位番号) のうち、変わった項目を返す
+// SyncRunID と ChangedAt は呼び出し元で設定する
+func StockMasterChanges(old, new *StockMaster) []*StockMasterHistory {
+	formatFloat := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	fields := []struct {
+		name     string
+		old, new string
+	}{
+		{"issue_name", old.IssueName, new.IssueName},
+		{"issue_name_short", old.IssueNameShort, new.IssueNameShort},
+		{"market_code", old.MarketCode, new.MarketCode},
+		{"trading_unit", strconv.Itoa(old.TradingUnit), strconv.Itoa(new.TradingUnit)},
+		{"upper_limit", formatFloat(old.UpperLimit), formatFloat(new.UpperLimit)},
+		{"lower_limit", formatFloat(old.LowerLimit), formatFloat(new.LowerLimit)},
+		{"tick_unit_number", old.TickUnitNumber, new.TickUnitNumber},
+	}
+
+	var changes []*StockMasterHistory
+	for _, f := range fields {
+		if f.old != f.new {
+			changes = append(changes, &StockMasterHistory{
+				IssueCode: new.IssueCode,
+				Field:     f.name,
+				OldValue:  f.old,
+				NewValue:  f.new,
+			})
+		}
+	}
+	return changes
+}
@@ -5,8 +5,15 @@ package model
 //  株式銘柄の市場ごとの情報（上場市場、前日終値など）を保持、 CLMIssueSizyouMstKabu の情報に対応
 
 type StockMarketMaster struct {
-	MasterBase            // 共通フィールド
-	IssueCode     string  `gorm:"index;size:255"` // 銘柄コード (複合ユニークキーの一部)
-	ListingMarket string  `gorm:"index;size:255"` // 上場市場 (複合ユニークキーの一部)
-	PreviousClose float64 // 前日終値
+	MasterBase                 // 共通フィールド
+	IssueCode          string  `gorm:"primaryKey;size:255"` // 銘柄コード (複合主キー)
+	ListingMarket      string  `gorm:"primaryKey;size:255"` // 上場市場 (複合主キー)
+	PreviousClose      float64 // 前日終値
+	UpperLimit         float64 // 値幅上限
+	LowerLimit         float64 // 値幅下限
+	TradingUnit        int     // 市場別売買単位
+	TickUnitNumber     string  `gorm:"size:255"` // 呼値の単位番号
+	NextTickUnitNumber string  `gorm:"size:255"` // 呼値の単位番号 (翌営業日)
+	MarginEligibility  string  `gorm:"size:255"` // 信用区分 (1:貸借銘柄, 2:信用制度銘柄, 3:一般信用銘柄)
+	DelistingDate      string  `gorm:"size:255"` // 上場廃止日 (YYYYMMDD)
 }
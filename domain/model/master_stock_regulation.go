@@ -0,0 +1,30 @@
+// domain/model/master_stock_regulation.go
+package model
+
+// TradingRegulation は取引種別ごとの規制状態 (停止区分)
+type TradingRegulation string
+
+const (
+	RegulationNone                  TradingRegulation = "0" // 通常 (規制なし)
+	RegulationProhibited            TradingRegulation = "1" // 取引禁止
+	RegulationMarketOrderProhibited TradingRegulation = "2" // 成行禁止
+	RegulationOddLotProhibited      TradingRegulation = "3" // 端株禁止
+)
+
+// StockIssueRegulation は、株式銘柄別・市場別規制の情報を表すモデル
+// 当日分の規制状態を保持、 CLMIssueSizyouKiseiKabu の情報に対応
+type StockIssueRegulation struct {
+	MasterBase
+	IssueCode                  string            `gorm:"primaryKey;size:255"` // 銘柄コード (複合主キー)
+	ListingMarket              string            `gorm:"primaryKey;size:255"` // 上場市場 (複合主キー)
+	TradingHalt                TradingRegulation `gorm:"size:8"`              // 停止区分
+	CashBuy                    TradingRegulation `gorm:"size:8"`              // 現物/買付
+	CashSell                   TradingRegulation `gorm:"size:8"`              // 現物/売付
+	MarginNewBuy               TradingRegulation `gorm:"size:8"`              // 制度信用/買建
+	MarginNewSell              TradingRegulation `gorm:"size:8"`              // 制度信用/売建
+	GeneralMarginNewBuy        TradingRegulation `gorm:"size:8"`              // 一般信用/買建
+	GeneralMarginNewSell       TradingRegulation `gorm:"size:8"`              // 一般信用/売建
+	PreAdjustment              bool              // 事前調整 (信用取引の新規建に事前の入金等が必要)
+	SameDaySettlement          bool              // 即日入金規制
+	ConcentratedMarginCategory string            `gorm:"size:8"` // 信用一極集中区分 (0:なし, 1:あり, 2:日々公表銘柄)
+}
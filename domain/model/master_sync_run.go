@@ -0,0 +1,48 @@
+// domain/model/master_sync_run.go
+package model
+
+import "time"
+
+// MasterSyncTrigger はマスタデータ同期を開始した契機
+type MasterSyncTrigger string
+
+const (
+	MasterSyncTriggerStartup   MasterSyncTrigger = "startup"   // 起動時の同期
+	MasterSyncTriggerScheduled MasterSyncTrigger = "scheduled" // 営業日ごとの定期同期
+	MasterSyncTriggerManual    MasterSyncTrigger = "manual"    // POST /master/update による同期
+)
+
+// MasterSyncStatus はマスタデータ同期の状態
+type MasterSyncStatus string
+
+const (
+	MasterSyncRunning   MasterSyncStatus = "running"   // 実行中
+	MasterSyncSucceeded MasterSyncStatus = "succeeded" // 成功
+	MasterSyncFailed    MasterSyncStatus = "failed"    // 失敗
+)
+
+// MasterSyncCounts は差分同期で反映した件数
+type MasterSyncCounts struct {
+	Inserted  int // 新規に追加した件数 (削除済みから復活したものを含む)
+	Updated   int // 内容が変わったため更新した件数
+	Unchanged int // 内容が変わらなかったため更新しなかった件数
+	Deleted   int // ダウンロードしたデータに含まれなかったため論理削除した件数
+}
+
+// MasterSyncRun はマスタデータ同期の実行履歴を表すモデル
+// 失敗した場合も、失敗するまでに反映した件数を記録する
+type MasterSyncRun struct {
+	ID                uint              `gorm:"primaryKey"`
+	Scope             string            `gorm:"size:255"`       // 同期範囲 (watched, full)
+	Trigger           MasterSyncTrigger `gorm:"size:255"`       // 同期の契機
+	Status            MasterSyncStatus  `gorm:"size:255;index"` // 同期の状態
+	StartedAt         time.Time         `gorm:"index"`          // 開始日時
+	FinishedAt        *time.Time        // 終了日時 (実行中は nil)
+	Error             string            `gorm:"type:text"` // 失敗した場合のエラー内容
+	Stocks            MasterSyncCounts  `gorm:"embedded;embeddedPrefix:stocks_"`
+	StockMarkets      MasterSyncCounts  `gorm:"embedded;embeddedPrefix:stock_markets_"`
+	TickRules         MasterSyncCounts  `gorm:"embedded;embeddedPrefix:tick_rules_"`
+	MarginMasters     MasterSyncCounts  `gorm:"embedded;embeddedPrefix:margin_masters_"`
+	Regulations       MasterSyncCounts  `gorm:"embedded;embeddedPrefix:regulations_"`
+	OperationStatuses MasterSyncCounts  `gorm:"embedded;embeddedPrefix:operation_statuses_"`
+}
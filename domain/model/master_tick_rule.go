@@ -1,7 +1,13 @@
 // domain/model/tick_rule.go
 package model
 
-import "time"
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
 
 // TickRule は、呼値の情報を表すモデル
 type TickRule struct {
@@ -22,3 +28,178 @@ type TickLevel struct {
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 }
+
+// TickRounding は呼値に合わせる際の丸め方向
+type TickRounding string
+
+const (
+	TickRoundDown    TickRounding = "DOWN"    // 切り下げ
+	TickRoundUp      TickRounding = "UP"      // 切り上げ
+	TickRoundNearest TickRounding = "NEAREST" // 最も近い呼値 (同じ距離の場合は低い方)
+)
+
+// TickRoundingForSide は売買区分に応じた丸め方向を返す
+// 買いは想定より高く買わないよう切り下げ、売りは想定より安く売らないよう切り上げる
+func TickRoundingForSide(tradeType TradeType) TickRounding {
+	if tradeType == TradeTypeSell {
+		return TickRoundUp
+	}
+	return TickRoundDown
+}
+
+// tickEpsilon は価格を呼値で割った商を整数とみなす許容誤差
+const tickEpsilon = 1e-6
+
+// standardTickTableTopPrice は呼値テーブルの最上位段階の上限値 (実質的な上限なし)
+const standardTickTableTopPrice = 999999999
+
+// StandardTSETickRule は東証の通常銘柄 (TOPIX500構成銘柄以外) の呼値テーブルを返す
+// このテーブルの呼値はTOPIX500構成銘柄の呼値の倍数になっているため、
+// 銘柄の呼値テーブルが取得できない場合のフォールバックとして、どちらの銘柄にも有効な価格を得られる
+func StandardTSETickRule() *TickRule {
+	return &TickRule{
+		TickUnitNumber: "",
+		TickLevels: []TickLevel{
+			{LowerPrice: 0, UpperPrice: 3000, TickValue: 1},
+			{LowerPrice: 3000, UpperPrice: 5000, TickValue: 5},
+			{LowerPrice: 5000, UpperPrice: 30000, TickValue: 10},
+			{LowerPrice: 30000, UpperPrice: 50000, TickValue: 50},
+			{LowerPrice: 50000, UpperPrice: 300000, TickValue: 100},
+			{LowerPrice: 300000, UpperPrice: 500000, TickValue: 500},
+			{LowerPrice: 500000, UpperPrice: 3000000, TickValue: 1000},
+			{LowerPrice: 3000000, UpperPrice: 5000000, TickValue: 5000},
+			{LowerPrice: 5000000, UpperPrice: 30000000, TickValue: 10000},
+			{LowerPrice: 30000000, UpperPrice: 50000000, TickValue: 50000},
+			{LowerPrice: 50000000, UpperPrice: standardTickTableTopPrice, TickValue: 100000},
+		},
+	}
+}
+
+// levels は上限値の昇順に並べた呼値の段階を返す
+func (r *TickRule) levels() []TickLevel {
+	levels := append([]TickLevel(nil), r.TickLevels...)
+	sort.Slice(levels, func(i, j int) bool { return levels[i].UpperPrice < levels[j].UpperPrice })
+	return levels
+}
+
+// TickSize は指定した価格に適用される呼値を返す
+// 各段階は下限値を含まず上限値を含む (例: 3,000円以下は1円、3,000円超5,000円以下は5円)
+func (r *TickRule) TickSize(price float64) (float64, error) {
+	if price <= 0 {
+		return 0, fmt.Errorf("price must be positive: %v", price)
+	}
+	for _, level := range r.levels() {
+		if price <= level.UpperPrice && level.TickValue > 0 {
+			return level.TickValue, nil
+		}
+	}
+	return 0, fmt.Errorf("no tick level for price %v in tick rule %q", price, r.TickUnitNumber)
+}
+
+// tickSizeAbove は指定した価格から1呼値上げる際の呼値を返す
+// 段階の上限値ちょうどの価格からは、一つ上の段階の呼値で上げる
+func (r *TickRule) tickSizeAbove(price float64) (float64, error) {
+	for _, level := range r.levels() {
+		if price < level.UpperPrice && level.TickValue > 0 {
+			return level.TickValue, nil
+		}
+	}
+	return 0, fmt.Errorf("no tick level above price %v in tick rule %q", price, r.TickUnitNumber)
+}
+
+// IsValidPrice は価格が呼値の単位に合っているかどうかを返す
+func (r *TickRule) IsValidPrice(price float64) bool {
+	tick, err := r.TickSize(price)
+	if err != nil {
+		return false
+	}
+	q := price / tick
+	return math.Abs(q-math.Round(q)) < tickEpsilon
+}
+
+// Round は価格を呼値の単位に丸める
+func (r *TickRule) Round(price float64, rounding TickRounding) (float64, error) {
+	tick, err := r.TickSize(price)
+	if err != nil {
+		return 0, err
+	}
+	q := price / tick
+	down := normalizeTickPrice(math.Floor(q+tickEpsilon) * tick)
+	up := normalizeTickPrice(math.Ceil(q-tickEpsilon) * tick)
+
+	var rounded float64
+	switch rounding {
+	case TickRoundDown:
+		rounded = down
+	case TickRoundUp:
+		rounded = up
+	case TickRoundNearest:
+		rounded = down
+		if up-price < price-down {
+			rounded = up
+		}
+	default:
+		return 0, fmt.Errorf("unsupported tick rounding: %s", rounding)
+	}
+	if rounded <= 0 {
+		return 0, fmt.Errorf("price %v is below the minimum tick %v", price, tick)
+	}
+	return rounded, nil
+}
+
+// Step は基準価格から n 呼値離れた価格を返す (n > 0 で上、n < 0 で下)
+// 基準価格が呼値の単位に合っていない場合は、その方向で最初の有効な価格を1呼値目とする
+// n == 0 の場合は最も近い呼値に丸めた価格を返す
+func (r *TickRule) Step(reference float64, n int) (float64, error) {
+	if n == 0 {
+		return r.Round(reference, TickRoundNearest)
+	}
+
+	price := reference
+	remaining := n
+	if !r.IsValidPrice(reference) {
+		rounding := TickRoundUp
+		if n < 0 {
+			rounding = TickRoundDown
+		}
+		rounded, err := r.Round(reference, rounding)
+		if err != nil {
+			return 0, err
+		}
+		price = rounded
+		if n > 0 {
+			remaining--
+		} else {
+			remaining++
+		}
+	}
+
+	for ; remaining > 0; remaining-- {
+		tick, err := r.tickSizeAbove(price)
+		if err != nil {
+			return 0, err
+		}
+		price = normalizeTickPrice(price + tick)
+	}
+	for ; remaining < 0; remaining++ {
+		tick, err := r.TickSize(price)
+		if err != nil {
+			return 0, err
+		}
+		price = normalizeTickPrice(price - tick)
+		if price <= 0 {
+			return 0, fmt.Errorf("stepping %d ticks from %v goes below the minimum price", n, reference)
+		}
+	}
+	return price, nil
+}
+
+// normalizeTickPrice は浮動小数点演算で生じた端数を取り除く (呼値の最小単位は0.1円)
+func normalizeTickPrice(price float64) float64 {
+	return math.Round(price*10000) / 10000
+}
+
+// FormatTickPrice は呼値に丸めた価格を発注APIに渡す文字列に変換する
+func FormatTickPrice(price float64) string {
+	return strconv.FormatFloat(normalizeTickPrice(price), 'f', -1, 64)
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// News は、ニュース (適時開示などを含む) を表すモデル
+// ニュースID単位で保存し、同じIDのニュースは重複して保存しない
+type News struct {
+	ID            string     `gorm:"primaryKey;size:255"`        // ニュースID
+	PublishedAt   time.Time  `gorm:"index"`                      // ニュース日時
+	Categories    []string   `gorm:"type:jsonb;serializer:json"` // ニュースカテゴリ
+	Genres        []string   `gorm:"type:jsonb;serializer:json"` // ニュースジャンル
+	Symbols       []string   `gorm:"type:jsonb;serializer:json"` // 関連銘柄コード
+	Headline      string     `gorm:"type:text"`                  // ヘッドライン (タイトル)
+	Body          string     `gorm:"type:text"`                  // 本文
+	BodyFetchedAt *time.Time `gorm:"index"`                      // 本文を取得した日時 (nilの場合は未取得)
+	CreatedAt     time.Time  // 保存した日時
+	UpdatedAt     time.Time
+}
+
+// HasSymbol は銘柄に関連するニュースかどうかを返す
+func (n *News) HasSymbol(symbol string) bool {
+	return slices.Contains(n.Symbols, symbol)
+}
+
+// HasAnyCategory はいずれかのカテゴリに属するニュースかどうかを返す。categories が空の場合は true を返す
+func (n *News) HasAnyCategory(categories []string) bool {
+	if len(categories) == 0 {
+		return true
+	}
+	for _, c := range categories {
+		if slices.Contains(n.Categories, c) {
+			return true
+		}
+	}
+	return false
+}
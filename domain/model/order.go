@@ -18,10 +18,21 @@ type Order struct {
 	TimeInForce  TimeInForce `gorm:"index;default:'DAY'"`                   // 有効期限
 	OrderStatus  OrderStatus `gorm:"index"`                                 // 注文状態
 	IsMargin     bool        `gorm:"not null;default:false"`                // 信用取引かどうか
+	AccountType  AccountType `gorm:"index;default:'SPECIFIC'"`              // 口座区分 (譲渡益課税区分)
+	SignalID     *uint       `gorm:"index"`                                 // 発注の契機となったシグナルのID (手動発注の場合はnil)
 	Executions   []Execution `gorm:"foreignKey:OrderID;references:OrderID"` // 約定情報
+	Version      int         `gorm:"not null;default:0"`                    // 楽観ロックのバージョン (状態を更新するたびに増える)
 	// Account    Account `gorm:"foreignKey:AccountID;references:ID"`
 }
 
+// accountType は口座区分を返す (保存前の注文など、未設定の場合は特定口座)
+func (o *Order) accountType() AccountType {
+	if o.AccountType == "" {
+		return AccountTypeSpecific
+	}
+	return o.AccountType
+}
+
 type TradeType string
 
 const (
@@ -48,17 +59,6 @@ const (
 	// ...
 )
 
-type OrderStatus string
-
-const (
-	OrderStatusNew             OrderStatus = "NEW"              // 新規
-	OrderStatusPartiallyFilled OrderStatus = "PARTIALLY_FILLED" // 一部約定
-	OrderStatusFilled          OrderStatus = "FILLED"           // 完全約定
-	OrderStatusCanceled        OrderStatus = "CANCELED"         // 取消済
-	OrderStatusRejected        OrderStatus = "REJECTED"         // 拒否
-	OrderStatusExpired         OrderStatus = "EXPIRED"          // 期限切れ
-)
-
 type Execution struct {
 	gorm.Model
 	OrderID           string `gorm:"index"`       // 注文ID (Orderモデルのgorm.Model.IDを参照)
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ErrInvalidOrderTransition は許可されていない注文の状態遷移の場合に返される
+// 完了した注文の状態を戻す更新 (遅れて届いた約定通知など) もこのエラーになる
+var ErrInvalidOrderTransition = errors.New("invalid order status transition")
+
+type OrderStatus string
+
+const (
+	OrderStatusNew             OrderStatus = "NEW"              // 新規
+	OrderStatusPartiallyFilled OrderStatus = "PARTIALLY_FILLED" // 一部約定
+	OrderStatusFilled          OrderStatus = "FILLED"           // 完全約定
+	OrderStatusCanceled        OrderStatus = "CANCELED"         // 取消済
+	OrderStatusRejected        OrderStatus = "REJECTED"         // 拒否
+	OrderStatusExpired         OrderStatus = "EXPIRED"          // 期限切れ
+)
+
+// orderTransitions は注文の状態ごとに遷移できる状態
+// 一部約定の注文は残りの数量が取消・失効しても一部約定の内容は残るため、拒否には遷移しない
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusNew:             {OrderStatusPartiallyFilled, OrderStatusFilled, OrderStatusCanceled, OrderStatusRejected, OrderStatusExpired},
+	OrderStatusPartiallyFilled: {OrderStatusFilled, OrderStatusCanceled, OrderStatusExpired},
+}
+
+// IsTerminal は注文が完了しており、これ以上状態が変わらないかどうかを返す
+func (s OrderStatus) IsTerminal() bool {
+	_, ok := orderTransitions[s]
+	return !ok
+}
+
+// ValidateTransition は現在の状態から next に遷移できるかを確認する
+// 同じ状態への遷移は何もしない更新として許可する (同じ通知を重複して適用する場合など)
+func (s OrderStatus) ValidateTransition(next OrderStatus) error {
+	if s == next || slices.Contains(orderTransitions[s], next) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s -> %s", ErrInvalidOrderTransition, s, next)
+}
+
+// FilledQuantity は約定 (Executions) から求めた約定済みの数量
+func (o *Order) FilledQuantity() int {
+	var filled int
+	for _, e := range o.Executions {
+		filled += e.ExecutionQuantity
+	}
+	return filled
+}
+
+// RemainingQuantity は約定していない残りの数量
+func (o *Order) RemainingQuantity() int {
+	return max(o.Quantity-o.FilledQuantity(), 0)
+}
+
+// FillStatus は約定 (Executions) の数量から求めた注文の状態 (新規・一部約定・完全約定)
+func (o *Order) FillStatus() OrderStatus {
+	switch filled := o.FilledQuantity(); {
+	case filled <= 0:
+		return OrderStatusNew
+	case filled < o.Quantity:
+		return OrderStatusPartiallyFilled
+	default:
+		return OrderStatusFilled
+	}
+}
+
+// OrderStatusTransition は注文の状態遷移の履歴
+// 注文の保存時 (FromStatus は空) と状態の更新時に記録する
+type OrderStatusTransition struct {
+	ID         uint        `gorm:"primaryKey"`
+	OrderID    string      `gorm:"index"` // 証券会社固有の注文ID
+	FromStatus OrderStatus // 遷移前の状態
+	ToStatus   OrderStatus // 遷移後の状態
+	Version    int         // 遷移後の注文のバージョン
+	CreatedAt  time.Time   // 遷移した日時
+}
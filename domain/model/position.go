@@ -1,6 +1,16 @@
 package model
 
-import "gorm.io/gorm"
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrNoSellableQuantity は売却可能な数量がない場合に返される (他の売り注文で拘束されている場合など)
+var ErrNoSellableQuantity = errors.New("no sellable quantity")
 
 type PositionType string
 
@@ -9,12 +19,110 @@ const (
 	PositionTypeShort PositionType = "SHORT"
 )
 
+// AccountType は口座区分 (譲渡益課税区分)
+type AccountType string
+
+const (
+	AccountTypeSpecific AccountType = "SPECIFIC" // 特定口座
+	AccountTypeGeneral  AccountType = "GENERAL"  // 一般口座
+	AccountTypeNISA     AccountType = "NISA"     // NISA口座
+)
+
 type Position struct {
 	gorm.Model
 	Symbol string `gorm:"index"` // 銘柄コード
 	// AccountID        uint
+	AccountType  AccountType  `gorm:"index;default:'SPECIFIC'"` // 口座区分
+	IsMargin     bool         `gorm:"not null;default:false"`   // 信用建玉かどうか
 	PositionType PositionType `gorm:"index"`
 	AveragePrice float64
 	Quantity     int
+	Lots         []PositionLot `gorm:"foreignKey:PositionID"` // 約定ごとの建玉 (約定から作成したポジションのみ)
 	// Account      Account `gorm:"foreignKey:AccountID;references:ID"`
 }
+
+// PositionLot は約定ごとの未決済の建玉 (税務上の取得ロット)
+// 決済は先入先出で古いロットから行う
+type PositionLot struct {
+	gorm.Model
+	PositionID   uint         `gorm:"index"`
+	Symbol       string       `gorm:"index"` // 銘柄コード
+	AccountType  AccountType  `gorm:"index"` // 口座区分
+	IsMargin     bool         `gorm:"not null;default:false"`
+	PositionType PositionType // LONG: 買い (現物・買建)、SHORT: 売建
+	OrderID      string       `gorm:"index"`       // 建てた注文の ID
+	ExecutionID  string       `gorm:"uniqueIndex"` // 建てた約定の ID
+	OpenedAt     time.Time    `gorm:"index"`       // 約定日時 (取得日)
+	Price        float64      // 約定単価
+	OpenQuantity int          // 約定数量
+	Quantity     int          // 未決済の数量
+	Commission   float64      // 約定の手数料のうち、未決済の数量に按分した額
+}
+
+// Cost は未決済の数量の取得価額 (約定代金と手数料の合計)
+func (l *PositionLot) Cost() float64 {
+	return l.Price*float64(l.Quantity) + l.Commission
+}
+
+// BuildPositions は注文の約定を先入先出で突き合わせ、決済されずに残ったロットからポジションを作成する
+// ポジションは銘柄・口座区分・現物/信用ごとに作成し、銘柄コードの順に返す。
+// 平均単価は残ったロットの約定単価を数量で加重平均した値で、手数料を含まない。
+func BuildPositions(orders []*Order) []*Position {
+	_, books := matchExecutions(orders, CostMethodFIFO)
+
+	var positions []*Position
+	for key, lots := range books {
+		if len(lots) == 0 {
+			continue
+		}
+		position := &Position{
+			Symbol:       key.symbol,
+			AccountType:  key.accountType,
+			IsMargin:     key.isMargin,
+			PositionType: lotPositionType(lots[0]),
+		}
+		var amount float64
+		for _, lot := range lots {
+			position.Lots = append(position.Lots, PositionLot{
+				Symbol:       key.symbol,
+				AccountType:  key.accountType,
+				IsMargin:     key.isMargin,
+				PositionType: position.PositionType,
+				OrderID:      lot.orderID,
+				ExecutionID:  lot.executionID,
+				OpenedAt:     lot.time,
+				Price:        lot.price,
+				OpenQuantity: int(lot.openQuantity),
+				Quantity:     int(lot.quantity),
+				Commission:   lot.commissionPerShare * lot.quantity,
+			})
+			position.Quantity += int(lot.quantity)
+			amount += lot.price * lot.quantity
+		}
+		position.AveragePrice = amount / float64(position.Quantity)
+		positions = append(positions, position)
+	}
+	slices.SortFunc(positions, func(a, b *Position) int {
+		return cmp.Or(cmp.Compare(a.Symbol, b.Symbol), cmp.Compare(a.AccountType, b.AccountType), compareBool(a.IsMargin, b.IsMargin))
+	})
+	return positions
+}
+
+func lotPositionType(lot *openLot) PositionType {
+	if lot.direction < 0 {
+		return PositionTypeShort
+	}
+	return PositionTypeLong
+}
+
+// compareBool は false を先に並べる
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
@@ -1,6 +1,9 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type SignalType string
 
@@ -10,13 +13,50 @@ const (
 	// SignalTypeExit SignalType = "EXIT" // 手仕舞いシグナル (必要に応じて)
 )
 
+// SignalSource はシグナルの取り込み元
+type SignalSource string
+
+const (
+	SignalSourceFile SignalSource = "FILE" // シグナルファイルから読み込んだシグナル
+	SignalSourceHTTP SignalSource = "HTTP" // HTTP APIで受け付けたシグナル
+)
+
 type Signal struct {
 	ID          uint   `gorm:"primaryKey"`
 	Symbol      string `gorm:"index"` // 銘柄コード
 	SignalType  SignalType
 	GeneratedAt time.Time
-	Rationale   string // シグナルの根拠
-	Price       float64
+	Rationale   string       // シグナルの根拠
+	Price       float64      // 指値 (0の場合は成行)
+	StopPrice   float64      // 損切り価格 (0の場合は指定なし)
+	TargetPrice float64      // 利確目標価格 (0の場合は指定なし)
+	Weight      float64      // 発注サイズの重み (0〜1、0の場合は1として扱う)
+	ExpiresAt   *time.Time   // 有効期限 (nilの場合は無期限)
+	SourceFile  string       // 読み込み元のシグナルファイルパス
+	FileHash    string       `gorm:"index"` // 読み込み元ファイルの内容ハッシュ (SHA-256)
+	ReadAt      time.Time    // エージェントがシグナルを読み込んだ日時
+	RecordKey   string       `gorm:"index"` // レコード単位の重複判定キー (取引日:銘柄コード:売買区分)
+	Source      SignalSource `gorm:"index"` // 取り込み元
+	ConsumedAt  *time.Time   `gorm:"index"` // エージェントが処理した日時 (nilの場合は未処理)
 	// PriceRange  string // シグナルが有効な価格帯 (必要に応じて)
-	// Expiration time.Time // 有効期限 (必要に応じて)
+}
+
+// IsExpired は指定した時刻においてシグナルの有効期限が切れているかどうかを返す
+func (s *Signal) IsExpired(now time.Time) bool {
+	return s.ExpiresAt != nil && now.After(*s.ExpiresAt)
+}
+
+// SignalRecordKey はレコード単位の重複判定キーを返す
+// 同じ取引日・銘柄・売買区分のシグナルは同一とみなす
+func SignalRecordKey(tradingDay string, symbol string, signalType SignalType) string {
+	return fmt.Sprintf("%s:%s:%s", tradingDay, symbol, signalType)
+}
+
+// SignalFile は、処理済みのシグナルファイルを表すモデル
+// 同じ内容のファイルから二重に注文が出ないよう、ファイルハッシュ単位で記録する
+type SignalFile struct {
+	FileHash    string `gorm:"primaryKey"` // ファイル内容のハッシュ (SHA-256)
+	Path        string // 処理時のファイルパス
+	RecordCount int    // ファイルに含まれていたレコード数
+	ProcessedAt time.Time
 }
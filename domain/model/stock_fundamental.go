@@ -0,0 +1,81 @@
+// domain/model/stock_fundamental.go
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// StockFundamental は、銘柄の投資指標 (BPS, EPS, 配当など) の日次スナップショットを表すモデル
+// 銘柄詳細情報問合取得 (CLMMfdsGetIssueDetail) の情報に対応し、銘柄・日付ごとに1件保存する
+// 数値項目は 0 の場合は未設定とする
+type StockFundamental struct {
+	ID                    uint      `gorm:"primaryKey"`
+	IssueCode             string    `gorm:"size:255;uniqueIndex:idx_stock_fundamentals_issue_code_snapshot_date"` // 銘柄コード
+	SnapshotDate          string    `gorm:"size:8;uniqueIndex:idx_stock_fundamentals_issue_code_snapshot_date"`   // スナップショットの日付 (YYYYMMDD, 日本時間)
+	BPS                   float64   // 一株資産 (実績・連結)
+	EPS                   float64   // 一株利益 (予想・通期連結)
+	ROE                   float64   // ROE (予想, %)
+	ReportedPER           float64   // PER (予想)。取得時点の株価による値
+	ReportedPBR           float64   // PBR (実績)。取得時点の株価による値
+	EarningsYield         float64   // 株式益回り (予想, %)
+	ReportedDividendYield float64   // 配当利回り (予想, %)。取得時点の株価による値
+	DividendPerShare      float64   // 一株配当 (予想)。配当利回りと取得時点の株価から求めた値
+	ExDividendDate        string    `gorm:"size:8"` // 配当権利落日 (本決算, YYYYMMDD)
+	InterimExDividendDate string    `gorm:"size:8"` // 中間配当権利落日 (YYYYMMDD)
+	LastExRightsDate      string    `gorm:"size:8"` // 最終落日 (決算期以外, YYYYMMDD)
+	YearHigh              float64   // 年初来高値
+	YearHighDate          string    `gorm:"size:8"` // 年初来高値の更新日 (YYYYMMDD)
+	YearLow               float64   // 年初来安値
+	YearLowDate           string    `gorm:"size:8"` // 年初来安値の更新日 (YYYYMMDD)
+	FetchedAt             time.Time // 取得した日時
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// ImpliedDividendPerShare は配当利回りと、PER×EPS (なければ PBR×BPS) で求めた取得時点の株価から一株配当を求める
+// 求められない場合は 0 を返す
+func ImpliedDividendPerShare(dividendYield, per, eps, pbr, bps float64) float64 {
+	if dividendYield <= 0 {
+		return 0
+	}
+	referencePrice := 0.0
+	if per > 0 && eps > 0 {
+		referencePrice = per * eps
+	} else if pbr > 0 && bps > 0 {
+		referencePrice = pbr * bps
+	}
+	if referencePrice <= 0 {
+		return 0
+	}
+	return math.Round(dividendYield*referencePrice) / 100
+}
+
+// PER は株価から PER を求める。EPS が正でない場合は false を返す
+func (f *StockFundamental) PER(price float64) (float64, bool) {
+	if price <= 0 || f.EPS <= 0 {
+		return 0, false
+	}
+	return roundRatio(price / f.EPS), true
+}
+
+// PBR は株価から PBR を求める。BPS が正でない場合は false を返す
+func (f *StockFundamental) PBR(price float64) (float64, bool) {
+	if price <= 0 || f.BPS <= 0 {
+		return 0, false
+	}
+	return roundRatio(price / f.BPS), true
+}
+
+// DividendYield は株価から配当利回り (%) を求める。一株配当が未設定の場合は false を返す
+func (f *StockFundamental) DividendYield(price float64) (float64, bool) {
+	if price <= 0 || f.DividendPerShare <= 0 {
+		return 0, false
+	}
+	return roundRatio(f.DividendPerShare / price * 100), true
+}
+
+// roundRatio は指標を小数点以下2桁に丸める
+func roundRatio(v float64) float64 {
+	return math.Round(v*100) / 100
+}
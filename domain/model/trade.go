@@ -0,0 +1,166 @@
+package model
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// CostMethod は往復取引の取得単価の計算方法
+type CostMethod string
+
+const (
+	CostMethodFIFO    CostMethod = "FIFO"    // 先入先出法 (建てた順に決済する)
+	CostMethodAverage CostMethod = "AVERAGE" // 移動平均法
+)
+
+// TradeSide は往復取引の方向
+type TradeSide string
+
+const (
+	TradeSideLong  TradeSide = "LONG"  // 買い → 売り
+	TradeSideShort TradeSide = "SHORT" // 売建 → 買戻し
+)
+
+// Trade は約定を突き合わせた往復取引 (建て → 決済) を表すモデル
+// 保存はせず、保存済みの約定 (Execution) から都度計算する
+type Trade struct {
+	Symbol          string
+	AccountType     AccountType
+	IsMargin        bool
+	Side            TradeSide
+	Quantity        int
+	EntryOrderID    string // 建てた注文の ID (移動平均法では最初に建てた注文)
+	ExitOrderID     string
+	EntryTime       time.Time // 建てた日時 (移動平均法では最初に建てた日時)
+	ExitTime        time.Time
+	EntryPrice      float64 // 取得単価 (移動平均法では平均単価)
+	ExitPrice       float64
+	Commission      float64 // 建てと決済の手数料のうち、この取引の数量に按分した額
+	GrossProfitLoss float64 // 手数料控除前の損益
+	NetProfitLoss   float64 // 手数料控除後の損益
+}
+
+// openLot は決済されていない建玉の一部
+type openLot struct {
+	orderID            string
+	executionID        string
+	time               time.Time
+	price              float64
+	openQuantity       float64 // 建てた数量
+	quantity           float64 // 未決済の数量
+	commissionPerShare float64
+	direction          float64 // 買いは 1、売建は -1
+}
+
+// bookKey は突き合わせの単位 (銘柄・口座区分・現物/信用)
+type bookKey struct {
+	symbol      string
+	accountType AccountType
+	isMargin    bool
+}
+
+// MatchTrades は注文の約定を約定日時の順に突き合わせ、往復取引を決済日時の順に返す
+// 銘柄ごとに口座区分と現物/信用を分けて扱う。信用は決済数量を超える約定で反対方向の建玉を建てるが、
+// 現物の売りで保有を超える数量 (約定の記録がない株式の売却など) は取得単価が分からないため突き合わせない。
+func MatchTrades(orders []*Order, method CostMethod) ([]*Trade, error) {
+	if method != CostMethodFIFO && method != CostMethodAverage {
+		return nil, fmt.Errorf("unknown cost method: %s", method)
+	}
+	trades, _ := matchExecutions(orders, method)
+	return trades, nil
+}
+
+// matchExecutions は MatchTrades の本体で、往復取引と決済されずに残った建玉を返す
+func matchExecutions(orders []*Order, method CostMethod) ([]*Trade, map[bookKey][]*openLot) {
+
+	type execution struct {
+		order *Order
+		*Execution
+	}
+	var executions []execution
+	for _, o := range orders {
+		for i := range o.Executions {
+			executions = append(executions, execution{order: o, Execution: &o.Executions[i]})
+		}
+	}
+	slices.SortStableFunc(executions, func(a, b execution) int {
+		return cmp.Or(a.ExecutionTime.Compare(b.ExecutionTime), cmp.Compare(a.ExecutionID, b.ExecutionID))
+	})
+
+	books := make(map[bookKey][]*openLot)
+	var trades []*Trade
+	for _, e := range executions {
+		if e.ExecutionQuantity <= 0 {
+			continue
+		}
+		key := bookKey{e.order.Symbol, e.order.accountType(), e.order.IsMargin}
+		lots := books[key]
+		quantity := float64(e.ExecutionQuantity)
+		commissionPerShare := e.Commission / quantity
+		direction := 1.0
+		if e.order.TradeType == TradeTypeSell {
+			direction = -1
+		}
+
+		// 決済: 反対方向の建玉を古い順に決済する
+		for len(lots) > 0 && lots[0].direction != direction && quantity > 0 {
+			lot := lots[0]
+			matched := math.Min(quantity, lot.quantity)
+			gross := matched * (e.ExecutionPrice - lot.price) * lot.direction
+			commission := matched * (lot.commissionPerShare + commissionPerShare)
+			side := TradeSideLong
+			if lot.direction < 0 {
+				side = TradeSideShort
+			}
+			trades = append(trades, &Trade{
+				Symbol:          e.order.Symbol,
+				AccountType:     key.accountType,
+				IsMargin:        e.order.IsMargin,
+				Side:            side,
+				Quantity:        int(matched),
+				EntryOrderID:    lot.orderID,
+				ExitOrderID:     e.order.OrderID,
+				EntryTime:       lot.time,
+				ExitTime:        e.ExecutionTime,
+				EntryPrice:      lot.price,
+				ExitPrice:       e.ExecutionPrice,
+				Commission:      commission,
+				GrossProfitLoss: gross,
+				NetProfitLoss:   gross - commission,
+			})
+			lot.quantity -= matched
+			quantity -= matched
+			if lot.quantity == 0 {
+				lots = lots[1:]
+			}
+		}
+
+		// 建て: 残りの数量で同じ方向の建玉を建てる (現物の売りは建てない)
+		if quantity > 0 && (e.order.IsMargin || direction > 0) {
+			if method == CostMethodAverage && len(lots) > 0 {
+				lot := lots[0]
+				total := lot.quantity + quantity
+				lot.price = (lot.price*lot.quantity + e.ExecutionPrice*quantity) / total
+				lot.commissionPerShare = (lot.commissionPerShare*lot.quantity + commissionPerShare*quantity) / total
+				lot.openQuantity += quantity
+				lot.quantity = total
+			} else {
+				lots = append(lots, &openLot{
+					orderID:            e.order.OrderID,
+					executionID:        e.ExecutionID,
+					time:               e.ExecutionTime,
+					price:              e.ExecutionPrice,
+					openQuantity:       quantity,
+					quantity:           quantity,
+					commissionPerShare: commissionPerShare,
+					direction:          direction,
+				})
+			}
+		}
+		books[key] = lots
+	}
+	return trades, books
+}
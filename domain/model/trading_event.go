@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// TradingEventType はエージェントの取引イベントの種別
+type TradingEventType string
+
+const (
+	TradingEventOrderRejected TradingEventType = "ORDER_REJECTED" // 発注がトレードサービスまたは証券会社に拒否された
+	TradingEventRiskBlocked   TradingEventType = "RISK_BLOCKED"   // リスク管理の確認によりシグナルの発注を見送った
+)
+
+// TradingEvent は、ログだけでは後から集計できない発注の拒否やリスク管理による見送りを記録したモデル
+// 日次レポートの集計に使用する
+type TradingEvent struct {
+	ID         uint             `gorm:"primaryKey"`
+	OccurredAt time.Time        `gorm:"index"` // 発生日時
+	Type       TradingEventType `gorm:"index"`
+	Symbol     string           // 銘柄コード
+	SignalID   *uint            // 契機となったシグナルのID (シグナル以外の場合はnil)
+	Message    string           // 拒否・見送りの理由
+	CreatedAt  time.Time
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"time"
+)
+
+// BalanceSnapshotQuery は残高スナップショットの検索条件。ゼロ値は条件に使用しない
+type BalanceSnapshotQuery struct {
+	Since time.Time // この日時以降のスナップショット
+	Until time.Time // この日時より前のスナップショット
+	Limit int
+}
+
+type BalanceRepository interface {
+	// SaveSnapshot は残高スナップショットを保存する
+	SaveSnapshot(ctx context.Context, snapshot *model.BalanceSnapshot) error
+	// FindSnapshots は条件に一致する残高スナップショットを新しい順に取得する
+	FindSnapshots(ctx context.Context, query BalanceSnapshotQuery) ([]*model.BalanceSnapshot, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"stock-bot/domain/model"
+)
+
+type FundamentalRepository interface {
+	// UpsertFundamentals は投資指標のスナップショットを保存する。同じ銘柄・日付のスナップショットは上書きする
+	UpsertFundamentals(ctx context.Context, fundamentals []*model.StockFundamental) error
+	// FindFundamental は銘柄・日付 (YYYYMMDD) のスナップショットを取得する。見つからない場合は nil を返す
+	FindFundamental(ctx context.Context, issueCode string, snapshotDate string) (*model.StockFundamental, error)
+	// FindLatestFundamental は銘柄の最新のスナップショットを取得する。見つからない場合は nil を返す
+	FindLatestFundamental(ctx context.Context, issueCode string) (*model.StockFundamental, error)
+}
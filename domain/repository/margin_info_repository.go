@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"stock-bot/domain/model"
+)
+
+// MarginInfoQuery は証金残・信用残・逆日歩の検索条件。空文字列とゼロ値は条件に使用しない
+type MarginInfoQuery struct {
+	IssueCode string // 銘柄コード
+	Since     string // この日付 (YYYYMMDD) 以降のデータ
+	Limit     int
+}
+
+type MarginInfoRepository interface {
+	// UpsertSecuritiesFinanceBalances は証金残を保存する。同じ銘柄・日付のデータは上書きする
+	UpsertSecuritiesFinanceBalances(ctx context.Context, balances []*model.SecuritiesFinanceBalance) error
+	// UpsertCreditBalances は信用残を保存する。同じ銘柄・日付のデータは上書きする
+	UpsertCreditBalances(ctx context.Context, balances []*model.CreditBalance) error
+	// UpsertMarginPremiums は逆日歩を保存する。同じ銘柄・日付のデータは上書きする
+	UpsertMarginPremiums(ctx context.Context, premiums []*model.MarginPremiumRecord) error
+
+	// FindSecuritiesFinanceBalances は条件に一致する証金残を新しい順に取得する
+	FindSecuritiesFinanceBalances(ctx context.Context, query MarginInfoQuery) ([]*model.SecuritiesFinanceBalance, error)
+	// FindCreditBalances は条件に一致する信用残を新しい順に取得する
+	FindCreditBalances(ctx context.Context, query MarginInfoQuery) ([]*model.CreditBalance, error)
+	// FindMarginPremiums は条件に一致する逆日歩を新しい順に取得する
+	FindMarginPremiums(ctx context.Context, query MarginInfoQuery) ([]*model.MarginPremiumRecord, error)
+	// FindLatestMarginPremium は銘柄の最新の逆日歩を取得する。見つからない場合は nil を返す
+	FindLatestMarginPremium(ctx context.Context, issueCode string) (*model.MarginPremiumRecord, error)
+}
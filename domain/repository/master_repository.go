@@ -5,12 +5,59 @@ import (
 	"stock-bot/domain/model"
 )
 
+// StockMasterQuery は銘柄マスタの検索条件
+// 空文字列・0のフィールドは絞り込みに使用しない
+type StockMasterQuery struct {
+	MarketCode   string // 優先市場コード
+	IndustryCode string // 業種コード
+	Keyword      string // 銘柄名・銘柄名略称・銘柄名（カナ）の部分一致
+	TradingUnit  int    // 売買単位
+	Offset       int    // 取得開始位置
+	Limit        int    // 取得件数 (0の場合は全件)
+}
+
+// IndustryCount は業種ごとの銘柄数
+type IndustryCount struct {
+	IndustryCode string
+	IndustryName string
+	Count        int64
+}
+
 type MasterRepository interface {
 	Save(ctx context.Context, entity interface{}) error
 	SaveAll(ctx context.Context, entities []interface{}) error
+	// FindByIssueCode は銘柄コードでマスタを取得する。見つからない場合は nil を返す
+	// 銘柄マスタ (StockMaster) は論理削除された銘柄を含まない
 	FindByIssueCode(ctx context.Context, issueCode string, entityType string) (interface{}, error)
 	UpsertStockMasters(ctx context.Context, stocks []*model.StockMaster) error
 	UpsertTickRules(ctx context.Context, tickRules []*model.TickRule) error
+	// FindTickRule は呼値の単位番号で呼値テーブルを取得する。見つからない場合は nil を返す
+	FindTickRule(ctx context.Context, tickUnitNumber string) (*model.TickRule, error)
+	// FindStockMasters は条件に一致する銘柄マスタを銘柄コード順に取得する。条件に一致する総件数も返す
+	FindStockMasters(ctx context.Context, query StockMasterQuery) ([]*model.StockMaster, int64, error)
+	// CountStocksByIndustry は業種ごとの銘柄数を業種コード順に返す
+	CountStocksByIndustry(ctx context.Context) ([]*IndustryCount, error)
+
+	// SyncStockMasters は銘柄マスタを差分で反映し、変わった項目の履歴を syncRunID と共に保存する
+	// deleteMissing が true の場合、stocks に含まれない銘柄を論理削除する
+	SyncStockMasters(ctx context.Context, stocks []*model.StockMaster, deleteMissing bool, syncRunID uint) (model.MasterSyncCounts, error)
+	// SyncStockMarketMasters は株式銘柄市場マスタを差分で反映し、含まれない行を論理削除する
+	SyncStockMarketMasters(ctx context.Context, markets []*model.StockMarketMaster) (model.MasterSyncCounts, error)
+	// SyncTickRules は呼値テーブルを差分で反映する。呼値テーブルは削除しない
+	SyncTickRules(ctx context.Context, tickRules []*model.TickRule) (model.MasterSyncCounts, error)
+	// SyncMarginMasters は保証金マスタを差分で反映し、含まれない行を論理削除する
+	SyncMarginMasters(ctx context.Context, margins []*model.MarginMaster) (model.MasterSyncCounts, error)
+	// SyncStockIssueRegulations は銘柄別・市場別規制を差分で反映し、含まれない行を論理削除する
+	SyncStockIssueRegulations(ctx context.Context, regulations []*model.StockIssueRegulation) (model.MasterSyncCounts, error)
+	// SyncOperationStatuses は運用ステータスを差分で反映し、含まれない行を論理削除する
+	SyncOperationStatuses(ctx context.Context, statuses []*model.OperationStatus) (model.MasterSyncCounts, error)
+
+	// CreateMasterSyncRun はマスタデータ同期の実行履歴を作成する (ID が設定される)
+	CreateMasterSyncRun(ctx context.Context, run *model.MasterSyncRun) error
+	// UpdateMasterSyncRun はマスタデータ同期の実行履歴を更新する
+	UpdateMasterSyncRun(ctx context.Context, run *model.MasterSyncRun) error
+	// FindMasterSyncRuns はマスタデータ同期の実行履歴を新しい順に最大 limit 件返す
+	FindMasterSyncRuns(ctx context.Context, limit int) ([]*model.MasterSyncRun, error)
 	// Find(ctx context.Context, conditions map[string]interface{}, entityType string) ([]interface{}, error) // より汎用的な検索
 	// Delete(ctx context.Context, entity interface{}) error // 削除が必要な場合
 }
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"time"
+)
+
+// NewsQuery はニュースの検索条件。空文字列とゼロ値は条件に使用しない
+type NewsQuery struct {
+	Symbol string    // 関連銘柄コード
+	Since  time.Time // この日時以降のニュース
+	Limit  int
+}
+
+type NewsRepository interface {
+	// SaveNews は未保存のニュースを保存し、保存した件数を返す。保存済みのニュースIDは無視する
+	SaveNews(ctx context.Context, news []*model.News) (int, error)
+	// FindByID はニュースを取得する。見つからない場合は nil を返す
+	FindByID(ctx context.Context, id string) (*model.News, error)
+	// FindNews は条件に一致するニュースを新しい順に取得する
+	FindNews(ctx context.Context, query NewsQuery) ([]*model.News, error)
+	// FindWithoutBody は本文を未取得のニュースを新しい順に最大 limit 件取得する
+	FindWithoutBody(ctx context.Context, limit int) ([]*model.News, error)
+	// SaveBody はニュースの本文を保存する
+	SaveBody(ctx context.Context, id string, body string, fetchedAt time.Time) error
+}
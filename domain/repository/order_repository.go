@@ -2,12 +2,47 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"stock-bot/domain/model"
+	"time"
 )
 
+// ErrOrderConflict は注文の状態を更新する前に、他の更新で注文が変更されていた場合に返される (楽観ロック)
+// 注文を読み込み直してから更新をやり直す
+var ErrOrderConflict = errors.New("order was modified concurrently")
+
+// OrderQuery は注文の検索条件。ゼロ値は条件に使用しない
+type OrderQuery struct {
+	Since    time.Time // この日時以降に発注 (保存) した注文
+	Until    time.Time // この日時より前に発注 (保存) した注文
+	Symbol   string    // 銘柄コード
+	SignalID *uint     // 発注の契機となったシグナルのID
+	Offset   int       // 読み飛ばす件数
+	Limit    int       // 取得件数の上限 (0 の場合は上限なし)
+}
+
 type OrderRepository interface {
+	// Save は注文を保存し、初期状態を状態遷移の履歴に記録する
 	Save(ctx context.Context, order *model.Order) error
+	// FindByID は注文を約定 (Executions) を読み込んだ状態で返す
 	FindByID(ctx context.Context, orderID string) (*model.Order, error)
 	FindByStatus(ctx context.Context, status model.OrderStatus) ([]*model.Order, error) // 例: 特定のステータスの注文を検索
+	// FindOrders は条件に一致する注文を、約定 (Executions) を読み込んだ状態で発注の古い順に返す
+	FindOrders(ctx context.Context, query OrderQuery) ([]*model.Order, error)
+	// UpdateStatus は注文の状態を更新して状態遷移の履歴に記録し、order の状態とバージョンを更新後の値にする
+	// 遷移できない状態の場合は model.ErrInvalidOrderTransition を返し、同じ状態の場合は何もしない
+	// 保存されている注文のバージョンが order.Version と異なる場合は ErrOrderConflict を返す (注文が存在しない場合も同じ)
+	UpdateStatus(ctx context.Context, order *model.Order, status model.OrderStatus) error
+	// FindStatusTransitions は注文の状態遷移の履歴を古い順に返す
+	FindStatusTransitions(ctx context.Context, orderID string) ([]*model.OrderStatusTransition, error)
+	// FindExecutedOrders は since 以降に約定がある注文を、約定 (Executions) を読み込んだ状態で返す
+	// since がゼロ値の場合は全期間を対象とする
+	FindExecutedOrders(ctx context.Context, since time.Time) ([]*model.Order, error)
+	// SaveExecutions は約定を ExecutionID で upsert する (手数料などは最新の値で更新する)
+	SaveExecutions(ctx context.Context, executions []*model.Execution) error
+	// SaveExecution は注文の約定を ExecutionID で upsert し、order.Executions にも反映する (約定日時の順に保つ)
+	SaveExecution(ctx context.Context, order *model.Order, execution *model.Execution) error
+	// FindExecutions は注文の約定を約定日時の順に返す
+	FindExecutions(ctx context.Context, orderID string) ([]*model.Execution, error)
 	// 他の必要なメソッドを定義
 }
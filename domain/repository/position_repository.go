@@ -3,11 +3,24 @@ package repository
 import (
 	"context"
 	"stock-bot/domain/model"
+	"time"
 )
 
+// PositionLotQuery は建玉 (ロット) の検索条件。ゼロ値は条件に使用しない
+type PositionLotQuery struct {
+	Symbol       string            // 銘柄コード
+	AccountType  model.AccountType // 口座区分
+	IsMargin     *bool             // 信用建玉かどうか
+	OpenedBefore time.Time         // この日時より前に建てたロット
+}
+
 type PositionRepository interface {
 	Save(ctx context.Context, position *model.Position) error
 	FindBySymbol(ctx context.Context, symbol string) (*model.Position, error) // 例: 銘柄コードでポジションを検索
 	FindAll(ctx context.Context) ([]*model.Position, error)                   // 例: すべてのポジションを取得
-	// 他の必要なメソッドを定義
+	// ReplaceAll は保存されているポジションとロットをすべて削除し、与えられたポジションとロットに置き換える
+	// 約定から作成し直した台帳を保存するために使用する
+	ReplaceAll(ctx context.Context, positions []*model.Position) error
+	// FindLots は条件に一致するロットを建てた日時の古い順に取得する
+	FindLots(ctx context.Context, query PositionLotQuery) ([]*model.PositionLot, error)
 }
@@ -3,12 +3,31 @@ package repository
 import (
 	"context"
 	"stock-bot/domain/model"
+	"time"
 )
 
 type SignalRepository interface {
 	Save(ctx context.Context, signal *model.Signal) error
+	// SaveBatch は複数のシグナルを1つのトランザクションで保存する
+	// file が nil でなければ、シグナルファイルの処理済み記録も同じトランザクションで保存する
+	SaveBatch(ctx context.Context, signals []*model.Signal, file *model.SignalFile) error
 	FindByID(ctx context.Context, id uint) (*model.Signal, error)
 	FindBySymbol(ctx context.Context, symbol string) ([]*model.Signal, error) // 例: 銘柄コードでシグナルを検索
+	FindByFileHash(ctx context.Context, fileHash string) ([]*model.Signal, error)
+
+	// IsFileProcessed は指定したハッシュのシグナルファイルが処理済みかどうかを返す
+	IsFileProcessed(ctx context.Context, fileHash string) (bool, error)
+	// ExistsByRecordKey は同じ重複判定キーを持つシグナルが既に保存されているかどうかを返す
+	ExistsByRecordKey(ctx context.Context, recordKey string) (bool, error)
+
+	// FindRecent は新しい順にシグナルを取得する。symbol が空の場合は全銘柄を対象とする
+	FindRecent(ctx context.Context, symbol string, limit int) ([]*model.Signal, error)
+	// FindUnconsumed はエージェントが未処理のシグナルを古い順に取得する
+	FindUnconsumed(ctx context.Context) ([]*model.Signal, error)
+	// MarkConsumed はシグナルをエージェントが処理済みとして記録する
+	MarkConsumed(ctx context.Context, id uint, consumedAt time.Time) error
+	// FindConsumed はエージェントが [since, until) に処理したシグナルを処理した順に取得する
+	FindConsumed(ctx context.Context, since, until time.Time) ([]*model.Signal, error)
 
 	// 他の必要なメソッドを定義
 }
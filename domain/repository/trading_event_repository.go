@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"time"
+)
+
+// TradingEventQuery は取引イベントの検索条件。ゼロ値は条件に使用しない
+type TradingEventQuery struct {
+	Since time.Time // この日時以降のイベント
+	Until time.Time // この日時より前のイベント
+}
+
+type TradingEventRepository interface {
+	// Save は取引イベントを保存する
+	Save(ctx context.Context, event *model.TradingEvent) error
+	// FindEvents は条件に一致する取引イベントを古い順に取得する
+	FindEvents(ctx context.Context, query TradingEventQuery) ([]*model.TradingEvent, error)
+}
@@ -15,13 +15,19 @@ import (
 
 // Client is the "balance" service client.
 type Client struct {
-	GetEndpoint goa.Endpoint
+	GetEndpoint            goa.Endpoint
+	HistoryEndpoint        goa.Endpoint
+	BuyingPowerEndpoint    goa.Endpoint
+	MarginCapacityEndpoint goa.Endpoint
 }
 
 // NewClient initializes a "balance" service client given the endpoints.
-func NewClient(get goa.Endpoint) *Client {
+func NewClient(get, history, buyingPower, marginCapacity goa.Endpoint) *Client {
 	return &Client{
-		GetEndpoint: get,
+		GetEndpoint:            get,
+		HistoryEndpoint:        history,
+		BuyingPowerEndpoint:    buyingPower,
+		MarginCapacityEndpoint: marginCapacity,
 	}
 }
 
@@ -34,3 +40,33 @@ func (c *Client) Get(ctx context.Context) (res *StockbotBalance, err error) {
 	}
 	return ires.(*StockbotBalance), nil
 }
+
+// History calls the "history" endpoint of the "balance" service.
+func (c *Client) History(ctx context.Context, p *HistoryPayload) (res *StockbotBalanceHistory, err error) {
+	var ires any
+	ires, err = c.HistoryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotBalanceHistory), nil
+}
+
+// BuyingPower calls the "buying_power" endpoint of the "balance" service.
+func (c *Client) BuyingPower(ctx context.Context, p *BuyingPowerPayload) (res *StockbotBuyingPower, err error) {
+	var ires any
+	ires, err = c.BuyingPowerEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotBuyingPower), nil
+}
+
+// MarginCapacity calls the "margin_capacity" endpoint of the "balance" service.
+func (c *Client) MarginCapacity(ctx context.Context, p *MarginCapacityPayload) (res *StockbotMarginCapacity, err error) {
+	var ires any
+	ires, err = c.MarginCapacityEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotMarginCapacity), nil
+}
@@ -15,19 +15,28 @@ import (
 
 // Endpoints wraps the "balance" service endpoints.
 type Endpoints struct {
-	Get goa.Endpoint
+	Get            goa.Endpoint
+	History        goa.Endpoint
+	BuyingPower    goa.Endpoint
+	MarginCapacity goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "balance" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		Get: NewGetEndpoint(s),
+		Get:            NewGetEndpoint(s),
+		History:        NewHistoryEndpoint(s),
+		BuyingPower:    NewBuyingPowerEndpoint(s),
+		MarginCapacity: NewMarginCapacityEndpoint(s),
 	}
 }
 
 // Use applies the given middleware to all the "balance" service endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Get = m(e.Get)
+	e.History = m(e.History)
+	e.BuyingPower = m(e.BuyingPower)
+	e.MarginCapacity = m(e.MarginCapacity)
 }
 
 // NewGetEndpoint returns an endpoint function that calls the method "get" of
@@ -42,3 +51,45 @@ func NewGetEndpoint(s Service) goa.Endpoint {
 		return vres, nil
 	}
 }
+
+// NewHistoryEndpoint returns an endpoint function that calls the method
+// "history" of service "balance".
+func NewHistoryEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*HistoryPayload)
+		res, err := s.History(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotBalanceHistory(res, "default")
+		return vres, nil
+	}
+}
+
+// NewBuyingPowerEndpoint returns an endpoint function that calls the method
+// "buying_power" of service "balance".
+func NewBuyingPowerEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BuyingPowerPayload)
+		res, err := s.BuyingPower(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotBuyingPower(res, "default")
+		return vres, nil
+	}
+}
+
+// NewMarginCapacityEndpoint returns an endpoint function that calls the method
+// "margin_capacity" of service "balance".
+func NewMarginCapacityEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*MarginCapacityPayload)
+		res, err := s.MarginCapacity(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotMarginCapacity(res, "default")
+		return vres, nil
+	}
+}
@@ -16,6 +16,14 @@ import (
 type Service interface {
 	// Get the account balance summary.
 	Get(context.Context) (res *StockbotBalance, err error)
+	// Get the daily balance history from the broker and the stored balance
+	// snapshots.
+	History(context.Context, *HistoryPayload) (res *StockbotBalanceHistory, err error)
+	// Get the breakdown of the cash buying power on a business day.
+	BuyingPower(context.Context, *BuyingPowerPayload) (res *StockbotBuyingPower, err error)
+	// Get the breakdown of the buying power for new margin positions on a business
+	// day.
+	MarginCapacity(context.Context, *MarginCapacityPayload) (res *StockbotMarginCapacity, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -32,7 +40,75 @@ const ServiceName = "balance"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [1]string{"get"}
+var MethodNames = [4]string{"get", "history", "buying_power", "margin_capacity"}
+
+// The balance of a business day reported by the broker.
+type BalanceDayResult struct {
+	// 日付 (YYYYMMDD)
+	Date string
+	// 現物株式買付可能額
+	CashBuyingPower float64
+	// 信用新規建可能額
+	MarginBuyingPower float64
+	// 出金可能額
+	WithdrawableCash float64
+	// 受入保証金
+	DepositedMargin float64
+	// 必要保証金
+	RequiredMargin float64
+	// 委託保証金率(%)
+	MaintenanceRate float64
+	// 追証余力
+	MarginCallSurplus float64
+	// 追証/立替金/保証金不足額
+	Shortfall float64
+}
+
+// A balance snapshot taken by the balance monitor.
+type BalanceSnapshotResult struct {
+	// 取得した日時 (RFC3339)
+	TakenAt string
+	// 現物株式買付可能額
+	CashBuyingPower float64
+	// 信用新規建可能額
+	MarginBuyingPower float64
+	// 出金可能額
+	WithdrawableCash float64
+	// 受入保証金
+	DepositedMargin float64
+	// 建株代金
+	PositionValue float64
+	// 評価損益
+	ValuationProfitLoss float64
+	// 委託保証金率(%) (リアルタイム)
+	MaintenanceRate float64
+	// 追証余力
+	MarginCallSurplus float64
+	// 追証が発生しているかどうか
+	HasMarginCall bool
+}
+
+// BuyingPowerPayload is the payload type of the balance service buying_power
+// method.
+type BuyingPowerPayload struct {
+	// 営業日インデックス (0:T+0 〜 5:T+5)
+	Day int
+}
+
+// HistoryPayload is the payload type of the balance service history method.
+type HistoryPayload struct {
+	// この日時以降のスナップショットに絞り込む (RFC3339)
+	Since *string
+	// スナップショットの取得件数
+	Limit int
+}
+
+// MarginCapacityPayload is the payload type of the balance service
+// margin_capacity method.
+type MarginCapacityPayload struct {
+	// 営業日インデックス (0:T+0 〜 5:T+5)
+	Day int
+}
 
 // StockbotBalance is the result type of the balance service get method.
 type StockbotBalance struct {
@@ -46,6 +122,97 @@ type StockbotBalance struct {
 	WithdrawableCash float64
 	// 追証発生フラグ (1:発生, 0:未発生)
 	HasMarginCall bool
+	// 信用現引可能額
+	MarginDeliveryCapacity float64
+	// NISA成長投資可能額
+	NisaGrowthCapacity float64
+	// 不足額 (入金請求額)
+	Shortfall float64
+	// 立替金が発生しているかどうか
+	HasAdvance bool
+	// 証券会社側の更新日時 (RFC3339)
+	UpdatedAt *string
+}
+
+// StockbotBalanceHistory is the result type of the balance service history
+// method.
+type StockbotBalanceHistory struct {
+	// 可能額推移 (過去6営業日)
+	Daily []*BalanceDayResult
+	// 保存済みの残高スナップショット
+	Snapshots []*BalanceSnapshotResult
+}
+
+// StockbotBuyingPower is the result type of the balance service buying_power
+// method.
+type StockbotBuyingPower struct {
+	// 営業日インデックス (0:T+0 〜 5:T+5)
+	Day int
+	// 日付 (YYYYMMDD)
+	Date string
+	// 現物株式買付可能額
+	CashBuyingPower float64
+	// 保証金からの現物株式買付可能額
+	MarginBuyingPower float64
+	// 預り金
+	DepositBalance float64
+	// 発注済み注文充当金
+	OrderReserved float64
+	// 日計り拘束金
+	DayTradeRestricted float64
+	// その他拘束金
+	OtherRestricted float64
+	// 現金保証金
+	CashMargin float64
+	// 必要現金保証金
+	RequiredCashMargin float64
+	// 代用証券評価額
+	CollateralValue float64
+	// 建株評価損益
+	PositionProfitLoss float64
+	// 受入保証金
+	DepositedMargin float64
+	// 必要保証金
+	RequiredMargin float64
+	// 保証金余力
+	MarginSurplus float64
+}
+
+// StockbotMarginCapacity is the result type of the balance service
+// margin_capacity method.
+type StockbotMarginCapacity struct {
+	// 営業日インデックス (0:T+0 〜 5:T+5)
+	Day int
+	// 日付 (YYYYMMDD)
+	Date string
+	// 信用新規建可能額
+	MarginBuyingPower float64
+	// 受入保証金
+	DepositedMargin float64
+	// 必要保証金
+	RequiredMargin float64
+	// 保証金余力
+	MarginSurplus float64
+	// 保証金徴収率(%)
+	CollectionRate float64
+	// 預り金
+	DepositBalance float64
+	// 発注済み注文充当金
+	OrderReserved float64
+	// 現金保証金
+	CashMargin float64
+	// 代用証券評価額
+	CollateralValue float64
+	// 建株代金
+	PositionValue float64
+	// 発注分建株代金
+	OrderPositionValue float64
+	// 建株評価損益
+	PositionProfitLoss float64
+	// 委託保証金率(%)
+	MaintenanceRate float64
+	// 本日決済損益合計
+	TodayProfitLoss float64
 }
 
 // NewStockbotBalance initializes result type StockbotBalance from viewed
@@ -61,10 +228,54 @@ func NewViewedStockbotBalance(res *StockbotBalance, view string) *balanceviews.S
 	return &balanceviews.StockbotBalance{Projected: p, View: "default"}
 }
 
+// NewStockbotBalanceHistory initializes result type StockbotBalanceHistory
+// from viewed result type StockbotBalanceHistory.
+func NewStockbotBalanceHistory(vres *balanceviews.StockbotBalanceHistory) *StockbotBalanceHistory {
+	return newStockbotBalanceHistory(vres.Projected)
+}
+
+// NewViewedStockbotBalanceHistory initializes viewed result type
+// StockbotBalanceHistory from result type StockbotBalanceHistory using the
+// given view.
+func NewViewedStockbotBalanceHistory(res *StockbotBalanceHistory, view string) *balanceviews.StockbotBalanceHistory {
+	p := newStockbotBalanceHistoryView(res)
+	return &balanceviews.StockbotBalanceHistory{Projected: p, View: "default"}
+}
+
+// NewStockbotBuyingPower initializes result type StockbotBuyingPower from
+// viewed result type StockbotBuyingPower.
+func NewStockbotBuyingPower(vres *balanceviews.StockbotBuyingPower) *StockbotBuyingPower {
+	return newStockbotBuyingPower(vres.Projected)
+}
+
+// NewViewedStockbotBuyingPower initializes viewed result type
+// StockbotBuyingPower from result type StockbotBuyingPower using the given
+// view.
+func NewViewedStockbotBuyingPower(res *StockbotBuyingPower, view string) *balanceviews.StockbotBuyingPower {
+	p := newStockbotBuyingPowerView(res)
+	return &balanceviews.StockbotBuyingPower{Projected: p, View: "default"}
+}
+
+// NewStockbotMarginCapacity initializes result type StockbotMarginCapacity
+// from viewed result type StockbotMarginCapacity.
+func NewStockbotMarginCapacity(vres *balanceviews.StockbotMarginCapacity) *StockbotMarginCapacity {
+	return newStockbotMarginCapacity(vres.Projected)
+}
+
+// NewViewedStockbotMarginCapacity initializes viewed result type
+// StockbotMarginCapacity from result type StockbotMarginCapacity using the
+// given view.
+func NewViewedStockbotMarginCapacity(res *StockbotMarginCapacity, view string) *balanceviews.StockbotMarginCapacity {
+	p := newStockbotMarginCapacityView(res)
+	return &balanceviews.StockbotMarginCapacity{Projected: p, View: "default"}
+}
+
 // newStockbotBalance converts projected type StockbotBalance to service type
 // StockbotBalance.
 func newStockbotBalance(vres *balanceviews.StockbotBalanceView) *StockbotBalance {
-	res := &StockbotBalance{}
+	res := &StockbotBalance{
+		UpdatedAt: vres.UpdatedAt,
+	}
 	if vres.AvailableCashForStock != nil {
 		res.AvailableCashForStock = *vres.AvailableCashForStock
 	}
@@ -80,6 +291,18 @@ func newStockbotBalance(vres *balanceviews.StockbotBalanceView) *StockbotBalance
 	if vres.HasMarginCall != nil {
 		res.HasMarginCall = *vres.HasMarginCall
 	}
+	if vres.MarginDeliveryCapacity != nil {
+		res.MarginDeliveryCapacity = *vres.MarginDeliveryCapacity
+	}
+	if vres.NisaGrowthCapacity != nil {
+		res.NisaGrowthCapacity = *vres.NisaGrowthCapacity
+	}
+	if vres.Shortfall != nil {
+		res.Shortfall = *vres.Shortfall
+	}
+	if vres.HasAdvance != nil {
+		res.HasAdvance = *vres.HasAdvance
+	}
 	return res
 }
 
@@ -92,6 +315,307 @@ func newStockbotBalanceView(res *StockbotBalance) *balanceviews.StockbotBalanceV
 		MarginMaintenanceRate:         &res.MarginMaintenanceRate,
 		WithdrawableCash:              &res.WithdrawableCash,
 		HasMarginCall:                 &res.HasMarginCall,
+		MarginDeliveryCapacity:        &res.MarginDeliveryCapacity,
+		NisaGrowthCapacity:            &res.NisaGrowthCapacity,
+		Shortfall:                     &res.Shortfall,
+		HasAdvance:                    &res.HasAdvance,
+		UpdatedAt:                     res.UpdatedAt,
 	}
 	return vres
 }
+
+// newStockbotBalanceHistory converts projected type StockbotBalanceHistory to
+// service type StockbotBalanceHistory.
+func newStockbotBalanceHistory(vres *balanceviews.StockbotBalanceHistoryView) *StockbotBalanceHistory {
+	res := &StockbotBalanceHistory{}
+	if vres.Daily != nil {
+		res.Daily = make([]*BalanceDayResult, len(vres.Daily))
+		for i, val := range vres.Daily {
+			if val == nil {
+				res.Daily[i] = nil
+				continue
+			}
+			res.Daily[i] = transformBalanceviewsBalanceDayResultViewToBalanceDayResult(val)
+		}
+	}
+	if vres.Snapshots != nil {
+		res.Snapshots = make([]*BalanceSnapshotResult, len(vres.Snapshots))
+		for i, val := range vres.Snapshots {
+			if val == nil {
+				res.Snapshots[i] = nil
+				continue
+			}
+			res.Snapshots[i] = transformBalanceviewsBalanceSnapshotResultViewToBalanceSnapshotResult(val)
+		}
+	}
+	return res
+}
+
+// newStockbotBalanceHistoryView projects result type StockbotBalanceHistory to
+// projected type StockbotBalanceHistoryView using the "default" view.
+func newStockbotBalanceHistoryView(res *StockbotBalanceHistory) *balanceviews.StockbotBalanceHistoryView {
+	vres := &balanceviews.StockbotBalanceHistoryView{}
+	if res.Daily != nil {
+		vres.Daily = make([]*balanceviews.BalanceDayResultView, len(res.Daily))
+		for i, val := range res.Daily {
+			if val == nil {
+				vres.Daily[i] = nil
+				continue
+			}
+			vres.Daily[i] = transformBalanceDayResultToBalanceviewsBalanceDayResultView(val)
+		}
+	} else {
+		vres.Daily = []*balanceviews.BalanceDayResultView{}
+	}
+	if res.Snapshots != nil {
+		vres.Snapshots = make([]*balanceviews.BalanceSnapshotResultView, len(res.Snapshots))
+		for i, val := range res.Snapshots {
+			if val == nil {
+				vres.Snapshots[i] = nil
+				continue
+			}
+			vres.Snapshots[i] = transformBalanceSnapshotResultToBalanceviewsBalanceSnapshotResultView(val)
+		}
+	} else {
+		vres.Snapshots = []*balanceviews.BalanceSnapshotResultView{}
+	}
+	return vres
+}
+
+// newStockbotBuyingPower converts projected type StockbotBuyingPower to
+// service type StockbotBuyingPower.
+func newStockbotBuyingPower(vres *balanceviews.StockbotBuyingPowerView) *StockbotBuyingPower {
+	res := &StockbotBuyingPower{}
+	if vres.Day != nil {
+		res.Day = *vres.Day
+	}
+	if vres.Date != nil {
+		res.Date = *vres.Date
+	}
+	if vres.CashBuyingPower != nil {
+		res.CashBuyingPower = *vres.CashBuyingPower
+	}
+	if vres.MarginBuyingPower != nil {
+		res.MarginBuyingPower = *vres.MarginBuyingPower
+	}
+	if vres.DepositBalance != nil {
+		res.DepositBalance = *vres.DepositBalance
+	}
+	if vres.OrderReserved != nil {
+		res.OrderReserved = *vres.OrderReserved
+	}
+	if vres.DayTradeRestricted != nil {
+		res.DayTradeRestricted = *vres.DayTradeRestricted
+	}
+	if vres.OtherRestricted != nil {
+		res.OtherRestricted = *vres.OtherRestricted
+	}
+	if vres.CashMargin != nil {
+		res.CashMargin = *vres.CashMargin
+	}
+	if vres.RequiredCashMargin != nil {
+		res.RequiredCashMargin = *vres.RequiredCashMargin
+	}
+	if vres.CollateralValue != nil {
+		res.CollateralValue = *vres.CollateralValue
+	}
+	if vres.PositionProfitLoss != nil {
+		res.PositionProfitLoss = *vres.PositionProfitLoss
+	}
+	if vres.DepositedMargin != nil {
+		res.DepositedMargin = *vres.DepositedMargin
+	}
+	if vres.RequiredMargin != nil {
+		res.RequiredMargin = *vres.RequiredMargin
+	}
+	if vres.MarginSurplus != nil {
+		res.MarginSurplus = *vres.MarginSurplus
+	}
+	return res
+}
+
+// newStockbotBuyingPowerView projects result type StockbotBuyingPower to
+// projected type StockbotBuyingPowerView using the "default" view.
+func newStockbotBuyingPowerView(res *StockbotBuyingPower) *balanceviews.StockbotBuyingPowerView {
+	vres := &balanceviews.StockbotBuyingPowerView{
+		Day:                &res.Day,
+		Date:               &res.Date,
+		CashBuyingPower:    &res.CashBuyingPower,
+		MarginBuyingPower:  &res.MarginBuyingPower,
+		DepositBalance:     &res.DepositBalance,
+		OrderReserved:      &res.OrderReserved,
+		DayTradeRestricted: &res.DayTradeRestricted,
+		OtherRestricted:    &res.OtherRestricted,
+		CashMargin:         &res.CashMargin,
+		RequiredCashMargin: &res.RequiredCashMargin,
+		CollateralValue:    &res.CollateralValue,
+		PositionProfitLoss: &res.PositionProfitLoss,
+		DepositedMargin:    &res.DepositedMargin,
+		RequiredMargin:     &res.RequiredMargin,
+		MarginSurplus:      &res.MarginSurplus,
+	}
+	return vres
+}
+
+// newStockbotMarginCapacity converts projected type StockbotMarginCapacity to
+// service type StockbotMarginCapacity.
+func newStockbotMarginCapacity(vres *balanceviews.StockbotMarginCapacityView) *StockbotMarginCapacity {
+	res := &StockbotMarginCapacity{}
+	if vres.Day != nil {
+		res.Day = *vres.Day
+	}
+	if vres.Date != nil {
+		res.Date = *vres.Date
+	}
+	if vres.MarginBuyingPower != nil {
+		res.MarginBuyingPower = *vres.MarginBuyingPower
+	}
+	if vres.DepositedMargin != nil {
+		res.DepositedMargin = *vres.DepositedMargin
+	}
+	if vres.RequiredMargin != nil {
+		res.RequiredMargin = *vres.RequiredMargin
+	}
+	if vres.MarginSurplus != nil {
+		res.MarginSurplus = *vres.MarginSurplus
+	}
+	if vres.CollectionRate != nil {
+		res.CollectionRate = *vres.CollectionRate
+	}
+	if vres.DepositBalance != nil {
+		res.DepositBalance = *vres.DepositBalance
+	}
+	if vres.OrderReserved != nil {
+		res.OrderReserved = *vres.OrderReserved
+	}
+	if vres.CashMargin != nil {
+		res.CashMargin = *vres.CashMargin
+	}
+	if vres.CollateralValue != nil {
+		res.CollateralValue = *vres.CollateralValue
+	}
+	if vres.PositionValue != nil {
+		res.PositionValue = *vres.PositionValue
+	}
+	if vres.OrderPositionValue != nil {
+		res.OrderPositionValue = *vres.OrderPositionValue
+	}
+	if vres.PositionProfitLoss != nil {
+		res.PositionProfitLoss = *vres.PositionProfitLoss
+	}
+	if vres.MaintenanceRate != nil {
+		res.MaintenanceRate = *vres.MaintenanceRate
+	}
+	if vres.TodayProfitLoss != nil {
+		res.TodayProfitLoss = *vres.TodayProfitLoss
+	}
+	return res
+}
+
+// newStockbotMarginCapacityView projects result type StockbotMarginCapacity to
+// projected type StockbotMarginCapacityView using the "default" view.
+func newStockbotMarginCapacityView(res *StockbotMarginCapacity) *balanceviews.StockbotMarginCapacityView {
+	vres := &balanceviews.StockbotMarginCapacityView{
+		Day:                &res.Day,
+		Date:               &res.Date,
+		MarginBuyingPower:  &res.MarginBuyingPower,
+		DepositedMargin:    &res.DepositedMargin,
+		RequiredMargin:     &res.RequiredMargin,
+		MarginSurplus:      &res.MarginSurplus,
+		CollectionRate:     &res.CollectionRate,
+		DepositBalance:     &res.DepositBalance,
+		OrderReserved:      &res.OrderReserved,
+		CashMargin:         &res.CashMargin,
+		CollateralValue:    &res.CollateralValue,
+		PositionValue:      &res.PositionValue,
+		OrderPositionValue: &res.OrderPositionValue,
+		PositionProfitLoss: &res.PositionProfitLoss,
+		MaintenanceRate:    &res.MaintenanceRate,
+		TodayProfitLoss:    &res.TodayProfitLoss,
+	}
+	return vres
+}
+
+// transformBalanceviewsBalanceDayResultViewToBalanceDayResult builds a value
+// of type *BalanceDayResult from a value of type
+// *balanceviews.BalanceDayResultView.
+func transformBalanceviewsBalanceDayResultViewToBalanceDayResult(v *balanceviews.BalanceDayResultView) *BalanceDayResult {
+	if v == nil {
+		return nil
+	}
+	res := &BalanceDayResult{
+		Date:              *v.Date,
+		CashBuyingPower:   *v.CashBuyingPower,
+		MarginBuyingPower: *v.MarginBuyingPower,
+		WithdrawableCash:  *v.WithdrawableCash,
+		DepositedMargin:   *v.DepositedMargin,
+		RequiredMargin:    *v.RequiredMargin,
+		MaintenanceRate:   *v.MaintenanceRate,
+		MarginCallSurplus: *v.MarginCallSurplus,
+		Shortfall:         *v.Shortfall,
+	}
+
+	return res
+}
+
+// transformBalanceviewsBalanceSnapshotResultViewToBalanceSnapshotResult builds
+// a value of type *BalanceSnapshotResult from a value of type
+// *balanceviews.BalanceSnapshotResultView.
+func transformBalanceviewsBalanceSnapshotResultViewToBalanceSnapshotResult(v *balanceviews.BalanceSnapshotResultView) *BalanceSnapshotResult {
+	if v == nil {
+		return nil
+	}
+	res := &BalanceSnapshotResult{
+		TakenAt:             *v.TakenAt,
+		CashBuyingPower:     *v.CashBuyingPower,
+		MarginBuyingPower:   *v.MarginBuyingPower,
+		WithdrawableCash:    *v.WithdrawableCash,
+		DepositedMargin:     *v.DepositedMargin,
+		PositionValue:       *v.PositionValue,
+		ValuationProfitLoss: *v.ValuationProfitLoss,
+		MaintenanceRate:     *v.MaintenanceRate,
+		MarginCallSurplus:   *v.MarginCallSurplus,
+		HasMarginCall:       *v.HasMarginCall,
+	}
+
+	return res
+}
+
+// transformBalanceDayResultToBalanceviewsBalanceDayResultView builds a value
+// of type *balanceviews.BalanceDayResultView from a value of type
+// *BalanceDayResult.
+func transformBalanceDayResultToBalanceviewsBalanceDayResultView(v *BalanceDayResult) *balanceviews.BalanceDayResultView {
+	res := &balanceviews.BalanceDayResultView{
+		Date:              &v.Date,
+		CashBuyingPower:   &v.CashBuyingPower,
+		MarginBuyingPower: &v.MarginBuyingPower,
+		WithdrawableCash:  &v.WithdrawableCash,
+		DepositedMargin:   &v.DepositedMargin,
+		RequiredMargin:    &v.RequiredMargin,
+		MaintenanceRate:   &v.MaintenanceRate,
+		MarginCallSurplus: &v.MarginCallSurplus,
+		Shortfall:         &v.Shortfall,
+	}
+
+	return res
+}
+
+// transformBalanceSnapshotResultToBalanceviewsBalanceSnapshotResultView builds
+// a value of type *balanceviews.BalanceSnapshotResultView from a value of type
+// *BalanceSnapshotResult.
+func transformBalanceSnapshotResultToBalanceviewsBalanceSnapshotResultView(v *BalanceSnapshotResult) *balanceviews.BalanceSnapshotResultView {
+	res := &balanceviews.BalanceSnapshotResultView{
+		TakenAt:             &v.TakenAt,
+		CashBuyingPower:     &v.CashBuyingPower,
+		MarginBuyingPower:   &v.MarginBuyingPower,
+		WithdrawableCash:    &v.WithdrawableCash,
+		DepositedMargin:     &v.DepositedMargin,
+		PositionValue:       &v.PositionValue,
+		ValuationProfitLoss: &v.ValuationProfitLoss,
+		MaintenanceRate:     &v.MaintenanceRate,
+		MarginCallSurplus:   &v.MarginCallSurplus,
+		HasMarginCall:       &v.HasMarginCall,
+	}
+
+	return res
+}
@@ -19,6 +19,33 @@ type StockbotBalance struct {
 	View string
 }
 
+// StockbotBalanceHistory is the viewed result type that is projected based on
+// a view.
+type StockbotBalanceHistory struct {
+	// Type to project
+	Projected *StockbotBalanceHistoryView
+	// View to render
+	View string
+}
+
+// StockbotBuyingPower is the viewed result type that is projected based on a
+// view.
+type StockbotBuyingPower struct {
+	// Type to project
+	Projected *StockbotBuyingPowerView
+	// View to render
+	View string
+}
+
+// StockbotMarginCapacity is the viewed result type that is projected based on
+// a view.
+type StockbotMarginCapacity struct {
+	// Type to project
+	Projected *StockbotMarginCapacityView
+	// View to render
+	View string
+}
+
 // StockbotBalanceView is a type that runs validations on a projected type.
 type StockbotBalanceView struct {
 	// 現物株式買付可能額
@@ -31,6 +58,143 @@ type StockbotBalanceView struct {
 	WithdrawableCash *float64
 	// 追証発生フラグ (1:発生, 0:未発生)
 	HasMarginCall *bool
+	// 信用現引可能額
+	MarginDeliveryCapacity *float64
+	// NISA成長投資可能額
+	NisaGrowthCapacity *float64
+	// 不足額 (入金請求額)
+	Shortfall *float64
+	// 立替金が発生しているかどうか
+	HasAdvance *bool
+	// 証券会社側の更新日時 (RFC3339)
+	UpdatedAt *string
+}
+
+// StockbotBalanceHistoryView is a type that runs validations on a projected
+// type.
+type StockbotBalanceHistoryView struct {
+	// 可能額推移 (過去6営業日)
+	Daily []*BalanceDayResultView
+	// 保存済みの残高スナップショット
+	Snapshots []*BalanceSnapshotResultView
+}
+
+// BalanceDayResultView is a type that runs validations on a projected type.
+type BalanceDayResultView struct {
+	// 日付 (YYYYMMDD)
+	Date *string
+	// 現物株式買付可能額
+	CashBuyingPower *float64
+	// 信用新規建可能額
+	MarginBuyingPower *float64
+	// 出金可能額
+	WithdrawableCash *float64
+	// 受入保証金
+	DepositedMargin *float64
+	// 必要保証金
+	RequiredMargin *float64
+	// 委託保証金率(%)
+	MaintenanceRate *float64
+	// 追証余力
+	MarginCallSurplus *float64
+	// 追証/立替金/保証金不足額
+	Shortfall *float64
+}
+
+// BalanceSnapshotResultView is a type that runs validations on a projected
+// type.
+type BalanceSnapshotResultView struct {
+	// 取得した日時 (RFC3339)
+	TakenAt *string
+	// 現物株式買付可能額
+	CashBuyingPower *float64
+	// 信用新規建可能額
+	MarginBuyingPower *float64
+	// 出金可能額
+	WithdrawableCash *float64
+	// 受入保証金
+	DepositedMargin *float64
+	// 建株代金
+	PositionValue *float64
+	// 評価損益
+	ValuationProfitLoss *float64
+	// 委託保証金率(%) (リアルタイム)
+	MaintenanceRate *float64
+	// 追証余力
+	MarginCallSurplus *float64
+	// 追証が発生しているかどうか
+	HasMarginCall *bool
+}
+
+// StockbotBuyingPowerView is a type that runs validations on a projected type.
+type StockbotBuyingPowerView struct {
+	// 営業日インデックス (0:T+0 〜 5:T+5)
+	Day *int
+	// 日付 (YYYYMMDD)
+	Date *string
+	// 現物株式買付可能額
+	CashBuyingPower *float64
+	// 保証金からの現物株式買付可能額
+	MarginBuyingPower *float64
+	// 預り金
+	DepositBalance *float64
+	// 発注済み注文充当金
+	OrderReserved *float64
+	// 日計り拘束金
+	DayTradeRestricted *float64
+	// その他拘束金
+	OtherRestricted *float64
+	// 現金保証金
+	CashMargin *float64
+	// 必要現金保証金
+	RequiredCashMargin *float64
+	// 代用証券評価額
+	CollateralValue *float64
+	// 建株評価損益
+	PositionProfitLoss *float64
+	// 受入保証金
+	DepositedMargin *float64
+	// 必要保証金
+	RequiredMargin *float64
+	// 保証金余力
+	MarginSurplus *float64
+}
+
+// StockbotMarginCapacityView is a type that runs validations on a projected
+// type.
+type StockbotMarginCapacityView struct {
+	// 営業日インデックス (0:T+0 〜 5:T+5)
+	Day *int
+	// 日付 (YYYYMMDD)
+	Date *string
+	// 信用新規建可能額
+	MarginBuyingPower *float64
+	// 受入保証金
+	DepositedMargin *float64
+	// 必要保証金
+	RequiredMargin *float64
+	// 保証金余力
+	MarginSurplus *float64
+	// 保証金徴収率(%)
+	CollectionRate *float64
+	// 預り金
+	DepositBalance *float64
+	// 発注済み注文充当金
+	OrderReserved *float64
+	// 現金保証金
+	CashMargin *float64
+	// 代用証券評価額
+	CollateralValue *float64
+	// 建株代金
+	PositionValue *float64
+	// 発注分建株代金
+	OrderPositionValue *float64
+	// 建株評価損益
+	PositionProfitLoss *float64
+	// 委託保証金率(%)
+	MaintenanceRate *float64
+	// 本日決済損益合計
+	TodayProfitLoss *float64
 }
 
 var (
@@ -43,6 +207,62 @@ var (
 			"margin_maintenance_rate",
 			"withdrawable_cash",
 			"has_margin_call",
+			"margin_delivery_capacity",
+			"nisa_growth_capacity",
+			"shortfall",
+			"has_advance",
+			"updated_at",
+		},
+	}
+	// StockbotBalanceHistoryMap is a map indexing the attribute names of
+	// StockbotBalanceHistory by view name.
+	StockbotBalanceHistoryMap = map[string][]string{
+		"default": {
+			"daily",
+			"snapshots",
+		},
+	}
+	// StockbotBuyingPowerMap is a map indexing the attribute names of
+	// StockbotBuyingPower by view name.
+	StockbotBuyingPowerMap = map[string][]string{
+		"default": {
+			"day",
+			"date",
+			"cash_buying_power",
+			"margin_buying_power",
+			"deposit_balance",
+			"order_reserved",
+			"day_trade_restricted",
+			"other_restricted",
+			"cash_margin",
+			"required_cash_margin",
+			"collateral_value",
+			"position_profit_loss",
+			"deposited_margin",
+			"required_margin",
+			"margin_surplus",
+		},
+	}
+	// StockbotMarginCapacityMap is a map indexing the attribute names of
+	// StockbotMarginCapacity by view name.
+	StockbotMarginCapacityMap = map[string][]string{
+		"default": {
+			"day",
+			"date",
+			"margin_buying_power",
+			"deposited_margin",
+			"required_margin",
+			"margin_surplus",
+			"collection_rate",
+			"deposit_balance",
+			"order_reserved",
+			"cash_margin",
+			"collateral_value",
+			"position_value",
+			"order_position_value",
+			"position_profit_loss",
+			"maintenance_rate",
+			"today_profit_loss",
 		},
 	}
 )
@@ -59,6 +279,42 @@ func ValidateStockbotBalance(result *StockbotBalance) (err error) {
 	return
 }
 
+// ValidateStockbotBalanceHistory runs the validations defined on the viewed
+// result type StockbotBalanceHistory.
+func ValidateStockbotBalanceHistory(result *StockbotBalanceHistory) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotBalanceHistoryView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotBuyingPower runs the validations defined on the viewed
+// result type StockbotBuyingPower.
+func ValidateStockbotBuyingPower(result *StockbotBuyingPower) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotBuyingPowerView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotMarginCapacity runs the validations defined on the viewed
+// result type StockbotMarginCapacity.
+func ValidateStockbotMarginCapacity(result *StockbotMarginCapacity) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotMarginCapacityView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
 // ValidateStockbotBalanceView runs the validations defined on
 // StockbotBalanceView using the "default" view.
 func ValidateStockbotBalanceView(result *StockbotBalanceView) (err error) {
@@ -77,5 +333,217 @@ func ValidateStockbotBalanceView(result *StockbotBalanceView) (err error) {
 	if result.HasMarginCall == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("has_margin_call", "result"))
 	}
+	if result.MarginDeliveryCapacity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_delivery_capacity", "result"))
+	}
+	if result.NisaGrowthCapacity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("nisa_growth_capacity", "result"))
+	}
+	if result.Shortfall == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("shortfall", "result"))
+	}
+	if result.HasAdvance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_advance", "result"))
+	}
+	return
+}
+
+// ValidateStockbotBalanceHistoryView runs the validations defined on
+// StockbotBalanceHistoryView using the "default" view.
+func ValidateStockbotBalanceHistoryView(result *StockbotBalanceHistoryView) (err error) {
+	if result.Daily == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("daily", "result"))
+	}
+	if result.Snapshots == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("snapshots", "result"))
+	}
+	for _, e := range result.Daily {
+		if e != nil {
+			if err2 := ValidateBalanceDayResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range result.Snapshots {
+		if e != nil {
+			if err2 := ValidateBalanceSnapshotResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBalanceDayResultView runs the validations defined on
+// BalanceDayResultView.
+func ValidateBalanceDayResultView(result *BalanceDayResultView) (err error) {
+	if result.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "result"))
+	}
+	if result.CashBuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_buying_power", "result"))
+	}
+	if result.MarginBuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_buying_power", "result"))
+	}
+	if result.WithdrawableCash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("withdrawable_cash", "result"))
+	}
+	if result.DepositedMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deposited_margin", "result"))
+	}
+	if result.RequiredMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("required_margin", "result"))
+	}
+	if result.MaintenanceRate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("maintenance_rate", "result"))
+	}
+	if result.MarginCallSurplus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_call_surplus", "result"))
+	}
+	if result.Shortfall == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("shortfall", "result"))
+	}
+	return
+}
+
+// ValidateBalanceSnapshotResultView runs the validations defined on
+// BalanceSnapshotResultView.
+func ValidateBalanceSnapshotResultView(result *BalanceSnapshotResultView) (err error) {
+	if result.TakenAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("taken_at", "result"))
+	}
+	if result.CashBuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_buying_power", "result"))
+	}
+	if result.MarginBuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_buying_power", "result"))
+	}
+	if result.WithdrawableCash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("withdrawable_cash", "result"))
+	}
+	if result.DepositedMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deposited_margin", "result"))
+	}
+	if result.PositionValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position_value", "result"))
+	}
+	if result.ValuationProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("valuation_profit_loss", "result"))
+	}
+	if result.MaintenanceRate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("maintenance_rate", "result"))
+	}
+	if result.MarginCallSurplus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_call_surplus", "result"))
+	}
+	if result.HasMarginCall == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_margin_call", "result"))
+	}
+	return
+}
+
+// ValidateStockbotBuyingPowerView runs the validations defined on
+// StockbotBuyingPowerView using the "default" view.
+func ValidateStockbotBuyingPowerView(result *StockbotBuyingPowerView) (err error) {
+	if result.Day == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("day", "result"))
+	}
+	if result.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "result"))
+	}
+	if result.CashBuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_buying_power", "result"))
+	}
+	if result.MarginBuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_buying_power", "result"))
+	}
+	if result.DepositBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deposit_balance", "result"))
+	}
+	if result.OrderReserved == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("order_reserved", "result"))
+	}
+	if result.DayTradeRestricted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("day_trade_restricted", "result"))
+	}
+	if result.OtherRestricted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("other_restricted", "result"))
+	}
+	if result.CashMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_margin", "result"))
+	}
+	if result.RequiredCashMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("required_cash_margin", "result"))
+	}
+	if result.CollateralValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("collateral_value", "result"))
+	}
+	if result.PositionProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position_profit_loss", "result"))
+	}
+	if result.DepositedMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deposited_margin", "result"))
+	}
+	if result.RequiredMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("required_margin", "result"))
+	}
+	if result.MarginSurplus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_surplus", "result"))
+	}
+	return
+}
+
+// ValidateStockbotMarginCapacityView runs the validations defined on
+// StockbotMarginCapacityView using the "default" view.
+func ValidateStockbotMarginCapacityView(result *StockbotMarginCapacityView) (err error) {
+	if result.Day == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("day", "result"))
+	}
+	if result.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "result"))
+	}
+	if result.MarginBuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_buying_power", "result"))
+	}
+	if result.DepositedMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deposited_margin", "result"))
+	}
+	if result.RequiredMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("required_margin", "result"))
+	}
+	if result.MarginSurplus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_surplus", "result"))
+	}
+	if result.CollectionRate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("collection_rate", "result"))
+	}
+	if result.DepositBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deposit_balance", "result"))
+	}
+	if result.OrderReserved == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("order_reserved", "result"))
+	}
+	if result.CashMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_margin", "result"))
+	}
+	if result.CollateralValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("collateral_value", "result"))
+	}
+	if result.PositionValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position_value", "result"))
+	}
+	if result.OrderPositionValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("order_position_value", "result"))
+	}
+	if result.PositionProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position_profit_loss", "result"))
+	}
+	if result.MaintenanceRate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("maintenance_rate", "result"))
+	}
+	if result.TodayProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("today_profit_loss", "result"))
+	}
 	return
 }
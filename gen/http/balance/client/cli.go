@@ -6,3 +6,112 @@
 // $ goa gen stock-bot/design
 
 package client
+
+import (
+	"fmt"
+	balance "stock-bot/gen/balance"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildHistoryPayload builds the payload for the balance history endpoint from
+// CLI flags.
+func BuildHistoryPayload(balanceHistorySince string, balanceHistoryLimit string) (*balance.HistoryPayload, error) {
+	var err error
+	var since *string
+	{
+		if balanceHistorySince != "" {
+			since = &balanceHistorySince
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if balanceHistoryLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(balanceHistoryLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &balance.HistoryPayload{}
+	v.Since = since
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildBuyingPowerPayload builds the payload for the balance buying_power
+// endpoint from CLI flags.
+func BuildBuyingPowerPayload(balanceBuyingPowerDay string) (*balance.BuyingPowerPayload, error) {
+	var err error
+	var day int
+	{
+		if balanceBuyingPowerDay != "" {
+			var v int64
+			v, err = strconv.ParseInt(balanceBuyingPowerDay, 10, strconv.IntSize)
+			day = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for day, must be INT")
+			}
+			if day < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("day", day, 0, true))
+			}
+			if day > 5 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("day", day, 5, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &balance.BuyingPowerPayload{}
+	v.Day = day
+
+	return v, nil
+}
+
+// BuildMarginCapacityPayload builds the payload for the balance
+// margin_capacity endpoint from CLI flags.
+func BuildMarginCapacityPayload(balanceMarginCapacityDay string) (*balance.MarginCapacityPayload, error) {
+	var err error
+	var day int
+	{
+		if balanceMarginCapacityDay != "" {
+			var v int64
+			v, err = strconv.ParseInt(balanceMarginCapacityDay, 10, strconv.IntSize)
+			day = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for day, must be INT")
+			}
+			if day < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("day", day, 0, true))
+			}
+			if day > 5 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("day", day, 5, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &balance.MarginCapacityPayload{}
+	v.Day = day
+
+	return v, nil
+}
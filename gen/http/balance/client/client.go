@@ -20,6 +20,18 @@ type Client struct {
 	// Get Doer is the HTTP client used to make requests to the get endpoint.
 	GetDoer goahttp.Doer
 
+	// History Doer is the HTTP client used to make requests to the history
+	// endpoint.
+	HistoryDoer goahttp.Doer
+
+	// BuyingPower Doer is the HTTP client used to make requests to the
+	// buying_power endpoint.
+	BuyingPowerDoer goahttp.Doer
+
+	// MarginCapacity Doer is the HTTP client used to make requests to the
+	// margin_capacity endpoint.
+	MarginCapacityDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -41,6 +53,9 @@ func NewClient(
 ) *Client {
 	return &Client{
 		GetDoer:             doer,
+		HistoryDoer:         doer,
+		BuyingPowerDoer:     doer,
+		MarginCapacityDoer:  doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
 		host:                host,
@@ -67,3 +82,75 @@ func (c *Client) Get() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// History returns an endpoint that makes HTTP requests to the balance service
+// history server.
+func (c *Client) History() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeHistoryRequest(c.encoder)
+		decodeResponse = DecodeHistoryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildHistoryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.HistoryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("balance", "history", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// BuyingPower returns an endpoint that makes HTTP requests to the balance
+// service buying_power server.
+func (c *Client) BuyingPower() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBuyingPowerRequest(c.encoder)
+		decodeResponse = DecodeBuyingPowerResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBuyingPowerRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BuyingPowerDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("balance", "buying_power", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// MarginCapacity returns an endpoint that makes HTTP requests to the balance
+// service margin_capacity server.
+func (c *Client) MarginCapacity() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeMarginCapacityRequest(c.encoder)
+		decodeResponse = DecodeMarginCapacityResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildMarginCapacityRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.MarginCapacityDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("balance", "margin_capacity", err)
+		}
+		return decodeResponse(resp)
+	}
+}
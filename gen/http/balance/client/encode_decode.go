@@ -10,6 +10,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -75,3 +76,261 @@ func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody
 		}
 	}
 }
+
+// BuildHistoryRequest instantiates a HTTP request object with method and path
+// set to call the "balance" service "history" endpoint
+func (c *Client) BuildHistoryRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: HistoryBalancePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("balance", "history", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeHistoryRequest returns an encoder for requests sent to the balance
+// history server.
+func EncodeHistoryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*balance.HistoryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("balance", "history", "*balance.HistoryPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Since != nil {
+			values.Add("since", *p.Since)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeHistoryResponse returns a decoder for responses returned by the
+// balance history endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeHistoryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body HistoryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("balance", "history", err)
+			}
+			p := NewHistoryStockbotBalanceHistoryOK(&body)
+			view := "default"
+			vres := &balanceviews.StockbotBalanceHistory{Projected: p, View: view}
+			if err = balanceviews.ValidateStockbotBalanceHistory(vres); err != nil {
+				return nil, goahttp.ErrValidationError("balance", "history", err)
+			}
+			res := balance.NewStockbotBalanceHistory(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("balance", "history", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBuyingPowerRequest instantiates a HTTP request object with method and
+// path set to call the "balance" service "buying_power" endpoint
+func (c *Client) BuildBuyingPowerRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BuyingPowerBalancePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("balance", "buying_power", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBuyingPowerRequest returns an encoder for requests sent to the balance
+// buying_power server.
+func EncodeBuyingPowerRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*balance.BuyingPowerPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("balance", "buying_power", "*balance.BuyingPowerPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("day", fmt.Sprintf("%v", p.Day))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeBuyingPowerResponse returns a decoder for responses returned by the
+// balance buying_power endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeBuyingPowerResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BuyingPowerResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("balance", "buying_power", err)
+			}
+			p := NewBuyingPowerStockbotBuyingPowerOK(&body)
+			view := "default"
+			vres := &balanceviews.StockbotBuyingPower{Projected: p, View: view}
+			if err = balanceviews.ValidateStockbotBuyingPower(vres); err != nil {
+				return nil, goahttp.ErrValidationError("balance", "buying_power", err)
+			}
+			res := balance.NewStockbotBuyingPower(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("balance", "buying_power", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildMarginCapacityRequest instantiates a HTTP request object with method
+// and path set to call the "balance" service "margin_capacity" endpoint
+func (c *Client) BuildMarginCapacityRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: MarginCapacityBalancePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("balance", "margin_capacity", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeMarginCapacityRequest returns an encoder for requests sent to the
+// balance margin_capacity server.
+func EncodeMarginCapacityRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*balance.MarginCapacityPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("balance", "margin_capacity", "*balance.MarginCapacityPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("day", fmt.Sprintf("%v", p.Day))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeMarginCapacityResponse returns a decoder for responses returned by the
+// balance margin_capacity endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeMarginCapacityResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body MarginCapacityResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("balance", "margin_capacity", err)
+			}
+			p := NewMarginCapacityStockbotMarginCapacityOK(&body)
+			view := "default"
+			vres := &balanceviews.StockbotMarginCapacity{Projected: p, View: view}
+			if err = balanceviews.ValidateStockbotMarginCapacity(vres); err != nil {
+				return nil, goahttp.ErrValidationError("balance", "margin_capacity", err)
+			}
+			res := balance.NewStockbotMarginCapacity(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("balance", "margin_capacity", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalBalanceDayResultResponseBodyToBalanceviewsBalanceDayResultView
+// builds a value of type *balanceviews.BalanceDayResultView from a value of
+// type *BalanceDayResultResponseBody.
+func unmarshalBalanceDayResultResponseBodyToBalanceviewsBalanceDayResultView(v *BalanceDayResultResponseBody) *balanceviews.BalanceDayResultView {
+	res := &balanceviews.BalanceDayResultView{
+		Date:              v.Date,
+		CashBuyingPower:   v.CashBuyingPower,
+		MarginBuyingPower: v.MarginBuyingPower,
+		WithdrawableCash:  v.WithdrawableCash,
+		DepositedMargin:   v.DepositedMargin,
+		RequiredMargin:    v.RequiredMargin,
+		MaintenanceRate:   v.MaintenanceRate,
+		MarginCallSurplus: v.MarginCallSurplus,
+		Shortfall:         v.Shortfall,
+	}
+
+	return res
+}
+
+// unmarshalBalanceSnapshotResultResponseBodyToBalanceviewsBalanceSnapshotResultView
+// builds a value of type *balanceviews.BalanceSnapshotResultView from a value
+// of type *BalanceSnapshotResultResponseBody.
+func unmarshalBalanceSnapshotResultResponseBodyToBalanceviewsBalanceSnapshotResultView(v *BalanceSnapshotResultResponseBody) *balanceviews.BalanceSnapshotResultView {
+	res := &balanceviews.BalanceSnapshotResultView{
+		TakenAt:             v.TakenAt,
+		CashBuyingPower:     v.CashBuyingPower,
+		MarginBuyingPower:   v.MarginBuyingPower,
+		WithdrawableCash:    v.WithdrawableCash,
+		DepositedMargin:     v.DepositedMargin,
+		PositionValue:       v.PositionValue,
+		ValuationProfitLoss: v.ValuationProfitLoss,
+		MaintenanceRate:     v.MaintenanceRate,
+		MarginCallSurplus:   v.MarginCallSurplus,
+		HasMarginCall:       v.HasMarginCall,
+	}
+
+	return res
+}
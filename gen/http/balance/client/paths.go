@@ -11,3 +11,18 @@ package client
 func GetBalancePath() string {
 	return "/balance"
 }
+
+// HistoryBalancePath returns the URL path to the balance service history HTTP endpoint.
+func HistoryBalancePath() string {
+	return "/balance/history"
+}
+
+// BuyingPowerBalancePath returns the URL path to the balance service buying_power HTTP endpoint.
+func BuyingPowerBalancePath() string {
+	return "/balance/buying-power"
+}
+
+// MarginCapacityBalancePath returns the URL path to the balance service margin_capacity HTTP endpoint.
+func MarginCapacityBalancePath() string {
+	return "/balance/margin-capacity"
+}
@@ -9,6 +9,8 @@ package client
 
 import (
 	balanceviews "stock-bot/gen/balance/views"
+
+	goa "goa.design/goa/v3/pkg"
 )
 
 // GetResponseBody is the type of the "balance" service "get" endpoint HTTP
@@ -24,6 +26,144 @@ type GetResponseBody struct {
 	WithdrawableCash *float64 `form:"withdrawable_cash,omitempty" json:"withdrawable_cash,omitempty" xml:"withdrawable_cash,omitempty"`
 	// 追証発生フラグ (1:発生, 0:未発生)
 	HasMarginCall *bool `form:"has_margin_call,omitempty" json:"has_margin_call,omitempty" xml:"has_margin_call,omitempty"`
+	// 信用現引可能額
+	MarginDeliveryCapacity *float64 `form:"margin_delivery_capacity,omitempty" json:"margin_delivery_capacity,omitempty" xml:"margin_delivery_capacity,omitempty"`
+	// NISA成長投資可能額
+	NisaGrowthCapacity *float64 `form:"nisa_growth_capacity,omitempty" json:"nisa_growth_capacity,omitempty" xml:"nisa_growth_capacity,omitempty"`
+	// 不足額 (入金請求額)
+	Shortfall *float64 `form:"shortfall,omitempty" json:"shortfall,omitempty" xml:"shortfall,omitempty"`
+	// 立替金が発生しているかどうか
+	HasAdvance *bool `form:"has_advance,omitempty" json:"has_advance,omitempty" xml:"has_advance,omitempty"`
+	// 証券会社側の更新日時 (RFC3339)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// HistoryResponseBody is the type of the "balance" service "history" endpoint
+// HTTP response body.
+type HistoryResponseBody struct {
+	// 可能額推移 (過去6営業日)
+	Daily []*BalanceDayResultResponseBody `form:"daily,omitempty" json:"daily,omitempty" xml:"daily,omitempty"`
+	// 保存済みの残高スナップショット
+	Snapshots []*BalanceSnapshotResultResponseBody `form:"snapshots,omitempty" json:"snapshots,omitempty" xml:"snapshots,omitempty"`
+}
+
+// BuyingPowerResponseBody is the type of the "balance" service "buying_power"
+// endpoint HTTP response body.
+type BuyingPowerResponseBody struct {
+	// 営業日インデックス (0:T+0 〜 5:T+5)
+	Day *int `form:"day,omitempty" json:"day,omitempty" xml:"day,omitempty"`
+	// 日付 (YYYYMMDD)
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// 現物株式買付可能額
+	CashBuyingPower *float64 `form:"cash_buying_power,omitempty" json:"cash_buying_power,omitempty" xml:"cash_buying_power,omitempty"`
+	// 保証金からの現物株式買付可能額
+	MarginBuyingPower *float64 `form:"margin_buying_power,omitempty" json:"margin_buying_power,omitempty" xml:"margin_buying_power,omitempty"`
+	// 預り金
+	DepositBalance *float64 `form:"deposit_balance,omitempty" json:"deposit_balance,omitempty" xml:"deposit_balance,omitempty"`
+	// 発注済み注文充当金
+	OrderReserved *float64 `form:"order_reserved,omitempty" json:"order_reserved,omitempty" xml:"order_reserved,omitempty"`
+	// 日計り拘束金
+	DayTradeRestricted *float64 `form:"day_trade_restricted,omitempty" json:"day_trade_restricted,omitempty" xml:"day_trade_restricted,omitempty"`
+	// その他拘束金
+	OtherRestricted *float64 `form:"other_restricted,omitempty" json:"other_restricted,omitempty" xml:"other_restricted,omitempty"`
+	// 現金保証金
+	CashMargin *float64 `form:"cash_margin,omitempty" json:"cash_margin,omitempty" xml:"cash_margin,omitempty"`
+	// 必要現金保証金
+	RequiredCashMargin *float64 `form:"required_cash_margin,omitempty" json:"required_cash_margin,omitempty" xml:"required_cash_margin,omitempty"`
+	// 代用証券評価額
+	CollateralValue *float64 `form:"collateral_value,omitempty" json:"collateral_value,omitempty" xml:"collateral_value,omitempty"`
+	// 建株評価損益
+	PositionProfitLoss *float64 `form:"position_profit_loss,omitempty" json:"position_profit_loss,omitempty" xml:"position_profit_loss,omitempty"`
+	// 受入保証金
+	DepositedMargin *float64 `form:"deposited_margin,omitempty" json:"deposited_margin,omitempty" xml:"deposited_margin,omitempty"`
+	// 必要保証金
+	RequiredMargin *float64 `form:"required_margin,omitempty" json:"required_margin,omitempty" xml:"required_margin,omitempty"`
+	// 保証金余力
+	MarginSurplus *float64 `form:"margin_surplus,omitempty" json:"margin_surplus,omitempty" xml:"margin_surplus,omitempty"`
+}
+
+// MarginCapacityResponseBody is the type of the "balance" service
+// "margin_capacity" endpoint HTTP response body.
+type MarginCapacityResponseBody struct {
+	// 営業日インデックス (0:T+0 〜 5:T+5)
+	Day *int `form:"day,omitempty" json:"day,omitempty" xml:"day,omitempty"`
+	// 日付 (YYYYMMDD)
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// 信用新規建可能額
+	MarginBuyingPower *float64 `form:"margin_buying_power,omitempty" json:"margin_buying_power,omitempty" xml:"margin_buying_power,omitempty"`
+	// 受入保証金
+	DepositedMargin *float64 `form:"deposited_margin,omitempty" json:"deposited_margin,omitempty" xml:"deposited_margin,omitempty"`
+	// 必要保証金
+	RequiredMargin *float64 `form:"required_margin,omitempty" json:"required_margin,omitempty" xml:"required_margin,omitempty"`
+	// 保証金余力
+	MarginSurplus *float64 `form:"margin_surplus,omitempty" json:"margin_surplus,omitempty" xml:"margin_surplus,omitempty"`
+	// 保証金徴収率(%)
+	CollectionRate *float64 `form:"collection_rate,omitempty" json:"collection_rate,omitempty" xml:"collection_rate,omitempty"`
+	// 預り金
+	DepositBalance *float64 `form:"deposit_balance,omitempty" json:"deposit_balance,omitempty" xml:"deposit_balance,omitempty"`
+	// 発注済み注文充当金
+	OrderReserved *float64 `form:"order_reserved,omitempty" json:"order_reserved,omitempty" xml:"order_reserved,omitempty"`
+	// 現金保証金
+	CashMargin *float64 `form:"cash_margin,omitempty" json:"cash_margin,omitempty" xml:"cash_margin,omitempty"`
+	// 代用証券評価額
+	CollateralValue *float64 `form:"collateral_value,omitempty" json:"collateral_value,omitempty" xml:"collateral_value,omitempty"`
+	// 建株代金
+	PositionValue *float64 `form:"position_value,omitempty" json:"position_value,omitempty" xml:"position_value,omitempty"`
+	// 発注分建株代金
+	OrderPositionValue *float64 `form:"order_position_value,omitempty" json:"order_position_value,omitempty" xml:"order_position_value,omitempty"`
+	// 建株評価損益
+	PositionProfitLoss *float64 `form:"position_profit_loss,omitempty" json:"position_profit_loss,omitempty" xml:"position_profit_loss,omitempty"`
+	// 委託保証金率(%)
+	MaintenanceRate *float64 `form:"maintenance_rate,omitempty" json:"maintenance_rate,omitempty" xml:"maintenance_rate,omitempty"`
+	// 本日決済損益合計
+	TodayProfitLoss *float64 `form:"today_profit_loss,omitempty" json:"today_profit_loss,omitempty" xml:"today_profit_loss,omitempty"`
+}
+
+// BalanceDayResultResponseBody is used to define fields on response body types.
+type BalanceDayResultResponseBody struct {
+	// 日付 (YYYYMMDD)
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// 現物株式買付可能額
+	CashBuyingPower *float64 `form:"cash_buying_power,omitempty" json:"cash_buying_power,omitempty" xml:"cash_buying_power,omitempty"`
+	// 信用新規建可能額
+	MarginBuyingPower *float64 `form:"margin_buying_power,omitempty" json:"margin_buying_power,omitempty" xml:"margin_buying_power,omitempty"`
+	// 出金可能額
+	WithdrawableCash *float64 `form:"withdrawable_cash,omitempty" json:"withdrawable_cash,omitempty" xml:"withdrawable_cash,omitempty"`
+	// 受入保証金
+	DepositedMargin *float64 `form:"deposited_margin,omitempty" json:"deposited_margin,omitempty" xml:"deposited_margin,omitempty"`
+	// 必要保証金
+	RequiredMargin *float64 `form:"required_margin,omitempty" json:"required_margin,omitempty" xml:"required_margin,omitempty"`
+	// 委託保証金率(%)
+	MaintenanceRate *float64 `form:"maintenance_rate,omitempty" json:"maintenance_rate,omitempty" xml:"maintenance_rate,omitempty"`
+	// 追証余力
+	MarginCallSurplus *float64 `form:"margin_call_surplus,omitempty" json:"margin_call_surplus,omitempty" xml:"margin_call_surplus,omitempty"`
+	// 追証/立替金/保証金不足額
+	Shortfall *float64 `form:"shortfall,omitempty" json:"shortfall,omitempty" xml:"shortfall,omitempty"`
+}
+
+// BalanceSnapshotResultResponseBody is used to define fields on response body
+// types.
+type BalanceSnapshotResultResponseBody struct {
+	// 取得した日時 (RFC3339)
+	TakenAt *string `form:"taken_at,omitempty" json:"taken_at,omitempty" xml:"taken_at,omitempty"`
+	// 現物株式買付可能額
+	CashBuyingPower *float64 `form:"cash_buying_power,omitempty" json:"cash_buying_power,omitempty" xml:"cash_buying_power,omitempty"`
+	// 信用新規建可能額
+	MarginBuyingPower *float64 `form:"margin_buying_power,omitempty" json:"margin_buying_power,omitempty" xml:"margin_buying_power,omitempty"`
+	// 出金可能額
+	WithdrawableCash *float64 `form:"withdrawable_cash,omitempty" json:"withdrawable_cash,omitempty" xml:"withdrawable_cash,omitempty"`
+	// 受入保証金
+	DepositedMargin *float64 `form:"deposited_margin,omitempty" json:"deposited_margin,omitempty" xml:"deposited_margin,omitempty"`
+	// 建株代金
+	PositionValue *float64 `form:"position_value,omitempty" json:"position_value,omitempty" xml:"position_value,omitempty"`
+	// 評価損益
+	ValuationProfitLoss *float64 `form:"valuation_profit_loss,omitempty" json:"valuation_profit_loss,omitempty" xml:"valuation_profit_loss,omitempty"`
+	// 委託保証金率(%) (リアルタイム)
+	MaintenanceRate *float64 `form:"maintenance_rate,omitempty" json:"maintenance_rate,omitempty" xml:"maintenance_rate,omitempty"`
+	// 追証余力
+	MarginCallSurplus *float64 `form:"margin_call_surplus,omitempty" json:"margin_call_surplus,omitempty" xml:"margin_call_surplus,omitempty"`
+	// 追証が発生しているかどうか
+	HasMarginCall *bool `form:"has_margin_call,omitempty" json:"has_margin_call,omitempty" xml:"has_margin_call,omitempty"`
 }
 
 // NewGetStockbotBalanceOK builds a "balance" service "get" endpoint result
@@ -35,7 +175,154 @@ func NewGetStockbotBalanceOK(body *GetResponseBody) *balanceviews.StockbotBalanc
 		MarginMaintenanceRate:         body.MarginMaintenanceRate,
 		WithdrawableCash:              body.WithdrawableCash,
 		HasMarginCall:                 body.HasMarginCall,
+		MarginDeliveryCapacity:        body.MarginDeliveryCapacity,
+		NisaGrowthCapacity:            body.NisaGrowthCapacity,
+		Shortfall:                     body.Shortfall,
+		HasAdvance:                    body.HasAdvance,
+		UpdatedAt:                     body.UpdatedAt,
 	}
 
 	return v
 }
+
+// NewHistoryStockbotBalanceHistoryOK builds a "balance" service "history"
+// endpoint result from a HTTP "OK" response.
+func NewHistoryStockbotBalanceHistoryOK(body *HistoryResponseBody) *balanceviews.StockbotBalanceHistoryView {
+	v := &balanceviews.StockbotBalanceHistoryView{}
+	v.Daily = make([]*balanceviews.BalanceDayResultView, len(body.Daily))
+	for i, val := range body.Daily {
+		if val == nil {
+			v.Daily[i] = nil
+			continue
+		}
+		v.Daily[i] = unmarshalBalanceDayResultResponseBodyToBalanceviewsBalanceDayResultView(val)
+	}
+	v.Snapshots = make([]*balanceviews.BalanceSnapshotResultView, len(body.Snapshots))
+	for i, val := range body.Snapshots {
+		if val == nil {
+			v.Snapshots[i] = nil
+			continue
+		}
+		v.Snapshots[i] = unmarshalBalanceSnapshotResultResponseBodyToBalanceviewsBalanceSnapshotResultView(val)
+	}
+
+	return v
+}
+
+// NewBuyingPowerStockbotBuyingPowerOK builds a "balance" service
+// "buying_power" endpoint result from a HTTP "OK" response.
+func NewBuyingPowerStockbotBuyingPowerOK(body *BuyingPowerResponseBody) *balanceviews.StockbotBuyingPowerView {
+	v := &balanceviews.StockbotBuyingPowerView{
+		Day:                body.Day,
+		Date:               body.Date,
+		CashBuyingPower:    body.CashBuyingPower,
+		MarginBuyingPower:  body.MarginBuyingPower,
+		DepositBalance:     body.DepositBalance,
+		OrderReserved:      body.OrderReserved,
+		DayTradeRestricted: body.DayTradeRestricted,
+		OtherRestricted:    body.OtherRestricted,
+		CashMargin:         body.CashMargin,
+		RequiredCashMargin: body.RequiredCashMargin,
+		CollateralValue:    body.CollateralValue,
+		PositionProfitLoss: body.PositionProfitLoss,
+		DepositedMargin:    body.DepositedMargin,
+		RequiredMargin:     body.RequiredMargin,
+		MarginSurplus:      body.MarginSurplus,
+	}
+
+	return v
+}
+
+// NewMarginCapacityStockbotMarginCapacityOK builds a "balance" service
+// "margin_capacity" endpoint result from a HTTP "OK" response.
+func NewMarginCapacityStockbotMarginCapacityOK(body *MarginCapacityResponseBody) *balanceviews.StockbotMarginCapacityView {
+	v := &balanceviews.StockbotMarginCapacityView{
+		Day:                body.Day,
+		Date:               body.Date,
+		MarginBuyingPower:  body.MarginBuyingPower,
+		DepositedMargin:    body.DepositedMargin,
+		RequiredMargin:     body.RequiredMargin,
+		MarginSurplus:      body.MarginSurplus,
+		CollectionRate:     body.CollectionRate,
+		DepositBalance:     body.DepositBalance,
+		OrderReserved:      body.OrderReserved,
+		CashMargin:         body.CashMargin,
+		CollateralValue:    body.CollateralValue,
+		PositionValue:      body.PositionValue,
+		OrderPositionValue: body.OrderPositionValue,
+		PositionProfitLoss: body.PositionProfitLoss,
+		MaintenanceRate:    body.MaintenanceRate,
+		TodayProfitLoss:    body.TodayProfitLoss,
+	}
+
+	return v
+}
+
+// ValidateBalanceDayResultResponseBody runs the validations defined on
+// BalanceDayResultResponseBody
+func ValidateBalanceDayResultResponseBody(body *BalanceDayResultResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.CashBuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_buying_power", "body"))
+	}
+	if body.MarginBuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_buying_power", "body"))
+	}
+	if body.WithdrawableCash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("withdrawable_cash", "body"))
+	}
+	if body.DepositedMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deposited_margin", "body"))
+	}
+	if body.RequiredMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("required_margin", "body"))
+	}
+	if body.MaintenanceRate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("maintenance_rate", "body"))
+	}
+	if body.MarginCallSurplus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_call_surplus", "body"))
+	}
+	if body.Shortfall == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("shortfall", "body"))
+	}
+	return
+}
+
+// ValidateBalanceSnapshotResultResponseBody runs the validations defined on
+// BalanceSnapshotResultResponseBody
+func ValidateBalanceSnapshotResultResponseBody(body *BalanceSnapshotResultResponseBody) (err error) {
+	if body.TakenAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("taken_at", "body"))
+	}
+	if body.CashBuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cash_buying_power", "body"))
+	}
+	if body.MarginBuyingPower == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_buying_power", "body"))
+	}
+	if body.WithdrawableCash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("withdrawable_cash", "body"))
+	}
+	if body.DepositedMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deposited_margin", "body"))
+	}
+	if body.PositionValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position_value", "body"))
+	}
+	if body.ValuationProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("valuation_profit_loss", "body"))
+	}
+	if body.MaintenanceRate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("maintenance_rate", "body"))
+	}
+	if body.MarginCallSurplus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_call_surplus", "body"))
+	}
+	if body.HasMarginCall == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_margin_call", "body"))
+	}
+	return
+}
@@ -10,9 +10,12 @@ package server
 import (
 	"context"
 	"net/http"
+	balance "stock-bot/gen/balance"
 	balanceviews "stock-bot/gen/balance/views"
+	"strconv"
 
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // EncodeGetResponse returns an encoder for responses returned by the balance
@@ -26,3 +29,188 @@ func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahtt
 		return enc.Encode(body)
 	}
 }
+
+// EncodeHistoryResponse returns an encoder for responses returned by the
+// balance history endpoint.
+func EncodeHistoryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*balanceviews.StockbotBalanceHistory)
+		enc := encoder(ctx, w)
+		body := NewHistoryResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeHistoryRequest returns a decoder for requests sent to the balance
+// history endpoint.
+func DecodeHistoryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*balance.HistoryPayload, error) {
+	return func(r *http.Request) (*balance.HistoryPayload, error) {
+		var (
+			since *string
+			limit int
+			err   error
+		)
+		qp := r.URL.Query()
+		sinceRaw := qp.Get("since")
+		if sinceRaw != "" {
+			since = &sinceRaw
+		}
+		if since != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 100
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewHistoryPayload(since, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeBuyingPowerResponse returns an encoder for responses returned by the
+// balance buying_power endpoint.
+func EncodeBuyingPowerResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*balanceviews.StockbotBuyingPower)
+		enc := encoder(ctx, w)
+		body := NewBuyingPowerResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeBuyingPowerRequest returns a decoder for requests sent to the balance
+// buying_power endpoint.
+func DecodeBuyingPowerRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*balance.BuyingPowerPayload, error) {
+	return func(r *http.Request) (*balance.BuyingPowerPayload, error) {
+		var (
+			day int
+			err error
+		)
+		{
+			dayRaw := r.URL.Query().Get("day")
+			if dayRaw != "" {
+				v, err2 := strconv.ParseInt(dayRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("day", dayRaw, "integer"))
+				}
+				day = int(v)
+			}
+		}
+		if day < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("day", day, 0, true))
+		}
+		if day > 5 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("day", day, 5, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewBuyingPowerPayload(day)
+
+		return payload, nil
+	}
+}
+
+// EncodeMarginCapacityResponse returns an encoder for responses returned by
+// the balance margin_capacity endpoint.
+func EncodeMarginCapacityResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*balanceviews.StockbotMarginCapacity)
+		enc := encoder(ctx, w)
+		body := NewMarginCapacityResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeMarginCapacityRequest returns a decoder for requests sent to the
+// balance margin_capacity endpoint.
+func DecodeMarginCapacityRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*balance.MarginCapacityPayload, error) {
+	return func(r *http.Request) (*balance.MarginCapacityPayload, error) {
+		var (
+			day int
+			err error
+		)
+		{
+			dayRaw := r.URL.Query().Get("day")
+			if dayRaw != "" {
+				v, err2 := strconv.ParseInt(dayRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("day", dayRaw, "integer"))
+				}
+				day = int(v)
+			}
+		}
+		if day < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("day", day, 0, true))
+		}
+		if day > 5 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("day", day, 5, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewMarginCapacityPayload(day)
+
+		return payload, nil
+	}
+}
+
+// marshalBalanceviewsBalanceDayResultViewToBalanceDayResultResponseBody builds
+// a value of type *BalanceDayResultResponseBody from a value of type
+// *balanceviews.BalanceDayResultView.
+func marshalBalanceviewsBalanceDayResultViewToBalanceDayResultResponseBody(v *balanceviews.BalanceDayResultView) *BalanceDayResultResponseBody {
+	res := &BalanceDayResultResponseBody{
+		Date:              *v.Date,
+		CashBuyingPower:   *v.CashBuyingPower,
+		MarginBuyingPower: *v.MarginBuyingPower,
+		WithdrawableCash:  *v.WithdrawableCash,
+		DepositedMargin:   *v.DepositedMargin,
+		RequiredMargin:    *v.RequiredMargin,
+		MaintenanceRate:   *v.MaintenanceRate,
+		MarginCallSurplus: *v.MarginCallSurplus,
+		Shortfall:         *v.Shortfall,
+	}
+
+	return res
+}
+
+// marshalBalanceviewsBalanceSnapshotResultViewToBalanceSnapshotResultResponseBody
+// builds a value of type *BalanceSnapshotResultResponseBody from a value of
+// type *balanceviews.BalanceSnapshotResultView.
+func marshalBalanceviewsBalanceSnapshotResultViewToBalanceSnapshotResultResponseBody(v *balanceviews.BalanceSnapshotResultView) *BalanceSnapshotResultResponseBody {
+	res := &BalanceSnapshotResultResponseBody{
+		TakenAt:             *v.TakenAt,
+		CashBuyingPower:     *v.CashBuyingPower,
+		MarginBuyingPower:   *v.MarginBuyingPower,
+		WithdrawableCash:    *v.WithdrawableCash,
+		DepositedMargin:     *v.DepositedMargin,
+		PositionValue:       *v.PositionValue,
+		ValuationProfitLoss: *v.ValuationProfitLoss,
+		MaintenanceRate:     *v.MaintenanceRate,
+		MarginCallSurplus:   *v.MarginCallSurplus,
+		HasMarginCall:       *v.HasMarginCall,
+	}
+
+	return res
+}
@@ -18,8 +18,11 @@ import (
 
 // Server lists the balance service endpoint HTTP handlers.
 type Server struct {
-	Mounts []*MountPoint
-	Get    http.Handler
+	Mounts         []*MountPoint
+	Get            http.Handler
+	History        http.Handler
+	BuyingPower    http.Handler
+	MarginCapacity http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -50,8 +53,14 @@ func New(
 	return &Server{
 		Mounts: []*MountPoint{
 			{"Get", "GET", "/balance"},
+			{"History", "GET", "/balance/history"},
+			{"BuyingPower", "GET", "/balance/buying-power"},
+			{"MarginCapacity", "GET", "/balance/margin-capacity"},
 		},
-		Get: NewGetHandler(e.Get, mux, decoder, encoder, errhandler, formatter),
+		Get:            NewGetHandler(e.Get, mux, decoder, encoder, errhandler, formatter),
+		History:        NewHistoryHandler(e.History, mux, decoder, encoder, errhandler, formatter),
+		BuyingPower:    NewBuyingPowerHandler(e.BuyingPower, mux, decoder, encoder, errhandler, formatter),
+		MarginCapacity: NewMarginCapacityHandler(e.MarginCapacity, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -61,6 +70,9 @@ func (s *Server) Service() string { return "balance" }
 // Use wraps the server handlers with the given middleware.
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Get = m(s.Get)
+	s.History = m(s.History)
+	s.BuyingPower = m(s.BuyingPower)
+	s.MarginCapacity = m(s.MarginCapacity)
 }
 
 // MethodNames returns the methods served.
@@ -69,6 +81,9 @@ func (s *Server) MethodNames() []string { return balance.MethodNames[:] }
 // Mount configures the mux to serve the balance endpoints.
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetHandler(mux, h.Get)
+	MountHistoryHandler(mux, h.History)
+	MountBuyingPowerHandler(mux, h.BuyingPower)
+	MountMarginCapacityHandler(mux, h.MarginCapacity)
 }
 
 // Mount configures the mux to serve the balance endpoints.
@@ -121,3 +136,162 @@ func NewGetHandler(
 		}
 	})
 }
+
+// MountHistoryHandler configures the mux to serve the "balance" service
+// "history" endpoint.
+func MountHistoryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/balance/history", f)
+}
+
+// NewHistoryHandler creates a HTTP handler which loads the HTTP request and
+// calls the "balance" service "history" endpoint.
+func NewHistoryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeHistoryRequest(mux, decoder)
+		encodeResponse = EncodeHistoryResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "history")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountBuyingPowerHandler configures the mux to serve the "balance" service
+// "buying_power" endpoint.
+func MountBuyingPowerHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/balance/buying-power", f)
+}
+
+// NewBuyingPowerHandler creates a HTTP handler which loads the HTTP request
+// and calls the "balance" service "buying_power" endpoint.
+func NewBuyingPowerHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBuyingPowerRequest(mux, decoder)
+		encodeResponse = EncodeBuyingPowerResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "buying_power")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountMarginCapacityHandler configures the mux to serve the "balance" service
+// "margin_capacity" endpoint.
+func MountMarginCapacityHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/balance/margin-capacity", f)
+}
+
+// NewMarginCapacityHandler creates a HTTP handler which loads the HTTP request
+// and calls the "balance" service "margin_capacity" endpoint.
+func NewMarginCapacityHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeMarginCapacityRequest(mux, decoder)
+		encodeResponse = EncodeMarginCapacityResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "margin_capacity")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "balance")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
@@ -8,6 +8,7 @@
 package server
 
 import (
+	balance "stock-bot/gen/balance"
 	balanceviews "stock-bot/gen/balance/views"
 )
 
@@ -24,6 +25,144 @@ type GetResponseBody struct {
 	WithdrawableCash float64 `form:"withdrawable_cash" json:"withdrawable_cash" xml:"withdrawable_cash"`
 	// 追証発生フラグ (1:発生, 0:未発生)
 	HasMarginCall bool `form:"has_margin_call" json:"has_margin_call" xml:"has_margin_call"`
+	// 信用現引可能額
+	MarginDeliveryCapacity float64 `form:"margin_delivery_capacity" json:"margin_delivery_capacity" xml:"margin_delivery_capacity"`
+	// NISA成長投資可能額
+	NisaGrowthCapacity float64 `form:"nisa_growth_capacity" json:"nisa_growth_capacity" xml:"nisa_growth_capacity"`
+	// 不足額 (入金請求額)
+	Shortfall float64 `form:"shortfall" json:"shortfall" xml:"shortfall"`
+	// 立替金が発生しているかどうか
+	HasAdvance bool `form:"has_advance" json:"has_advance" xml:"has_advance"`
+	// 証券会社側の更新日時 (RFC3339)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// HistoryResponseBody is the type of the "balance" service "history" endpoint
+// HTTP response body.
+type HistoryResponseBody struct {
+	// 可能額推移 (過去6営業日)
+	Daily []*BalanceDayResultResponseBody `form:"daily" json:"daily" xml:"daily"`
+	// 保存済みの残高スナップショット
+	Snapshots []*BalanceSnapshotResultResponseBody `form:"snapshots" json:"snapshots" xml:"snapshots"`
+}
+
+// BuyingPowerResponseBody is the type of the "balance" service "buying_power"
+// endpoint HTTP response body.
+type BuyingPowerResponseBody struct {
+	// 営業日インデックス (0:T+0 〜 5:T+5)
+	Day int `form:"day" json:"day" xml:"day"`
+	// 日付 (YYYYMMDD)
+	Date string `form:"date" json:"date" xml:"date"`
+	// 現物株式買付可能額
+	CashBuyingPower float64 `form:"cash_buying_power" json:"cash_buying_power" xml:"cash_buying_power"`
+	// 保証金からの現物株式買付可能額
+	MarginBuyingPower float64 `form:"margin_buying_power" json:"margin_buying_power" xml:"margin_buying_power"`
+	// 預り金
+	DepositBalance float64 `form:"deposit_balance" json:"deposit_balance" xml:"deposit_balance"`
+	// 発注済み注文充当金
+	OrderReserved float64 `form:"order_reserved" json:"order_reserved" xml:"order_reserved"`
+	// 日計り拘束金
+	DayTradeRestricted float64 `form:"day_trade_restricted" json:"day_trade_restricted" xml:"day_trade_restricted"`
+	// その他拘束金
+	OtherRestricted float64 `form:"other_restricted" json:"other_restricted" xml:"other_restricted"`
+	// 現金保証金
+	CashMargin float64 `form:"cash_margin" json:"cash_margin" xml:"cash_margin"`
+	// 必要現金保証金
+	RequiredCashMargin float64 `form:"required_cash_margin" json:"required_cash_margin" xml:"required_cash_margin"`
+	// 代用証券評価額
+	CollateralValue float64 `form:"collateral_value" json:"collateral_value" xml:"collateral_value"`
+	// 建株評価損益
+	PositionProfitLoss float64 `form:"position_profit_loss" json:"position_profit_loss" xml:"position_profit_loss"`
+	// 受入保証金
+	DepositedMargin float64 `form:"deposited_margin" json:"deposited_margin" xml:"deposited_margin"`
+	// 必要保証金
+	RequiredMargin float64 `form:"required_margin" json:"required_margin" xml:"required_margin"`
+	// 保証金余力
+	MarginSurplus float64 `form:"margin_surplus" json:"margin_surplus" xml:"margin_surplus"`
+}
+
+// MarginCapacityResponseBody is the type of the "balance" service
+// "margin_capacity" endpoint HTTP response body.
+type MarginCapacityResponseBody struct {
+	// 営業日インデックス (0:T+0 〜 5:T+5)
+	Day int `form:"day" json:"day" xml:"day"`
+	// 日付 (YYYYMMDD)
+	Date string `form:"date" json:"date" xml:"date"`
+	// 信用新規建可能額
+	MarginBuyingPower float64 `form:"margin_buying_power" json:"margin_buying_power" xml:"margin_buying_power"`
+	// 受入保証金
+	DepositedMargin float64 `form:"deposited_margin" json:"deposited_margin" xml:"deposited_margin"`
+	// 必要保証金
+	RequiredMargin float64 `form:"required_margin" json:"required_margin" xml:"required_margin"`
+	// 保証金余力
+	MarginSurplus float64 `form:"margin_surplus" json:"margin_surplus" xml:"margin_surplus"`
+	// 保証金徴収率(%)
+	CollectionRate float64 `form:"collection_rate" json:"collection_rate" xml:"collection_rate"`
+	// 預り金
+	DepositBalance float64 `form:"deposit_balance" json:"deposit_balance" xml:"deposit_balance"`
+	// 発注済み注文充当金
+	OrderReserved float64 `form:"order_reserved" json:"order_reserved" xml:"order_reserved"`
+	// 現金保証金
+	CashMargin float64 `form:"cash_margin" json:"cash_margin" xml:"cash_margin"`
+	// 代用証券評価額
+	CollateralValue float64 `form:"collateral_value" json:"collateral_value" xml:"collateral_value"`
+	// 建株代金
+	PositionValue float64 `form:"position_value" json:"position_value" xml:"position_value"`
+	// 発注分建株代金
+	OrderPositionValue float64 `form:"order_position_value" json:"order_position_value" xml:"order_position_value"`
+	// 建株評価損益
+	PositionProfitLoss float64 `form:"position_profit_loss" json:"position_profit_loss" xml:"position_profit_loss"`
+	// 委託保証金率(%)
+	MaintenanceRate float64 `form:"maintenance_rate" json:"maintenance_rate" xml:"maintenance_rate"`
+	// 本日決済損益合計
+	TodayProfitLoss float64 `form:"today_profit_loss" json:"today_profit_loss" xml:"today_profit_loss"`
+}
+
+// BalanceDayResultResponseBody is used to define fields on response body types.
+type BalanceDayResultResponseBody struct {
+	// 日付 (YYYYMMDD)
+	Date string `form:"date" json:"date" xml:"date"`
+	// 現物株式買付可能額
+	CashBuyingPower float64 `form:"cash_buying_power" json:"cash_buying_power" xml:"cash_buying_power"`
+	// 信用新規建可能額
+	MarginBuyingPower float64 `form:"margin_buying_power" json:"margin_buying_power" xml:"margin_buying_power"`
+	// 出金可能額
+	WithdrawableCash float64 `form:"withdrawable_cash" json:"withdrawable_cash" xml:"withdrawable_cash"`
+	// 受入保証金
+	DepositedMargin float64 `form:"deposited_margin" json:"deposited_margin" xml:"deposited_margin"`
+	// 必要保証金
+	RequiredMargin float64 `form:"required_margin" json:"required_margin" xml:"required_margin"`
+	// 委託保証金率(%)
+	MaintenanceRate float64 `form:"maintenance_rate" json:"maintenance_rate" xml:"maintenance_rate"`
+	// 追証余力
+	MarginCallSurplus float64 `form:"margin_call_surplus" json:"margin_call_surplus" xml:"margin_call_surplus"`
+	// 追証/立替金/保証金不足額
+	Shortfall float64 `form:"shortfall" json:"shortfall" xml:"shortfall"`
+}
+
+// BalanceSnapshotResultResponseBody is used to define fields on response body
+// types.
+type BalanceSnapshotResultResponseBody struct {
+	// 取得した日時 (RFC3339)
+	TakenAt string `form:"taken_at" json:"taken_at" xml:"taken_at"`
+	// 現物株式買付可能額
+	CashBuyingPower float64 `form:"cash_buying_power" json:"cash_buying_power" xml:"cash_buying_power"`
+	// 信用新規建可能額
+	MarginBuyingPower float64 `form:"margin_buying_power" json:"margin_buying_power" xml:"margin_buying_power"`
+	// 出金可能額
+	WithdrawableCash float64 `form:"withdrawable_cash" json:"withdrawable_cash" xml:"withdrawable_cash"`
+	// 受入保証金
+	DepositedMargin float64 `form:"deposited_margin" json:"deposited_margin" xml:"deposited_margin"`
+	// 建株代金
+	PositionValue float64 `form:"position_value" json:"position_value" xml:"position_value"`
+	// 評価損益
+	ValuationProfitLoss float64 `form:"valuation_profit_loss" json:"valuation_profit_loss" xml:"valuation_profit_loss"`
+	// 委託保証金率(%) (リアルタイム)
+	MaintenanceRate float64 `form:"maintenance_rate" json:"maintenance_rate" xml:"maintenance_rate"`
+	// 追証余力
+	MarginCallSurplus float64 `form:"margin_call_surplus" json:"margin_call_surplus" xml:"margin_call_surplus"`
+	// 追証が発生しているかどうか
+	HasMarginCall bool `form:"has_margin_call" json:"has_margin_call" xml:"has_margin_call"`
 }
 
 // NewGetResponseBody builds the HTTP response body from the result of the
@@ -35,6 +174,115 @@ func NewGetResponseBody(res *balanceviews.StockbotBalanceView) *GetResponseBody
 		MarginMaintenanceRate:         *res.MarginMaintenanceRate,
 		WithdrawableCash:              *res.WithdrawableCash,
 		HasMarginCall:                 *res.HasMarginCall,
+		MarginDeliveryCapacity:        *res.MarginDeliveryCapacity,
+		NisaGrowthCapacity:            *res.NisaGrowthCapacity,
+		Shortfall:                     *res.Shortfall,
+		HasAdvance:                    *res.HasAdvance,
+		UpdatedAt:                     res.UpdatedAt,
 	}
 	return body
 }
+
+// NewHistoryResponseBody builds the HTTP response body from the result of the
+// "history" endpoint of the "balance" service.
+func NewHistoryResponseBody(res *balanceviews.StockbotBalanceHistoryView) *HistoryResponseBody {
+	body := &HistoryResponseBody{}
+	if res.Daily != nil {
+		body.Daily = make([]*BalanceDayResultResponseBody, len(res.Daily))
+		for i, val := range res.Daily {
+			if val == nil {
+				body.Daily[i] = nil
+				continue
+			}
+			body.Daily[i] = marshalBalanceviewsBalanceDayResultViewToBalanceDayResultResponseBody(val)
+		}
+	} else {
+		body.Daily = []*BalanceDayResultResponseBody{}
+	}
+	if res.Snapshots != nil {
+		body.Snapshots = make([]*BalanceSnapshotResultResponseBody, len(res.Snapshots))
+		for i, val := range res.Snapshots {
+			if val == nil {
+				body.Snapshots[i] = nil
+				continue
+			}
+			body.Snapshots[i] = marshalBalanceviewsBalanceSnapshotResultViewToBalanceSnapshotResultResponseBody(val)
+		}
+	} else {
+		body.Snapshots = []*BalanceSnapshotResultResponseBody{}
+	}
+	return body
+}
+
+// NewBuyingPowerResponseBody builds the HTTP response body from the result of
+// the "buying_power" endpoint of the "balance" service.
+func NewBuyingPowerResponseBody(res *balanceviews.StockbotBuyingPowerView) *BuyingPowerResponseBody {
+	body := &BuyingPowerResponseBody{
+		Day:                *res.Day,
+		Date:               *res.Date,
+		CashBuyingPower:    *res.CashBuyingPower,
+		MarginBuyingPower:  *res.MarginBuyingPower,
+		DepositBalance:     *res.DepositBalance,
+		OrderReserved:      *res.OrderReserved,
+		DayTradeRestricted: *res.DayTradeRestricted,
+		OtherRestricted:    *res.OtherRestricted,
+		CashMargin:         *res.CashMargin,
+		RequiredCashMargin: *res.RequiredCashMargin,
+		CollateralValue:    *res.CollateralValue,
+		PositionProfitLoss: *res.PositionProfitLoss,
+		DepositedMargin:    *res.DepositedMargin,
+		RequiredMargin:     *res.RequiredMargin,
+		MarginSurplus:      *res.MarginSurplus,
+	}
+	return body
+}
+
+// NewMarginCapacityResponseBody builds the HTTP response body from the result
+// of the "margin_capacity" endpoint of the "balance" service.
+func NewMarginCapacityResponseBody(res *balanceviews.StockbotMarginCapacityView) *MarginCapacityResponseBody {
+	body := &MarginCapacityResponseBody{
+		Day:                *res.Day,
+		Date:               *res.Date,
+		MarginBuyingPower:  *res.MarginBuyingPower,
+		DepositedMargin:    *res.DepositedMargin,
+		RequiredMargin:     *res.RequiredMargin,
+		MarginSurplus:      *res.MarginSurplus,
+		CollectionRate:     *res.CollectionRate,
+		DepositBalance:     *res.DepositBalance,
+		OrderReserved:      *res.OrderReserved,
+		CashMargin:         *res.CashMargin,
+		CollateralValue:    *res.CollateralValue,
+		PositionValue:      *res.PositionValue,
+		OrderPositionValue: *res.OrderPositionValue,
+		PositionProfitLoss: *res.PositionProfitLoss,
+		MaintenanceRate:    *res.MaintenanceRate,
+		TodayProfitLoss:    *res.TodayProfitLoss,
+	}
+	return body
+}
+
+// NewHistoryPayload builds a balance service history endpoint payload.
+func NewHistoryPayload(since *string, limit int) *balance.HistoryPayload {
+	v := &balance.HistoryPayload{}
+	v.Since = since
+	v.Limit = limit
+
+	return v
+}
+
+// NewBuyingPowerPayload builds a balance service buying_power endpoint payload.
+func NewBuyingPowerPayload(day int) *balance.BuyingPowerPayload {
+	v := &balance.BuyingPowerPayload{}
+	v.Day = day
+
+	return v
+}
+
+// NewMarginCapacityPayload builds a balance service margin_capacity endpoint
+// payload.
+func NewMarginCapacityPayload(day int) *balance.MarginCapacityPayload {
+	v := &balance.MarginCapacityPayload{}
+	v.Day = day
+
+	return v
+}
@@ -13,10 +13,16 @@ import (
 	"net/http"
 	"os"
 	balancec "stock-bot/gen/http/balance/client"
+	marginc "stock-bot/gen/http/margin/client"
 	masterc "stock-bot/gen/http/master/client"
+	newsc "stock-bot/gen/http/news/client"
 	orderc "stock-bot/gen/http/order/client"
+	portfolioc "stock-bot/gen/http/portfolio/client"
 	positionc "stock-bot/gen/http/position/client"
 	pricec "stock-bot/gen/http/price/client"
+	reportc "stock-bot/gen/http/report/client"
+	signalc "stock-bot/gen/http/signal/client"
+	tradec "stock-bot/gen/http/trade/client"
 
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
@@ -28,20 +34,26 @@ import (
 func UsageCommands() []string {
 	return []string{
 		"order create",
-		"balance get",
+		"balance (get|history|buying-power|margin-capacity)",
 		"price get",
 		"position list",
-		"master (get-stock|update)",
+		"portfolio get",
+		"master (get-stock|get-fundamentals|list-stocks|list-industries|update|list-sync-runs)",
+		"signal (create|list)",
+		"news (list|get)",
+		"margin (get|collect)",
+		"trade (list|daily-pnl|sync)",
+		"report (daily|generate)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + " " + "order create --body '{\n      \"is_margin\": true,\n      \"order_type\": \"STOP\",\n      \"price\": 0.4518777138956951,\n      \"quantity\": 15444783396623686298,\n      \"symbol\": \"A ut.\",\n      \"trade_type\": \"BUY\"\n   }'" + "\n" +
+	return os.Args[0] + " " + "order create --body '{\n      \"is_margin\": false,\n      \"order_type\": \"LIMIT\",\n      \"price\": 0.05929565034780394,\n      \"quantity\": 8352840840340130941,\n      \"symbol\": \"Neque aspernatur id autem a qui alias.\",\n      \"trade_type\": \"SELL\"\n   }'" + "\n" +
 		os.Args[0] + " " + "balance get" + "\n" +
-		os.Args[0] + " " + "price get --symbol \"Blanditiis voluptatibus dolores quia facilis.\"" + "\n" +
-		os.Args[0] + " " + "position list --type \"margin\"" + "\n" +
-		os.Args[0] + " " + "master get-stock --symbol \"Eligendi voluptatem officia vel.\"" + "\n" +
+		os.Args[0] + " " + "price get --symbol \"Eos est.\"" + "\n" +
+		os.Args[0] + " " + "position list --type \"all\"" + "\n" +
+		os.Args[0] + " " + "portfolio get" + "\n" +
 		""
 }
 
@@ -64,6 +76,16 @@ func ParseEndpoint(
 
 		balanceGetFlags = flag.NewFlagSet("get", flag.ExitOnError)
 
+		balanceHistoryFlags     = flag.NewFlagSet("history", flag.ExitOnError)
+		balanceHistorySinceFlag = balanceHistoryFlags.String("since", "", "")
+		balanceHistoryLimitFlag = balanceHistoryFlags.String("limit", "100", "")
+
+		balanceBuyingPowerFlags   = flag.NewFlagSet("buying-power", flag.ExitOnError)
+		balanceBuyingPowerDayFlag = balanceBuyingPowerFlags.String("day", "", "")
+
+		balanceMarginCapacityFlags   = flag.NewFlagSet("margin-capacity", flag.ExitOnError)
+		balanceMarginCapacityDayFlag = balanceMarginCapacityFlags.String("day", "", "")
+
 		priceFlags = flag.NewFlagSet("price", flag.ContinueOnError)
 
 		priceGetFlags      = flag.NewFlagSet("get", flag.ExitOnError)
@@ -74,18 +96,94 @@ func ParseEndpoint(
 		positionListFlags    = flag.NewFlagSet("list", flag.ExitOnError)
 		positionListTypeFlag = positionListFlags.String("type", "all", "")
 
+		portfolioFlags = flag.NewFlagSet("portfolio", flag.ContinueOnError)
+
+		portfolioGetFlags = flag.NewFlagSet("get", flag.ExitOnError)
+
 		masterFlags = flag.NewFlagSet("master", flag.ContinueOnError)
 
 		masterGetStockFlags      = flag.NewFlagSet("get-stock", flag.ExitOnError)
 		masterGetStockSymbolFlag = masterGetStockFlags.String("symbol", "REQUIRED", "Stock symbol to look up")
 
+		masterGetFundamentalsFlags      = flag.NewFlagSet("get-fundamentals", flag.ExitOnError)
+		masterGetFundamentalsSymbolFlag = masterGetFundamentalsFlags.String("symbol", "REQUIRED", "銘柄コード")
+
+		masterListStocksFlags            = flag.NewFlagSet("list-stocks", flag.ExitOnError)
+		masterListStocksMarketFlag       = masterListStocksFlags.String("market", "", "")
+		masterListStocksIndustryCodeFlag = masterListStocksFlags.String("industry-code", "", "")
+		masterListStocksQFlag            = masterListStocksFlags.String("q", "", "")
+		masterListStocksTradingUnitFlag  = masterListStocksFlags.String("trading-unit", "", "")
+		masterListStocksOffsetFlag       = masterListStocksFlags.String("offset", "", "")
+		masterListStocksLimitFlag        = masterListStocksFlags.String("limit", "100", "")
+
+		masterListIndustriesFlags = flag.NewFlagSet("list-industries", flag.ExitOnError)
+
 		masterUpdateFlags = flag.NewFlagSet("update", flag.ExitOnError)
+
+		masterListSyncRunsFlags     = flag.NewFlagSet("list-sync-runs", flag.ExitOnError)
+		masterListSyncRunsLimitFlag = masterListSyncRunsFlags.String("limit", "20", "")
+
+		signalFlags = flag.NewFlagSet("signal", flag.ContinueOnError)
+
+		signalCreateFlags    = flag.NewFlagSet("create", flag.ExitOnError)
+		signalCreateBodyFlag = signalCreateFlags.String("body", "REQUIRED", "")
+
+		signalListFlags      = flag.NewFlagSet("list", flag.ExitOnError)
+		signalListSymbolFlag = signalListFlags.String("symbol", "", "")
+		signalListLimitFlag  = signalListFlags.String("limit", "100", "")
+
+		newsFlags = flag.NewFlagSet("news", flag.ContinueOnError)
+
+		newsListFlags      = flag.NewFlagSet("list", flag.ExitOnError)
+		newsListSymbolFlag = newsListFlags.String("symbol", "", "")
+		newsListSinceFlag  = newsListFlags.String("since", "", "")
+		newsListLimitFlag  = newsListFlags.String("limit", "50", "")
+
+		newsGetFlags  = flag.NewFlagSet("get", flag.ExitOnError)
+		newsGetIDFlag = newsGetFlags.String("id", "REQUIRED", "ニュースID")
+
+		marginFlags = flag.NewFlagSet("margin", flag.ContinueOnError)
+
+		marginGetFlags      = flag.NewFlagSet("get", flag.ExitOnError)
+		marginGetSymbolFlag = marginGetFlags.String("symbol", "REQUIRED", "銘柄コード")
+		marginGetSinceFlag  = marginGetFlags.String("since", "", "")
+		marginGetLimitFlag  = marginGetFlags.String("limit", "30", "")
+
+		marginCollectFlags = flag.NewFlagSet("collect", flag.ExitOnError)
+
+		tradeFlags = flag.NewFlagSet("trade", flag.ContinueOnError)
+
+		tradeListFlags      = flag.NewFlagSet("list", flag.ExitOnError)
+		tradeListSymbolFlag = tradeListFlags.String("symbol", "", "")
+		tradeListMethodFlag = tradeListFlags.String("method", "FIFO", "")
+		tradeListSinceFlag  = tradeListFlags.String("since", "", "")
+		tradeListUntilFlag  = tradeListFlags.String("until", "", "")
+		tradeListLimitFlag  = tradeListFlags.String("limit", "100", "")
+
+		tradeDailyPnlFlags      = flag.NewFlagSet("daily-pnl", flag.ExitOnError)
+		tradeDailyPnlMethodFlag = tradeDailyPnlFlags.String("method", "FIFO", "")
+		tradeDailyPnlFromFlag   = tradeDailyPnlFlags.String("from", "", "")
+		tradeDailyPnlToFlag     = tradeDailyPnlFlags.String("to", "", "")
+
+		tradeSyncFlags = flag.NewFlagSet("sync", flag.ExitOnError)
+
+		reportFlags = flag.NewFlagSet("report", flag.ContinueOnError)
+
+		reportDailyFlags      = flag.NewFlagSet("daily", flag.ExitOnError)
+		reportDailyDateFlag   = reportDailyFlags.String("date", "REQUIRED", "レポートの日付 (YYYY-MM-DD, 日本時間)")
+		reportDailyFormatFlag = reportDailyFlags.String("format", "json", "")
+
+		reportGenerateFlags    = flag.NewFlagSet("generate", flag.ExitOnError)
+		reportGenerateDateFlag = reportGenerateFlags.String("date", "REQUIRED", "レポートの日付 (YYYY-MM-DD, 日本時間)")
 	)
 	orderFlags.Usage = orderUsage
 	orderCreateFlags.Usage = orderCreateUsage
 
 	balanceFlags.Usage = balanceUsage
 	balanceGetFlags.Usage = balanceGetUsage
+	balanceHistoryFlags.Usage = balanceHistoryUsage
+	balanceBuyingPowerFlags.Usage = balanceBuyingPowerUsage
+	balanceMarginCapacityFlags.Usage = balanceMarginCapacityUsage
 
 	priceFlags.Usage = priceUsage
 	priceGetFlags.Usage = priceGetUsage
@@ -93,9 +191,37 @@ func ParseEndpoint(
 	positionFlags.Usage = positionUsage
 	positionListFlags.Usage = positionListUsage
 
+	portfolioFlags.Usage = portfolioUsage
+	portfolioGetFlags.Usage = portfolioGetUsage
+
 	masterFlags.Usage = masterUsage
 	masterGetStockFlags.Usage = masterGetStockUsage
+	masterGetFundamentalsFlags.Usage = masterGetFundamentalsUsage
+	masterListStocksFlags.Usage = masterListStocksUsage
+	masterListIndustriesFlags.Usage = masterListIndustriesUsage
 	masterUpdateFlags.Usage = masterUpdateUsage
+	masterListSyncRunsFlags.Usage = masterListSyncRunsUsage
+
+	signalFlags.Usage = signalUsage
+	signalCreateFlags.Usage = signalCreateUsage
+	signalListFlags.Usage = signalListUsage
+
+	newsFlags.Usage = newsUsage
+	newsListFlags.Usage = newsListUsage
+	newsGetFlags.Usage = newsGetUsage
+
+	marginFlags.Usage = marginUsage
+	marginGetFlags.Usage = marginGetUsage
+	marginCollectFlags.Usage = marginCollectUsage
+
+	tradeFlags.Usage = tradeUsage
+	tradeListFlags.Usage = tradeListUsage
+	tradeDailyPnlFlags.Usage = tradeDailyPnlUsage
+	tradeSyncFlags.Usage = tradeSyncUsage
+
+	reportFlags.Usage = reportUsage
+	reportDailyFlags.Usage = reportDailyUsage
+	reportGenerateFlags.Usage = reportGenerateUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -120,8 +246,20 @@ func ParseEndpoint(
 			svcf = priceFlags
 		case "position":
 			svcf = positionFlags
+		case "portfolio":
+			svcf = portfolioFlags
 		case "master":
 			svcf = masterFlags
+		case "signal":
+			svcf = signalFlags
+		case "news":
+			svcf = newsFlags
+		case "margin":
+			svcf = marginFlags
+		case "trade":
+			svcf = tradeFlags
+		case "report":
+			svcf = reportFlags
 		default:
 			return nil, nil, fmt.Errorf("unknown service %q", svcn)
 		}
@@ -149,6 +287,15 @@ func ParseEndpoint(
 			case "get":
 				epf = balanceGetFlags
 
+			case "history":
+				epf = balanceHistoryFlags
+
+			case "buying-power":
+				epf = balanceBuyingPowerFlags
+
+			case "margin-capacity":
+				epf = balanceMarginCapacityFlags
+
 			}
 
 		case "price":
@@ -165,14 +312,86 @@ func ParseEndpoint(
 
 			}
 
+		case "portfolio":
+			switch epn {
+			case "get":
+				epf = portfolioGetFlags
+
+			}
+
 		case "master":
 			switch epn {
 			case "get-stock":
 				epf = masterGetStockFlags
 
+			case "get-fundamentals":
+				epf = masterGetFundamentalsFlags
+
+			case "list-stocks":
+				epf = masterListStocksFlags
+
+			case "list-industries":
+				epf = masterListIndustriesFlags
+
 			case "update":
 				epf = masterUpdateFlags
 
+			case "list-sync-runs":
+				epf = masterListSyncRunsFlags
+
+			}
+
+		case "signal":
+			switch epn {
+			case "create":
+				epf = signalCreateFlags
+
+			case "list":
+				epf = signalListFlags
+
+			}
+
+		case "news":
+			switch epn {
+			case "list":
+				epf = newsListFlags
+
+			case "get":
+				epf = newsGetFlags
+
+			}
+
+		case "margin":
+			switch epn {
+			case "get":
+				epf = marginGetFlags
+
+			case "collect":
+				epf = marginCollectFlags
+
+			}
+
+		case "trade":
+			switch epn {
+			case "list":
+				epf = tradeListFlags
+
+			case "daily-pnl":
+				epf = tradeDailyPnlFlags
+
+			case "sync":
+				epf = tradeSyncFlags
+
+			}
+
+		case "report":
+			switch epn {
+			case "daily":
+				epf = reportDailyFlags
+
+			case "generate":
+				epf = reportGenerateFlags
+
 			}
 
 		}
@@ -207,6 +426,15 @@ func ParseEndpoint(
 			switch epn {
 			case "get":
 				endpoint = c.Get()
+			case "history":
+				endpoint = c.History()
+				data, err = balancec.BuildHistoryPayload(*balanceHistorySinceFlag, *balanceHistoryLimitFlag)
+			case "buying-power":
+				endpoint = c.BuyingPower()
+				data, err = balancec.BuildBuyingPowerPayload(*balanceBuyingPowerDayFlag)
+			case "margin-capacity":
+				endpoint = c.MarginCapacity()
+				data, err = balancec.BuildMarginCapacityPayload(*balanceMarginCapacityDayFlag)
 			}
 		case "price":
 			c := pricec.NewClient(scheme, host, doer, enc, dec, restore)
@@ -222,14 +450,82 @@ func ParseEndpoint(
 				endpoint = c.List()
 				data, err = positionc.BuildListPayload(*positionListTypeFlag)
 			}
+		case "portfolio":
+			c := portfolioc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "get":
+				endpoint = c.Get()
+			}
 		case "master":
 			c := masterc.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
 			case "get-stock":
 				endpoint = c.GetStock()
 				data, err = masterc.BuildGetStockPayload(*masterGetStockSymbolFlag)
+			case "get-fundamentals":
+				endpoint = c.GetFundamentals()
+				data, err = masterc.BuildGetFundamentalsPayload(*masterGetFundamentalsSymbolFlag)
+			case "list-stocks":
+				endpoint = c.ListStocks()
+				data, err = masterc.BuildListStocksPayload(*masterListStocksMarketFlag, *masterListStocksIndustryCodeFlag, *masterListStocksQFlag, *masterListStocksTradingUnitFlag, *masterListStocksOffsetFlag, *masterListStocksLimitFlag)
+			case "list-industries":
+				endpoint = c.ListIndustries()
 			case "update":
 				endpoint = c.Update()
+			case "list-sync-runs":
+				endpoint = c.ListSyncRuns()
+				data, err = masterc.BuildListSyncRunsPayload(*masterListSyncRunsLimitFlag)
+			}
+		case "signal":
+			c := signalc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create":
+				endpoint = c.Create()
+				data, err = signalc.BuildCreatePayload(*signalCreateBodyFlag)
+			case "list":
+				endpoint = c.List()
+				data, err = signalc.BuildListPayload(*signalListSymbolFlag, *signalListLimitFlag)
+			}
+		case "news":
+			c := newsc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list":
+				endpoint = c.List()
+				data, err = newsc.BuildListPayload(*newsListSymbolFlag, *newsListSinceFlag, *newsListLimitFlag)
+			case "get":
+				endpoint = c.Get()
+				data, err = newsc.BuildGetPayload(*newsGetIDFlag)
+			}
+		case "margin":
+			c := marginc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "get":
+				endpoint = c.Get()
+				data, err = marginc.BuildGetPayload(*marginGetSymbolFlag, *marginGetSinceFlag, *marginGetLimitFlag)
+			case "collect":
+				endpoint = c.Collect()
+			}
+		case "trade":
+			c := tradec.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list":
+				endpoint = c.List()
+				data, err = tradec.BuildListPayload(*tradeListSymbolFlag, *tradeListMethodFlag, *tradeListSinceFlag, *tradeListUntilFlag, *tradeListLimitFlag)
+			case "daily-pnl":
+				endpoint = c.DailyPnl()
+				data, err = tradec.BuildDailyPnlPayload(*tradeDailyPnlMethodFlag, *tradeDailyPnlFromFlag, *tradeDailyPnlToFlag)
+			case "sync":
+				endpoint = c.Sync()
+			}
+		case "report":
+			c := reportc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "daily":
+				endpoint = c.Daily()
+				data, err = reportc.BuildDailyPayload(*reportDailyDateFlag, *reportDailyFormatFlag)
+			case "generate":
+				endpoint = c.Generate()
+				data, err = reportc.BuildGeneratePayload(*reportGenerateDateFlag)
 			}
 		}
 	}
@@ -265,7 +561,7 @@ func orderCreateUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "order create --body '{\n      \"is_margin\": true,\n      \"order_type\": \"STOP\",\n      \"price\": 0.4518777138956951,\n      \"quantity\": 15444783396623686298,\n      \"symbol\": \"A ut.\",\n      \"trade_type\": \"BUY\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "order create --body '{\n      \"is_margin\": false,\n      \"order_type\": \"LIMIT\",\n      \"price\": 0.05929565034780394,\n      \"quantity\": 8352840840340130941,\n      \"symbol\": \"Neque aspernatur id autem a qui alias.\",\n      \"trade_type\": \"SELL\"\n   }'")
 }
 
 // balanceUsage displays the usage of the balance command and its subcommands.
@@ -274,6 +570,9 @@ func balanceUsage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] balance COMMAND [flags]\n\n", os.Args[0])
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    get: Get the account balance summary.`)
+	fmt.Fprintln(os.Stderr, `    history: Get the daily balance history from the broker and the stored balance snapshots.`)
+	fmt.Fprintln(os.Stderr, `    buying-power: Get the breakdown of the cash buying power on a business day.`)
+	fmt.Fprintln(os.Stderr, `    margin-capacity: Get the breakdown of the buying power for new margin positions on a business day.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s balance COMMAND --help\n", os.Args[0])
@@ -294,6 +593,62 @@ func balanceGetUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "balance get")
 }
 
+func balanceHistoryUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] balance history", os.Args[0])
+	fmt.Fprint(os.Stderr, " -since STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the daily balance history from the broker and the stored balance snapshots.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -since STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "balance history --since \"1970-12-13T11:03:43Z\" --limit 378")
+}
+
+func balanceBuyingPowerUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] balance buying-power", os.Args[0])
+	fmt.Fprint(os.Stderr, " -day INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the breakdown of the cash buying power on a business day.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -day INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "balance buying-power --day 4")
+}
+
+func balanceMarginCapacityUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] balance margin-capacity", os.Args[0])
+	fmt.Fprint(os.Stderr, " -day INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the breakdown of the buying power for new margin positions on a business day.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -day INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "balance margin-capacity --day 1")
+}
+
 // priceUsage displays the usage of the price command and its subcommands.
 func priceUsage() {
 	fmt.Fprintln(os.Stderr, `The price service provides current stock price information.`)
@@ -319,7 +674,7 @@ func priceGetUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "price get --symbol \"Blanditiis voluptatibus dolores quia facilis.\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "price get --symbol \"Eos est.\"")
 }
 
 // positionUsage displays the usage of the position command and its subcommands.
@@ -347,7 +702,34 @@ func positionListUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "position list --type \"margin\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "position list --type \"all\"")
+}
+
+// portfolioUsage displays the usage of the portfolio command and its
+// subcommands.
+func portfolioUsage() {
+	fmt.Fprintln(os.Stderr, `The portfolio service provides analytics over the current holdings.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] portfolio COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    get: Get the totals, exposures, concentration and realized P&L of the portfolio.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s portfolio COMMAND --help\n", os.Args[0])
+}
+func portfolioGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] portfolio get", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the totals, exposures, concentration and realized P&L of the portfolio.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "portfolio get")
 }
 
 // masterUsage displays the usage of the master command and its subcommands.
@@ -356,7 +738,11 @@ func masterUsage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] master COMMAND [flags]\n\n", os.Args[0])
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    get-stock: Get basic master data for a single stock.`)
-	fmt.Fprintln(os.Stderr, `    update: Trigger a manual update of the master data.`)
+	fmt.Fprintln(os.Stderr, `    get-fundamentals: Get today's fundamentals snapshot (BPS, EPS, dividends) of a stock with PER, PBR and dividend yield derived from the latest price.`)
+	fmt.Fprintln(os.Stderr, `    list-stocks: Search stock master data with filters and paging, ordered by symbol.`)
+	fmt.Fprintln(os.Stderr, `    list-industries: List industries with the number of stocks in each.`)
+	fmt.Fprintln(os.Stderr, `    update: Trigger a manual update of the master data and report the changes.`)
+	fmt.Fprintln(os.Stderr, `    list-sync-runs: List the most recent master data sync runs, newest first.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s master COMMAND --help\n", os.Args[0])
@@ -376,7 +762,69 @@ func masterGetStockUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "master get-stock --symbol \"Eligendi voluptatem officia vel.\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "master get-stock --symbol \"Consequatur eum ratione dignissimos.\"")
+}
+
+func masterGetFundamentalsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] master get-fundamentals", os.Args[0])
+	fmt.Fprint(os.Stderr, " -symbol STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get today's fundamentals snapshot (BPS, EPS, dividends) of a stock with PER, PBR and dividend yield derived from the latest price.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -symbol STRING: 銘柄コード`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "master get-fundamentals --symbol \"Et alias velit.\"")
+}
+
+func masterListStocksUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] master list-stocks", os.Args[0])
+	fmt.Fprint(os.Stderr, " -market STRING")
+	fmt.Fprint(os.Stderr, " -industry-code STRING")
+	fmt.Fprint(os.Stderr, " -q STRING")
+	fmt.Fprint(os.Stderr, " -trading-unit INT")
+	fmt.Fprint(os.Stderr, " -offset INT")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Search stock master data with filters and paging, ordered by symbol.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -market STRING: `)
+	fmt.Fprintln(os.Stderr, `    -industry-code STRING: `)
+	fmt.Fprintln(os.Stderr, `    -q STRING: `)
+	fmt.Fprintln(os.Stderr, `    -trading-unit INT: `)
+	fmt.Fprintln(os.Stderr, `    -offset INT: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "master list-stocks --market \"Et ipsa voluptatibus.\" --industry-code \"Vel consequatur repellat est nostrum.\" --q \"Numquam eos et.\" --trading-unit 5472010182957929880 --offset 3023043589780645262 --limit 764")
+}
+
+func masterListIndustriesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] master list-industries", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List industries with the number of stocks in each.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "master list-industries")
 }
 
 func masterUpdateUsage() {
@@ -386,7 +834,7 @@ func masterUpdateUsage() {
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Trigger a manual update of the master data.`)
+	fmt.Fprintln(os.Stderr, `Trigger a manual update of the master data and report the changes.`)
 
 	// Flags list
 
@@ -394,3 +842,295 @@ func masterUpdateUsage() {
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "master update")
 }
+
+func masterListSyncRunsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] master list-sync-runs", os.Args[0])
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the most recent master data sync runs, newest first.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "master list-sync-runs --limit 77")
+}
+
+// signalUsage displays the usage of the signal command and its subcommands.
+func signalUsage() {
+	fmt.Fprintln(os.Stderr, `The signal service ingests trading signals and exposes their history.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] signal COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    create: Ingest a batch of trading signals.`)
+	fmt.Fprintln(os.Stderr, `    list: List received signals, newest first.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s signal COMMAND --help\n", os.Args[0])
+}
+func signalCreateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] signal create", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Ingest a batch of trading signals.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "signal create --body '{\n      \"generated_at\": \"2004-09-02T02:19:26Z\",\n      \"signals\": [\n         {\n            \"limit_price\": 0.15723335209923148,\n            \"rationale\": \"Voluptates facilis.\",\n            \"side\": \"SELL\",\n            \"stop_price\": 0.7005259617280328,\n            \"symbol\": \"6s\",\n            \"target_price\": 0.8886505277950612,\n            \"valid_until\": \"1970-03-16T11:33:00Z\",\n            \"weight\": 0.6215613437835261\n         },\n         {\n            \"limit_price\": 0.15723335209923148,\n            \"rationale\": \"Voluptates facilis.\",\n            \"side\": \"SELL\",\n            \"stop_price\": 0.7005259617280328,\n            \"symbol\": \"6s\",\n            \"target_price\": 0.8886505277950612,\n            \"valid_until\": \"1970-03-16T11:33:00Z\",\n            \"weight\": 0.6215613437835261\n         }\n      ]\n   }'")
+}
+
+func signalListUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] signal list", os.Args[0])
+	fmt.Fprint(os.Stderr, " -symbol STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List received signals, newest first.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -symbol STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "signal list --symbol \"Officiis reprehenderit reiciendis.\" --limit 142")
+}
+
+// newsUsage displays the usage of the news command and its subcommands.
+func newsUsage() {
+	fmt.Fprintln(os.Stderr, `The news service exposes news headlines and bodies polled for the watched stocks.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] news COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    list: List stored news, newest first. The body is not included.`)
+	fmt.Fprintln(os.Stderr, `    get: Get a news item with its body. The body is fetched from the broker if it has not been stored yet.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s news COMMAND --help\n", os.Args[0])
+}
+func newsListUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] news list", os.Args[0])
+	fmt.Fprint(os.Stderr, " -symbol STRING")
+	fmt.Fprint(os.Stderr, " -since STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List stored news, newest first. The body is not included.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -symbol STRING: `)
+	fmt.Fprintln(os.Stderr, `    -since STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "news list --symbol \"Reprehenderit animi voluptatibus.\" --since \"1988-05-07T05:11:48Z\" --limit 100")
+}
+
+func newsGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] news get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get a news item with its body. The body is fetched from the broker if it has not been stored yet.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: ニュースID`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "news get --id \"Adipisci itaque.\"")
+}
+
+// marginUsage displays the usage of the margin command and its subcommands.
+func marginUsage() {
+	fmt.Fprintln(os.Stderr, `The margin service exposes the margin and short-interest data (証金残, 信用残, 逆日歩) collected for the watched stocks.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] margin COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    get: Get the stored margin time series of a stock, newest first.`)
+	fmt.Fprintln(os.Stderr, `    collect: Collect the margin data of the watched stocks now.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s margin COMMAND --help\n", os.Args[0])
+}
+func marginGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] margin get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -symbol STRING")
+	fmt.Fprint(os.Stderr, " -since STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the stored margin time series of a stock, newest first.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -symbol STRING: 銘柄コード`)
+	fmt.Fprintln(os.Stderr, `    -since STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "margin get --symbol \"Similique aliquam veniam.\" --since \"32304139\" --limit 492")
+}
+
+func marginCollectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] margin collect", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Collect the margin data of the watched stocks now.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "margin collect")
+}
+
+// tradeUsage displays the usage of the trade command and its subcommands.
+func tradeUsage() {
+	fmt.Fprintln(os.Stderr, `The trade service exposes the round-trip trades and the realized P&L computed from the stored executions.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] trade COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    list: List the round-trip trades closed in a period, newest exit first.`)
+	fmt.Fprintln(os.Stderr, `    daily-pnl: Get the realized P&L by the exit date (Japan time), newest first.`)
+	fmt.Fprintln(os.Stderr, `    sync: Fetch the executions of today's orders from the broker and store them now.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s trade COMMAND --help\n", os.Args[0])
+}
+func tradeListUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] trade list", os.Args[0])
+	fmt.Fprint(os.Stderr, " -symbol STRING")
+	fmt.Fprint(os.Stderr, " -method STRING")
+	fmt.Fprint(os.Stderr, " -since STRING")
+	fmt.Fprint(os.Stderr, " -until STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the round-trip trades closed in a period, newest exit first.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -symbol STRING: `)
+	fmt.Fprintln(os.Stderr, `    -method STRING: `)
+	fmt.Fprintln(os.Stderr, `    -since STRING: `)
+	fmt.Fprintln(os.Stderr, `    -until STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "trade list --symbol \"Cumque dolorum ad quam alias est.\" --method \"AVERAGE\" --since \"1984-08-06T13:36:45Z\" --until \"1990-12-17T12:11:43Z\" --limit 438")
+}
+
+func tradeDailyPnlUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] trade daily-pnl", os.Args[0])
+	fmt.Fprint(os.Stderr, " -method STRING")
+	fmt.Fprint(os.Stderr, " -from STRING")
+	fmt.Fprint(os.Stderr, " -to STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the realized P&L by the exit date (Japan time), newest first.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -method STRING: `)
+	fmt.Fprintln(os.Stderr, `    -from STRING: `)
+	fmt.Fprintln(os.Stderr, `    -to STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "trade daily-pnl --method \"FIFO\" --from \"2001-01-06\" --to \"1986-07-21\"")
+}
+
+func tradeSyncUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] trade sync", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Fetch the executions of today's orders from the broker and store them now.`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "trade sync")
+}
+
+// reportUsage displays the usage of the report command and its subcommands.
+func reportUsage() {
+	fmt.Fprintln(os.Stderr, `The report service serves the end-of-day reports of the bot's activity.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] report COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    daily: Get the stored daily report as JSON or Markdown.`)
+	fmt.Fprintln(os.Stderr, `    generate: Generate the daily report now, replacing the stored report of the day. The unrealized P&L is that of the current positions.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s report COMMAND --help\n", os.Args[0])
+}
+func reportDailyUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] report daily", os.Args[0])
+	fmt.Fprint(os.Stderr, " -date STRING")
+	fmt.Fprint(os.Stderr, " -format STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the stored daily report as JSON or Markdown.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -date STRING: レポートの日付 (YYYY-MM-DD, 日本時間)`)
+	fmt.Fprintln(os.Stderr, `    -format STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "report daily --date \"1989-05-13\" --format \"markdown\"")
+}
+
+func reportGenerateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] report generate", os.Args[0])
+	fmt.Fprint(os.Stderr, " -date STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Generate the daily report now, replacing the stored report of the day. The unrealized P&L is that of the current positions.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -date STRING: レポートの日付 (YYYY-MM-DD, 日本時間)`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "report generate --date \"1986-08-22\"")
+}
@@ -0,0 +1,62 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// margin HTTP client CLI support package
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"fmt"
+	margin "stock-bot/gen/margin"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildGetPayload builds the payload for the margin get endpoint from CLI
+// flags.
+func BuildGetPayload(marginGetSymbol string, marginGetSince string, marginGetLimit string) (*margin.GetPayload, error) {
+	var err error
+	var symbol string
+	{
+		symbol = marginGetSymbol
+	}
+	var since *string
+	{
+		if marginGetSince != "" {
+			since = &marginGetSince
+			err = goa.MergeErrors(err, goa.ValidatePattern("since", *since, "^\\d{8}$"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if marginGetLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(marginGetLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &margin.GetPayload{}
+	v.Symbol = symbol
+	v.Since = since
+	v.Limit = limit
+
+	return v, nil
+}
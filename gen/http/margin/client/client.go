@@ -0,0 +1,98 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// margin client HTTP transport
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the margin service endpoint HTTP clients.
+type Client struct {
+	// Get Doer is the HTTP client used to make requests to the get endpoint.
+	GetDoer goahttp.Doer
+
+	// Collect Doer is the HTTP client used to make requests to the collect
+	// endpoint.
+	CollectDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the margin service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		GetDoer:             doer,
+		CollectDoer:         doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// Get returns an endpoint that makes HTTP requests to the margin service get
+// server.
+func (c *Client) Get() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetRequest(c.encoder)
+		decodeResponse = DecodeGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("margin", "get", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Collect returns an endpoint that makes HTTP requests to the margin service
+// collect server.
+func (c *Client) Collect() goa.Endpoint {
+	var (
+		decodeResponse = DecodeCollectResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCollectRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CollectDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("margin", "collect", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,211 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// margin HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	margin "stock-bot/gen/margin"
+	marginviews "stock-bot/gen/margin/views"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "margin" service "get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		symbol string
+	)
+	{
+		p, ok := v.(*margin.GetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("margin", "get", "*margin.GetPayload", v)
+		}
+		symbol = p.Symbol
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetMarginPath(symbol)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("margin", "get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetRequest returns an encoder for requests sent to the margin get
+// server.
+func EncodeGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*margin.GetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("margin", "get", "*margin.GetPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Since != nil {
+			values.Add("since", *p.Since)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the margin get
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("margin", "get", err)
+			}
+			p := NewGetStockbotMarginInfoOK(&body)
+			view := "default"
+			vres := &marginviews.StockbotMarginInfo{Projected: p, View: view}
+			if err = marginviews.ValidateStockbotMarginInfo(vres); err != nil {
+				return nil, goahttp.ErrValidationError("margin", "get", err)
+			}
+			res := margin.NewStockbotMarginInfo(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("margin", "get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCollectRequest instantiates a HTTP request object with method and path
+// set to call the "margin" service "collect" endpoint
+func (c *Client) BuildCollectRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CollectMarginPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("margin", "collect", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeCollectResponse returns a decoder for responses returned by the margin
+// collect endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeCollectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			var (
+				body CollectResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("margin", "collect", err)
+			}
+			p := NewCollectStockbotMarginCollectAccepted(&body)
+			view := "default"
+			vres := &marginviews.StockbotMarginCollect{Projected: p, View: view}
+			if err = marginviews.ValidateStockbotMarginCollect(vres); err != nil {
+				return nil, goahttp.ErrValidationError("margin", "collect", err)
+			}
+			res := margin.NewStockbotMarginCollect(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("margin", "collect", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalSecuritiesFinanceBalanceResultResponseBodyToMarginviewsSecuritiesFinanceBalanceResultView
+// builds a value of type *marginviews.SecuritiesFinanceBalanceResultView from
+// a value of type *SecuritiesFinanceBalanceResultResponseBody.
+func unmarshalSecuritiesFinanceBalanceResultResponseBodyToMarginviewsSecuritiesFinanceBalanceResultView(v *SecuritiesFinanceBalanceResultResponseBody) *marginviews.SecuritiesFinanceBalanceResultView {
+	res := &marginviews.SecuritiesFinanceBalanceResultView{
+		Date:             v.Date,
+		Preliminary:      v.Preliminary,
+		LoanBalance:      v.LoanBalance,
+		LoanChange:       v.LoanChange,
+		StockLoanBalance: v.StockLoanBalance,
+		StockLoanChange:  v.StockLoanChange,
+		NetBalance:       v.NetBalance,
+		NetChange:        v.NetChange,
+		LoanRatio:        v.LoanRatio,
+		TurnoverDays:     v.TurnoverDays,
+	}
+
+	return res
+}
+
+// unmarshalCreditBalanceResultResponseBodyToMarginviewsCreditBalanceResultView
+// builds a value of type *marginviews.CreditBalanceResultView from a value of
+// type *CreditBalanceResultResponseBody.
+func unmarshalCreditBalanceResultResponseBodyToMarginviewsCreditBalanceResultView(v *CreditBalanceResultResponseBody) *marginviews.CreditBalanceResultView {
+	res := &marginviews.CreditBalanceResultView{
+		Date:         v.Date,
+		LongBalance:  v.LongBalance,
+		LongChange:   v.LongChange,
+		ShortBalance: v.ShortBalance,
+		ShortChange:  v.ShortChange,
+		Ratio:        v.Ratio,
+	}
+
+	return res
+}
+
+// unmarshalMarginPremiumResultResponseBodyToMarginviewsMarginPremiumResultView
+// builds a value of type *marginviews.MarginPremiumResultView from a value of
+// type *MarginPremiumResultResponseBody.
+func unmarshalMarginPremiumResultResponseBodyToMarginviewsMarginPremiumResultView(v *MarginPremiumResultResponseBody) *marginviews.MarginPremiumResultView {
+	res := &marginviews.MarginPremiumResultView{
+		Date:    v.Date,
+		Premium: v.Premium,
+	}
+
+	return res
+}
@@ -0,0 +1,22 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the margin service.
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// GetMarginPath returns the URL path to the margin service get HTTP endpoint.
+func GetMarginPath(symbol string) string {
+	return fmt.Sprintf("/margin/%v", symbol)
+}
+
+// CollectMarginPath returns the URL path to the margin service collect HTTP endpoint.
+func CollectMarginPath() string {
+	return "/margin/collect"
+}
@@ -0,0 +1,207 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// margin HTTP client types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	marginviews "stock-bot/gen/margin/views"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// GetResponseBody is the type of the "margin" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// 銘柄コード
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// 証金残
+	SecuritiesFinance []*SecuritiesFinanceBalanceResultResponseBody `form:"securities_finance,omitempty" json:"securities_finance,omitempty" xml:"securities_finance,omitempty"`
+	// 信用残
+	Credit []*CreditBalanceResultResponseBody `form:"credit,omitempty" json:"credit,omitempty" xml:"credit,omitempty"`
+	// 逆日歩
+	Premiums []*MarginPremiumResultResponseBody `form:"premiums,omitempty" json:"premiums,omitempty" xml:"premiums,omitempty"`
+}
+
+// CollectResponseBody is the type of the "margin" service "collect" endpoint
+// HTTP response body.
+type CollectResponseBody struct {
+	// 保存した証金残の件数
+	SecuritiesFinance *int `form:"securities_finance,omitempty" json:"securities_finance,omitempty" xml:"securities_finance,omitempty"`
+	// 保存した信用残の件数
+	Credit *int `form:"credit,omitempty" json:"credit,omitempty" xml:"credit,omitempty"`
+	// 保存した逆日歩の件数
+	Premiums *int `form:"premiums,omitempty" json:"premiums,omitempty" xml:"premiums,omitempty"`
+}
+
+// SecuritiesFinanceBalanceResultResponseBody is used to define fields on
+// response body types.
+type SecuritiesFinanceBalanceResultResponseBody struct {
+	// 証金更新日 (YYYYMMDD)
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// 速報の場合は true、確報の場合は false
+	Preliminary *bool `form:"preliminary,omitempty" json:"preliminary,omitempty" xml:"preliminary,omitempty"`
+	// 融資残
+	LoanBalance *int64 `form:"loan_balance,omitempty" json:"loan_balance,omitempty" xml:"loan_balance,omitempty"`
+	// 融資前日比
+	LoanChange *int64 `form:"loan_change,omitempty" json:"loan_change,omitempty" xml:"loan_change,omitempty"`
+	// 貸株残
+	StockLoanBalance *int64 `form:"stock_loan_balance,omitempty" json:"stock_loan_balance,omitempty" xml:"stock_loan_balance,omitempty"`
+	// 貸株前日比
+	StockLoanChange *int64 `form:"stock_loan_change,omitempty" json:"stock_loan_change,omitempty" xml:"stock_loan_change,omitempty"`
+	// 差引残
+	NetBalance *int64 `form:"net_balance,omitempty" json:"net_balance,omitempty" xml:"net_balance,omitempty"`
+	// 差引残前日比
+	NetChange *int64 `form:"net_change,omitempty" json:"net_change,omitempty" xml:"net_change,omitempty"`
+	// 貸借倍率
+	LoanRatio *float64 `form:"loan_ratio,omitempty" json:"loan_ratio,omitempty" xml:"loan_ratio,omitempty"`
+	// 回転日数
+	TurnoverDays *float64 `form:"turnover_days,omitempty" json:"turnover_days,omitempty" xml:"turnover_days,omitempty"`
+}
+
+// CreditBalanceResultResponseBody is used to define fields on response body
+// types.
+type CreditBalanceResultResponseBody struct {
+	// 信用残日付 (YYYYMMDD)
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// 買残
+	LongBalance *int64 `form:"long_balance,omitempty" json:"long_balance,omitempty" xml:"long_balance,omitempty"`
+	// 買残前週比
+	LongChange *int64 `form:"long_change,omitempty" json:"long_change,omitempty" xml:"long_change,omitempty"`
+	// 売残
+	ShortBalance *int64 `form:"short_balance,omitempty" json:"short_balance,omitempty" xml:"short_balance,omitempty"`
+	// 売残前週比
+	ShortChange *int64 `form:"short_change,omitempty" json:"short_change,omitempty" xml:"short_change,omitempty"`
+	// 信用倍率
+	Ratio *float64 `form:"ratio,omitempty" json:"ratio,omitempty" xml:"ratio,omitempty"`
+}
+
+// MarginPremiumResultResponseBody is used to define fields on response body
+// types.
+type MarginPremiumResultResponseBody struct {
+	// 取得した日 (YYYYMMDD)
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// 逆日歩 (1株あたり・円)
+	Premium *float64 `form:"premium,omitempty" json:"premium,omitempty" xml:"premium,omitempty"`
+}
+
+// NewGetStockbotMarginInfoOK builds a "margin" service "get" endpoint result
+// from a HTTP "OK" response.
+func NewGetStockbotMarginInfoOK(body *GetResponseBody) *marginviews.StockbotMarginInfoView {
+	v := &marginviews.StockbotMarginInfoView{
+		Symbol: body.Symbol,
+	}
+	v.SecuritiesFinance = make([]*marginviews.SecuritiesFinanceBalanceResultView, len(body.SecuritiesFinance))
+	for i, val := range body.SecuritiesFinance {
+		if val == nil {
+			v.SecuritiesFinance[i] = nil
+			continue
+		}
+		v.SecuritiesFinance[i] = unmarshalSecuritiesFinanceBalanceResultResponseBodyToMarginviewsSecuritiesFinanceBalanceResultView(val)
+	}
+	v.Credit = make([]*marginviews.CreditBalanceResultView, len(body.Credit))
+	for i, val := range body.Credit {
+		if val == nil {
+			v.Credit[i] = nil
+			continue
+		}
+		v.Credit[i] = unmarshalCreditBalanceResultResponseBodyToMarginviewsCreditBalanceResultView(val)
+	}
+	v.Premiums = make([]*marginviews.MarginPremiumResultView, len(body.Premiums))
+	for i, val := range body.Premiums {
+		if val == nil {
+			v.Premiums[i] = nil
+			continue
+		}
+		v.Premiums[i] = unmarshalMarginPremiumResultResponseBodyToMarginviewsMarginPremiumResultView(val)
+	}
+
+	return v
+}
+
+// NewCollectStockbotMarginCollectAccepted builds a "margin" service "collect"
+// endpoint result from a HTTP "Accepted" response.
+func NewCollectStockbotMarginCollectAccepted(body *CollectResponseBody) *marginviews.StockbotMarginCollectView {
+	v := &marginviews.StockbotMarginCollectView{
+		SecuritiesFinance: body.SecuritiesFinance,
+		Credit:            body.Credit,
+		Premiums:          body.Premiums,
+	}
+
+	return v
+}
+
+// ValidateSecuritiesFinanceBalanceResultResponseBody runs the validations
+// defined on SecuritiesFinanceBalanceResultResponseBody
+func ValidateSecuritiesFinanceBalanceResultResponseBody(body *SecuritiesFinanceBalanceResultResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Preliminary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("preliminary", "body"))
+	}
+	if body.LoanBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loan_balance", "body"))
+	}
+	if body.LoanChange == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loan_change", "body"))
+	}
+	if body.StockLoanBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stock_loan_balance", "body"))
+	}
+	if body.StockLoanChange == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stock_loan_change", "body"))
+	}
+	if body.NetBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_balance", "body"))
+	}
+	if body.NetChange == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_change", "body"))
+	}
+	if body.LoanRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loan_ratio", "body"))
+	}
+	if body.TurnoverDays == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("turnover_days", "body"))
+	}
+	return
+}
+
+// ValidateCreditBalanceResultResponseBody runs the validations defined on
+// CreditBalanceResultResponseBody
+func ValidateCreditBalanceResultResponseBody(body *CreditBalanceResultResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.LongBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_balance", "body"))
+	}
+	if body.LongChange == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_change", "body"))
+	}
+	if body.ShortBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_balance", "body"))
+	}
+	if body.ShortChange == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_change", "body"))
+	}
+	if body.Ratio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ratio", "body"))
+	}
+	return
+}
+
+// ValidateMarginPremiumResultResponseBody runs the validations defined on
+// MarginPremiumResultResponseBody
+func ValidateMarginPremiumResultResponseBody(body *MarginPremiumResultResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Premium == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("premium", "body"))
+	}
+	return
+}
@@ -0,0 +1,139 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// margin HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+	margin "stock-bot/gen/margin"
+	marginviews "stock-bot/gen/margin/views"
+	"strconv"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeGetResponse returns an encoder for responses returned by the margin
+// get endpoint.
+func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*marginviews.StockbotMarginInfo)
+		enc := encoder(ctx, w)
+		body := NewGetResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetRequest returns a decoder for requests sent to the margin get
+// endpoint.
+func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*margin.GetPayload, error) {
+	return func(r *http.Request) (*margin.GetPayload, error) {
+		var (
+			symbol string
+			since  *string
+			limit  int
+			err    error
+
+			params = mux.Vars(r)
+		)
+		symbol = params["symbol"]
+		qp := r.URL.Query()
+		sinceRaw := qp.Get("since")
+		if sinceRaw != "" {
+			since = &sinceRaw
+		}
+		if since != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("since", *since, "^\\d{8}$"))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 30
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetPayload(symbol, since, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeCollectResponse returns an encoder for responses returned by the
+// margin collect endpoint.
+func EncodeCollectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*marginviews.StockbotMarginCollect)
+		enc := encoder(ctx, w)
+		body := NewCollectResponseBody(res.Projected)
+		w.WriteHeader(http.StatusAccepted)
+		return enc.Encode(body)
+	}
+}
+
+// marshalMarginviewsSecuritiesFinanceBalanceResultViewToSecuritiesFinanceBalanceResultResponseBody
+// builds a value of type *SecuritiesFinanceBalanceResultResponseBody from a
+// value of type *marginviews.SecuritiesFinanceBalanceResultView.
+func marshalMarginviewsSecuritiesFinanceBalanceResultViewToSecuritiesFinanceBalanceResultResponseBody(v *marginviews.SecuritiesFinanceBalanceResultView) *SecuritiesFinanceBalanceResultResponseBody {
+	res := &SecuritiesFinanceBalanceResultResponseBody{
+		Date:             *v.Date,
+		Preliminary:      *v.Preliminary,
+		LoanBalance:      *v.LoanBalance,
+		LoanChange:       *v.LoanChange,
+		StockLoanBalance: *v.StockLoanBalance,
+		StockLoanChange:  *v.StockLoanChange,
+		NetBalance:       *v.NetBalance,
+		NetChange:        *v.NetChange,
+		LoanRatio:        *v.LoanRatio,
+		TurnoverDays:     *v.TurnoverDays,
+	}
+
+	return res
+}
+
+// marshalMarginviewsCreditBalanceResultViewToCreditBalanceResultResponseBody
+// builds a value of type *CreditBalanceResultResponseBody from a value of type
+// *marginviews.CreditBalanceResultView.
+func marshalMarginviewsCreditBalanceResultViewToCreditBalanceResultResponseBody(v *marginviews.CreditBalanceResultView) *CreditBalanceResultResponseBody {
+	res := &CreditBalanceResultResponseBody{
+		Date:         *v.Date,
+		LongBalance:  *v.LongBalance,
+		LongChange:   *v.LongChange,
+		ShortBalance: *v.ShortBalance,
+		ShortChange:  *v.ShortChange,
+		Ratio:        *v.Ratio,
+	}
+
+	return res
+}
+
+// marshalMarginviewsMarginPremiumResultViewToMarginPremiumResultResponseBody
+// builds a value of type *MarginPremiumResultResponseBody from a value of type
+// *marginviews.MarginPremiumResultView.
+func marshalMarginviewsMarginPremiumResultViewToMarginPremiumResultResponseBody(v *marginviews.MarginPremiumResultView) *MarginPremiumResultResponseBody {
+	res := &MarginPremiumResultResponseBody{
+		Date:    *v.Date,
+		Premium: *v.Premium,
+	}
+
+	return res
+}
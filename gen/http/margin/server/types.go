@@ -0,0 +1,154 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// margin HTTP server types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	margin "stock-bot/gen/margin"
+	marginviews "stock-bot/gen/margin/views"
+)
+
+// GetResponseBody is the type of the "margin" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// 銘柄コード
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// 証金残
+	SecuritiesFinance []*SecuritiesFinanceBalanceResultResponseBody `form:"securities_finance" json:"securities_finance" xml:"securities_finance"`
+	// 信用残
+	Credit []*CreditBalanceResultResponseBody `form:"credit" json:"credit" xml:"credit"`
+	// 逆日歩
+	Premiums []*MarginPremiumResultResponseBody `form:"premiums" json:"premiums" xml:"premiums"`
+}
+
+// CollectResponseBody is the type of the "margin" service "collect" endpoint
+// HTTP response body.
+type CollectResponseBody struct {
+	// 保存した証金残の件数
+	SecuritiesFinance int `form:"securities_finance" json:"securities_finance" xml:"securities_finance"`
+	// 保存した信用残の件数
+	Credit int `form:"credit" json:"credit" xml:"credit"`
+	// 保存した逆日歩の件数
+	Premiums int `form:"premiums" json:"premiums" xml:"premiums"`
+}
+
+// SecuritiesFinanceBalanceResultResponseBody is used to define fields on
+// response body types.
+type SecuritiesFinanceBalanceResultResponseBody struct {
+	// 証金更新日 (YYYYMMDD)
+	Date string `form:"date" json:"date" xml:"date"`
+	// 速報の場合は true、確報の場合は false
+	Preliminary bool `form:"preliminary" json:"preliminary" xml:"preliminary"`
+	// 融資残
+	LoanBalance int64 `form:"loan_balance" json:"loan_balance" xml:"loan_balance"`
+	// 融資前日比
+	LoanChange int64 `form:"loan_change" json:"loan_change" xml:"loan_change"`
+	// 貸株残
+	StockLoanBalance int64 `form:"stock_loan_balance" json:"stock_loan_balance" xml:"stock_loan_balance"`
+	// 貸株前日比
+	StockLoanChange int64 `form:"stock_loan_change" json:"stock_loan_change" xml:"stock_loan_change"`
+	// 差引残
+	NetBalance int64 `form:"net_balance" json:"net_balance" xml:"net_balance"`
+	// 差引残前日比
+	NetChange int64 `form:"net_change" json:"net_change" xml:"net_change"`
+	// 貸借倍率
+	LoanRatio float64 `form:"loan_ratio" json:"loan_ratio" xml:"loan_ratio"`
+	// 回転日数
+	TurnoverDays float64 `form:"turnover_days" json:"turnover_days" xml:"turnover_days"`
+}
+
+// CreditBalanceResultResponseBody is used to define fields on response body
+// types.
+type CreditBalanceResultResponseBody struct {
+	// 信用残日付 (YYYYMMDD)
+	Date string `form:"date" json:"date" xml:"date"`
+	// 買残
+	LongBalance int64 `form:"long_balance" json:"long_balance" xml:"long_balance"`
+	// 買残前週比
+	LongChange int64 `form:"long_change" json:"long_change" xml:"long_change"`
+	// 売残
+	ShortBalance int64 `form:"short_balance" json:"short_balance" xml:"short_balance"`
+	// 売残前週比
+	ShortChange int64 `form:"short_change" json:"short_change" xml:"short_change"`
+	// 信用倍率
+	Ratio float64 `form:"ratio" json:"ratio" xml:"ratio"`
+}
+
+// MarginPremiumResultResponseBody is used to define fields on response body
+// types.
+type MarginPremiumResultResponseBody struct {
+	// 取得した日 (YYYYMMDD)
+	Date string `form:"date" json:"date" xml:"date"`
+	// 逆日歩 (1株あたり・円)
+	Premium float64 `form:"premium" json:"premium" xml:"premium"`
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "get" endpoint of the "margin" service.
+func NewGetResponseBody(res *marginviews.StockbotMarginInfoView) *GetResponseBody {
+	body := &GetResponseBody{
+		Symbol: *res.Symbol,
+	}
+	if res.SecuritiesFinance != nil {
+		body.SecuritiesFinance = make([]*SecuritiesFinanceBalanceResultResponseBody, len(res.SecuritiesFinance))
+		for i, val := range res.SecuritiesFinance {
+			if val == nil {
+				body.SecuritiesFinance[i] = nil
+				continue
+			}
+			body.SecuritiesFinance[i] = marshalMarginviewsSecuritiesFinanceBalanceResultViewToSecuritiesFinanceBalanceResultResponseBody(val)
+		}
+	} else {
+		body.SecuritiesFinance = []*SecuritiesFinanceBalanceResultResponseBody{}
+	}
+	if res.Credit != nil {
+		body.Credit = make([]*CreditBalanceResultResponseBody, len(res.Credit))
+		for i, val := range res.Credit {
+			if val == nil {
+				body.Credit[i] = nil
+				continue
+			}
+			body.Credit[i] = marshalMarginviewsCreditBalanceResultViewToCreditBalanceResultResponseBody(val)
+		}
+	} else {
+		body.Credit = []*CreditBalanceResultResponseBody{}
+	}
+	if res.Premiums != nil {
+		body.Premiums = make([]*MarginPremiumResultResponseBody, len(res.Premiums))
+		for i, val := range res.Premiums {
+			if val == nil {
+				body.Premiums[i] = nil
+				continue
+			}
+			body.Premiums[i] = marshalMarginviewsMarginPremiumResultViewToMarginPremiumResultResponseBody(val)
+		}
+	} else {
+		body.Premiums = []*MarginPremiumResultResponseBody{}
+	}
+	return body
+}
+
+// NewCollectResponseBody builds the HTTP response body from the result of the
+// "collect" endpoint of the "margin" service.
+func NewCollectResponseBody(res *marginviews.StockbotMarginCollectView) *CollectResponseBody {
+	body := &CollectResponseBody{
+		SecuritiesFinance: *res.SecuritiesFinance,
+		Credit:            *res.Credit,
+		Premiums:          *res.Premiums,
+	}
+	return body
+}
+
+// NewGetPayload builds a margin service get endpoint payload.
+func NewGetPayload(symbol string, since *string, limit int) *margin.GetPayload {
+	v := &margin.GetPayload{}
+	v.Symbol = symbol
+	v.Since = since
+	v.Limit = limit
+
+	return v
+}
@@ -8,7 +8,11 @@
 package client
 
 import (
+	"fmt"
 	master "stock-bot/gen/master"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildGetStockPayload builds the payload for the master get_stock endpoint
@@ -23,3 +27,134 @@ func BuildGetStockPayload(masterGetStockSymbol string) (*master.GetStockPayload,
 
 	return v, nil
 }
+
+// BuildGetFundamentalsPayload builds the payload for the master
+// get_fundamentals endpoint from CLI flags.
+func BuildGetFundamentalsPayload(masterGetFundamentalsSymbol string) (*master.GetFundamentalsPayload, error) {
+	var symbol string
+	{
+		symbol = masterGetFundamentalsSymbol
+	}
+	v := &master.GetFundamentalsPayload{}
+	v.Symbol = symbol
+
+	return v, nil
+}
+
+// BuildListStocksPayload builds the payload for the master list_stocks
+// endpoint from CLI flags.
+func BuildListStocksPayload(masterListStocksMarket string, masterListStocksIndustryCode string, masterListStocksQ string, masterListStocksTradingUnit string, masterListStocksOffset string, masterListStocksLimit string) (*master.ListStocksPayload, error) {
+	var err error
+	var market *string
+	{
+		if masterListStocksMarket != "" {
+			market = &masterListStocksMarket
+		}
+	}
+	var industryCode *string
+	{
+		if masterListStocksIndustryCode != "" {
+			industryCode = &masterListStocksIndustryCode
+		}
+	}
+	var q *string
+	{
+		if masterListStocksQ != "" {
+			q = &masterListStocksQ
+		}
+	}
+	var tradingUnit *int
+	{
+		if masterListStocksTradingUnit != "" {
+			var v int64
+			v, err = strconv.ParseInt(masterListStocksTradingUnit, 10, strconv.IntSize)
+			val := int(v)
+			tradingUnit = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for tradingUnit, must be INT")
+			}
+			if *tradingUnit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("trading_unit", *tradingUnit, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var offset int
+	{
+		if masterListStocksOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(masterListStocksOffset, 10, strconv.IntSize)
+			offset = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+			if offset < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if masterListStocksLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(masterListStocksLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &master.ListStocksPayload{}
+	v.Market = market
+	v.IndustryCode = industryCode
+	v.Q = q
+	v.TradingUnit = tradingUnit
+	v.Offset = offset
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildListSyncRunsPayload builds the payload for the master list_sync_runs
+// endpoint from CLI flags.
+func BuildListSyncRunsPayload(masterListSyncRunsLimit string) (*master.ListSyncRunsPayload, error) {
+	var err error
+	var limit int
+	{
+		if masterListSyncRunsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(masterListSyncRunsLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &master.ListSyncRunsPayload{}
+	v.Limit = limit
+
+	return v, nil
+}
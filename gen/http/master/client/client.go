@@ -21,9 +21,25 @@ type Client struct {
 	// endpoint.
 	GetStockDoer goahttp.Doer
 
+	// GetFundamentals Doer is the HTTP client used to make requests to the
+	// get_fundamentals endpoint.
+	GetFundamentalsDoer goahttp.Doer
+
+	// ListStocks Doer is the HTTP client used to make requests to the list_stocks
+	// endpoint.
+	ListStocksDoer goahttp.Doer
+
+	// ListIndustries Doer is the HTTP client used to make requests to the
+	// list_industries endpoint.
+	ListIndustriesDoer goahttp.Doer
+
 	// Update Doer is the HTTP client used to make requests to the update endpoint.
 	UpdateDoer goahttp.Doer
 
+	// ListSyncRuns Doer is the HTTP client used to make requests to the
+	// list_sync_runs endpoint.
+	ListSyncRunsDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -45,7 +61,11 @@ func NewClient(
 ) *Client {
 	return &Client{
 		GetStockDoer:        doer,
+		GetFundamentalsDoer: doer,
+		ListStocksDoer:      doer,
+		ListIndustriesDoer:  doer,
 		UpdateDoer:          doer,
+		ListSyncRunsDoer:    doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
 		host:                host,
@@ -73,6 +93,68 @@ func (c *Client) GetStock() goa.Endpoint {
 	}
 }
 
+// GetFundamentals returns an endpoint that makes HTTP requests to the master
+// service get_fundamentals server.
+func (c *Client) GetFundamentals() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetFundamentalsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetFundamentalsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetFundamentalsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("master", "get_fundamentals", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListStocks returns an endpoint that makes HTTP requests to the master
+// service list_stocks server.
+func (c *Client) ListStocks() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListStocksRequest(c.encoder)
+		decodeResponse = DecodeListStocksResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListStocksRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListStocksDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("master", "list_stocks", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListIndustries returns an endpoint that makes HTTP requests to the master
+// service list_industries server.
+func (c *Client) ListIndustries() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListIndustriesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListIndustriesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListIndustriesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("master", "list_industries", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // Update returns an endpoint that makes HTTP requests to the master service
 // update server.
 func (c *Client) Update() goa.Endpoint {
@@ -91,3 +173,27 @@ func (c *Client) Update() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// ListSyncRuns returns an endpoint that makes HTTP requests to the master
+// service list_sync_runs server.
+func (c *Client) ListSyncRuns() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListSyncRunsRequest(c.encoder)
+		decodeResponse = DecodeListSyncRunsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListSyncRunsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListSyncRunsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("master", "list_sync_runs", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -10,6 +10,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -86,6 +87,232 @@ func DecodeGetStockResponse(decoder func(*http.Response) goahttp.Decoder, restor
 	}
 }
 
+// BuildGetFundamentalsRequest instantiates a HTTP request object with method
+// and path set to call the "master" service "get_fundamentals" endpoint
+func (c *Client) BuildGetFundamentalsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		symbol string
+	)
+	{
+		p, ok := v.(*master.GetFundamentalsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("master", "get_fundamentals", "*master.GetFundamentalsPayload", v)
+		}
+		symbol = p.Symbol
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetFundamentalsMasterPath(symbol)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("master", "get_fundamentals", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetFundamentalsResponse returns a decoder for responses returned by
+// the master get_fundamentals endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetFundamentalsResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeGetFundamentalsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetFundamentalsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("master", "get_fundamentals", err)
+			}
+			p := NewGetFundamentalsStockbotStockFundamentalsOK(&body)
+			view := "default"
+			vres := &masterviews.StockbotStockFundamentals{Projected: p, View: view}
+			if err = masterviews.ValidateStockbotStockFundamentals(vres); err != nil {
+				return nil, goahttp.ErrValidationError("master", "get_fundamentals", err)
+			}
+			res := master.NewStockbotStockFundamentals(vres)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetFundamentalsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("master", "get_fundamentals", err)
+			}
+			err = ValidateGetFundamentalsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("master", "get_fundamentals", err)
+			}
+			return nil, NewGetFundamentalsNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("master", "get_fundamentals", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListStocksRequest instantiates a HTTP request object with method and
+// path set to call the "master" service "list_stocks" endpoint
+func (c *Client) BuildListStocksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListStocksMasterPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("master", "list_stocks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListStocksRequest returns an encoder for requests sent to the master
+// list_stocks server.
+func EncodeListStocksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*master.ListStocksPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("master", "list_stocks", "*master.ListStocksPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Market != nil {
+			values.Add("market", *p.Market)
+		}
+		if p.IndustryCode != nil {
+			values.Add("industry_code", *p.IndustryCode)
+		}
+		if p.Q != nil {
+			values.Add("q", *p.Q)
+		}
+		if p.TradingUnit != nil {
+			values.Add("trading_unit", fmt.Sprintf("%v", *p.TradingUnit))
+		}
+		values.Add("offset", fmt.Sprintf("%v", p.Offset))
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListStocksResponse returns a decoder for responses returned by the
+// master list_stocks endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeListStocksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListStocksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("master", "list_stocks", err)
+			}
+			p := NewListStocksStockbotStockMasterPageOK(&body)
+			view := "default"
+			vres := &masterviews.StockbotStockMasterPage{Projected: p, View: view}
+			if err = masterviews.ValidateStockbotStockMasterPage(vres); err != nil {
+				return nil, goahttp.ErrValidationError("master", "list_stocks", err)
+			}
+			res := master.NewStockbotStockMasterPage(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("master", "list_stocks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListIndustriesRequest instantiates a HTTP request object with method
+// and path set to call the "master" service "list_industries" endpoint
+func (c *Client) BuildListIndustriesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListIndustriesMasterPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("master", "list_industries", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListIndustriesResponse returns a decoder for responses returned by the
+// master list_industries endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeListIndustriesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListIndustriesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("master", "list_industries", err)
+			}
+			p := NewListIndustriesStockbotIndustryCollectionOK(&body)
+			view := "default"
+			vres := &masterviews.StockbotIndustryCollection{Projected: p, View: view}
+			if err = masterviews.ValidateStockbotIndustryCollection(vres); err != nil {
+				return nil, goahttp.ErrValidationError("master", "list_industries", err)
+			}
+			res := master.NewStockbotIndustryCollection(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("master", "list_industries", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildUpdateRequest instantiates a HTTP request object with method and path
 // set to call the "master" service "update" endpoint
 func (c *Client) BuildUpdateRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -120,10 +347,178 @@ func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 		}
 		switch resp.StatusCode {
 		case http.StatusAccepted:
-			return nil, nil
+			var (
+				body UpdateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("master", "update", err)
+			}
+			p := NewUpdateStockbotMasterSyncSummaryAccepted(&body)
+			view := "default"
+			vres := &masterviews.StockbotMasterSyncSummary{Projected: p, View: view}
+			if err = masterviews.ValidateStockbotMasterSyncSummary(vres); err != nil {
+				return nil, goahttp.ErrValidationError("master", "update", err)
+			}
+			res := master.NewStockbotMasterSyncSummary(vres)
+			return res, nil
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("master", "update", resp.StatusCode, string(body))
 		}
 	}
 }
+
+// BuildListSyncRunsRequest instantiates a HTTP request object with method and
+// path set to call the "master" service "list_sync_runs" endpoint
+func (c *Client) BuildListSyncRunsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListSyncRunsMasterPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("master", "list_sync_runs", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListSyncRunsRequest returns an encoder for requests sent to the master
+// list_sync_runs server.
+func EncodeListSyncRunsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*master.ListSyncRunsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("master", "list_sync_runs", "*master.ListSyncRunsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListSyncRunsResponse returns a decoder for responses returned by the
+// master list_sync_runs endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeListSyncRunsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListSyncRunsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("master", "list_sync_runs", err)
+			}
+			p := NewListSyncRunsStockbotMasterSyncRunCollectionOK(&body)
+			view := "default"
+			vres := &masterviews.StockbotMasterSyncRunCollection{Projected: p, View: view}
+			if err = masterviews.ValidateStockbotMasterSyncRunCollection(vres); err != nil {
+				return nil, goahttp.ErrValidationError("master", "list_sync_runs", err)
+			}
+			res := master.NewStockbotMasterSyncRunCollection(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("master", "list_sync_runs", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalStockbotStockMasterResponseBodyToMasterviewsStockbotStockMasterView
+// builds a value of type *masterviews.StockbotStockMasterView from a value of
+// type *StockbotStockMasterResponseBody.
+func unmarshalStockbotStockMasterResponseBodyToMasterviewsStockbotStockMasterView(v *StockbotStockMasterResponseBody) *masterviews.StockbotStockMasterView {
+	res := &masterviews.StockbotStockMasterView{
+		Symbol:       v.Symbol,
+		Name:         v.Name,
+		NameKana:     v.NameKana,
+		Market:       v.Market,
+		IndustryCode: v.IndustryCode,
+		IndustryName: v.IndustryName,
+		UpperLimit:   v.UpperLimit,
+		LowerLimit:   v.LowerLimit,
+		TradingUnit:  v.TradingUnit,
+	}
+
+	return res
+}
+
+// unmarshalIndustryResultResponseBodyToMasterviewsIndustryResultView builds a
+// value of type *masterviews.IndustryResultView from a value of type
+// *IndustryResultResponseBody.
+func unmarshalIndustryResultResponseBodyToMasterviewsIndustryResultView(v *IndustryResultResponseBody) *masterviews.IndustryResultView {
+	res := &masterviews.IndustryResultView{
+		IndustryCode: v.IndustryCode,
+		IndustryName: v.IndustryName,
+		Count:        v.Count,
+	}
+
+	return res
+}
+
+// unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView
+// builds a value of type *masterviews.MasterSyncCountsView from a value of
+// type *MasterSyncCountsResponseBody.
+func unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(v *MasterSyncCountsResponseBody) *masterviews.MasterSyncCountsView {
+	res := &masterviews.MasterSyncCountsView{
+		Inserted:  v.Inserted,
+		Updated:   v.Updated,
+		Unchanged: v.Unchanged,
+		Deleted:   v.Deleted,
+	}
+
+	return res
+}
+
+// unmarshalMasterSyncRunResponseBodyToMasterviewsMasterSyncRunView builds a
+// value of type *masterviews.MasterSyncRunView from a value of type
+// *MasterSyncRunResponseBody.
+func unmarshalMasterSyncRunResponseBodyToMasterviewsMasterSyncRunView(v *MasterSyncRunResponseBody) *masterviews.MasterSyncRunView {
+	res := &masterviews.MasterSyncRunView{
+		ID:         v.ID,
+		Trigger:    v.Trigger,
+		Status:     v.Status,
+		StartedAt:  v.StartedAt,
+		FinishedAt: v.FinishedAt,
+		Error:      v.Error,
+	}
+	res.Summary = unmarshalStockbotMasterSyncSummaryResponseBodyToMasterviewsStockbotMasterSyncSummaryView(v.Summary)
+
+	return res
+}
+
+// unmarshalStockbotMasterSyncSummaryResponseBodyToMasterviewsStockbotMasterSyncSummaryView
+// builds a value of type *masterviews.StockbotMasterSyncSummaryView from a
+// value of type *StockbotMasterSyncSummaryResponseBody.
+func unmarshalStockbotMasterSyncSummaryResponseBodyToMasterviewsStockbotMasterSyncSummaryView(v *StockbotMasterSyncSummaryResponseBody) *masterviews.StockbotMasterSyncSummaryView {
+	res := &masterviews.StockbotMasterSyncSummaryView{
+		RunID: v.RunID,
+		Scope: v.Scope,
+	}
+	res.Stocks = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(v.Stocks)
+	res.StockMarkets = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(v.StockMarkets)
+	res.TickRules = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(v.TickRules)
+	res.MarginMasters = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(v.MarginMasters)
+	res.Regulations = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(v.Regulations)
+	res.OperationStatuses = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(v.OperationStatuses)
+
+	return res
+}
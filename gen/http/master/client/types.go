@@ -9,6 +9,8 @@ package client
 
 import (
 	masterviews "stock-bot/gen/master/views"
+
+	goa "goa.design/goa/v3/pkg"
 )
 
 // GetStockResponseBody is the type of the "master" service "get_stock"
@@ -26,6 +28,211 @@ type GetStockResponseBody struct {
 	IndustryCode *string `form:"industry_code,omitempty" json:"industry_code,omitempty" xml:"industry_code,omitempty"`
 	// 業種コード名
 	IndustryName *string `form:"industry_name,omitempty" json:"industry_name,omitempty" xml:"industry_name,omitempty"`
+	// 値幅上限 (ストップ高)
+	UpperLimit *float64 `form:"upper_limit,omitempty" json:"upper_limit,omitempty" xml:"upper_limit,omitempty"`
+	// 値幅下限 (ストップ安)
+	LowerLimit *float64 `form:"lower_limit,omitempty" json:"lower_limit,omitempty" xml:"lower_limit,omitempty"`
+	// 売買単位
+	TradingUnit *int `form:"trading_unit,omitempty" json:"trading_unit,omitempty" xml:"trading_unit,omitempty"`
+}
+
+// GetFundamentalsResponseBody is the type of the "master" service
+// "get_fundamentals" endpoint HTTP response body.
+type GetFundamentalsResponseBody struct {
+	// 銘柄コード
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// スナップショットの日付 (YYYYMMDD)
+	SnapshotDate *string `form:"snapshot_date,omitempty" json:"snapshot_date,omitempty" xml:"snapshot_date,omitempty"`
+	// スナップショットを取得した日時 (RFC3339)
+	FetchedAt *string `form:"fetched_at,omitempty" json:"fetched_at,omitempty" xml:"fetched_at,omitempty"`
+	// 一株資産 (実績・連結)
+	Bps *float64 `form:"bps,omitempty" json:"bps,omitempty" xml:"bps,omitempty"`
+	// 一株利益 (予想・通期連結)
+	Eps *float64 `form:"eps,omitempty" json:"eps,omitempty" xml:"eps,omitempty"`
+	// ROE (予想, %)
+	Roe *float64 `form:"roe,omitempty" json:"roe,omitempty" xml:"roe,omitempty"`
+	// 一株配当 (予想, 配当利回りから算出)
+	DividendPerShare *float64 `form:"dividend_per_share,omitempty" json:"dividend_per_share,omitempty" xml:"dividend_per_share,omitempty"`
+	// PER (予想, 取得時点)
+	ReportedPer *float64 `form:"reported_per,omitempty" json:"reported_per,omitempty" xml:"reported_per,omitempty"`
+	// PBR (実績, 取得時点)
+	ReportedPbr *float64 `form:"reported_pbr,omitempty" json:"reported_pbr,omitempty" xml:"reported_pbr,omitempty"`
+	// 配当利回り (予想, %, 取得時点)
+	ReportedDividendYield *float64 `form:"reported_dividend_yield,omitempty" json:"reported_dividend_yield,omitempty" xml:"reported_dividend_yield,omitempty"`
+	// 株式益回り (予想, %)
+	EarningsYield *float64 `form:"earnings_yield,omitempty" json:"earnings_yield,omitempty" xml:"earnings_yield,omitempty"`
+	// 配当権利落日 (本決算, YYYYMMDD)
+	ExDividendDate *string `form:"ex_dividend_date,omitempty" json:"ex_dividend_date,omitempty" xml:"ex_dividend_date,omitempty"`
+	// 中間配当権利落日 (YYYYMMDD)
+	InterimExDividendDate *string `form:"interim_ex_dividend_date,omitempty" json:"interim_ex_dividend_date,omitempty" xml:"interim_ex_dividend_date,omitempty"`
+	// 最終落日 (決算期以外, YYYYMMDD)
+	LastExRightsDate *string `form:"last_ex_rights_date,omitempty" json:"last_ex_rights_date,omitempty" xml:"last_ex_rights_date,omitempty"`
+	// 年初来高値
+	YearHigh *float64 `form:"year_high,omitempty" json:"year_high,omitempty" xml:"year_high,omitempty"`
+	// 年初来高値の更新日 (YYYYMMDD)
+	YearHighDate *string `form:"year_high_date,omitempty" json:"year_high_date,omitempty" xml:"year_high_date,omitempty"`
+	// 年初来安値
+	YearLow *float64 `form:"year_low,omitempty" json:"year_low,omitempty" xml:"year_low,omitempty"`
+	// 年初来安値の更新日 (YYYYMMDD)
+	YearLowDate *string `form:"year_low_date,omitempty" json:"year_low_date,omitempty" xml:"year_low_date,omitempty"`
+	// 指標の算出に使用した現在値 (取得できない場合は省略)
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// 現在値による PER
+	Per *float64 `form:"per,omitempty" json:"per,omitempty" xml:"per,omitempty"`
+	// 現在値による PBR
+	Pbr *float64 `form:"pbr,omitempty" json:"pbr,omitempty" xml:"pbr,omitempty"`
+	// 現在値による配当利回り (%)
+	DividendYield *float64 `form:"dividend_yield,omitempty" json:"dividend_yield,omitempty" xml:"dividend_yield,omitempty"`
+}
+
+// ListStocksResponseBody is the type of the "master" service "list_stocks"
+// endpoint HTTP response body.
+type ListStocksResponseBody struct {
+	// 銘柄マスタのリスト
+	Stocks []*StockbotStockMasterResponseBody `form:"stocks,omitempty" json:"stocks,omitempty" xml:"stocks,omitempty"`
+	// 検索条件に一致する銘柄の総数
+	Total *int64 `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// 取得開始位置
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty" xml:"offset,omitempty"`
+	// 取得件数
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty" xml:"limit,omitempty"`
+}
+
+// ListIndustriesResponseBody is the type of the "master" service
+// "list_industries" endpoint HTTP response body.
+type ListIndustriesResponseBody struct {
+	// 業種のリスト
+	Industries []*IndustryResultResponseBody `form:"industries,omitempty" json:"industries,omitempty" xml:"industries,omitempty"`
+}
+
+// UpdateResponseBody is the type of the "master" service "update" endpoint
+// HTTP response body.
+type UpdateResponseBody struct {
+	// 同期の実行履歴ID
+	RunID *uint `form:"run_id,omitempty" json:"run_id,omitempty" xml:"run_id,omitempty"`
+	// 同期範囲 (watched, full)
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// 銘柄マスタ
+	Stocks *MasterSyncCountsResponseBody `form:"stocks,omitempty" json:"stocks,omitempty" xml:"stocks,omitempty"`
+	// 株式銘柄市場マスタ
+	StockMarkets *MasterSyncCountsResponseBody `form:"stock_markets,omitempty" json:"stock_markets,omitempty" xml:"stock_markets,omitempty"`
+	// 呼値
+	TickRules *MasterSyncCountsResponseBody `form:"tick_rules,omitempty" json:"tick_rules,omitempty" xml:"tick_rules,omitempty"`
+	// 保証金マスタ
+	MarginMasters *MasterSyncCountsResponseBody `form:"margin_masters,omitempty" json:"margin_masters,omitempty" xml:"margin_masters,omitempty"`
+	// 銘柄別・市場別規制
+	Regulations *MasterSyncCountsResponseBody `form:"regulations,omitempty" json:"regulations,omitempty" xml:"regulations,omitempty"`
+	// 運用ステータス
+	OperationStatuses *MasterSyncCountsResponseBody `form:"operation_statuses,omitempty" json:"operation_statuses,omitempty" xml:"operation_statuses,omitempty"`
+}
+
+// ListSyncRunsResponseBody is the type of the "master" service
+// "list_sync_runs" endpoint HTTP response body.
+type ListSyncRunsResponseBody struct {
+	// 同期の実行履歴のリスト
+	Runs []*MasterSyncRunResponseBody `form:"runs,omitempty" json:"runs,omitempty" xml:"runs,omitempty"`
+}
+
+// GetFundamentalsNotFoundResponseBody is the type of the "master" service
+// "get_fundamentals" endpoint HTTP response body for the "not_found" error.
+type GetFundamentalsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// StockbotStockMasterResponseBody is used to define fields on response body
+// types.
+type StockbotStockMasterResponseBody struct {
+	// 銘柄コード
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// 銘柄名
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// 銘柄名（カナ）
+	NameKana *string `form:"name_kana,omitempty" json:"name_kana,omitempty" xml:"name_kana,omitempty"`
+	// 優先市場
+	Market *string `form:"market,omitempty" json:"market,omitempty" xml:"market,omitempty"`
+	// 業種コード
+	IndustryCode *string `form:"industry_code,omitempty" json:"industry_code,omitempty" xml:"industry_code,omitempty"`
+	// 業種コード名
+	IndustryName *string `form:"industry_name,omitempty" json:"industry_name,omitempty" xml:"industry_name,omitempty"`
+	// 値幅上限 (ストップ高)
+	UpperLimit *float64 `form:"upper_limit,omitempty" json:"upper_limit,omitempty" xml:"upper_limit,omitempty"`
+	// 値幅下限 (ストップ安)
+	LowerLimit *float64 `form:"lower_limit,omitempty" json:"lower_limit,omitempty" xml:"lower_limit,omitempty"`
+	// 売買単位
+	TradingUnit *int `form:"trading_unit,omitempty" json:"trading_unit,omitempty" xml:"trading_unit,omitempty"`
+}
+
+// IndustryResultResponseBody is used to define fields on response body types.
+type IndustryResultResponseBody struct {
+	// 業種コード
+	IndustryCode *string `form:"industry_code,omitempty" json:"industry_code,omitempty" xml:"industry_code,omitempty"`
+	// 業種コード名
+	IndustryName *string `form:"industry_name,omitempty" json:"industry_name,omitempty" xml:"industry_name,omitempty"`
+	// 銘柄数
+	Count *int64 `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+}
+
+// MasterSyncCountsResponseBody is used to define fields on response body types.
+type MasterSyncCountsResponseBody struct {
+	// 新規に追加した件数
+	Inserted *int `form:"inserted,omitempty" json:"inserted,omitempty" xml:"inserted,omitempty"`
+	// 更新した件数
+	Updated *int `form:"updated,omitempty" json:"updated,omitempty" xml:"updated,omitempty"`
+	// 変更がなかった件数
+	Unchanged *int `form:"unchanged,omitempty" json:"unchanged,omitempty" xml:"unchanged,omitempty"`
+	// 論理削除した件数
+	Deleted *int `form:"deleted,omitempty" json:"deleted,omitempty" xml:"deleted,omitempty"`
+}
+
+// MasterSyncRunResponseBody is used to define fields on response body types.
+type MasterSyncRunResponseBody struct {
+	// 同期の実行履歴ID
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// 同期の契機 (startup, scheduled, manual)
+	Trigger *string `form:"trigger,omitempty" json:"trigger,omitempty" xml:"trigger,omitempty"`
+	// 同期の状態 (running, succeeded, failed)
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// 開始日時 (RFC3339)
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// 終了日時 (RFC3339, 実行中は省略)
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// 失敗した場合のエラー内容
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// 反映した件数 (失敗した場合は失敗するまでに反映した件数)
+	Summary *StockbotMasterSyncSummaryResponseBody `form:"summary,omitempty" json:"summary,omitempty" xml:"summary,omitempty"`
+}
+
+// StockbotMasterSyncSummaryResponseBody is used to define fields on response
+// body types.
+type StockbotMasterSyncSummaryResponseBody struct {
+	// 同期の実行履歴ID
+	RunID *uint `form:"run_id,omitempty" json:"run_id,omitempty" xml:"run_id,omitempty"`
+	// 同期範囲 (watched, full)
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// 銘柄マスタ
+	Stocks *MasterSyncCountsResponseBody `form:"stocks,omitempty" json:"stocks,omitempty" xml:"stocks,omitempty"`
+	// 株式銘柄市場マスタ
+	StockMarkets *MasterSyncCountsResponseBody `form:"stock_markets,omitempty" json:"stock_markets,omitempty" xml:"stock_markets,omitempty"`
+	// 呼値
+	TickRules *MasterSyncCountsResponseBody `form:"tick_rules,omitempty" json:"tick_rules,omitempty" xml:"tick_rules,omitempty"`
+	// 保証金マスタ
+	MarginMasters *MasterSyncCountsResponseBody `form:"margin_masters,omitempty" json:"margin_masters,omitempty" xml:"margin_masters,omitempty"`
+	// 銘柄別・市場別規制
+	Regulations *MasterSyncCountsResponseBody `form:"regulations,omitempty" json:"regulations,omitempty" xml:"regulations,omitempty"`
+	// 運用ステータス
+	OperationStatuses *MasterSyncCountsResponseBody `form:"operation_statuses,omitempty" json:"operation_statuses,omitempty" xml:"operation_statuses,omitempty"`
 }
 
 // NewGetStockStockbotStockMasterOK builds a "master" service "get_stock"
@@ -38,7 +245,283 @@ func NewGetStockStockbotStockMasterOK(body *GetStockResponseBody) *masterviews.S
 		Market:       body.Market,
 		IndustryCode: body.IndustryCode,
 		IndustryName: body.IndustryName,
+		UpperLimit:   body.UpperLimit,
+		LowerLimit:   body.LowerLimit,
+		TradingUnit:  body.TradingUnit,
+	}
+
+	return v
+}
+
+// NewGetFundamentalsStockbotStockFundamentalsOK builds a "master" service
+// "get_fundamentals" endpoint result from a HTTP "OK" response.
+func NewGetFundamentalsStockbotStockFundamentalsOK(body *GetFundamentalsResponseBody) *masterviews.StockbotStockFundamentalsView {
+	v := &masterviews.StockbotStockFundamentalsView{
+		Symbol:                body.Symbol,
+		SnapshotDate:          body.SnapshotDate,
+		FetchedAt:             body.FetchedAt,
+		Bps:                   body.Bps,
+		Eps:                   body.Eps,
+		Roe:                   body.Roe,
+		DividendPerShare:      body.DividendPerShare,
+		ReportedPer:           body.ReportedPer,
+		ReportedPbr:           body.ReportedPbr,
+		ReportedDividendYield: body.ReportedDividendYield,
+		EarningsYield:         body.EarningsYield,
+		ExDividendDate:        body.ExDividendDate,
+		InterimExDividendDate: body.InterimExDividendDate,
+		LastExRightsDate:      body.LastExRightsDate,
+		YearHigh:              body.YearHigh,
+		YearHighDate:          body.YearHighDate,
+		YearLow:               body.YearLow,
+		YearLowDate:           body.YearLowDate,
+		Price:                 body.Price,
+		Per:                   body.Per,
+		Pbr:                   body.Pbr,
+		DividendYield:         body.DividendYield,
+	}
+
+	return v
+}
+
+// NewGetFundamentalsNotFound builds a master service get_fundamentals endpoint
+// not_found error.
+func NewGetFundamentalsNotFound(body *GetFundamentalsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListStocksStockbotStockMasterPageOK builds a "master" service
+// "list_stocks" endpoint result from a HTTP "OK" response.
+func NewListStocksStockbotStockMasterPageOK(body *ListStocksResponseBody) *masterviews.StockbotStockMasterPageView {
+	v := &masterviews.StockbotStockMasterPageView{
+		Total:  body.Total,
+		Offset: body.Offset,
+		Limit:  body.Limit,
+	}
+	v.Stocks = make([]*masterviews.StockbotStockMasterView, len(body.Stocks))
+	for i, val := range body.Stocks {
+		if val == nil {
+			v.Stocks[i] = nil
+			continue
+		}
+		v.Stocks[i] = unmarshalStockbotStockMasterResponseBodyToMasterviewsStockbotStockMasterView(val)
+	}
+
+	return v
+}
+
+// NewListIndustriesStockbotIndustryCollectionOK builds a "master" service
+// "list_industries" endpoint result from a HTTP "OK" response.
+func NewListIndustriesStockbotIndustryCollectionOK(body *ListIndustriesResponseBody) *masterviews.StockbotIndustryCollectionView {
+	v := &masterviews.StockbotIndustryCollectionView{}
+	v.Industries = make([]*masterviews.IndustryResultView, len(body.Industries))
+	for i, val := range body.Industries {
+		if val == nil {
+			v.Industries[i] = nil
+			continue
+		}
+		v.Industries[i] = unmarshalIndustryResultResponseBodyToMasterviewsIndustryResultView(val)
+	}
+
+	return v
+}
+
+// NewUpdateStockbotMasterSyncSummaryAccepted builds a "master" service
+// "update" endpoint result from a HTTP "Accepted" response.
+func NewUpdateStockbotMasterSyncSummaryAccepted(body *UpdateResponseBody) *masterviews.StockbotMasterSyncSummaryView {
+	v := &masterviews.StockbotMasterSyncSummaryView{
+		RunID: body.RunID,
+		Scope: body.Scope,
 	}
+	v.Stocks = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(body.Stocks)
+	v.StockMarkets = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(body.StockMarkets)
+	v.TickRules = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(body.TickRules)
+	v.MarginMasters = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(body.MarginMasters)
+	v.Regulations = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(body.Regulations)
+	v.OperationStatuses = unmarshalMasterSyncCountsResponseBodyToMasterviewsMasterSyncCountsView(body.OperationStatuses)
 
 	return v
 }
+
+// NewListSyncRunsStockbotMasterSyncRunCollectionOK builds a "master" service
+// "list_sync_runs" endpoint result from a HTTP "OK" response.
+func NewListSyncRunsStockbotMasterSyncRunCollectionOK(body *ListSyncRunsResponseBody) *masterviews.StockbotMasterSyncRunCollectionView {
+	v := &masterviews.StockbotMasterSyncRunCollectionView{}
+	v.Runs = make([]*masterviews.MasterSyncRunView, len(body.Runs))
+	for i, val := range body.Runs {
+		if val == nil {
+			v.Runs[i] = nil
+			continue
+		}
+		v.Runs[i] = unmarshalMasterSyncRunResponseBodyToMasterviewsMasterSyncRunView(val)
+	}
+
+	return v
+}
+
+// ValidateGetFundamentalsNotFoundResponseBody runs the validations defined on
+// get_fundamentals_not_found_response_body
+func ValidateGetFundamentalsNotFoundResponseBody(body *GetFundamentalsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateStockbotStockMasterResponseBody runs the validations defined on
+// StockbotStock-MasterResponseBody
+func ValidateStockbotStockMasterResponseBody(body *StockbotStockMasterResponseBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Market == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market", "body"))
+	}
+	return
+}
+
+// ValidateIndustryResultResponseBody runs the validations defined on
+// IndustryResultResponseBody
+func ValidateIndustryResultResponseBody(body *IndustryResultResponseBody) (err error) {
+	if body.IndustryCode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("industry_code", "body"))
+	}
+	if body.IndustryName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("industry_name", "body"))
+	}
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	return
+}
+
+// ValidateMasterSyncCountsResponseBody runs the validations defined on
+// MasterSyncCountsResponseBody
+func ValidateMasterSyncCountsResponseBody(body *MasterSyncCountsResponseBody) (err error) {
+	if body.Inserted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("inserted", "body"))
+	}
+	if body.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "body"))
+	}
+	if body.Unchanged == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unchanged", "body"))
+	}
+	if body.Deleted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deleted", "body"))
+	}
+	return
+}
+
+// ValidateMasterSyncRunResponseBody runs the validations defined on
+// MasterSyncRunResponseBody
+func ValidateMasterSyncRunResponseBody(body *MasterSyncRunResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Trigger == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trigger", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.Summary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("summary", "body"))
+	}
+	if body.Summary != nil {
+		if err2 := ValidateStockbotMasterSyncSummaryResponseBody(body.Summary); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateStockbotMasterSyncSummaryResponseBody runs the validations defined
+// on StockbotMaster-Sync-SummaryResponseBody
+func ValidateStockbotMasterSyncSummaryResponseBody(body *StockbotMasterSyncSummaryResponseBody) (err error) {
+	if body.RunID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("run_id", "body"))
+	}
+	if body.Scope == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scope", "body"))
+	}
+	if body.Stocks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stocks", "body"))
+	}
+	if body.StockMarkets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stock_markets", "body"))
+	}
+	if body.TickRules == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tick_rules", "body"))
+	}
+	if body.MarginMasters == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_masters", "body"))
+	}
+	if body.Regulations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("regulations", "body"))
+	}
+	if body.OperationStatuses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operation_statuses", "body"))
+	}
+	if body.Stocks != nil {
+		if err2 := ValidateMasterSyncCountsResponseBody(body.Stocks); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.StockMarkets != nil {
+		if err2 := ValidateMasterSyncCountsResponseBody(body.StockMarkets); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.TickRules != nil {
+		if err2 := ValidateMasterSyncCountsResponseBody(body.TickRules); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.MarginMasters != nil {
+		if err2 := ValidateMasterSyncCountsResponseBody(body.MarginMasters); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Regulations != nil {
+		if err2 := ValidateMasterSyncCountsResponseBody(body.Regulations); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.OperationStatuses != nil {
+		if err2 := ValidateMasterSyncCountsResponseBody(body.OperationStatuses); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
@@ -9,11 +9,14 @@ package server
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	master "stock-bot/gen/master"
 	masterviews "stock-bot/gen/master/views"
+	"strconv"
 
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // EncodeGetStockResponse returns an encoder for responses returned by the
@@ -44,11 +47,319 @@ func DecodeGetStockRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 	}
 }
 
+// EncodeGetFundamentalsResponse returns an encoder for responses returned by
+// the master get_fundamentals endpoint.
+func EncodeGetFundamentalsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*masterviews.StockbotStockFundamentals)
+		enc := encoder(ctx, w)
+		body := NewGetFundamentalsResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetFundamentalsRequest returns a decoder for requests sent to the
+// master get_fundamentals endpoint.
+func DecodeGetFundamentalsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*master.GetFundamentalsPayload, error) {
+	return func(r *http.Request) (*master.GetFundamentalsPayload, error) {
+		var (
+			symbol string
+
+			params = mux.Vars(r)
+		)
+		symbol = params["symbol"]
+		payload := NewGetFundamentalsPayload(symbol)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetFundamentalsError returns an encoder for errors returned by the
+// get_fundamentals master endpoint.
+func EncodeGetFundamentalsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetFundamentalsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListStocksResponse returns an encoder for responses returned by the
+// master list_stocks endpoint.
+func EncodeListStocksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*masterviews.StockbotStockMasterPage)
+		enc := encoder(ctx, w)
+		body := NewListStocksResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListStocksRequest returns a decoder for requests sent to the master
+// list_stocks endpoint.
+func DecodeListStocksRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*master.ListStocksPayload, error) {
+	return func(r *http.Request) (*master.ListStocksPayload, error) {
+		var (
+			market       *string
+			industryCode *string
+			q            *string
+			tradingUnit  *int
+			offset       int
+			limit        int
+			err          error
+		)
+		qp := r.URL.Query()
+		marketRaw := qp.Get("market")
+		if marketRaw != "" {
+			market = &marketRaw
+		}
+		industryCodeRaw := qp.Get("industry_code")
+		if industryCodeRaw != "" {
+			industryCode = &industryCodeRaw
+		}
+		qRaw := qp.Get("q")
+		if qRaw != "" {
+			q = &qRaw
+		}
+		{
+			tradingUnitRaw := qp.Get("trading_unit")
+			if tradingUnitRaw != "" {
+				v, err2 := strconv.ParseInt(tradingUnitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("trading_unit", tradingUnitRaw, "integer"))
+				}
+				pv := int(v)
+				tradingUnit = &pv
+			}
+		}
+		if tradingUnit != nil {
+			if *tradingUnit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("trading_unit", *tradingUnit, 1, true))
+			}
+		}
+		{
+			offsetRaw := qp.Get("offset")
+			if offsetRaw != "" {
+				v, err2 := strconv.ParseInt(offsetRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("offset", offsetRaw, "integer"))
+				}
+				offset = int(v)
+			}
+		}
+		if offset < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 100
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListStocksPayload(market, industryCode, q, tradingUnit, offset, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeListIndustriesResponse returns an encoder for responses returned by
+// the master list_industries endpoint.
+func EncodeListIndustriesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*masterviews.StockbotIndustryCollection)
+		enc := encoder(ctx, w)
+		body := NewListIndustriesResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
 // EncodeUpdateResponse returns an encoder for responses returned by the master
 // update endpoint.
 func EncodeUpdateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*masterviews.StockbotMasterSyncSummary)
+		enc := encoder(ctx, w)
+		body := NewUpdateResponseBody(res.Projected)
 		w.WriteHeader(http.StatusAccepted)
-		return nil
+		return enc.Encode(body)
+	}
+}
+
+// EncodeListSyncRunsResponse returns an encoder for responses returned by the
+// master list_sync_runs endpoint.
+func EncodeListSyncRunsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*masterviews.StockbotMasterSyncRunCollection)
+		enc := encoder(ctx, w)
+		body := NewListSyncRunsResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListSyncRunsRequest returns a decoder for requests sent to the master
+// list_sync_runs endpoint.
+func DecodeListSyncRunsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*master.ListSyncRunsPayload, error) {
+	return func(r *http.Request) (*master.ListSyncRunsPayload, error) {
+		var (
+			limit int
+			err   error
+		)
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw == "" {
+				limit = 20
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListSyncRunsPayload(limit)
+
+		return payload, nil
+	}
+}
+
+// marshalMasterviewsStockbotStockMasterViewToStockbotStockMasterResponseBody
+// builds a value of type *StockbotStockMasterResponseBody from a value of type
+// *masterviews.StockbotStockMasterView.
+func marshalMasterviewsStockbotStockMasterViewToStockbotStockMasterResponseBody(v *masterviews.StockbotStockMasterView) *StockbotStockMasterResponseBody {
+	res := &StockbotStockMasterResponseBody{
+		Symbol:       *v.Symbol,
+		Name:         *v.Name,
+		NameKana:     v.NameKana,
+		Market:       *v.Market,
+		IndustryCode: v.IndustryCode,
+		IndustryName: v.IndustryName,
+		UpperLimit:   v.UpperLimit,
+		LowerLimit:   v.LowerLimit,
+		TradingUnit:  v.TradingUnit,
+	}
+
+	return res
+}
+
+// marshalMasterviewsIndustryResultViewToIndustryResultResponseBody builds a
+// value of type *IndustryResultResponseBody from a value of type
+// *masterviews.IndustryResultView.
+func marshalMasterviewsIndustryResultViewToIndustryResultResponseBody(v *masterviews.IndustryResultView) *IndustryResultResponseBody {
+	res := &IndustryResultResponseBody{
+		IndustryCode: *v.IndustryCode,
+		IndustryName: *v.IndustryName,
+		Count:        *v.Count,
+	}
+
+	return res
+}
+
+// marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody builds
+// a value of type *MasterSyncCountsResponseBody from a value of type
+// *masterviews.MasterSyncCountsView.
+func marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(v *masterviews.MasterSyncCountsView) *MasterSyncCountsResponseBody {
+	res := &MasterSyncCountsResponseBody{
+		Inserted:  *v.Inserted,
+		Updated:   *v.Updated,
+		Unchanged: *v.Unchanged,
+		Deleted:   *v.Deleted,
 	}
+
+	return res
+}
+
+// marshalMasterviewsMasterSyncRunViewToMasterSyncRunResponseBody builds a
+// value of type *MasterSyncRunResponseBody from a value of type
+// *masterviews.MasterSyncRunView.
+func marshalMasterviewsMasterSyncRunViewToMasterSyncRunResponseBody(v *masterviews.MasterSyncRunView) *MasterSyncRunResponseBody {
+	res := &MasterSyncRunResponseBody{
+		ID:         *v.ID,
+		Trigger:    *v.Trigger,
+		Status:     *v.Status,
+		StartedAt:  *v.StartedAt,
+		FinishedAt: v.FinishedAt,
+		Error:      v.Error,
+	}
+	if v.Summary != nil {
+		res.Summary = marshalMasterviewsStockbotMasterSyncSummaryViewToStockbotMasterSyncSummaryResponseBody(v.Summary)
+	}
+
+	return res
+}
+
+// marshalMasterviewsStockbotMasterSyncSummaryViewToStockbotMasterSyncSummaryResponseBody
+// builds a value of type *StockbotMasterSyncSummaryResponseBody from a value
+// of type *masterviews.StockbotMasterSyncSummaryView.
+func marshalMasterviewsStockbotMasterSyncSummaryViewToStockbotMasterSyncSummaryResponseBody(v *masterviews.StockbotMasterSyncSummaryView) *StockbotMasterSyncSummaryResponseBody {
+	res := &StockbotMasterSyncSummaryResponseBody{
+		RunID: *v.RunID,
+		Scope: *v.Scope,
+	}
+	if v.Stocks != nil {
+		res.Stocks = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(v.Stocks)
+	}
+	if v.StockMarkets != nil {
+		res.StockMarkets = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(v.StockMarkets)
+	}
+	if v.TickRules != nil {
+		res.TickRules = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(v.TickRules)
+	}
+	if v.MarginMasters != nil {
+		res.MarginMasters = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(v.MarginMasters)
+	}
+	if v.Regulations != nil {
+		res.Regulations = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(v.Regulations)
+	}
+	if v.OperationStatuses != nil {
+		res.OperationStatuses = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(v.OperationStatuses)
+	}
+
+	return res
 }
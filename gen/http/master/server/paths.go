@@ -16,7 +16,27 @@ func GetStockMasterPath(symbol string) string {
 	return fmt.Sprintf("/master/stocks/%v", symbol)
 }
 
+// GetFundamentalsMasterPath returns the URL path to the master service get_fundamentals HTTP endpoint.
+func GetFundamentalsMasterPath(symbol string) string {
+	return fmt.Sprintf("/master/stocks/%v/fundamentals", symbol)
+}
+
+// ListStocksMasterPath returns the URL path to the master service list_stocks HTTP endpoint.
+func ListStocksMasterPath() string {
+	return "/master/stocks"
+}
+
+// ListIndustriesMasterPath returns the URL path to the master service list_industries HTTP endpoint.
+func ListIndustriesMasterPath() string {
+	return "/master/industries"
+}
+
 // UpdateMasterPath returns the URL path to the master service update HTTP endpoint.
 func UpdateMasterPath() string {
 	return "/master/update"
 }
+
+// ListSyncRunsMasterPath returns the URL path to the master service list_sync_runs HTTP endpoint.
+func ListSyncRunsMasterPath() string {
+	return "/master/sync-runs"
+}
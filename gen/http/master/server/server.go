@@ -18,9 +18,13 @@ import (
 
 // Server lists the master service endpoint HTTP handlers.
 type Server struct {
-	Mounts   []*MountPoint
-	GetStock http.Handler
-	Update   http.Handler
+	Mounts          []*MountPoint
+	GetStock        http.Handler
+	GetFundamentals http.Handler
+	ListStocks      http.Handler
+	ListIndustries  http.Handler
+	Update          http.Handler
+	ListSyncRuns    http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -51,10 +55,18 @@ func New(
 	return &Server{
 		Mounts: []*MountPoint{
 			{"GetStock", "GET", "/master/stocks/{symbol}"},
+			{"GetFundamentals", "GET", "/master/stocks/{symbol}/fundamentals"},
+			{"ListStocks", "GET", "/master/stocks"},
+			{"ListIndustries", "GET", "/master/industries"},
 			{"Update", "POST", "/master/update"},
+			{"ListSyncRuns", "GET", "/master/sync-runs"},
 		},
-		GetStock: NewGetStockHandler(e.GetStock, mux, decoder, encoder, errhandler, formatter),
-		Update:   NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
+		GetStock:        NewGetStockHandler(e.GetStock, mux, decoder, encoder, errhandler, formatter),
+		GetFundamentals: NewGetFundamentalsHandler(e.GetFundamentals, mux, decoder, encoder, errhandler, formatter),
+		ListStocks:      NewListStocksHandler(e.ListStocks, mux, decoder, encoder, errhandler, formatter),
+		ListIndustries:  NewListIndustriesHandler(e.ListIndustries, mux, decoder, encoder, errhandler, formatter),
+		Update:          NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
+		ListSyncRuns:    NewListSyncRunsHandler(e.ListSyncRuns, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -64,7 +76,11 @@ func (s *Server) Service() string { return "master" }
 // Use wraps the server handlers with the given middleware.
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.GetStock = m(s.GetStock)
+	s.GetFundamentals = m(s.GetFundamentals)
+	s.ListStocks = m(s.ListStocks)
+	s.ListIndustries = m(s.ListIndustries)
 	s.Update = m(s.Update)
+	s.ListSyncRuns = m(s.ListSyncRuns)
 }
 
 // MethodNames returns the methods served.
@@ -73,7 +89,11 @@ func (s *Server) MethodNames() []string { return master.MethodNames[:] }
 // Mount configures the mux to serve the master endpoints.
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetStockHandler(mux, h.GetStock)
+	MountGetFundamentalsHandler(mux, h.GetFundamentals)
+	MountListStocksHandler(mux, h.ListStocks)
+	MountListIndustriesHandler(mux, h.ListIndustries)
 	MountUpdateHandler(mux, h.Update)
+	MountListSyncRunsHandler(mux, h.ListSyncRuns)
 }
 
 // Mount configures the mux to serve the master endpoints.
@@ -134,6 +154,158 @@ func NewGetStockHandler(
 	})
 }
 
+// MountGetFundamentalsHandler configures the mux to serve the "master" service
+// "get_fundamentals" endpoint.
+func MountGetFundamentalsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/master/stocks/{symbol}/fundamentals", f)
+}
+
+// NewGetFundamentalsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "master" service "get_fundamentals" endpoint.
+func NewGetFundamentalsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetFundamentalsRequest(mux, decoder)
+		encodeResponse = EncodeGetFundamentalsResponse(encoder)
+		encodeError    = EncodeGetFundamentalsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_fundamentals")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "master")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListStocksHandler configures the mux to serve the "master" service
+// "list_stocks" endpoint.
+func MountListStocksHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/master/stocks", f)
+}
+
+// NewListStocksHandler creates a HTTP handler which loads the HTTP request and
+// calls the "master" service "list_stocks" endpoint.
+func NewListStocksHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListStocksRequest(mux, decoder)
+		encodeResponse = EncodeListStocksResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_stocks")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "master")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListIndustriesHandler configures the mux to serve the "master" service
+// "list_industries" endpoint.
+func MountListIndustriesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/master/industries", f)
+}
+
+// NewListIndustriesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "master" service "list_industries" endpoint.
+func NewListIndustriesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeListIndustriesResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_industries")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "master")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountUpdateHandler configures the mux to serve the "master" service "update"
 // endpoint.
 func MountUpdateHandler(mux goahttp.Muxer, h http.Handler) {
@@ -179,3 +351,56 @@ func NewUpdateHandler(
 		}
 	})
 }
+
+// MountListSyncRunsHandler configures the mux to serve the "master" service
+// "list_sync_runs" endpoint.
+func MountListSyncRunsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/master/sync-runs", f)
+}
+
+// NewListSyncRunsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "master" service "list_sync_runs" endpoint.
+func NewListSyncRunsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListSyncRunsRequest(mux, decoder)
+		encodeResponse = EncodeListSyncRunsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_sync_runs")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "master")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
@@ -10,6 +10,8 @@ package server
 import (
 	master "stock-bot/gen/master"
 	masterviews "stock-bot/gen/master/views"
+
+	goa "goa.design/goa/v3/pkg"
 )
 
 // GetStockResponseBody is the type of the "master" service "get_stock"
@@ -27,6 +29,211 @@ type GetStockResponseBody struct {
 	IndustryCode *string `form:"industry_code,omitempty" json:"industry_code,omitempty" xml:"industry_code,omitempty"`
 	// 業種コード名
 	IndustryName *string `form:"industry_name,omitempty" json:"industry_name,omitempty" xml:"industry_name,omitempty"`
+	// 値幅上限 (ストップ高)
+	UpperLimit *float64 `form:"upper_limit,omitempty" json:"upper_limit,omitempty" xml:"upper_limit,omitempty"`
+	// 値幅下限 (ストップ安)
+	LowerLimit *float64 `form:"lower_limit,omitempty" json:"lower_limit,omitempty" xml:"lower_limit,omitempty"`
+	// 売買単位
+	TradingUnit *int `form:"trading_unit,omitempty" json:"trading_unit,omitempty" xml:"trading_unit,omitempty"`
+}
+
+// GetFundamentalsResponseBody is the type of the "master" service
+// "get_fundamentals" endpoint HTTP response body.
+type GetFundamentalsResponseBody struct {
+	// 銘柄コード
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// スナップショットの日付 (YYYYMMDD)
+	SnapshotDate string `form:"snapshot_date" json:"snapshot_date" xml:"snapshot_date"`
+	// スナップショットを取得した日時 (RFC3339)
+	FetchedAt string `form:"fetched_at" json:"fetched_at" xml:"fetched_at"`
+	// 一株資産 (実績・連結)
+	Bps *float64 `form:"bps,omitempty" json:"bps,omitempty" xml:"bps,omitempty"`
+	// 一株利益 (予想・通期連結)
+	Eps *float64 `form:"eps,omitempty" json:"eps,omitempty" xml:"eps,omitempty"`
+	// ROE (予想, %)
+	Roe *float64 `form:"roe,omitempty" json:"roe,omitempty" xml:"roe,omitempty"`
+	// 一株配当 (予想, 配当利回りから算出)
+	DividendPerShare *float64 `form:"dividend_per_share,omitempty" json:"dividend_per_share,omitempty" xml:"dividend_per_share,omitempty"`
+	// PER (予想, 取得時点)
+	ReportedPer *float64 `form:"reported_per,omitempty" json:"reported_per,omitempty" xml:"reported_per,omitempty"`
+	// PBR (実績, 取得時点)
+	ReportedPbr *float64 `form:"reported_pbr,omitempty" json:"reported_pbr,omitempty" xml:"reported_pbr,omitempty"`
+	// 配当利回り (予想, %, 取得時点)
+	ReportedDividendYield *float64 `form:"reported_dividend_yield,omitempty" json:"reported_dividend_yield,omitempty" xml:"reported_dividend_yield,omitempty"`
+	// 株式益回り (予想, %)
+	EarningsYield *float64 `form:"earnings_yield,omitempty" json:"earnings_yield,omitempty" xml:"earnings_yield,omitempty"`
+	// 配当権利落日 (本決算, YYYYMMDD)
+	ExDividendDate *string `form:"ex_dividend_date,omitempty" json:"ex_dividend_date,omitempty" xml:"ex_dividend_date,omitempty"`
+	// 中間配当権利落日 (YYYYMMDD)
+	InterimExDividendDate *string `form:"interim_ex_dividend_date,omitempty" json:"interim_ex_dividend_date,omitempty" xml:"interim_ex_dividend_date,omitempty"`
+	// 最終落日 (決算期以外, YYYYMMDD)
+	LastExRightsDate *string `form:"last_ex_rights_date,omitempty" json:"last_ex_rights_date,omitempty" xml:"last_ex_rights_date,omitempty"`
+	// 年初来高値
+	YearHigh *float64 `form:"year_high,omitempty" json:"year_high,omitempty" xml:"year_high,omitempty"`
+	// 年初来高値の更新日 (YYYYMMDD)
+	YearHighDate *string `form:"year_high_date,omitempty" json:"year_high_date,omitempty" xml:"year_high_date,omitempty"`
+	// 年初来安値
+	YearLow *float64 `form:"year_low,omitempty" json:"year_low,omitempty" xml:"year_low,omitempty"`
+	// 年初来安値の更新日 (YYYYMMDD)
+	YearLowDate *string `form:"year_low_date,omitempty" json:"year_low_date,omitempty" xml:"year_low_date,omitempty"`
+	// 指標の算出に使用した現在値 (取得できない場合は省略)
+	Price *float64 `form:"price,omitempty" json:"price,omitempty" xml:"price,omitempty"`
+	// 現在値による PER
+	Per *float64 `form:"per,omitempty" json:"per,omitempty" xml:"per,omitempty"`
+	// 現在値による PBR
+	Pbr *float64 `form:"pbr,omitempty" json:"pbr,omitempty" xml:"pbr,omitempty"`
+	// 現在値による配当利回り (%)
+	DividendYield *float64 `form:"dividend_yield,omitempty" json:"dividend_yield,omitempty" xml:"dividend_yield,omitempty"`
+}
+
+// ListStocksResponseBody is the type of the "master" service "list_stocks"
+// endpoint HTTP response body.
+type ListStocksResponseBody struct {
+	// 銘柄マスタのリスト
+	Stocks []*StockbotStockMasterResponseBody `form:"stocks" json:"stocks" xml:"stocks"`
+	// 検索条件に一致する銘柄の総数
+	Total int64 `form:"total" json:"total" xml:"total"`
+	// 取得開始位置
+	Offset int `form:"offset" json:"offset" xml:"offset"`
+	// 取得件数
+	Limit int `form:"limit" json:"limit" xml:"limit"`
+}
+
+// ListIndustriesResponseBody is the type of the "master" service
+// "list_industries" endpoint HTTP response body.
+type ListIndustriesResponseBody struct {
+	// 業種のリスト
+	Industries []*IndustryResultResponseBody `form:"industries" json:"industries" xml:"industries"`
+}
+
+// UpdateResponseBody is the type of the "master" service "update" endpoint
+// HTTP response body.
+type UpdateResponseBody struct {
+	// 同期の実行履歴ID
+	RunID uint `form:"run_id" json:"run_id" xml:"run_id"`
+	// 同期範囲 (watched, full)
+	Scope string `form:"scope" json:"scope" xml:"scope"`
+	// 銘柄マスタ
+	Stocks *MasterSyncCountsResponseBody `form:"stocks" json:"stocks" xml:"stocks"`
+	// 銘柄マスタ
+	StockMarkets *MasterSyncCountsResponseBody `form:"stock_markets" json:"stock_markets" xml:"stock_markets"`
+	// 銘柄マスタ
+	TickRules *MasterSyncCountsResponseBody `form:"tick_rules" json:"tick_rules" xml:"tick_rules"`
+	// 銘柄マスタ
+	MarginMasters *MasterSyncCountsResponseBody `form:"margin_masters" json:"margin_masters" xml:"margin_masters"`
+	// 銘柄マスタ
+	Regulations *MasterSyncCountsResponseBody `form:"regulations" json:"regulations" xml:"regulations"`
+	// 銘柄マスタ
+	OperationStatuses *MasterSyncCountsResponseBody `form:"operation_statuses" json:"operation_statuses" xml:"operation_statuses"`
+}
+
+// ListSyncRunsResponseBody is the type of the "master" service
+// "list_sync_runs" endpoint HTTP response body.
+type ListSyncRunsResponseBody struct {
+	// 同期の実行履歴のリスト
+	Runs []*MasterSyncRunResponseBody `form:"runs" json:"runs" xml:"runs"`
+}
+
+// GetFundamentalsNotFoundResponseBody is the type of the "master" service
+// "get_fundamentals" endpoint HTTP response body for the "not_found" error.
+type GetFundamentalsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// StockbotStockMasterResponseBody is used to define fields on response body
+// types.
+type StockbotStockMasterResponseBody struct {
+	// 銘柄コード
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// 銘柄名
+	Name string `form:"name" json:"name" xml:"name"`
+	// 銘柄名（カナ）
+	NameKana *string `form:"name_kana,omitempty" json:"name_kana,omitempty" xml:"name_kana,omitempty"`
+	// 優先市場
+	Market string `form:"market" json:"market" xml:"market"`
+	// 業種コード
+	IndustryCode *string `form:"industry_code,omitempty" json:"industry_code,omitempty" xml:"industry_code,omitempty"`
+	// 業種コード名
+	IndustryName *string `form:"industry_name,omitempty" json:"industry_name,omitempty" xml:"industry_name,omitempty"`
+	// 値幅上限 (ストップ高)
+	UpperLimit *float64 `form:"upper_limit,omitempty" json:"upper_limit,omitempty" xml:"upper_limit,omitempty"`
+	// 値幅下限 (ストップ安)
+	LowerLimit *float64 `form:"lower_limit,omitempty" json:"lower_limit,omitempty" xml:"lower_limit,omitempty"`
+	// 売買単位
+	TradingUnit *int `form:"trading_unit,omitempty" json:"trading_unit,omitempty" xml:"trading_unit,omitempty"`
+}
+
+// IndustryResultResponseBody is used to define fields on response body types.
+type IndustryResultResponseBody struct {
+	// 業種コード
+	IndustryCode string `form:"industry_code" json:"industry_code" xml:"industry_code"`
+	// 業種コード名
+	IndustryName string `form:"industry_name" json:"industry_name" xml:"industry_name"`
+	// 銘柄数
+	Count int64 `form:"count" json:"count" xml:"count"`
+}
+
+// MasterSyncCountsResponseBody is used to define fields on response body types.
+type MasterSyncCountsResponseBody struct {
+	// 新規に追加した件数
+	Inserted int `form:"inserted" json:"inserted" xml:"inserted"`
+	// 更新した件数
+	Updated int `form:"updated" json:"updated" xml:"updated"`
+	// 変更がなかった件数
+	Unchanged int `form:"unchanged" json:"unchanged" xml:"unchanged"`
+	// 論理削除した件数
+	Deleted int `form:"deleted" json:"deleted" xml:"deleted"`
+}
+
+// MasterSyncRunResponseBody is used to define fields on response body types.
+type MasterSyncRunResponseBody struct {
+	// 同期の実行履歴ID
+	ID uint `form:"id" json:"id" xml:"id"`
+	// 同期の契機 (startup, scheduled, manual)
+	Trigger string `form:"trigger" json:"trigger" xml:"trigger"`
+	// 同期の状態 (running, succeeded, failed)
+	Status string `form:"status" json:"status" xml:"status"`
+	// 開始日時 (RFC3339)
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// 終了日時 (RFC3339, 実行中は省略)
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// 失敗した場合のエラー内容
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// 反映した件数 (失敗した場合は失敗するまでに反映した件数)
+	Summary *StockbotMasterSyncSummaryResponseBody `form:"summary" json:"summary" xml:"summary"`
+}
+
+// StockbotMasterSyncSummaryResponseBody is used to define fields on response
+// body types.
+type StockbotMasterSyncSummaryResponseBody struct {
+	// 同期の実行履歴ID
+	RunID uint `form:"run_id" json:"run_id" xml:"run_id"`
+	// 同期範囲 (watched, full)
+	Scope string `form:"scope" json:"scope" xml:"scope"`
+	// 銘柄マスタ
+	Stocks *MasterSyncCountsResponseBody `form:"stocks" json:"stocks" xml:"stocks"`
+	// 株式銘柄市場マスタ
+	StockMarkets *MasterSyncCountsResponseBody `form:"stock_markets" json:"stock_markets" xml:"stock_markets"`
+	// 呼値
+	TickRules *MasterSyncCountsResponseBody `form:"tick_rules" json:"tick_rules" xml:"tick_rules"`
+	// 保証金マスタ
+	MarginMasters *MasterSyncCountsResponseBody `form:"margin_masters" json:"margin_masters" xml:"margin_masters"`
+	// 銘柄別・市場別規制
+	Regulations *MasterSyncCountsResponseBody `form:"regulations" json:"regulations" xml:"regulations"`
+	// 運用ステータス
+	OperationStatuses *MasterSyncCountsResponseBody `form:"operation_statuses" json:"operation_statuses" xml:"operation_statuses"`
 }
 
 // NewGetStockResponseBody builds the HTTP response body from the result of the
@@ -39,6 +246,142 @@ func NewGetStockResponseBody(res *masterviews.StockbotStockMasterView) *GetStock
 		Market:       *res.Market,
 		IndustryCode: res.IndustryCode,
 		IndustryName: res.IndustryName,
+		UpperLimit:   res.UpperLimit,
+		LowerLimit:   res.LowerLimit,
+		TradingUnit:  res.TradingUnit,
+	}
+	return body
+}
+
+// NewGetFundamentalsResponseBody builds the HTTP response body from the result
+// of the "get_fundamentals" endpoint of the "master" service.
+func NewGetFundamentalsResponseBody(res *masterviews.StockbotStockFundamentalsView) *GetFundamentalsResponseBody {
+	body := &GetFundamentalsResponseBody{
+		Symbol:                *res.Symbol,
+		SnapshotDate:          *res.SnapshotDate,
+		FetchedAt:             *res.FetchedAt,
+		Bps:                   res.Bps,
+		Eps:                   res.Eps,
+		Roe:                   res.Roe,
+		DividendPerShare:      res.DividendPerShare,
+		ReportedPer:           res.ReportedPer,
+		ReportedPbr:           res.ReportedPbr,
+		ReportedDividendYield: res.ReportedDividendYield,
+		EarningsYield:         res.EarningsYield,
+		ExDividendDate:        res.ExDividendDate,
+		InterimExDividendDate: res.InterimExDividendDate,
+		LastExRightsDate:      res.LastExRightsDate,
+		YearHigh:              res.YearHigh,
+		YearHighDate:          res.YearHighDate,
+		YearLow:               res.YearLow,
+		YearLowDate:           res.YearLowDate,
+		Price:                 res.Price,
+		Per:                   res.Per,
+		Pbr:                   res.Pbr,
+		DividendYield:         res.DividendYield,
+	}
+	return body
+}
+
+// NewListStocksResponseBody builds the HTTP response body from the result of
+// the "list_stocks" endpoint of the "master" service.
+func NewListStocksResponseBody(res *masterviews.StockbotStockMasterPageView) *ListStocksResponseBody {
+	body := &ListStocksResponseBody{
+		Total:  *res.Total,
+		Offset: *res.Offset,
+		Limit:  *res.Limit,
+	}
+	if res.Stocks != nil {
+		body.Stocks = make([]*StockbotStockMasterResponseBody, len(res.Stocks))
+		for i, val := range res.Stocks {
+			if val == nil {
+				body.Stocks[i] = nil
+				continue
+			}
+			body.Stocks[i] = marshalMasterviewsStockbotStockMasterViewToStockbotStockMasterResponseBody(val)
+		}
+	} else {
+		body.Stocks = []*StockbotStockMasterResponseBody{}
+	}
+	return body
+}
+
+// NewListIndustriesResponseBody builds the HTTP response body from the result
+// of the "list_industries" endpoint of the "master" service.
+func NewListIndustriesResponseBody(res *masterviews.StockbotIndustryCollectionView) *ListIndustriesResponseBody {
+	body := &ListIndustriesResponseBody{}
+	if res.Industries != nil {
+		body.Industries = make([]*IndustryResultResponseBody, len(res.Industries))
+		for i, val := range res.Industries {
+			if val == nil {
+				body.Industries[i] = nil
+				continue
+			}
+			body.Industries[i] = marshalMasterviewsIndustryResultViewToIndustryResultResponseBody(val)
+		}
+	} else {
+		body.Industries = []*IndustryResultResponseBody{}
+	}
+	return body
+}
+
+// NewUpdateResponseBody builds the HTTP response body from the result of the
+// "update" endpoint of the "master" service.
+func NewUpdateResponseBody(res *masterviews.StockbotMasterSyncSummaryView) *UpdateResponseBody {
+	body := &UpdateResponseBody{
+		RunID: *res.RunID,
+		Scope: *res.Scope,
+	}
+	if res.Stocks != nil {
+		body.Stocks = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(res.Stocks)
+	}
+	if res.StockMarkets != nil {
+		body.StockMarkets = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(res.StockMarkets)
+	}
+	if res.TickRules != nil {
+		body.TickRules = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(res.TickRules)
+	}
+	if res.MarginMasters != nil {
+		body.MarginMasters = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(res.MarginMasters)
+	}
+	if res.Regulations != nil {
+		body.Regulations = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(res.Regulations)
+	}
+	if res.OperationStatuses != nil {
+		body.OperationStatuses = marshalMasterviewsMasterSyncCountsViewToMasterSyncCountsResponseBody(res.OperationStatuses)
+	}
+	return body
+}
+
+// NewListSyncRunsResponseBody builds the HTTP response body from the result of
+// the "list_sync_runs" endpoint of the "master" service.
+func NewListSyncRunsResponseBody(res *masterviews.StockbotMasterSyncRunCollectionView) *ListSyncRunsResponseBody {
+	body := &ListSyncRunsResponseBody{}
+	if res.Runs != nil {
+		body.Runs = make([]*MasterSyncRunResponseBody, len(res.Runs))
+		for i, val := range res.Runs {
+			if val == nil {
+				body.Runs[i] = nil
+				continue
+			}
+			body.Runs[i] = marshalMasterviewsMasterSyncRunViewToMasterSyncRunResponseBody(val)
+		}
+	} else {
+		body.Runs = []*MasterSyncRunResponseBody{}
+	}
+	return body
+}
+
+// NewGetFundamentalsNotFoundResponseBody builds the HTTP response body from
+// the result of the "get_fundamentals" endpoint of the "master" service.
+func NewGetFundamentalsNotFoundResponseBody(res *goa.ServiceError) *GetFundamentalsNotFoundResponseBody {
+	body := &GetFundamentalsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
@@ -50,3 +393,34 @@ func NewGetStockPayload(symbol string) *master.GetStockPayload {
 
 	return v
 }
+
+// NewGetFundamentalsPayload builds a master service get_fundamentals endpoint
+// payload.
+func NewGetFundamentalsPayload(symbol string) *master.GetFundamentalsPayload {
+	v := &master.GetFundamentalsPayload{}
+	v.Symbol = symbol
+
+	return v
+}
+
+// NewListStocksPayload builds a master service list_stocks endpoint payload.
+func NewListStocksPayload(market *string, industryCode *string, q *string, tradingUnit *int, offset int, limit int) *master.ListStocksPayload {
+	v := &master.ListStocksPayload{}
+	v.Market = market
+	v.IndustryCode = industryCode
+	v.Q = q
+	v.TradingUnit = tradingUnit
+	v.Offset = offset
+	v.Limit = limit
+
+	return v
+}
+
+// NewListSyncRunsPayload builds a master service list_sync_runs endpoint
+// payload.
+func NewListSyncRunsPayload(limit int) *master.ListSyncRunsPayload {
+	v := &master.ListSyncRunsPayload{}
+	v.Limit = limit
+
+	return v
+}
@@ -0,0 +1,76 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// news HTTP client CLI support package
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"fmt"
+	news "stock-bot/gen/news"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListPayload builds the payload for the news list endpoint from CLI
+// flags.
+func BuildListPayload(newsListSymbol string, newsListSince string, newsListLimit string) (*news.ListPayload, error) {
+	var err error
+	var symbol *string
+	{
+		if newsListSymbol != "" {
+			symbol = &newsListSymbol
+		}
+	}
+	var since *string
+	{
+		if newsListSince != "" {
+			since = &newsListSince
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if newsListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(newsListLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &news.ListPayload{}
+	v.Symbol = symbol
+	v.Since = since
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildGetPayload builds the payload for the news get endpoint from CLI flags.
+func BuildGetPayload(newsGetID string) (*news.GetPayload, error) {
+	var id string
+	{
+		id = newsGetID
+	}
+	v := &news.GetPayload{}
+	v.ID = id
+
+	return v, nil
+}
@@ -0,0 +1,206 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// news HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	news "stock-bot/gen/news"
+	newsviews "stock-bot/gen/news/views"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "news" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListNewsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("news", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the news list
+// server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*news.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("news", "list", "*news.ListPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Symbol != nil {
+			values.Add("symbol", *p.Symbol)
+		}
+		if p.Since != nil {
+			values.Add("since", *p.Since)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the news list
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("news", "list", err)
+			}
+			p := NewListStockbotNewsCollectionOK(&body)
+			view := "default"
+			vres := &newsviews.StockbotNewsCollection{Projected: p, View: view}
+			if err = newsviews.ValidateStockbotNewsCollection(vres); err != nil {
+				return nil, goahttp.ErrValidationError("news", "list", err)
+			}
+			res := news.NewStockbotNewsCollection(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("news", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "news" service "get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*news.GetPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("news", "get", "*news.GetPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetNewsPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("news", "get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the news get
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+// DecodeGetResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("news", "get", err)
+			}
+			err = ValidateGetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("news", "get", err)
+			}
+			res := NewGetNewsResultOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("news", "get", err)
+			}
+			err = ValidateGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("news", "get", err)
+			}
+			return nil, NewGetNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("news", "get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalNewsResultResponseBodyToNewsviewsNewsResultView builds a value of
+// type *newsviews.NewsResultView from a value of type *NewsResultResponseBody.
+func unmarshalNewsResultResponseBodyToNewsviewsNewsResultView(v *NewsResultResponseBody) *newsviews.NewsResultView {
+	res := &newsviews.NewsResultView{
+		ID:          v.ID,
+		PublishedAt: v.PublishedAt,
+		Headline:    v.Headline,
+		Body:        v.Body,
+	}
+	res.Categories = make([]string, len(v.Categories))
+	for i, val := range v.Categories {
+		res.Categories[i] = val
+	}
+	res.Genres = make([]string, len(v.Genres))
+	for i, val := range v.Genres {
+		res.Genres[i] = val
+	}
+	res.Symbols = make([]string, len(v.Symbols))
+	for i, val := range v.Symbols {
+		res.Symbols[i] = val
+	}
+
+	return res
+}
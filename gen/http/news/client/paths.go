@@ -0,0 +1,22 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the news service.
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// ListNewsPath returns the URL path to the news service list HTTP endpoint.
+func ListNewsPath() string {
+	return "/news"
+}
+
+// GetNewsPath returns the URL path to the news service get HTTP endpoint.
+func GetNewsPath(id string) string {
+	return fmt.Sprintf("/news/%v", id)
+}
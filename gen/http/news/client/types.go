@@ -0,0 +1,203 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// news HTTP client types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	news "stock-bot/gen/news"
+	newsviews "stock-bot/gen/news/views"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// ListResponseBody is the type of the "news" service "list" endpoint HTTP
+// response body.
+type ListResponseBody struct {
+	// ニュースのリスト
+	News []*NewsResultResponseBody `form:"news,omitempty" json:"news,omitempty" xml:"news,omitempty"`
+}
+
+// GetResponseBody is the type of the "news" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// ニュースID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// ニュース日時 (RFC3339)
+	PublishedAt *string `form:"published_at,omitempty" json:"published_at,omitempty" xml:"published_at,omitempty"`
+	// ニュースカテゴリ
+	Categories []string `form:"categories,omitempty" json:"categories,omitempty" xml:"categories,omitempty"`
+	// ニュースジャンル
+	Genres []string `form:"genres,omitempty" json:"genres,omitempty" xml:"genres,omitempty"`
+	// 関連銘柄コード
+	Symbols []string `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// ヘッドライン
+	Headline *string `form:"headline,omitempty" json:"headline,omitempty" xml:"headline,omitempty"`
+	// 本文 (未取得の場合は省略)
+	Body *string `form:"body,omitempty" json:"body,omitempty" xml:"body,omitempty"`
+}
+
+// GetNotFoundResponseBody is the type of the "news" service "get" endpoint
+// HTTP response body for the "not_found" error.
+type GetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// NewsResultResponseBody is used to define fields on response body types.
+type NewsResultResponseBody struct {
+	// ニュースID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// ニュース日時 (RFC3339)
+	PublishedAt *string `form:"published_at,omitempty" json:"published_at,omitempty" xml:"published_at,omitempty"`
+	// ニュースカテゴリ
+	Categories []string `form:"categories,omitempty" json:"categories,omitempty" xml:"categories,omitempty"`
+	// ニュースジャンル
+	Genres []string `form:"genres,omitempty" json:"genres,omitempty" xml:"genres,omitempty"`
+	// 関連銘柄コード
+	Symbols []string `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// ヘッドライン
+	Headline *string `form:"headline,omitempty" json:"headline,omitempty" xml:"headline,omitempty"`
+	// 本文 (未取得の場合は省略)
+	Body *string `form:"body,omitempty" json:"body,omitempty" xml:"body,omitempty"`
+}
+
+// NewListStockbotNewsCollectionOK builds a "news" service "list" endpoint
+// result from a HTTP "OK" response.
+func NewListStockbotNewsCollectionOK(body *ListResponseBody) *newsviews.StockbotNewsCollectionView {
+	v := &newsviews.StockbotNewsCollectionView{}
+	v.News = make([]*newsviews.NewsResultView, len(body.News))
+	for i, val := range body.News {
+		if val == nil {
+			v.News[i] = nil
+			continue
+		}
+		v.News[i] = unmarshalNewsResultResponseBodyToNewsviewsNewsResultView(val)
+	}
+
+	return v
+}
+
+// NewGetNewsResultOK builds a "news" service "get" endpoint result from a HTTP
+// "OK" response.
+func NewGetNewsResultOK(body *GetResponseBody) *news.NewsResult {
+	v := &news.NewsResult{
+		ID:          *body.ID,
+		PublishedAt: *body.PublishedAt,
+		Headline:    *body.Headline,
+		Body:        body.Body,
+	}
+	v.Categories = make([]string, len(body.Categories))
+	for i, val := range body.Categories {
+		v.Categories[i] = val
+	}
+	v.Genres = make([]string, len(body.Genres))
+	for i, val := range body.Genres {
+		v.Genres[i] = val
+	}
+	v.Symbols = make([]string, len(body.Symbols))
+	for i, val := range body.Symbols {
+		v.Symbols[i] = val
+	}
+
+	return v
+}
+
+// NewGetNotFound builds a news service get endpoint not_found error.
+func NewGetNotFound(body *GetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateGetResponseBody runs the validations defined on GetResponseBody
+func ValidateGetResponseBody(body *GetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.PublishedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("published_at", "body"))
+	}
+	if body.Categories == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("categories", "body"))
+	}
+	if body.Genres == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("genres", "body"))
+	}
+	if body.Symbols == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbols", "body"))
+	}
+	if body.Headline == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("headline", "body"))
+	}
+	return
+}
+
+// ValidateGetNotFoundResponseBody runs the validations defined on
+// get_not_found_response_body
+func ValidateGetNotFoundResponseBody(body *GetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateNewsResultResponseBody runs the validations defined on
+// NewsResultResponseBody
+func ValidateNewsResultResponseBody(body *NewsResultResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.PublishedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("published_at", "body"))
+	}
+	if body.Categories == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("categories", "body"))
+	}
+	if body.Genres == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("genres", "body"))
+	}
+	if body.Symbols == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbols", "body"))
+	}
+	if body.Headline == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("headline", "body"))
+	}
+	return
+}
@@ -0,0 +1,175 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// news HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	news "stock-bot/gen/news"
+	newsviews "stock-bot/gen/news/views"
+	"strconv"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeListResponse returns an encoder for responses returned by the news
+// list endpoint.
+func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*newsviews.StockbotNewsCollection)
+		enc := encoder(ctx, w)
+		body := NewListResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListRequest returns a decoder for requests sent to the news list
+// endpoint.
+func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*news.ListPayload, error) {
+	return func(r *http.Request) (*news.ListPayload, error) {
+		var (
+			symbol *string
+			since  *string
+			limit  int
+			err    error
+		)
+		qp := r.URL.Query()
+		symbolRaw := qp.Get("symbol")
+		if symbolRaw != "" {
+			symbol = &symbolRaw
+		}
+		sinceRaw := qp.Get("since")
+		if sinceRaw != "" {
+			since = &sinceRaw
+		}
+		if since != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 50
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListPayload(symbol, since, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetResponse returns an encoder for responses returned by the news get
+// endpoint.
+func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*news.NewsResult)
+		enc := encoder(ctx, w)
+		body := NewGetResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetRequest returns a decoder for requests sent to the news get
+// endpoint.
+func DecodeGetRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*news.GetPayload, error) {
+	return func(r *http.Request) (*news.GetPayload, error) {
+		var (
+			id string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		payload := NewGetPayload(id)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetError returns an encoder for errors returned by the get news
+// endpoint.
+func EncodeGetError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalNewsviewsNewsResultViewToNewsResultResponseBody builds a value of
+// type *NewsResultResponseBody from a value of type *newsviews.NewsResultView.
+func marshalNewsviewsNewsResultViewToNewsResultResponseBody(v *newsviews.NewsResultView) *NewsResultResponseBody {
+	res := &NewsResultResponseBody{
+		ID:          *v.ID,
+		PublishedAt: *v.PublishedAt,
+		Headline:    *v.Headline,
+		Body:        v.Body,
+	}
+	if v.Categories != nil {
+		res.Categories = make([]string, len(v.Categories))
+		for i, val := range v.Categories {
+			res.Categories[i] = val
+		}
+	} else {
+		res.Categories = []string{}
+	}
+	if v.Genres != nil {
+		res.Genres = make([]string, len(v.Genres))
+		for i, val := range v.Genres {
+			res.Genres[i] = val
+		}
+	} else {
+		res.Genres = []string{}
+	}
+	if v.Symbols != nil {
+		res.Symbols = make([]string, len(v.Symbols))
+		for i, val := range v.Symbols {
+			res.Symbols[i] = val
+		}
+	} else {
+		res.Symbols = []string{}
+	}
+
+	return res
+}
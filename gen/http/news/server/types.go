@@ -0,0 +1,164 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// news HTTP server types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	news "stock-bot/gen/news"
+	newsviews "stock-bot/gen/news/views"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// ListResponseBody is the type of the "news" service "list" endpoint HTTP
+// response body.
+type ListResponseBody struct {
+	// ニュースのリスト
+	News []*NewsResultResponseBody `form:"news" json:"news" xml:"news"`
+}
+
+// GetResponseBody is the type of the "news" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// ニュースID
+	ID string `form:"id" json:"id" xml:"id"`
+	// ニュース日時 (RFC3339)
+	PublishedAt string `form:"published_at" json:"published_at" xml:"published_at"`
+	// ニュースカテゴリ
+	Categories []string `form:"categories" json:"categories" xml:"categories"`
+	// ニュースジャンル
+	Genres []string `form:"genres" json:"genres" xml:"genres"`
+	// 関連銘柄コード
+	Symbols []string `form:"symbols" json:"symbols" xml:"symbols"`
+	// ヘッドライン
+	Headline string `form:"headline" json:"headline" xml:"headline"`
+	// 本文 (未取得の場合は省略)
+	Body *string `form:"body,omitempty" json:"body,omitempty" xml:"body,omitempty"`
+}
+
+// GetNotFoundResponseBody is the type of the "news" service "get" endpoint
+// HTTP response body for the "not_found" error.
+type GetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// NewsResultResponseBody is used to define fields on response body types.
+type NewsResultResponseBody struct {
+	// ニュースID
+	ID string `form:"id" json:"id" xml:"id"`
+	// ニュース日時 (RFC3339)
+	PublishedAt string `form:"published_at" json:"published_at" xml:"published_at"`
+	// ニュースカテゴリ
+	Categories []string `form:"categories" json:"categories" xml:"categories"`
+	// ニュースジャンル
+	Genres []string `form:"genres" json:"genres" xml:"genres"`
+	// 関連銘柄コード
+	Symbols []string `form:"symbols" json:"symbols" xml:"symbols"`
+	// ヘッドライン
+	Headline string `form:"headline" json:"headline" xml:"headline"`
+	// 本文 (未取得の場合は省略)
+	Body *string `form:"body,omitempty" json:"body,omitempty" xml:"body,omitempty"`
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "news" service.
+func NewListResponseBody(res *newsviews.StockbotNewsCollectionView) *ListResponseBody {
+	body := &ListResponseBody{}
+	if res.News != nil {
+		body.News = make([]*NewsResultResponseBody, len(res.News))
+		for i, val := range res.News {
+			if val == nil {
+				body.News[i] = nil
+				continue
+			}
+			body.News[i] = marshalNewsviewsNewsResultViewToNewsResultResponseBody(val)
+		}
+	} else {
+		body.News = []*NewsResultResponseBody{}
+	}
+	return body
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "get" endpoint of the "news" service.
+func NewGetResponseBody(res *news.NewsResult) *GetResponseBody {
+	body := &GetResponseBody{
+		ID:          res.ID,
+		PublishedAt: res.PublishedAt,
+		Headline:    res.Headline,
+		Body:        res.Body,
+	}
+	if res.Categories != nil {
+		body.Categories = make([]string, len(res.Categories))
+		for i, val := range res.Categories {
+			body.Categories[i] = val
+		}
+	} else {
+		body.Categories = []string{}
+	}
+	if res.Genres != nil {
+		body.Genres = make([]string, len(res.Genres))
+		for i, val := range res.Genres {
+			body.Genres[i] = val
+		}
+	} else {
+		body.Genres = []string{}
+	}
+	if res.Symbols != nil {
+		body.Symbols = make([]string, len(res.Symbols))
+		for i, val := range res.Symbols {
+			body.Symbols[i] = val
+		}
+	} else {
+		body.Symbols = []string{}
+	}
+	return body
+}
+
+// NewGetNotFoundResponseBody builds the HTTP response body from the result of
+// the "get" endpoint of the "news" service.
+func NewGetNotFoundResponseBody(res *goa.ServiceError) *GetNotFoundResponseBody {
+	body := &GetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListPayload builds a news service list endpoint payload.
+func NewListPayload(symbol *string, since *string, limit int) *news.ListPayload {
+	v := &news.ListPayload{}
+	v.Symbol = symbol
+	v.Since = since
+	v.Limit = limit
+
+	return v
+}
+
+// NewGetPayload builds a news service get endpoint payload.
+func NewGetPayload(id string) *news.GetPayload {
+	v := &news.GetPayload{}
+	v.ID = id
+
+	return v
+}
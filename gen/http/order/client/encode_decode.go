@@ -52,6 +52,10 @@ func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 // DecodeCreateResponse returns a decoder for responses returned by the order
 // create endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
+// DecodeCreateResponse may return the following errors:
+//   - "invalid_order" (type *goa.ServiceError): http.StatusBadRequest
+//   - "margin_entry_blocked" (type *goa.ServiceError): http.StatusConflict
+//   - error: internal error
 func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -82,6 +86,34 @@ func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 			}
 			res := NewCreateResultCreated(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateInvalidOrderResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("order", "create", err)
+			}
+			err = ValidateCreateInvalidOrderResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("order", "create", err)
+			}
+			return nil, NewCreateInvalidOrder(&body)
+		case http.StatusConflict:
+			var (
+				body CreateMarginEntryBlockedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("order", "create", err)
+			}
+			err = ValidateCreateMarginEntryBlockedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("order", "create", err)
+			}
+			return nil, NewCreateMarginEntryBlocked(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("order", "create", resp.StatusCode, string(body))
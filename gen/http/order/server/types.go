@@ -37,6 +37,42 @@ type CreateResponseBody struct {
 	OrderID string `form:"order_id" json:"order_id" xml:"order_id"`
 }
 
+// CreateInvalidOrderResponseBody is the type of the "order" service "create"
+// endpoint HTTP response body for the "invalid_order" error.
+type CreateInvalidOrderResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateMarginEntryBlockedResponseBody is the type of the "order" service
+// "create" endpoint HTTP response body for the "margin_entry_blocked" error.
+type CreateMarginEntryBlockedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // NewCreateResponseBody builds the HTTP response body from the result of the
 // "create" endpoint of the "order" service.
 func NewCreateResponseBody(res *order.CreateResult) *CreateResponseBody {
@@ -46,6 +82,34 @@ func NewCreateResponseBody(res *order.CreateResult) *CreateResponseBody {
 	return body
 }
 
+// NewCreateInvalidOrderResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "order" service.
+func NewCreateInvalidOrderResponseBody(res *goa.ServiceError) *CreateInvalidOrderResponseBody {
+	body := &CreateInvalidOrderResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateMarginEntryBlockedResponseBody builds the HTTP response body from
+// the result of the "create" endpoint of the "order" service.
+func NewCreateMarginEntryBlockedResponseBody(res *goa.ServiceError) *CreateMarginEntryBlockedResponseBody {
+	body := &CreateMarginEntryBlockedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewCreatePayload builds a order service create endpoint payload.
 func NewCreatePayload(body *CreateRequestBody) *order.CreatePayload {
 	v := &order.CreatePayload{
@@ -0,0 +1,148 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// portfolio HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	portfolio "stock-bot/gen/portfolio"
+	portfolioviews "stock-bot/gen/portfolio/views"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "portfolio" service "get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetPortfolioPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("portfolio", "get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the portfolio
+// get endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("portfolio", "get", err)
+			}
+			p := NewGetStockbotPortfolioOK(&body)
+			view := "default"
+			vres := &portfolioviews.StockbotPortfolio{Projected: p, View: view}
+			if err = portfolioviews.ValidateStockbotPortfolio(vres); err != nil {
+				return nil, goahttp.ErrValidationError("portfolio", "get", err)
+			}
+			res := portfolio.NewStockbotPortfolio(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("portfolio", "get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalPositionResultResponseBodyToPortfolioviewsPositionResultView builds
+// a value of type *portfolioviews.PositionResultView from a value of type
+// *PositionResultResponseBody.
+func unmarshalPositionResultResponseBodyToPortfolioviewsPositionResultView(v *PositionResultResponseBody) *portfolioviews.PositionResultView {
+	res := &portfolioviews.PositionResultView{
+		Symbol:           v.Symbol,
+		PositionType:     v.PositionType,
+		Quantity:         v.Quantity,
+		AverageCost:      v.AverageCost,
+		CurrentPrice:     v.CurrentPrice,
+		UnrealizedPl:     v.UnrealizedPl,
+		UnrealizedPlRate: v.UnrealizedPlRate,
+		OpenedDate:       v.OpenedDate,
+	}
+	if v.ParseErrors != nil {
+		res.ParseErrors = make([]string, len(v.ParseErrors))
+		for i, val := range v.ParseErrors {
+			res.ParseErrors[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalPortfolioTotalsResultResponseBodyToPortfolioviewsPortfolioTotalsResultView
+// builds a value of type *portfolioviews.PortfolioTotalsResultView from a
+// value of type *PortfolioTotalsResultResponseBody.
+func unmarshalPortfolioTotalsResultResponseBodyToPortfolioviewsPortfolioTotalsResultView(v *PortfolioTotalsResultResponseBody) *portfolioviews.PortfolioTotalsResultView {
+	res := &portfolioviews.PortfolioTotalsResultView{
+		PositionCount: v.PositionCount,
+		MarketValue:   v.MarketValue,
+		LongValue:     v.LongValue,
+		ShortValue:    v.ShortValue,
+		NetExposure:   v.NetExposure,
+		CostBasis:     v.CostBasis,
+		UnrealizedPl:  v.UnrealizedPl,
+	}
+
+	return res
+}
+
+// unmarshalPortfolioExposureResultResponseBodyToPortfolioviewsPortfolioExposureResultView
+// builds a value of type *portfolioviews.PortfolioExposureResultView from a
+// value of type *PortfolioExposureResultResponseBody.
+func unmarshalPortfolioExposureResultResponseBodyToPortfolioviewsPortfolioExposureResultView(v *PortfolioExposureResultResponseBody) *portfolioviews.PortfolioExposureResultView {
+	res := &portfolioviews.PortfolioExposureResultView{
+		Code:          v.Code,
+		Name:          v.Name,
+		PositionCount: v.PositionCount,
+		MarketValue:   v.MarketValue,
+		Weight:        v.Weight,
+		UnrealizedPl:  v.UnrealizedPl,
+	}
+
+	return res
+}
+
+// unmarshalPortfolioConcentrationResultResponseBodyToPortfolioviewsPortfolioConcentrationResultView
+// builds a value of type *portfolioviews.PortfolioConcentrationResultView from
+// a value of type *PortfolioConcentrationResultResponseBody.
+func unmarshalPortfolioConcentrationResultResponseBodyToPortfolioviewsPortfolioConcentrationResultView(v *PortfolioConcentrationResultResponseBody) *portfolioviews.PortfolioConcentrationResultView {
+	res := &portfolioviews.PortfolioConcentrationResultView{
+		LargestSymbol: v.LargestSymbol,
+		LargestWeight: v.LargestWeight,
+		Top5Weight:    v.Top5Weight,
+		Hhi:           v.Hhi,
+	}
+
+	return res
+}
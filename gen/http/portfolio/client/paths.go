@@ -0,0 +1,13 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the portfolio service.
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+// GetPortfolioPath returns the URL path to the portfolio service get HTTP endpoint.
+func GetPortfolioPath() string {
+	return "/portfolio"
+}
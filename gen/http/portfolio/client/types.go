@@ -0,0 +1,239 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// portfolio HTTP client types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	portfolioviews "stock-bot/gen/portfolio/views"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// GetResponseBody is the type of the "portfolio" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// 保有ポジションのリスト
+	Positions []*PositionResultResponseBody `form:"positions,omitempty" json:"positions,omitempty" xml:"positions,omitempty"`
+	// 合計
+	Totals *PortfolioTotalsResultResponseBody `form:"totals,omitempty" json:"totals,omitempty" xml:"totals,omitempty"`
+	// 現物・信用買・信用売ごとの評価額
+	ByType []*PortfolioExposureResultResponseBody `form:"by_type,omitempty" json:"by_type,omitempty" xml:"by_type,omitempty"`
+	// 業種ごとの評価額 (評価額の大きい順)
+	ByIndustry []*PortfolioExposureResultResponseBody `form:"by_industry,omitempty" json:"by_industry,omitempty" xml:"by_industry,omitempty"`
+	// 銘柄の集中度
+	Concentration *PortfolioConcentrationResultResponseBody `form:"concentration,omitempty" json:"concentration,omitempty" xml:"concentration,omitempty"`
+	// 保存済みの約定から移動平均法で計算した実現損益 (手数料控除後)
+	RealizedPl *float64 `form:"realized_pl,omitempty" json:"realized_pl,omitempty" xml:"realized_pl,omitempty"`
+	// 本日の実現損益
+	RealizedPlToday *float64 `form:"realized_pl_today,omitempty" json:"realized_pl_today,omitempty" xml:"realized_pl_today,omitempty"`
+	// 解析できなかった値 (合計では 0 として扱う)
+	ParseErrors []string `form:"parse_errors,omitempty" json:"parse_errors,omitempty" xml:"parse_errors,omitempty"`
+}
+
+// PositionResultResponseBody is used to define fields on response body types.
+type PositionResultResponseBody struct {
+	// 銘柄コード
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// ポジション種別 (CASH, MARGIN_LONG, MARGIN_SHORT)
+	PositionType *string `form:"position_type,omitempty" json:"position_type,omitempty" xml:"position_type,omitempty"`
+	// 保有数量
+	Quantity *float64 `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// 平均取得単価
+	AverageCost *float64 `form:"average_cost,omitempty" json:"average_cost,omitempty" xml:"average_cost,omitempty"`
+	// 現在値
+	CurrentPrice *float64 `form:"current_price,omitempty" json:"current_price,omitempty" xml:"current_price,omitempty"`
+	// 評価損益
+	UnrealizedPl *float64 `form:"unrealized_pl,omitempty" json:"unrealized_pl,omitempty" xml:"unrealized_pl,omitempty"`
+	// 評価損益率(%)
+	UnrealizedPlRate *float64 `form:"unrealized_pl_rate,omitempty" json:"unrealized_pl_rate,omitempty" xml:"unrealized_pl_rate,omitempty"`
+	// 建日 (信用取引の場合 YYYYMMDD)
+	OpenedDate *string `form:"opened_date,omitempty" json:"opened_date,omitempty" xml:"opened_date,omitempty"`
+	// 解析できなかった値 (該当する項目は 0)
+	ParseErrors []string `form:"parse_errors,omitempty" json:"parse_errors,omitempty" xml:"parse_errors,omitempty"`
+}
+
+// PortfolioTotalsResultResponseBody is used to define fields on response body
+// types.
+type PortfolioTotalsResultResponseBody struct {
+	// ポジション数
+	PositionCount *int `form:"position_count,omitempty" json:"position_count,omitempty" xml:"position_count,omitempty"`
+	// 評価額の合計 (買建と売建の合計)
+	MarketValue *float64 `form:"market_value,omitempty" json:"market_value,omitempty" xml:"market_value,omitempty"`
+	// 買いポジションの評価額
+	LongValue *float64 `form:"long_value,omitempty" json:"long_value,omitempty" xml:"long_value,omitempty"`
+	// 売建の評価額
+	ShortValue *float64 `form:"short_value,omitempty" json:"short_value,omitempty" xml:"short_value,omitempty"`
+	// 買いポジションの評価額 - 売建の評価額
+	NetExposure *float64 `form:"net_exposure,omitempty" json:"net_exposure,omitempty" xml:"net_exposure,omitempty"`
+	// 取得金額の合計
+	CostBasis *float64 `form:"cost_basis,omitempty" json:"cost_basis,omitempty" xml:"cost_basis,omitempty"`
+	// 評価損益の合計
+	UnrealizedPl *float64 `form:"unrealized_pl,omitempty" json:"unrealized_pl,omitempty" xml:"unrealized_pl,omitempty"`
+}
+
+// PortfolioExposureResultResponseBody is used to define fields on response
+// body types.
+type PortfolioExposureResultResponseBody struct {
+	// ポジション種別または業種コード (業種が不明な場合は空)
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// 名称
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ポジション数
+	PositionCount *int `form:"position_count,omitempty" json:"position_count,omitempty" xml:"position_count,omitempty"`
+	// 評価額
+	MarketValue *float64 `form:"market_value,omitempty" json:"market_value,omitempty" xml:"market_value,omitempty"`
+	// 評価額の合計に占める割合 (0〜1)
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// 評価損益
+	UnrealizedPl *float64 `form:"unrealized_pl,omitempty" json:"unrealized_pl,omitempty" xml:"unrealized_pl,omitempty"`
+}
+
+// PortfolioConcentrationResultResponseBody is used to define fields on
+// response body types.
+type PortfolioConcentrationResultResponseBody struct {
+	// 評価額が最も大きい銘柄
+	LargestSymbol *string `form:"largest_symbol,omitempty" json:"largest_symbol,omitempty" xml:"largest_symbol,omitempty"`
+	// 評価額が最も大きい銘柄の割合 (0〜1)
+	LargestWeight *float64 `form:"largest_weight,omitempty" json:"largest_weight,omitempty" xml:"largest_weight,omitempty"`
+	// 評価額の上位5銘柄の割合 (0〜1)
+	Top5Weight *float64 `form:"top5_weight,omitempty" json:"top5_weight,omitempty" xml:"top5_weight,omitempty"`
+	// 銘柄ごとの割合のハーフィンダール・ハーシュマン指数 (0〜1)
+	Hhi *float64 `form:"hhi,omitempty" json:"hhi,omitempty" xml:"hhi,omitempty"`
+}
+
+// NewGetStockbotPortfolioOK builds a "portfolio" service "get" endpoint result
+// from a HTTP "OK" response.
+func NewGetStockbotPortfolioOK(body *GetResponseBody) *portfolioviews.StockbotPortfolioView {
+	v := &portfolioviews.StockbotPortfolioView{
+		RealizedPl:      body.RealizedPl,
+		RealizedPlToday: body.RealizedPlToday,
+	}
+	v.Positions = make([]*portfolioviews.PositionResultView, len(body.Positions))
+	for i, val := range body.Positions {
+		if val == nil {
+			v.Positions[i] = nil
+			continue
+		}
+		v.Positions[i] = unmarshalPositionResultResponseBodyToPortfolioviewsPositionResultView(val)
+	}
+	v.Totals = unmarshalPortfolioTotalsResultResponseBodyToPortfolioviewsPortfolioTotalsResultView(body.Totals)
+	v.ByType = make([]*portfolioviews.PortfolioExposureResultView, len(body.ByType))
+	for i, val := range body.ByType {
+		if val == nil {
+			v.ByType[i] = nil
+			continue
+		}
+		v.ByType[i] = unmarshalPortfolioExposureResultResponseBodyToPortfolioviewsPortfolioExposureResultView(val)
+	}
+	v.ByIndustry = make([]*portfolioviews.PortfolioExposureResultView, len(body.ByIndustry))
+	for i, val := range body.ByIndustry {
+		if val == nil {
+			v.ByIndustry[i] = nil
+			continue
+		}
+		v.ByIndustry[i] = unmarshalPortfolioExposureResultResponseBodyToPortfolioviewsPortfolioExposureResultView(val)
+	}
+	v.Concentration = unmarshalPortfolioConcentrationResultResponseBodyToPortfolioviewsPortfolioConcentrationResultView(body.Concentration)
+	v.ParseErrors = make([]string, len(body.ParseErrors))
+	for i, val := range body.ParseErrors {
+		v.ParseErrors[i] = val
+	}
+
+	return v
+}
+
+// ValidatePositionResultResponseBody runs the validations defined on
+// PositionResultResponseBody
+func ValidatePositionResultResponseBody(body *PositionResultResponseBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.PositionType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position_type", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.AverageCost == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("average_cost", "body"))
+	}
+	if body.PositionType != nil {
+		if !(*body.PositionType == "CASH" || *body.PositionType == "MARGIN_LONG" || *body.PositionType == "MARGIN_SHORT") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.position_type", *body.PositionType, []any{"CASH", "MARGIN_LONG", "MARGIN_SHORT"}))
+		}
+	}
+	return
+}
+
+// ValidatePortfolioTotalsResultResponseBody runs the validations defined on
+// PortfolioTotalsResultResponseBody
+func ValidatePortfolioTotalsResultResponseBody(body *PortfolioTotalsResultResponseBody) (err error) {
+	if body.PositionCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position_count", "body"))
+	}
+	if body.MarketValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_value", "body"))
+	}
+	if body.LongValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_value", "body"))
+	}
+	if body.ShortValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_value", "body"))
+	}
+	if body.NetExposure == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_exposure", "body"))
+	}
+	if body.CostBasis == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cost_basis", "body"))
+	}
+	if body.UnrealizedPl == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unrealized_pl", "body"))
+	}
+	return
+}
+
+// ValidatePortfolioExposureResultResponseBody runs the validations defined on
+// PortfolioExposureResultResponseBody
+func ValidatePortfolioExposureResultResponseBody(body *PortfolioExposureResultResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.PositionCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position_count", "body"))
+	}
+	if body.MarketValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_value", "body"))
+	}
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
+	if body.UnrealizedPl == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unrealized_pl", "body"))
+	}
+	return
+}
+
+// ValidatePortfolioConcentrationResultResponseBody runs the validations
+// defined on PortfolioConcentrationResultResponseBody
+func ValidatePortfolioConcentrationResultResponseBody(body *PortfolioConcentrationResultResponseBody) (err error) {
+	if body.LargestSymbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("largest_symbol", "body"))
+	}
+	if body.LargestWeight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("largest_weight", "body"))
+	}
+	if body.Top5Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("top5_weight", "body"))
+	}
+	if body.Hhi == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hhi", "body"))
+	}
+	return
+}
@@ -0,0 +1,99 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// portfolio HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+	portfolioviews "stock-bot/gen/portfolio/views"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// EncodeGetResponse returns an encoder for responses returned by the portfolio
+// get endpoint.
+func EncodeGetResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*portfolioviews.StockbotPortfolio)
+		enc := encoder(ctx, w)
+		body := NewGetResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// marshalPortfolioviewsPositionResultViewToPositionResultResponseBody builds a
+// value of type *PositionResultResponseBody from a value of type
+// *portfolioviews.PositionResultView.
+func marshalPortfolioviewsPositionResultViewToPositionResultResponseBody(v *portfolioviews.PositionResultView) *PositionResultResponseBody {
+	res := &PositionResultResponseBody{
+		Symbol:           *v.Symbol,
+		PositionType:     *v.PositionType,
+		Quantity:         *v.Quantity,
+		AverageCost:      *v.AverageCost,
+		CurrentPrice:     v.CurrentPrice,
+		UnrealizedPl:     v.UnrealizedPl,
+		UnrealizedPlRate: v.UnrealizedPlRate,
+		OpenedDate:       v.OpenedDate,
+	}
+	if v.ParseErrors != nil {
+		res.ParseErrors = make([]string, len(v.ParseErrors))
+		for i, val := range v.ParseErrors {
+			res.ParseErrors[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalPortfolioviewsPortfolioTotalsResultViewToPortfolioTotalsResultResponseBody
+// builds a value of type *PortfolioTotalsResultResponseBody from a value of
+// type *portfolioviews.PortfolioTotalsResultView.
+func marshalPortfolioviewsPortfolioTotalsResultViewToPortfolioTotalsResultResponseBody(v *portfolioviews.PortfolioTotalsResultView) *PortfolioTotalsResultResponseBody {
+	res := &PortfolioTotalsResultResponseBody{
+		PositionCount: *v.PositionCount,
+		MarketValue:   *v.MarketValue,
+		LongValue:     *v.LongValue,
+		ShortValue:    *v.ShortValue,
+		NetExposure:   *v.NetExposure,
+		CostBasis:     *v.CostBasis,
+		UnrealizedPl:  *v.UnrealizedPl,
+	}
+
+	return res
+}
+
+// marshalPortfolioviewsPortfolioExposureResultViewToPortfolioExposureResultResponseBody
+// builds a value of type *PortfolioExposureResultResponseBody from a value of
+// type *portfolioviews.PortfolioExposureResultView.
+func marshalPortfolioviewsPortfolioExposureResultViewToPortfolioExposureResultResponseBody(v *portfolioviews.PortfolioExposureResultView) *PortfolioExposureResultResponseBody {
+	res := &PortfolioExposureResultResponseBody{
+		Code:          *v.Code,
+		Name:          *v.Name,
+		PositionCount: *v.PositionCount,
+		MarketValue:   *v.MarketValue,
+		Weight:        *v.Weight,
+		UnrealizedPl:  *v.UnrealizedPl,
+	}
+
+	return res
+}
+
+// marshalPortfolioviewsPortfolioConcentrationResultViewToPortfolioConcentrationResultResponseBody
+// builds a value of type *PortfolioConcentrationResultResponseBody from a
+// value of type *portfolioviews.PortfolioConcentrationResultView.
+func marshalPortfolioviewsPortfolioConcentrationResultViewToPortfolioConcentrationResultResponseBody(v *portfolioviews.PortfolioConcentrationResultView) *PortfolioConcentrationResultResponseBody {
+	res := &PortfolioConcentrationResultResponseBody{
+		LargestSymbol: *v.LargestSymbol,
+		LargestWeight: *v.LargestWeight,
+		Top5Weight:    *v.Top5Weight,
+		Hhi:           *v.Hhi,
+	}
+
+	return res
+}
@@ -0,0 +1,164 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// portfolio HTTP server types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	portfolioviews "stock-bot/gen/portfolio/views"
+)
+
+// GetResponseBody is the type of the "portfolio" service "get" endpoint HTTP
+// response body.
+type GetResponseBody struct {
+	// 保有ポジションのリスト
+	Positions []*PositionResultResponseBody `form:"positions" json:"positions" xml:"positions"`
+	// 合計
+	Totals *PortfolioTotalsResultResponseBody `form:"totals" json:"totals" xml:"totals"`
+	// 現物・信用買・信用売ごとの評価額
+	ByType []*PortfolioExposureResultResponseBody `form:"by_type" json:"by_type" xml:"by_type"`
+	// 業種ごとの評価額 (評価額の大きい順)
+	ByIndustry []*PortfolioExposureResultResponseBody `form:"by_industry" json:"by_industry" xml:"by_industry"`
+	// 銘柄の集中度
+	Concentration *PortfolioConcentrationResultResponseBody `form:"concentration" json:"concentration" xml:"concentration"`
+	// 保存済みの約定から移動平均法で計算した実現損益 (手数料控除後)
+	RealizedPl float64 `form:"realized_pl" json:"realized_pl" xml:"realized_pl"`
+	// 本日の実現損益
+	RealizedPlToday float64 `form:"realized_pl_today" json:"realized_pl_today" xml:"realized_pl_today"`
+	// 解析できなかった値 (合計では 0 として扱う)
+	ParseErrors []string `form:"parse_errors" json:"parse_errors" xml:"parse_errors"`
+}
+
+// PositionResultResponseBody is used to define fields on response body types.
+type PositionResultResponseBody struct {
+	// 銘柄コード
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// ポジション種別 (CASH, MARGIN_LONG, MARGIN_SHORT)
+	PositionType string `form:"position_type" json:"position_type" xml:"position_type"`
+	// 保有数量
+	Quantity float64 `form:"quantity" json:"quantity" xml:"quantity"`
+	// 平均取得単価
+	AverageCost float64 `form:"average_cost" json:"average_cost" xml:"average_cost"`
+	// 現在値
+	CurrentPrice *float64 `form:"current_price,omitempty" json:"current_price,omitempty" xml:"current_price,omitempty"`
+	// 評価損益
+	UnrealizedPl *float64 `form:"unrealized_pl,omitempty" json:"unrealized_pl,omitempty" xml:"unrealized_pl,omitempty"`
+	// 評価損益率(%)
+	UnrealizedPlRate *float64 `form:"unrealized_pl_rate,omitempty" json:"unrealized_pl_rate,omitempty" xml:"unrealized_pl_rate,omitempty"`
+	// 建日 (信用取引の場合 YYYYMMDD)
+	OpenedDate *string `form:"opened_date,omitempty" json:"opened_date,omitempty" xml:"opened_date,omitempty"`
+	// 解析できなかった値 (該当する項目は 0)
+	ParseErrors []string `form:"parse_errors,omitempty" json:"parse_errors,omitempty" xml:"parse_errors,omitempty"`
+}
+
+// PortfolioTotalsResultResponseBody is used to define fields on response body
+// types.
+type PortfolioTotalsResultResponseBody struct {
+	// ポジション数
+	PositionCount int `form:"position_count" json:"position_count" xml:"position_count"`
+	// 評価額の合計 (買建と売建の合計)
+	MarketValue float64 `form:"market_value" json:"market_value" xml:"market_value"`
+	// 買いポジションの評価額
+	LongValue float64 `form:"long_value" json:"long_value" xml:"long_value"`
+	// 売建の評価額
+	ShortValue float64 `form:"short_value" json:"short_value" xml:"short_value"`
+	// 買いポジションの評価額 - 売建の評価額
+	NetExposure float64 `form:"net_exposure" json:"net_exposure" xml:"net_exposure"`
+	// 取得金額の合計
+	CostBasis float64 `form:"cost_basis" json:"cost_basis" xml:"cost_basis"`
+	// 評価損益の合計
+	UnrealizedPl float64 `form:"unrealized_pl" json:"unrealized_pl" xml:"unrealized_pl"`
+}
+
+// PortfolioExposureResultResponseBody is used to define fields on response
+// body types.
+type PortfolioExposureResultResponseBody struct {
+	// ポジション種別または業種コード (業種が不明な場合は空)
+	Code string `form:"code" json:"code" xml:"code"`
+	// 名称
+	Name string `form:"name" json:"name" xml:"name"`
+	// ポジション数
+	PositionCount int `form:"position_count" json:"position_count" xml:"position_count"`
+	// 評価額
+	MarketValue float64 `form:"market_value" json:"market_value" xml:"market_value"`
+	// 評価額の合計に占める割合 (0〜1)
+	Weight float64 `form:"weight" json:"weight" xml:"weight"`
+	// 評価損益
+	UnrealizedPl float64 `form:"unrealized_pl" json:"unrealized_pl" xml:"unrealized_pl"`
+}
+
+// PortfolioConcentrationResultResponseBody is used to define fields on
+// response body types.
+type PortfolioConcentrationResultResponseBody struct {
+	// 評価額が最も大きい銘柄
+	LargestSymbol string `form:"largest_symbol" json:"largest_symbol" xml:"largest_symbol"`
+	// 評価額が最も大きい銘柄の割合 (0〜1)
+	LargestWeight float64 `form:"largest_weight" json:"largest_weight" xml:"largest_weight"`
+	// 評価額の上位5銘柄の割合 (0〜1)
+	Top5Weight float64 `form:"top5_weight" json:"top5_weight" xml:"top5_weight"`
+	// 銘柄ごとの割合のハーフィンダール・ハーシュマン指数 (0〜1)
+	Hhi float64 `form:"hhi" json:"hhi" xml:"hhi"`
+}
+
+// NewGetResponseBody builds the HTTP response body from the result of the
+// "get" endpoint of the "portfolio" service.
+func NewGetResponseBody(res *portfolioviews.StockbotPortfolioView) *GetResponseBody {
+	body := &GetResponseBody{
+		RealizedPl:      *res.RealizedPl,
+		RealizedPlToday: *res.RealizedPlToday,
+	}
+	if res.Positions != nil {
+		body.Positions = make([]*PositionResultResponseBody, len(res.Positions))
+		for i, val := range res.Positions {
+			if val == nil {
+				body.Positions[i] = nil
+				continue
+			}
+			body.Positions[i] = marshalPortfolioviewsPositionResultViewToPositionResultResponseBody(val)
+		}
+	} else {
+		body.Positions = []*PositionResultResponseBody{}
+	}
+	if res.Totals != nil {
+		body.Totals = marshalPortfolioviewsPortfolioTotalsResultViewToPortfolioTotalsResultResponseBody(res.Totals)
+	}
+	if res.ByType != nil {
+		body.ByType = make([]*PortfolioExposureResultResponseBody, len(res.ByType))
+		for i, val := range res.ByType {
+			if val == nil {
+				body.ByType[i] = nil
+				continue
+			}
+			body.ByType[i] = marshalPortfolioviewsPortfolioExposureResultViewToPortfolioExposureResultResponseBody(val)
+		}
+	} else {
+		body.ByType = []*PortfolioExposureResultResponseBody{}
+	}
+	if res.ByIndustry != nil {
+		body.ByIndustry = make([]*PortfolioExposureResultResponseBody, len(res.ByIndustry))
+		for i, val := range res.ByIndustry {
+			if val == nil {
+				body.ByIndustry[i] = nil
+				continue
+			}
+			body.ByIndustry[i] = marshalPortfolioviewsPortfolioExposureResultViewToPortfolioExposureResultResponseBody(val)
+		}
+	} else {
+		body.ByIndustry = []*PortfolioExposureResultResponseBody{}
+	}
+	if res.Concentration != nil {
+		body.Concentration = marshalPortfolioviewsPortfolioConcentrationResultViewToPortfolioConcentrationResultResponseBody(res.Concentration)
+	}
+	if res.ParseErrors != nil {
+		body.ParseErrors = make([]string, len(res.ParseErrors))
+		for i, val := range res.ParseErrors {
+			body.ParseErrors[i] = val
+		}
+	} else {
+		body.ParseErrors = []string{}
+	}
+	return body
+}
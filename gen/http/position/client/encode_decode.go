@@ -105,6 +105,12 @@ func unmarshalPositionResultResponseBodyToPositionviewsPositionResultView(v *Pos
 		UnrealizedPlRate: v.UnrealizedPlRate,
 		OpenedDate:       v.OpenedDate,
 	}
+	if v.ParseErrors != nil {
+		res.ParseErrors = make([]string, len(v.ParseErrors))
+		for i, val := range v.ParseErrors {
+			res.ParseErrors[i] = val
+		}
+	}
 
 	return res
 }
@@ -38,6 +38,8 @@ type PositionResultResponseBody struct {
 	UnrealizedPlRate *float64 `form:"unrealized_pl_rate,omitempty" json:"unrealized_pl_rate,omitempty" xml:"unrealized_pl_rate,omitempty"`
 	// 建日 (信用取引の場合 YYYYMMDD)
 	OpenedDate *string `form:"opened_date,omitempty" json:"opened_date,omitempty" xml:"opened_date,omitempty"`
+	// 解析できなかった値 (該当する項目は 0)
+	ParseErrors []string `form:"parse_errors,omitempty" json:"parse_errors,omitempty" xml:"parse_errors,omitempty"`
 }
 
 // NewListStockbotPositionCollectionOK builds a "position" service "list"
@@ -69,6 +69,12 @@ func marshalPositionviewsPositionResultViewToPositionResultResponseBody(v *posit
 		UnrealizedPlRate: v.UnrealizedPlRate,
 		OpenedDate:       v.OpenedDate,
 	}
+	if v.ParseErrors != nil {
+		res.ParseErrors = make([]string, len(v.ParseErrors))
+		for i, val := range v.ParseErrors {
+			res.ParseErrors[i] = val
+		}
+	}
 
 	return res
 }
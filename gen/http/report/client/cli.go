@@ -0,0 +1,63 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// report HTTP client CLI support package
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	report "stock-bot/gen/report"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildDailyPayload builds the payload for the report daily endpoint from CLI
+// flags.
+func BuildDailyPayload(reportDailyDate string, reportDailyFormat string) (*report.DailyPayload, error) {
+	var err error
+	var date string
+	{
+		date = reportDailyDate
+		err = goa.MergeErrors(err, goa.ValidateFormat("date", date, goa.FormatDate))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var format string
+	{
+		if reportDailyFormat != "" {
+			format = reportDailyFormat
+			if !(format == "json" || format == "markdown") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"json", "markdown"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &report.DailyPayload{}
+	v.Date = date
+	v.Format = format
+
+	return v, nil
+}
+
+// BuildGeneratePayload builds the payload for the report generate endpoint
+// from CLI flags.
+func BuildGeneratePayload(reportGenerateDate string) (*report.GeneratePayload, error) {
+	var err error
+	var date string
+	{
+		date = reportGenerateDate
+		err = goa.MergeErrors(err, goa.ValidateFormat("date", date, goa.FormatDate))
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &report.GeneratePayload{}
+	v.Date = date
+
+	return v, nil
+}
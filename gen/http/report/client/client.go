@@ -0,0 +1,104 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// report client HTTP transport
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+	report "stock-bot/gen/report"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the report service endpoint HTTP clients.
+type Client struct {
+	// Daily Doer is the HTTP client used to make requests to the daily endpoint.
+	DailyDoer goahttp.Doer
+
+	// Generate Doer is the HTTP client used to make requests to the generate
+	// endpoint.
+	GenerateDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the report service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		DailyDoer:           doer,
+		GenerateDoer:        doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// Daily returns an endpoint that makes HTTP requests to the report service
+// daily server.
+func (c *Client) Daily() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDailyRequest(c.encoder)
+		decodeResponse = DecodeDailyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDailyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DailyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("report", "daily", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &report.DailyResponseData{Result: res.(*report.DailyResult), Body: resp.Body}, nil
+	}
+}
+
+// Generate returns an endpoint that makes HTTP requests to the report service
+// generate server.
+func (c *Client) Generate() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGenerateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGenerateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GenerateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("report", "generate", err)
+		}
+		return decodeResponse(resp)
+	}
+}
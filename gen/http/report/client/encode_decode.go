@@ -0,0 +1,183 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// report HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	report "stock-bot/gen/report"
+	reportviews "stock-bot/gen/report/views"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildDailyRequest instantiates a HTTP request object with method and path
+// set to call the "report" service "daily" endpoint
+func (c *Client) BuildDailyRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		date string
+	)
+	{
+		p, ok := v.(*report.DailyPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("report", "daily", "*report.DailyPayload", v)
+		}
+		date = p.Date
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DailyReportPath(date)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("report", "daily", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDailyRequest returns an encoder for requests sent to the report daily
+// server.
+func EncodeDailyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*report.DailyPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("report", "daily", "*report.DailyPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("format", p.Format)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDailyResponse returns a decoder for responses returned by the report
+// daily endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeDailyResponse may return the following errors:
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - error: internal error
+func DecodeDailyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType string
+				err         error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			}
+			contentType = contentTypeRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("report", "daily", err)
+			}
+			res := NewDailyResultOK(contentType)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body DailyNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("report", "daily", err)
+			}
+			err = ValidateDailyNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("report", "daily", err)
+			}
+			return nil, NewDailyNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("report", "daily", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGenerateRequest instantiates a HTTP request object with method and path
+// set to call the "report" service "generate" endpoint
+func (c *Client) BuildGenerateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		date string
+	)
+	{
+		p, ok := v.(*report.GeneratePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("report", "generate", "*report.GeneratePayload", v)
+		}
+		date = p.Date
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GenerateReportPath(date)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("report", "generate", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGenerateResponse returns a decoder for responses returned by the
+// report generate endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeGenerateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GenerateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("report", "generate", err)
+			}
+			p := NewGenerateStockbotDailyReportSummaryOK(&body)
+			view := "default"
+			vres := &reportviews.StockbotDailyReportSummary{Projected: p, View: view}
+			if err = reportviews.ValidateStockbotDailyReportSummary(vres); err != nil {
+				return nil, goahttp.ErrValidationError("report", "generate", err)
+			}
+			res := report.NewStockbotDailyReportSummary(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("report", "generate", resp.StatusCode, string(body))
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// report HTTP client types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	report "stock-bot/gen/report"
+	reportviews "stock-bot/gen/report/views"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// GenerateResponseBody is the type of the "report" service "generate" endpoint
+// HTTP response body.
+type GenerateResponseBody struct {
+	// レポートの日付 (YYYY-MM-DD, 日本時間)
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// 作成日時 (RFC3339)
+	GeneratedAt *string `form:"generated_at,omitempty" json:"generated_at,omitempty" xml:"generated_at,omitempty"`
+	// エージェントが処理したシグナルの件数
+	SignalsConsumed *int `form:"signals_consumed,omitempty" json:"signals_consumed,omitempty" xml:"signals_consumed,omitempty"`
+	// 発注した注文の件数
+	OrdersPlaced *int `form:"orders_placed,omitempty" json:"orders_placed,omitempty" xml:"orders_placed,omitempty"`
+	// 拒否された発注の件数
+	OrdersRejected *int `form:"orders_rejected,omitempty" json:"orders_rejected,omitempty" xml:"orders_rejected,omitempty"`
+	// 約定の件数
+	Fills *int `form:"fills,omitempty" json:"fills,omitempty" xml:"fills,omitempty"`
+	// リスクイベントの件数
+	RiskEvents *int `form:"risk_events,omitempty" json:"risk_events,omitempty" xml:"risk_events,omitempty"`
+	// この日に決済した取引の実現損益 (手数料控除後)
+	RealizedProfitLoss *float64 `form:"realized_profit_loss,omitempty" json:"realized_profit_loss,omitempty" xml:"realized_profit_loss,omitempty"`
+	// 作成時点の建玉の評価損益 (当日のレポートのみ)
+	UnrealizedProfitLoss *float64 `form:"unrealized_profit_loss,omitempty" json:"unrealized_profit_loss,omitempty" xml:"unrealized_profit_loss,omitempty"`
+	// 取得できずにレポートに含まれていない項目
+	Warnings []string `form:"warnings,omitempty" json:"warnings,omitempty" xml:"warnings,omitempty"`
+}
+
+// DailyNotFoundResponseBody is the type of the "report" service "daily"
+// endpoint HTTP response body for the "not_found" error.
+type DailyNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// NewDailyResultOK builds a "report" service "daily" endpoint result from a
+// HTTP "OK" response.
+func NewDailyResultOK(contentType string) *report.DailyResult {
+	v := &report.DailyResult{}
+	v.ContentType = contentType
+
+	return v
+}
+
+// NewDailyNotFound builds a report service daily endpoint not_found error.
+func NewDailyNotFound(body *DailyNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGenerateStockbotDailyReportSummaryOK builds a "report" service "generate"
+// endpoint result from a HTTP "OK" response.
+func NewGenerateStockbotDailyReportSummaryOK(body *GenerateResponseBody) *reportviews.StockbotDailyReportSummaryView {
+	v := &reportviews.StockbotDailyReportSummaryView{
+		Date:                 body.Date,
+		GeneratedAt:          body.GeneratedAt,
+		SignalsConsumed:      body.SignalsConsumed,
+		OrdersPlaced:         body.OrdersPlaced,
+		OrdersRejected:       body.OrdersRejected,
+		Fills:                body.Fills,
+		RiskEvents:           body.RiskEvents,
+		RealizedProfitLoss:   body.RealizedProfitLoss,
+		UnrealizedProfitLoss: body.UnrealizedProfitLoss,
+	}
+	v.Warnings = make([]string, len(body.Warnings))
+	for i, val := range body.Warnings {
+		v.Warnings[i] = val
+	}
+
+	return v
+}
+
+// ValidateDailyNotFoundResponseBody runs the validations defined on
+// daily_not_found_response_body
+func ValidateDailyNotFoundResponseBody(body *DailyNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
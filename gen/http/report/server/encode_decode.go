@@ -0,0 +1,123 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// report HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	report "stock-bot/gen/report"
+	reportviews "stock-bot/gen/report/views"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeDailyResponse returns an encoder for responses returned by the report
+// daily endpoint.
+func EncodeDailyResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*report.DailyResult)
+		w.Header().Set("Content-Type", res.ContentType)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDailyRequest returns a decoder for requests sent to the report daily
+// endpoint.
+func DecodeDailyRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*report.DailyPayload, error) {
+	return func(r *http.Request) (*report.DailyPayload, error) {
+		var (
+			date   string
+			format string
+			err    error
+
+			params = mux.Vars(r)
+		)
+		date = params["date"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("date", date, goa.FormatDate))
+		formatRaw := r.URL.Query().Get("format")
+		if formatRaw != "" {
+			format = formatRaw
+		} else {
+			format = "json"
+		}
+		if !(format == "json" || format == "markdown") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"json", "markdown"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDailyPayload(date, format)
+
+		return payload, nil
+	}
+}
+
+// EncodeDailyError returns an encoder for errors returned by the daily report
+// endpoint.
+func EncodeDailyError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDailyNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGenerateResponse returns an encoder for responses returned by the
+// report generate endpoint.
+func EncodeGenerateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*reportviews.StockbotDailyReportSummary)
+		enc := encoder(ctx, w)
+		body := NewGenerateResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGenerateRequest returns a decoder for requests sent to the report
+// generate endpoint.
+func DecodeGenerateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*report.GeneratePayload, error) {
+	return func(r *http.Request) (*report.GeneratePayload, error) {
+		var (
+			date string
+			err  error
+
+			params = mux.Vars(r)
+		)
+		date = params["date"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("date", date, goa.FormatDate))
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGeneratePayload(date)
+
+		return payload, nil
+	}
+}
@@ -0,0 +1,22 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the report service.
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	"fmt"
+)
+
+// DailyReportPath returns the URL path to the report service daily HTTP endpoint.
+func DailyReportPath(date string) string {
+	return fmt.Sprintf("/reports/daily/%v", date)
+}
+
+// GenerateReportPath returns the URL path to the report service generate HTTP endpoint.
+func GenerateReportPath(date string) string {
+	return fmt.Sprintf("/reports/daily/%v", date)
+}
@@ -0,0 +1,114 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// report HTTP server types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	report "stock-bot/gen/report"
+	reportviews "stock-bot/gen/report/views"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// GenerateResponseBody is the type of the "report" service "generate" endpoint
+// HTTP response body.
+type GenerateResponseBody struct {
+	// レポートの日付 (YYYY-MM-DD, 日本時間)
+	Date string `form:"date" json:"date" xml:"date"`
+	// 作成日時 (RFC3339)
+	GeneratedAt string `form:"generated_at" json:"generated_at" xml:"generated_at"`
+	// エージェントが処理したシグナルの件数
+	SignalsConsumed int `form:"signals_consumed" json:"signals_consumed" xml:"signals_consumed"`
+	// 発注した注文の件数
+	OrdersPlaced int `form:"orders_placed" json:"orders_placed" xml:"orders_placed"`
+	// 拒否された発注の件数
+	OrdersRejected int `form:"orders_rejected" json:"orders_rejected" xml:"orders_rejected"`
+	// 約定の件数
+	Fills int `form:"fills" json:"fills" xml:"fills"`
+	// リスクイベントの件数
+	RiskEvents int `form:"risk_events" json:"risk_events" xml:"risk_events"`
+	// この日に決済した取引の実現損益 (手数料控除後)
+	RealizedProfitLoss float64 `form:"realized_profit_loss" json:"realized_profit_loss" xml:"realized_profit_loss"`
+	// 作成時点の建玉の評価損益 (当日のレポートのみ)
+	UnrealizedProfitLoss *float64 `form:"unrealized_profit_loss,omitempty" json:"unrealized_profit_loss,omitempty" xml:"unrealized_profit_loss,omitempty"`
+	// 取得できずにレポートに含まれていない項目
+	Warnings []string `form:"warnings" json:"warnings" xml:"warnings"`
+}
+
+// DailyNotFoundResponseBody is the type of the "report" service "daily"
+// endpoint HTTP response body for the "not_found" error.
+type DailyNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// NewGenerateResponseBody builds the HTTP response body from the result of the
+// "generate" endpoint of the "report" service.
+func NewGenerateResponseBody(res *reportviews.StockbotDailyReportSummaryView) *GenerateResponseBody {
+	body := &GenerateResponseBody{
+		Date:                 *res.Date,
+		GeneratedAt:          *res.GeneratedAt,
+		SignalsConsumed:      *res.SignalsConsumed,
+		OrdersPlaced:         *res.OrdersPlaced,
+		OrdersRejected:       *res.OrdersRejected,
+		Fills:                *res.Fills,
+		RiskEvents:           *res.RiskEvents,
+		RealizedProfitLoss:   *res.RealizedProfitLoss,
+		UnrealizedProfitLoss: res.UnrealizedProfitLoss,
+	}
+	if res.Warnings != nil {
+		body.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			body.Warnings[i] = val
+		}
+	} else {
+		body.Warnings = []string{}
+	}
+	return body
+}
+
+// NewDailyNotFoundResponseBody builds the HTTP response body from the result
+// of the "daily" endpoint of the "report" service.
+func NewDailyNotFoundResponseBody(res *goa.ServiceError) *DailyNotFoundResponseBody {
+	body := &DailyNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDailyPayload builds a report service daily endpoint payload.
+func NewDailyPayload(date string, format string) *report.DailyPayload {
+	v := &report.DailyPayload{}
+	v.Date = date
+	v.Format = format
+
+	return v
+}
+
+// NewGeneratePayload builds a report service generate endpoint payload.
+func NewGeneratePayload(date string) *report.GeneratePayload {
+	v := &report.GeneratePayload{}
+	v.Date = date
+
+	return v
+}
@@ -0,0 +1,106 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// signal HTTP client CLI support package
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	signal "stock-bot/gen/signal"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreatePayload builds the payload for the signal create endpoint from
+// CLI flags.
+func BuildCreatePayload(signalCreateBody string) (*signal.CreatePayload, error) {
+	var err error
+	var body CreateRequestBody
+	{
+		err = json.Unmarshal([]byte(signalCreateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"generated_at\": \"1979-04-02T13:55:55Z\",\n      \"signals\": [\n         {\n            \"limit_price\": 0.9555864477848275,\n            \"rationale\": \"Tenetur odit reiciendis mollitia et harum doloribus.\",\n            \"side\": \"SELL\",\n            \"stop_price\": 0.5874236835123349,\n            \"symbol\": \"3q9\",\n            \"target_price\": 0.16108655680675593,\n            \"valid_until\": \"1972-05-29T23:01:22Z\",\n            \"weight\": 0.07583907376715615\n         }\n      ]\n   }'")
+		}
+		if body.Signals == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("signals", "body"))
+		}
+		if len(body.Signals) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.signals", body.Signals, len(body.Signals), 1, true))
+		}
+		if len(body.Signals) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.signals", body.Signals, len(body.Signals), 1000, false))
+		}
+		for _, e := range body.Signals {
+			if e != nil {
+				if err2 := ValidateSignalInputRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if body.GeneratedAt != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.generated_at", *body.GeneratedAt, goa.FormatDateTime))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &signal.CreatePayload{
+		GeneratedAt: body.GeneratedAt,
+	}
+	if body.Signals != nil {
+		v.Signals = make([]*signal.SignalInput, len(body.Signals))
+		for i, val := range body.Signals {
+			if val == nil {
+				v.Signals[i] = nil
+				continue
+			}
+			v.Signals[i] = marshalSignalInputRequestBodyToSignalSignalInput(val)
+		}
+	} else {
+		v.Signals = []*signal.SignalInput{}
+	}
+
+	return v, nil
+}
+
+// BuildListPayload builds the payload for the signal list endpoint from CLI
+// flags.
+func BuildListPayload(signalListSymbol string, signalListLimit string) (*signal.ListPayload, error) {
+	var err error
+	var symbol *string
+	{
+		if signalListSymbol != "" {
+			symbol = &signalListSymbol
+		}
+	}
+	var limit int
+	{
+		if signalListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(signalListLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &signal.ListPayload{}
+	v.Symbol = symbol
+	v.Limit = limit
+
+	return v, nil
+}
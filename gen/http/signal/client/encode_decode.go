@@ -0,0 +1,239 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// signal HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	signal "stock-bot/gen/signal"
+	signalviews "stock-bot/gen/signal/views"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildCreateRequest instantiates a HTTP request object with method and path
+// set to call the "signal" service "create" endpoint
+func (c *Client) BuildCreateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateSignalPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("signal", "create", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateRequest returns an encoder for requests sent to the signal
+// create server.
+func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*signal.CreatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("signal", "create", "*signal.CreatePayload", v)
+		}
+		body := NewCreateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("signal", "create", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateResponse returns a decoder for responses returned by the signal
+// create endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("signal", "create", err)
+			}
+			p := NewCreateStockbotSignalIngestCreated(&body)
+			view := "default"
+			vres := &signalviews.StockbotSignalIngest{Projected: p, View: view}
+			if err = signalviews.ValidateStockbotSignalIngest(vres); err != nil {
+				return nil, goahttp.ErrValidationError("signal", "create", err)
+			}
+			res := signal.NewStockbotSignalIngest(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("signal", "create", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "signal" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListSignalPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("signal", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the signal list
+// server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*signal.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("signal", "list", "*signal.ListPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Symbol != nil {
+			values.Add("symbol", *p.Symbol)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the signal
+// list endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("signal", "list", err)
+			}
+			p := NewListStockbotSignalCollectionOK(&body)
+			view := "default"
+			vres := &signalviews.StockbotSignalCollection{Projected: p, View: view}
+			if err = signalviews.ValidateStockbotSignalCollection(vres); err != nil {
+				return nil, goahttp.ErrValidationError("signal", "list", err)
+			}
+			res := signal.NewStockbotSignalCollection(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("signal", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// marshalSignalSignalInputToSignalInputRequestBody builds a value of type
+// *SignalInputRequestBody from a value of type *signal.SignalInput.
+func marshalSignalSignalInputToSignalInputRequestBody(v *signal.SignalInput) *SignalInputRequestBody {
+	res := &SignalInputRequestBody{
+		Symbol:      v.Symbol,
+		Side:        v.Side,
+		LimitPrice:  v.LimitPrice,
+		StopPrice:   v.StopPrice,
+		TargetPrice: v.TargetPrice,
+		Weight:      v.Weight,
+		ValidUntil:  v.ValidUntil,
+		Rationale:   v.Rationale,
+	}
+
+	return res
+}
+
+// marshalSignalInputRequestBodyToSignalSignalInput builds a value of type
+// *signal.SignalInput from a value of type *SignalInputRequestBody.
+func marshalSignalInputRequestBodyToSignalSignalInput(v *SignalInputRequestBody) *signal.SignalInput {
+	res := &signal.SignalInput{
+		Symbol:      v.Symbol,
+		Side:        v.Side,
+		LimitPrice:  v.LimitPrice,
+		StopPrice:   v.StopPrice,
+		TargetPrice: v.TargetPrice,
+		Weight:      v.Weight,
+		ValidUntil:  v.ValidUntil,
+		Rationale:   v.Rationale,
+	}
+
+	return res
+}
+
+// unmarshalSignalRejectionResponseBodyToSignalviewsSignalRejectionView builds
+// a value of type *signalviews.SignalRejectionView from a value of type
+// *SignalRejectionResponseBody.
+func unmarshalSignalRejectionResponseBodyToSignalviewsSignalRejectionView(v *SignalRejectionResponseBody) *signalviews.SignalRejectionView {
+	res := &signalviews.SignalRejectionView{
+		Index:  v.Index,
+		Symbol: v.Symbol,
+		Reason: v.Reason,
+	}
+
+	return res
+}
+
+// unmarshalSignalResultResponseBodyToSignalviewsSignalResultView builds a
+// value of type *signalviews.SignalResultView from a value of type
+// *SignalResultResponseBody.
+func unmarshalSignalResultResponseBodyToSignalviewsSignalResultView(v *SignalResultResponseBody) *signalviews.SignalResultView {
+	res := &signalviews.SignalResultView{
+		ID:          v.ID,
+		Symbol:      v.Symbol,
+		Side:        v.Side,
+		GeneratedAt: v.GeneratedAt,
+		Rationale:   v.Rationale,
+		LimitPrice:  v.LimitPrice,
+		StopPrice:   v.StopPrice,
+		TargetPrice: v.TargetPrice,
+		Weight:      v.Weight,
+		ValidUntil:  v.ValidUntil,
+		Source:      v.Source,
+		SourceFile:  v.SourceFile,
+		ConsumedAt:  v.ConsumedAt,
+	}
+
+	return res
+}
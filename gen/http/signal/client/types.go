@@ -0,0 +1,241 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// signal HTTP client types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	signal "stock-bot/gen/signal"
+	signalviews "stock-bot/gen/signal/views"
+	"unicode/utf8"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "signal" service "create" endpoint HTTP
+// request body.
+type CreateRequestBody struct {
+	// シグナルのリスト
+	Signals []*SignalInputRequestBody `form:"signals" json:"signals" xml:"signals"`
+	// シグナル生成日時 (RFC3339, 省略時は受信日時)
+	GeneratedAt *string `form:"generated_at,omitempty" json:"generated_at,omitempty" xml:"generated_at,omitempty"`
+}
+
+// CreateResponseBody is the type of the "signal" service "create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// 受け付けたシグナル数
+	Accepted *int `form:"accepted,omitempty" json:"accepted,omitempty" xml:"accepted,omitempty"`
+	// 受け付けたシグナルのID
+	SignalIds []uint `form:"signal_ids,omitempty" json:"signal_ids,omitempty" xml:"signal_ids,omitempty"`
+	// 却下されたシグナル
+	Rejected []*SignalRejectionResponseBody `form:"rejected,omitempty" json:"rejected,omitempty" xml:"rejected,omitempty"`
+}
+
+// ListResponseBody is the type of the "signal" service "list" endpoint HTTP
+// response body.
+type ListResponseBody struct {
+	// シグナルのリスト
+	Signals []*SignalResultResponseBody `form:"signals,omitempty" json:"signals,omitempty" xml:"signals,omitempty"`
+}
+
+// SignalInputRequestBody is used to define fields on request body types.
+type SignalInputRequestBody struct {
+	// 銘柄コード
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// 売買区分 (BUY/SELL)
+	Side string `form:"side" json:"side" xml:"side"`
+	// 指値 (省略時は成行)
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// 損切り価格
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// 利確目標価格
+	TargetPrice *float64 `form:"target_price,omitempty" json:"target_price,omitempty" xml:"target_price,omitempty"`
+	// 資金配分の重み (0〜1、省略時は1)
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// 有効期限 (RFC3339)
+	ValidUntil *string `form:"valid_until,omitempty" json:"valid_until,omitempty" xml:"valid_until,omitempty"`
+	// シグナルの根拠
+	Rationale *string `form:"rationale,omitempty" json:"rationale,omitempty" xml:"rationale,omitempty"`
+}
+
+// SignalRejectionResponseBody is used to define fields on response body types.
+type SignalRejectionResponseBody struct {
+	// リクエスト内での位置 (0始まり)
+	Index *int `form:"index,omitempty" json:"index,omitempty" xml:"index,omitempty"`
+	// 銘柄コード
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// 却下理由
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// SignalResultResponseBody is used to define fields on response body types.
+type SignalResultResponseBody struct {
+	// シグナルID
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// 銘柄コード
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// 売買区分 (BUY/SELL)
+	Side *string `form:"side,omitempty" json:"side,omitempty" xml:"side,omitempty"`
+	// シグナル生成日時 (RFC3339)
+	GeneratedAt *string `form:"generated_at,omitempty" json:"generated_at,omitempty" xml:"generated_at,omitempty"`
+	// シグナルの根拠
+	Rationale *string `form:"rationale,omitempty" json:"rationale,omitempty" xml:"rationale,omitempty"`
+	// 指値
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// 損切り価格
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// 利確目標価格
+	TargetPrice *float64 `form:"target_price,omitempty" json:"target_price,omitempty" xml:"target_price,omitempty"`
+	// 資金配分の重み
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// 有効期限 (RFC3339)
+	ValidUntil *string `form:"valid_until,omitempty" json:"valid_until,omitempty" xml:"valid_until,omitempty"`
+	// 取り込み元 (FILE/HTTP)
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// 取り込み元ファイル
+	SourceFile *string `form:"source_file,omitempty" json:"source_file,omitempty" xml:"source_file,omitempty"`
+	// エージェントが処理した日時 (RFC3339)
+	ConsumedAt *string `form:"consumed_at,omitempty" json:"consumed_at,omitempty" xml:"consumed_at,omitempty"`
+}
+
+// NewCreateRequestBody builds the HTTP request body from the payload of the
+// "create" endpoint of the "signal" service.
+func NewCreateRequestBody(p *signal.CreatePayload) *CreateRequestBody {
+	body := &CreateRequestBody{
+		GeneratedAt: p.GeneratedAt,
+	}
+	if p.Signals != nil {
+		body.Signals = make([]*SignalInputRequestBody, len(p.Signals))
+		for i, val := range p.Signals {
+			if val == nil {
+				body.Signals[i] = nil
+				continue
+			}
+			body.Signals[i] = marshalSignalSignalInputToSignalInputRequestBody(val)
+		}
+	} else {
+		body.Signals = []*SignalInputRequestBody{}
+	}
+	return body
+}
+
+// NewCreateStockbotSignalIngestCreated builds a "signal" service "create"
+// endpoint result from a HTTP "Created" response.
+func NewCreateStockbotSignalIngestCreated(body *CreateResponseBody) *signalviews.StockbotSignalIngestView {
+	v := &signalviews.StockbotSignalIngestView{
+		Accepted: body.Accepted,
+	}
+	v.SignalIds = make([]uint, len(body.SignalIds))
+	for i, val := range body.SignalIds {
+		v.SignalIds[i] = val
+	}
+	v.Rejected = make([]*signalviews.SignalRejectionView, len(body.Rejected))
+	for i, val := range body.Rejected {
+		if val == nil {
+			v.Rejected[i] = nil
+			continue
+		}
+		v.Rejected[i] = unmarshalSignalRejectionResponseBodyToSignalviewsSignalRejectionView(val)
+	}
+
+	return v
+}
+
+// NewListStockbotSignalCollectionOK builds a "signal" service "list" endpoint
+// result from a HTTP "OK" response.
+func NewListStockbotSignalCollectionOK(body *ListResponseBody) *signalviews.StockbotSignalCollectionView {
+	v := &signalviews.StockbotSignalCollectionView{}
+	v.Signals = make([]*signalviews.SignalResultView, len(body.Signals))
+	for i, val := range body.Signals {
+		if val == nil {
+			v.Signals[i] = nil
+			continue
+		}
+		v.Signals[i] = unmarshalSignalResultResponseBodyToSignalviewsSignalResultView(val)
+	}
+
+	return v
+}
+
+// ValidateSignalInputRequestBody runs the validations defined on
+// SignalInputRequestBody
+func ValidateSignalInputRequestBody(body *SignalInputRequestBody) (err error) {
+	if utf8.RuneCountInString(body.Symbol) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.symbol", body.Symbol, utf8.RuneCountInString(body.Symbol), 1, true))
+	}
+	if utf8.RuneCountInString(body.Symbol) > 16 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.symbol", body.Symbol, utf8.RuneCountInString(body.Symbol), 16, false))
+	}
+	if !(body.Side == "BUY" || body.Side == "SELL") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.side", body.Side, []any{"BUY", "SELL"}))
+	}
+	if body.LimitPrice != nil {
+		if *body.LimitPrice < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit_price", *body.LimitPrice, 0, true))
+		}
+	}
+	if body.StopPrice != nil {
+		if *body.StopPrice < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.stop_price", *body.StopPrice, 0, true))
+		}
+	}
+	if body.TargetPrice != nil {
+		if *body.TargetPrice < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.target_price", *body.TargetPrice, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, false))
+		}
+	}
+	if body.ValidUntil != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.valid_until", *body.ValidUntil, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSignalRejectionResponseBody runs the validations defined on
+// SignalRejectionResponseBody
+func ValidateSignalRejectionResponseBody(body *SignalRejectionResponseBody) (err error) {
+	if body.Index == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("index", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	return
+}
+
+// ValidateSignalResultResponseBody runs the validations defined on
+// SignalResultResponseBody
+func ValidateSignalResultResponseBody(body *SignalResultResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Side == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("side", "body"))
+	}
+	if body.GeneratedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("generated_at", "body"))
+	}
+	if body.Source == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("source", "body"))
+	}
+	return
+}
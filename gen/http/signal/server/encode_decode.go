@@ -0,0 +1,168 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// signal HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	signal "stock-bot/gen/signal"
+	signalviews "stock-bot/gen/signal/views"
+	"strconv"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeCreateResponse returns an encoder for responses returned by the signal
+// create endpoint.
+func EncodeCreateResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*signalviews.StockbotSignalIngest)
+		enc := encoder(ctx, w)
+		body := NewCreateResponseBody(res.Projected)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateRequest returns a decoder for requests sent to the signal create
+// endpoint.
+func DecodeCreateRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*signal.CreatePayload, error) {
+	return func(r *http.Request) (*signal.CreatePayload, error) {
+		var (
+			body CreateRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreatePayload(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeListResponse returns an encoder for responses returned by the signal
+// list endpoint.
+func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*signalviews.StockbotSignalCollection)
+		enc := encoder(ctx, w)
+		body := NewListResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListRequest returns a decoder for requests sent to the signal list
+// endpoint.
+func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*signal.ListPayload, error) {
+	return func(r *http.Request) (*signal.ListPayload, error) {
+		var (
+			symbol *string
+			limit  int
+			err    error
+		)
+		qp := r.URL.Query()
+		symbolRaw := qp.Get("symbol")
+		if symbolRaw != "" {
+			symbol = &symbolRaw
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 100
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListPayload(symbol, limit)
+
+		return payload, nil
+	}
+}
+
+// unmarshalSignalInputRequestBodyToSignalSignalInput builds a value of type
+// *signal.SignalInput from a value of type *SignalInputRequestBody.
+func unmarshalSignalInputRequestBodyToSignalSignalInput(v *SignalInputRequestBody) *signal.SignalInput {
+	res := &signal.SignalInput{
+		Symbol:      *v.Symbol,
+		Side:        *v.Side,
+		LimitPrice:  v.LimitPrice,
+		StopPrice:   v.StopPrice,
+		TargetPrice: v.TargetPrice,
+		Weight:      v.Weight,
+		ValidUntil:  v.ValidUntil,
+		Rationale:   v.Rationale,
+	}
+
+	return res
+}
+
+// marshalSignalviewsSignalRejectionViewToSignalRejectionResponseBody builds a
+// value of type *SignalRejectionResponseBody from a value of type
+// *signalviews.SignalRejectionView.
+func marshalSignalviewsSignalRejectionViewToSignalRejectionResponseBody(v *signalviews.SignalRejectionView) *SignalRejectionResponseBody {
+	res := &SignalRejectionResponseBody{
+		Index:  *v.Index,
+		Symbol: *v.Symbol,
+		Reason: *v.Reason,
+	}
+
+	return res
+}
+
+// marshalSignalviewsSignalResultViewToSignalResultResponseBody builds a value
+// of type *SignalResultResponseBody from a value of type
+// *signalviews.SignalResultView.
+func marshalSignalviewsSignalResultViewToSignalResultResponseBody(v *signalviews.SignalResultView) *SignalResultResponseBody {
+	res := &SignalResultResponseBody{
+		ID:          *v.ID,
+		Symbol:      *v.Symbol,
+		Side:        *v.Side,
+		GeneratedAt: *v.GeneratedAt,
+		Rationale:   v.Rationale,
+		LimitPrice:  v.LimitPrice,
+		StopPrice:   v.StopPrice,
+		TargetPrice: v.TargetPrice,
+		Weight:      v.Weight,
+		ValidUntil:  v.ValidUntil,
+		Source:      *v.Source,
+		SourceFile:  v.SourceFile,
+		ConsumedAt:  v.ConsumedAt,
+	}
+
+	return res
+}
@@ -0,0 +1,18 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the signal service.
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+// CreateSignalPath returns the URL path to the signal service create HTTP endpoint.
+func CreateSignalPath() string {
+	return "/signals"
+}
+
+// ListSignalPath returns the URL path to the signal service list HTTP endpoint.
+func ListSignalPath() string {
+	return "/signals"
+}
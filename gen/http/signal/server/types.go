@@ -0,0 +1,256 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// signal HTTP server types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	signal "stock-bot/gen/signal"
+	signalviews "stock-bot/gen/signal/views"
+	"unicode/utf8"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateRequestBody is the type of the "signal" service "create" endpoint HTTP
+// request body.
+type CreateRequestBody struct {
+	// シグナルのリスト
+	Signals []*SignalInputRequestBody `form:"signals,omitempty" json:"signals,omitempty" xml:"signals,omitempty"`
+	// シグナル生成日時 (RFC3339, 省略時は受信日時)
+	GeneratedAt *string `form:"generated_at,omitempty" json:"generated_at,omitempty" xml:"generated_at,omitempty"`
+}
+
+// CreateResponseBody is the type of the "signal" service "create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// 受け付けたシグナル数
+	Accepted int `form:"accepted" json:"accepted" xml:"accepted"`
+	// 受け付けたシグナルのID
+	SignalIds []uint `form:"signal_ids" json:"signal_ids" xml:"signal_ids"`
+	// 却下されたシグナル
+	Rejected []*SignalRejectionResponseBody `form:"rejected" json:"rejected" xml:"rejected"`
+}
+
+// ListResponseBody is the type of the "signal" service "list" endpoint HTTP
+// response body.
+type ListResponseBody struct {
+	// シグナルのリスト
+	Signals []*SignalResultResponseBody `form:"signals" json:"signals" xml:"signals"`
+}
+
+// SignalRejectionResponseBody is used to define fields on response body types.
+type SignalRejectionResponseBody struct {
+	// リクエスト内での位置 (0始まり)
+	Index int `form:"index" json:"index" xml:"index"`
+	// 銘柄コード
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// 却下理由
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+}
+
+// SignalResultResponseBody is used to define fields on response body types.
+type SignalResultResponseBody struct {
+	// シグナルID
+	ID uint `form:"id" json:"id" xml:"id"`
+	// 銘柄コード
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// 売買区分 (BUY/SELL)
+	Side string `form:"side" json:"side" xml:"side"`
+	// シグナル生成日時 (RFC3339)
+	GeneratedAt string `form:"generated_at" json:"generated_at" xml:"generated_at"`
+	// シグナルの根拠
+	Rationale *string `form:"rationale,omitempty" json:"rationale,omitempty" xml:"rationale,omitempty"`
+	// 指値
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// 損切り価格
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// 利確目標価格
+	TargetPrice *float64 `form:"target_price,omitempty" json:"target_price,omitempty" xml:"target_price,omitempty"`
+	// 資金配分の重み
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// 有効期限 (RFC3339)
+	ValidUntil *string `form:"valid_until,omitempty" json:"valid_until,omitempty" xml:"valid_until,omitempty"`
+	// 取り込み元 (FILE/HTTP)
+	Source string `form:"source" json:"source" xml:"source"`
+	// 取り込み元ファイル
+	SourceFile *string `form:"source_file,omitempty" json:"source_file,omitempty" xml:"source_file,omitempty"`
+	// エージェントが処理した日時 (RFC3339)
+	ConsumedAt *string `form:"consumed_at,omitempty" json:"consumed_at,omitempty" xml:"consumed_at,omitempty"`
+}
+
+// SignalInputRequestBody is used to define fields on request body types.
+type SignalInputRequestBody struct {
+	// 銘柄コード
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// 売買区分 (BUY/SELL)
+	Side *string `form:"side,omitempty" json:"side,omitempty" xml:"side,omitempty"`
+	// 指値 (省略時は成行)
+	LimitPrice *float64 `form:"limit_price,omitempty" json:"limit_price,omitempty" xml:"limit_price,omitempty"`
+	// 損切り価格
+	StopPrice *float64 `form:"stop_price,omitempty" json:"stop_price,omitempty" xml:"stop_price,omitempty"`
+	// 利確目標価格
+	TargetPrice *float64 `form:"target_price,omitempty" json:"target_price,omitempty" xml:"target_price,omitempty"`
+	// 資金配分の重み (0〜1、省略時は1)
+	Weight *float64 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+	// 有効期限 (RFC3339)
+	ValidUntil *string `form:"valid_until,omitempty" json:"valid_until,omitempty" xml:"valid_until,omitempty"`
+	// シグナルの根拠
+	Rationale *string `form:"rationale,omitempty" json:"rationale,omitempty" xml:"rationale,omitempty"`
+}
+
+// NewCreateResponseBody builds the HTTP response body from the result of the
+// "create" endpoint of the "signal" service.
+func NewCreateResponseBody(res *signalviews.StockbotSignalIngestView) *CreateResponseBody {
+	body := &CreateResponseBody{
+		Accepted: *res.Accepted,
+	}
+	if res.SignalIds != nil {
+		body.SignalIds = make([]uint, len(res.SignalIds))
+		for i, val := range res.SignalIds {
+			body.SignalIds[i] = val
+		}
+	} else {
+		body.SignalIds = []uint{}
+	}
+	if res.Rejected != nil {
+		body.Rejected = make([]*SignalRejectionResponseBody, len(res.Rejected))
+		for i, val := range res.Rejected {
+			if val == nil {
+				body.Rejected[i] = nil
+				continue
+			}
+			body.Rejected[i] = marshalSignalviewsSignalRejectionViewToSignalRejectionResponseBody(val)
+		}
+	} else {
+		body.Rejected = []*SignalRejectionResponseBody{}
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "signal" service.
+func NewListResponseBody(res *signalviews.StockbotSignalCollectionView) *ListResponseBody {
+	body := &ListResponseBody{}
+	if res.Signals != nil {
+		body.Signals = make([]*SignalResultResponseBody, len(res.Signals))
+		for i, val := range res.Signals {
+			if val == nil {
+				body.Signals[i] = nil
+				continue
+			}
+			body.Signals[i] = marshalSignalviewsSignalResultViewToSignalResultResponseBody(val)
+		}
+	} else {
+		body.Signals = []*SignalResultResponseBody{}
+	}
+	return body
+}
+
+// NewCreatePayload builds a signal service create endpoint payload.
+func NewCreatePayload(body *CreateRequestBody) *signal.CreatePayload {
+	v := &signal.CreatePayload{
+		GeneratedAt: body.GeneratedAt,
+	}
+	v.Signals = make([]*signal.SignalInput, len(body.Signals))
+	for i, val := range body.Signals {
+		if val == nil {
+			v.Signals[i] = nil
+			continue
+		}
+		v.Signals[i] = unmarshalSignalInputRequestBodyToSignalSignalInput(val)
+	}
+
+	return v
+}
+
+// NewListPayload builds a signal service list endpoint payload.
+func NewListPayload(symbol *string, limit int) *signal.ListPayload {
+	v := &signal.ListPayload{}
+	v.Symbol = symbol
+	v.Limit = limit
+
+	return v
+}
+
+// ValidateCreateRequestBody runs the validations defined on CreateRequestBody
+func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
+	if body.Signals == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("signals", "body"))
+	}
+	if len(body.Signals) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.signals", body.Signals, len(body.Signals), 1, true))
+	}
+	if len(body.Signals) > 1000 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.signals", body.Signals, len(body.Signals), 1000, false))
+	}
+	for _, e := range body.Signals {
+		if e != nil {
+			if err2 := ValidateSignalInputRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.GeneratedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.generated_at", *body.GeneratedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSignalInputRequestBody runs the validations defined on
+// SignalInputRequestBody
+func ValidateSignalInputRequestBody(body *SignalInputRequestBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.Side == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("side", "body"))
+	}
+	if body.Symbol != nil {
+		if utf8.RuneCountInString(*body.Symbol) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.symbol", *body.Symbol, utf8.RuneCountInString(*body.Symbol), 1, true))
+		}
+	}
+	if body.Symbol != nil {
+		if utf8.RuneCountInString(*body.Symbol) > 16 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.symbol", *body.Symbol, utf8.RuneCountInString(*body.Symbol), 16, false))
+		}
+	}
+	if body.Side != nil {
+		if !(*body.Side == "BUY" || *body.Side == "SELL") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.side", *body.Side, []any{"BUY", "SELL"}))
+		}
+	}
+	if body.LimitPrice != nil {
+		if *body.LimitPrice < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit_price", *body.LimitPrice, 0, true))
+		}
+	}
+	if body.StopPrice != nil {
+		if *body.StopPrice < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.stop_price", *body.StopPrice, 0, true))
+		}
+	}
+	if body.TargetPrice != nil {
+		if *body.TargetPrice < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.target_price", *body.TargetPrice, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, false))
+		}
+	}
+	if body.ValidUntil != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.valid_until", *body.ValidUntil, goa.FormatDateTime))
+	}
+	return
+}
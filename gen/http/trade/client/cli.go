@@ -0,0 +1,132 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// trade HTTP client CLI support package
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"fmt"
+	trade "stock-bot/gen/trade"
+	"strconv"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListPayload builds the payload for the trade list endpoint from CLI
+// flags.
+func BuildListPayload(tradeListSymbol string, tradeListMethod string, tradeListSince string, tradeListUntil string, tradeListLimit string) (*trade.ListPayload, error) {
+	var err error
+	var symbol *string
+	{
+		if tradeListSymbol != "" {
+			symbol = &tradeListSymbol
+		}
+	}
+	var method string
+	{
+		if tradeListMethod != "" {
+			method = tradeListMethod
+			if !(method == "FIFO" || method == "AVERAGE") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("method", method, []any{"FIFO", "AVERAGE"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var since *string
+	{
+		if tradeListSince != "" {
+			since = &tradeListSince
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var until *string
+	{
+		if tradeListUntil != "" {
+			until = &tradeListUntil
+			err = goa.MergeErrors(err, goa.ValidateFormat("until", *until, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if tradeListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(tradeListLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &trade.ListPayload{}
+	v.Symbol = symbol
+	v.Method = method
+	v.Since = since
+	v.Until = until
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildDailyPnlPayload builds the payload for the trade daily_pnl endpoint
+// from CLI flags.
+func BuildDailyPnlPayload(tradeDailyPnlMethod string, tradeDailyPnlFrom string, tradeDailyPnlTo string) (*trade.DailyPnlPayload, error) {
+	var err error
+	var method string
+	{
+		if tradeDailyPnlMethod != "" {
+			method = tradeDailyPnlMethod
+			if !(method == "FIFO" || method == "AVERAGE") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("method", method, []any{"FIFO", "AVERAGE"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var from *string
+	{
+		if tradeDailyPnlFrom != "" {
+			from = &tradeDailyPnlFrom
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var to *string
+	{
+		if tradeDailyPnlTo != "" {
+			to = &tradeDailyPnlTo
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDate))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &trade.DailyPnlPayload{}
+	v.Method = method
+	v.From = from
+	v.To = to
+
+	return v, nil
+}
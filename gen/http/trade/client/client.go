@@ -0,0 +1,126 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// trade client HTTP transport
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the trade service endpoint HTTP clients.
+type Client struct {
+	// List Doer is the HTTP client used to make requests to the list endpoint.
+	ListDoer goahttp.Doer
+
+	// DailyPnl Doer is the HTTP client used to make requests to the daily_pnl
+	// endpoint.
+	DailyPnlDoer goahttp.Doer
+
+	// Sync Doer is the HTTP client used to make requests to the sync endpoint.
+	SyncDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the trade service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		ListDoer:            doer,
+		DailyPnlDoer:        doer,
+		SyncDoer:            doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// List returns an endpoint that makes HTTP requests to the trade service list
+// server.
+func (c *Client) List() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListRequest(c.encoder)
+		decodeResponse = DecodeListResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("trade", "list", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DailyPnl returns an endpoint that makes HTTP requests to the trade service
+// daily_pnl server.
+func (c *Client) DailyPnl() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDailyPnlRequest(c.encoder)
+		decodeResponse = DecodeDailyPnlResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDailyPnlRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DailyPnlDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("trade", "daily_pnl", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Sync returns an endpoint that makes HTTP requests to the trade service sync
+// server.
+func (c *Client) Sync() goa.Endpoint {
+	var (
+		decodeResponse = DecodeSyncResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSyncRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SyncDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("trade", "sync", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,278 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// trade HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	trade "stock-bot/gen/trade"
+	tradeviews "stock-bot/gen/trade/views"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "trade" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListTradePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("trade", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the trade list
+// server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*trade.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("trade", "list", "*trade.ListPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Symbol != nil {
+			values.Add("symbol", *p.Symbol)
+		}
+		values.Add("method", p.Method)
+		if p.Since != nil {
+			values.Add("since", *p.Since)
+		}
+		if p.Until != nil {
+			values.Add("until", *p.Until)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the trade
+// list endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("trade", "list", err)
+			}
+			p := NewListStockbotTradeCollectionOK(&body)
+			view := "default"
+			vres := &tradeviews.StockbotTradeCollection{Projected: p, View: view}
+			if err = tradeviews.ValidateStockbotTradeCollection(vres); err != nil {
+				return nil, goahttp.ErrValidationError("trade", "list", err)
+			}
+			res := trade.NewStockbotTradeCollection(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("trade", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDailyPnlRequest instantiates a HTTP request object with method and path
+// set to call the "trade" service "daily_pnl" endpoint
+func (c *Client) BuildDailyPnlRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DailyPnlTradePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("trade", "daily_pnl", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDailyPnlRequest returns an encoder for requests sent to the trade
+// daily_pnl server.
+func EncodeDailyPnlRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*trade.DailyPnlPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("trade", "daily_pnl", "*trade.DailyPnlPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("method", p.Method)
+		if p.From != nil {
+			values.Add("from", *p.From)
+		}
+		if p.To != nil {
+			values.Add("to", *p.To)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDailyPnlResponse returns a decoder for responses returned by the trade
+// daily_pnl endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeDailyPnlResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DailyPnlResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("trade", "daily_pnl", err)
+			}
+			p := NewDailyPnlStockbotDailyPnlCollectionOK(&body)
+			view := "default"
+			vres := &tradeviews.StockbotDailyPnlCollection{Projected: p, View: view}
+			if err = tradeviews.ValidateStockbotDailyPnlCollection(vres); err != nil {
+				return nil, goahttp.ErrValidationError("trade", "daily_pnl", err)
+			}
+			res := trade.NewStockbotDailyPnlCollection(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("trade", "daily_pnl", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSyncRequest instantiates a HTTP request object with method and path set
+// to call the "trade" service "sync" endpoint
+func (c *Client) BuildSyncRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SyncTradePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("trade", "sync", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeSyncResponse returns a decoder for responses returned by the trade
+// sync endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeSyncResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SyncResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("trade", "sync", err)
+			}
+			p := NewSyncStockbotExecutionSyncOK(&body)
+			view := "default"
+			vres := &tradeviews.StockbotExecutionSync{Projected: p, View: view}
+			if err = tradeviews.ValidateStockbotExecutionSync(vres); err != nil {
+				return nil, goahttp.ErrValidationError("trade", "sync", err)
+			}
+			res := trade.NewStockbotExecutionSync(vres)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("trade", "sync", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalTradeResultResponseBodyToTradeviewsTradeResultView builds a value
+// of type *tradeviews.TradeResultView from a value of type
+// *TradeResultResponseBody.
+func unmarshalTradeResultResponseBodyToTradeviewsTradeResultView(v *TradeResultResponseBody) *tradeviews.TradeResultView {
+	res := &tradeviews.TradeResultView{
+		Symbol:          v.Symbol,
+		IsMargin:        v.IsMargin,
+		Side:            v.Side,
+		Quantity:        v.Quantity,
+		EntryOrderID:    v.EntryOrderID,
+		ExitOrderID:     v.ExitOrderID,
+		EntryTime:       v.EntryTime,
+		ExitTime:        v.ExitTime,
+		EntryPrice:      v.EntryPrice,
+		ExitPrice:       v.ExitPrice,
+		Commission:      v.Commission,
+		GrossProfitLoss: v.GrossProfitLoss,
+		NetProfitLoss:   v.NetProfitLoss,
+	}
+
+	return res
+}
+
+// unmarshalDailyProfitLossResultResponseBodyToTradeviewsDailyProfitLossResultView
+// builds a value of type *tradeviews.DailyProfitLossResultView from a value of
+// type *DailyProfitLossResultResponseBody.
+func unmarshalDailyProfitLossResultResponseBodyToTradeviewsDailyProfitLossResultView(v *DailyProfitLossResultResponseBody) *tradeviews.DailyProfitLossResultView {
+	res := &tradeviews.DailyProfitLossResultView{
+		Date:            v.Date,
+		Trades:          v.Trades,
+		Wins:            v.Wins,
+		Losses:          v.Losses,
+		GrossProfitLoss: v.GrossProfitLoss,
+		Commission:      v.Commission,
+		NetProfitLoss:   v.NetProfitLoss,
+	}
+
+	return res
+}
@@ -0,0 +1,23 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the trade service.
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+// ListTradePath returns the URL path to the trade service list HTTP endpoint.
+func ListTradePath() string {
+	return "/trades"
+}
+
+// DailyPnlTradePath returns the URL path to the trade service daily_pnl HTTP endpoint.
+func DailyPnlTradePath() string {
+	return "/pnl/daily"
+}
+
+// SyncTradePath returns the URL path to the trade service sync HTTP endpoint.
+func SyncTradePath() string {
+	return "/trades/sync"
+}
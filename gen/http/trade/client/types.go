@@ -0,0 +1,218 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// trade HTTP client types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package client
+
+import (
+	tradeviews "stock-bot/gen/trade/views"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// ListResponseBody is the type of the "trade" service "list" endpoint HTTP
+// response body.
+type ListResponseBody struct {
+	// 往復取引のリスト
+	Trades []*TradeResultResponseBody `form:"trades,omitempty" json:"trades,omitempty" xml:"trades,omitempty"`
+}
+
+// DailyPnlResponseBody is the type of the "trade" service "daily_pnl" endpoint
+// HTTP response body.
+type DailyPnlResponseBody struct {
+	// 日ごとの実現損益
+	Days []*DailyProfitLossResultResponseBody `form:"days,omitempty" json:"days,omitempty" xml:"days,omitempty"`
+}
+
+// SyncResponseBody is the type of the "trade" service "sync" endpoint HTTP
+// response body.
+type SyncResponseBody struct {
+	// 約定のあった注文の件数
+	Orders *int `form:"orders,omitempty" json:"orders,omitempty" xml:"orders,omitempty"`
+	// ボット以外から発注され、新たに保存した注文の件数
+	Imported *int `form:"imported,omitempty" json:"imported,omitempty" xml:"imported,omitempty"`
+	// 保存した約定の件数
+	Executions *int `form:"executions,omitempty" json:"executions,omitempty" xml:"executions,omitempty"`
+	// 約定から作成し直したポジション台帳の未決済の建玉 (ロット) の件数
+	Lots *int `form:"lots,omitempty" json:"lots,omitempty" xml:"lots,omitempty"`
+	// 証券会社で取消・失効・受付エラーになり、完了にした注文の件数 (注文一覧にない前日以前の注文の失効を含む)
+	Closed *int `form:"closed,omitempty" json:"closed,omitempty" xml:"closed,omitempty"`
+	// 約定があるものの、内容を変換できずに保存しなかった注文の件数
+	Skipped *int `form:"skipped,omitempty" json:"skipped,omitempty" xml:"skipped,omitempty"`
+}
+
+// TradeResultResponseBody is used to define fields on response body types.
+type TradeResultResponseBody struct {
+	// 銘柄コード
+	Symbol *string `form:"symbol,omitempty" json:"symbol,omitempty" xml:"symbol,omitempty"`
+	// 信用取引の場合は true
+	IsMargin *bool `form:"is_margin,omitempty" json:"is_margin,omitempty" xml:"is_margin,omitempty"`
+	// 方向 (LONG: 買い→売り, SHORT: 売建→買戻し)
+	Side *string `form:"side,omitempty" json:"side,omitempty" xml:"side,omitempty"`
+	// 数量
+	Quantity *int `form:"quantity,omitempty" json:"quantity,omitempty" xml:"quantity,omitempty"`
+	// 建てた注文のID (移動平均法では最初に建てた注文)
+	EntryOrderID *string `form:"entry_order_id,omitempty" json:"entry_order_id,omitempty" xml:"entry_order_id,omitempty"`
+	// 決済した注文のID
+	ExitOrderID *string `form:"exit_order_id,omitempty" json:"exit_order_id,omitempty" xml:"exit_order_id,omitempty"`
+	// 建てた日時 (RFC3339)
+	EntryTime *string `form:"entry_time,omitempty" json:"entry_time,omitempty" xml:"entry_time,omitempty"`
+	// 決済した日時 (RFC3339)
+	ExitTime *string `form:"exit_time,omitempty" json:"exit_time,omitempty" xml:"exit_time,omitempty"`
+	// 取得単価 (移動平均法では平均単価)
+	EntryPrice *float64 `form:"entry_price,omitempty" json:"entry_price,omitempty" xml:"entry_price,omitempty"`
+	// 決済単価
+	ExitPrice *float64 `form:"exit_price,omitempty" json:"exit_price,omitempty" xml:"exit_price,omitempty"`
+	// この取引の数量に按分した建てと決済の手数料
+	Commission *float64 `form:"commission,omitempty" json:"commission,omitempty" xml:"commission,omitempty"`
+	// 手数料控除前の損益
+	GrossProfitLoss *float64 `form:"gross_profit_loss,omitempty" json:"gross_profit_loss,omitempty" xml:"gross_profit_loss,omitempty"`
+	// 手数料控除後の損益
+	NetProfitLoss *float64 `form:"net_profit_loss,omitempty" json:"net_profit_loss,omitempty" xml:"net_profit_loss,omitempty"`
+}
+
+// DailyProfitLossResultResponseBody is used to define fields on response body
+// types.
+type DailyProfitLossResultResponseBody struct {
+	// 決済日 (YYYY-MM-DD)
+	Date *string `form:"date,omitempty" json:"date,omitempty" xml:"date,omitempty"`
+	// 決済した取引の件数
+	Trades *int `form:"trades,omitempty" json:"trades,omitempty" xml:"trades,omitempty"`
+	// 手数料控除後の損益がプラスの取引の件数
+	Wins *int `form:"wins,omitempty" json:"wins,omitempty" xml:"wins,omitempty"`
+	// 手数料控除後の損益がマイナスの取引の件数
+	Losses *int `form:"losses,omitempty" json:"losses,omitempty" xml:"losses,omitempty"`
+	// 手数料控除前の損益
+	GrossProfitLoss *float64 `form:"gross_profit_loss,omitempty" json:"gross_profit_loss,omitempty" xml:"gross_profit_loss,omitempty"`
+	// 手数料
+	Commission *float64 `form:"commission,omitempty" json:"commission,omitempty" xml:"commission,omitempty"`
+	// 手数料控除後の損益
+	NetProfitLoss *float64 `form:"net_profit_loss,omitempty" json:"net_profit_loss,omitempty" xml:"net_profit_loss,omitempty"`
+}
+
+// NewListStockbotTradeCollectionOK builds a "trade" service "list" endpoint
+// result from a HTTP "OK" response.
+func NewListStockbotTradeCollectionOK(body *ListResponseBody) *tradeviews.StockbotTradeCollectionView {
+	v := &tradeviews.StockbotTradeCollectionView{}
+	v.Trades = make([]*tradeviews.TradeResultView, len(body.Trades))
+	for i, val := range body.Trades {
+		if val == nil {
+			v.Trades[i] = nil
+			continue
+		}
+		v.Trades[i] = unmarshalTradeResultResponseBodyToTradeviewsTradeResultView(val)
+	}
+
+	return v
+}
+
+// NewDailyPnlStockbotDailyPnlCollectionOK builds a "trade" service "daily_pnl"
+// endpoint result from a HTTP "OK" response.
+func NewDailyPnlStockbotDailyPnlCollectionOK(body *DailyPnlResponseBody) *tradeviews.StockbotDailyPnlCollectionView {
+	v := &tradeviews.StockbotDailyPnlCollectionView{}
+	v.Days = make([]*tradeviews.DailyProfitLossResultView, len(body.Days))
+	for i, val := range body.Days {
+		if val == nil {
+			v.Days[i] = nil
+			continue
+		}
+		v.Days[i] = unmarshalDailyProfitLossResultResponseBodyToTradeviewsDailyProfitLossResultView(val)
+	}
+
+	return v
+}
+
+// NewSyncStockbotExecutionSyncOK builds a "trade" service "sync" endpoint
+// result from a HTTP "OK" response.
+func NewSyncStockbotExecutionSyncOK(body *SyncResponseBody) *tradeviews.StockbotExecutionSyncView {
+	v := &tradeviews.StockbotExecutionSyncView{
+		Orders:     body.Orders,
+		Imported:   body.Imported,
+		Executions: body.Executions,
+		Lots:       body.Lots,
+		Closed:     body.Closed,
+		Skipped:    body.Skipped,
+	}
+
+	return v
+}
+
+// ValidateTradeResultResponseBody runs the validations defined on
+// TradeResultResponseBody
+func ValidateTradeResultResponseBody(body *TradeResultResponseBody) (err error) {
+	if body.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "body"))
+	}
+	if body.IsMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_margin", "body"))
+	}
+	if body.Side == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("side", "body"))
+	}
+	if body.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "body"))
+	}
+	if body.EntryOrderID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entry_order_id", "body"))
+	}
+	if body.ExitOrderID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exit_order_id", "body"))
+	}
+	if body.EntryTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entry_time", "body"))
+	}
+	if body.ExitTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exit_time", "body"))
+	}
+	if body.EntryPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entry_price", "body"))
+	}
+	if body.ExitPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exit_price", "body"))
+	}
+	if body.Commission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("commission", "body"))
+	}
+	if body.GrossProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gross_profit_loss", "body"))
+	}
+	if body.NetProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_profit_loss", "body"))
+	}
+	if body.Side != nil {
+		if !(*body.Side == "LONG" || *body.Side == "SHORT") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.side", *body.Side, []any{"LONG", "SHORT"}))
+		}
+	}
+	return
+}
+
+// ValidateDailyProfitLossResultResponseBody runs the validations defined on
+// DailyProfitLossResultResponseBody
+func ValidateDailyProfitLossResultResponseBody(body *DailyProfitLossResultResponseBody) (err error) {
+	if body.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "body"))
+	}
+	if body.Trades == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trades", "body"))
+	}
+	if body.Wins == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("wins", "body"))
+	}
+	if body.Losses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("losses", "body"))
+	}
+	if body.GrossProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gross_profit_loss", "body"))
+	}
+	if body.Commission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("commission", "body"))
+	}
+	if body.NetProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_profit_loss", "body"))
+	}
+	return
+}
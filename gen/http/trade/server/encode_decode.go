@@ -0,0 +1,205 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// trade HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+	trade "stock-bot/gen/trade"
+	tradeviews "stock-bot/gen/trade/views"
+	"strconv"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeListResponse returns an encoder for responses returned by the trade
+// list endpoint.
+func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*tradeviews.StockbotTradeCollection)
+		enc := encoder(ctx, w)
+		body := NewListResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListRequest returns a decoder for requests sent to the trade list
+// endpoint.
+func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*trade.ListPayload, error) {
+	return func(r *http.Request) (*trade.ListPayload, error) {
+		var (
+			symbol *string
+			method string
+			since  *string
+			until  *string
+			limit  int
+			err    error
+		)
+		qp := r.URL.Query()
+		symbolRaw := qp.Get("symbol")
+		if symbolRaw != "" {
+			symbol = &symbolRaw
+		}
+		methodRaw := qp.Get("method")
+		if methodRaw != "" {
+			method = methodRaw
+		} else {
+			method = "FIFO"
+		}
+		if !(method == "FIFO" || method == "AVERAGE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("method", method, []any{"FIFO", "AVERAGE"}))
+		}
+		sinceRaw := qp.Get("since")
+		if sinceRaw != "" {
+			since = &sinceRaw
+		}
+		if since != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+		}
+		untilRaw := qp.Get("until")
+		if untilRaw != "" {
+			until = &untilRaw
+		}
+		if until != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("until", *until, goa.FormatDateTime))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 100
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListPayload(symbol, method, since, until, limit)
+
+		return payload, nil
+	}
+}
+
+// EncodeDailyPnlResponse returns an encoder for responses returned by the
+// trade daily_pnl endpoint.
+func EncodeDailyPnlResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*tradeviews.StockbotDailyPnlCollection)
+		enc := encoder(ctx, w)
+		body := NewDailyPnlResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDailyPnlRequest returns a decoder for requests sent to the trade
+// daily_pnl endpoint.
+func DecodeDailyPnlRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*trade.DailyPnlPayload, error) {
+	return func(r *http.Request) (*trade.DailyPnlPayload, error) {
+		var (
+			method string
+			from   *string
+			to     *string
+			err    error
+		)
+		qp := r.URL.Query()
+		methodRaw := qp.Get("method")
+		if methodRaw != "" {
+			method = methodRaw
+		} else {
+			method = "FIFO"
+		}
+		if !(method == "FIFO" || method == "AVERAGE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("method", method, []any{"FIFO", "AVERAGE"}))
+		}
+		fromRaw := qp.Get("from")
+		if fromRaw != "" {
+			from = &fromRaw
+		}
+		if from != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("from", *from, goa.FormatDate))
+		}
+		toRaw := qp.Get("to")
+		if toRaw != "" {
+			to = &toRaw
+		}
+		if to != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("to", *to, goa.FormatDate))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDailyPnlPayload(method, from, to)
+
+		return payload, nil
+	}
+}
+
+// EncodeSyncResponse returns an encoder for responses returned by the trade
+// sync endpoint.
+func EncodeSyncResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*tradeviews.StockbotExecutionSync)
+		enc := encoder(ctx, w)
+		body := NewSyncResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// marshalTradeviewsTradeResultViewToTradeResultResponseBody builds a value of
+// type *TradeResultResponseBody from a value of type
+// *tradeviews.TradeResultView.
+func marshalTradeviewsTradeResultViewToTradeResultResponseBody(v *tradeviews.TradeResultView) *TradeResultResponseBody {
+	res := &TradeResultResponseBody{
+		Symbol:          *v.Symbol,
+		IsMargin:        *v.IsMargin,
+		Side:            *v.Side,
+		Quantity:        *v.Quantity,
+		EntryOrderID:    *v.EntryOrderID,
+		ExitOrderID:     *v.ExitOrderID,
+		EntryTime:       *v.EntryTime,
+		ExitTime:        *v.ExitTime,
+		EntryPrice:      *v.EntryPrice,
+		ExitPrice:       *v.ExitPrice,
+		Commission:      *v.Commission,
+		GrossProfitLoss: *v.GrossProfitLoss,
+		NetProfitLoss:   *v.NetProfitLoss,
+	}
+
+	return res
+}
+
+// marshalTradeviewsDailyProfitLossResultViewToDailyProfitLossResultResponseBody
+// builds a value of type *DailyProfitLossResultResponseBody from a value of
+// type *tradeviews.DailyProfitLossResultView.
+func marshalTradeviewsDailyProfitLossResultViewToDailyProfitLossResultResponseBody(v *tradeviews.DailyProfitLossResultView) *DailyProfitLossResultResponseBody {
+	res := &DailyProfitLossResultResponseBody{
+		Date:            *v.Date,
+		Trades:          *v.Trades,
+		Wins:            *v.Wins,
+		Losses:          *v.Losses,
+		GrossProfitLoss: *v.GrossProfitLoss,
+		Commission:      *v.Commission,
+		NetProfitLoss:   *v.NetProfitLoss,
+	}
+
+	return res
+}
@@ -0,0 +1,167 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// trade HTTP server types
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package server
+
+import (
+	trade "stock-bot/gen/trade"
+	tradeviews "stock-bot/gen/trade/views"
+)
+
+// ListResponseBody is the type of the "trade" service "list" endpoint HTTP
+// response body.
+type ListResponseBody struct {
+	// 往復取引のリスト
+	Trades []*TradeResultResponseBody `form:"trades" json:"trades" xml:"trades"`
+}
+
+// DailyPnlResponseBody is the type of the "trade" service "daily_pnl" endpoint
+// HTTP response body.
+type DailyPnlResponseBody struct {
+	// 日ごとの実現損益
+	Days []*DailyProfitLossResultResponseBody `form:"days" json:"days" xml:"days"`
+}
+
+// SyncResponseBody is the type of the "trade" service "sync" endpoint HTTP
+// response body.
+type SyncResponseBody struct {
+	// 約定のあった注文の件数
+	Orders int `form:"orders" json:"orders" xml:"orders"`
+	// ボット以外から発注され、新たに保存した注文の件数
+	Imported int `form:"imported" json:"imported" xml:"imported"`
+	// 保存した約定の件数
+	Executions int `form:"executions" json:"executions" xml:"executions"`
+	// 約定から作成し直したポジション台帳の未決済の建玉 (ロット) の件数
+	Lots int `form:"lots" json:"lots" xml:"lots"`
+	// 証券会社で取消・失効・受付エラーになり、完了にした注文の件数 (注文一覧にない前日以前の注文の失効を含む)
+	Closed int `form:"closed" json:"closed" xml:"closed"`
+	// 約定があるものの、内容を変換できずに保存しなかった注文の件数
+	Skipped int `form:"skipped" json:"skipped" xml:"skipped"`
+}
+
+// TradeResultResponseBody is used to define fields on response body types.
+type TradeResultResponseBody struct {
+	// 銘柄コード
+	Symbol string `form:"symbol" json:"symbol" xml:"symbol"`
+	// 信用取引の場合は true
+	IsMargin bool `form:"is_margin" json:"is_margin" xml:"is_margin"`
+	// 方向 (LONG: 買い→売り, SHORT: 売建→買戻し)
+	Side string `form:"side" json:"side" xml:"side"`
+	// 数量
+	Quantity int `form:"quantity" json:"quantity" xml:"quantity"`
+	// 建てた注文のID (移動平均法では最初に建てた注文)
+	EntryOrderID string `form:"entry_order_id" json:"entry_order_id" xml:"entry_order_id"`
+	// 決済した注文のID
+	ExitOrderID string `form:"exit_order_id" json:"exit_order_id" xml:"exit_order_id"`
+	// 建てた日時 (RFC3339)
+	EntryTime string `form:"entry_time" json:"entry_time" xml:"entry_time"`
+	// 決済した日時 (RFC3339)
+	ExitTime string `form:"exit_time" json:"exit_time" xml:"exit_time"`
+	// 取得単価 (移動平均法では平均単価)
+	EntryPrice float64 `form:"entry_price" json:"entry_price" xml:"entry_price"`
+	// 決済単価
+	ExitPrice float64 `form:"exit_price" json:"exit_price" xml:"exit_price"`
+	// この取引の数量に按分した建てと決済の手数料
+	Commission float64 `form:"commission" json:"commission" xml:"commission"`
+	// 手数料控除前の損益
+	GrossProfitLoss float64 `form:"gross_profit_loss" json:"gross_profit_loss" xml:"gross_profit_loss"`
+	// 手数料控除後の損益
+	NetProfitLoss float64 `form:"net_profit_loss" json:"net_profit_loss" xml:"net_profit_loss"`
+}
+
+// DailyProfitLossResultResponseBody is used to define fields on response body
+// types.
+type DailyProfitLossResultResponseBody struct {
+	// 決済日 (YYYY-MM-DD)
+	Date string `form:"date" json:"date" xml:"date"`
+	// 決済した取引の件数
+	Trades int `form:"trades" json:"trades" xml:"trades"`
+	// 手数料控除後の損益がプラスの取引の件数
+	Wins int `form:"wins" json:"wins" xml:"wins"`
+	// 手数料控除後の損益がマイナスの取引の件数
+	Losses int `form:"losses" json:"losses" xml:"losses"`
+	// 手数料控除前の損益
+	GrossProfitLoss float64 `form:"gross_profit_loss" json:"gross_profit_loss" xml:"gross_profit_loss"`
+	// 手数料
+	Commission float64 `form:"commission" json:"commission" xml:"commission"`
+	// 手数料控除後の損益
+	NetProfitLoss float64 `form:"net_profit_loss" json:"net_profit_loss" xml:"net_profit_loss"`
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "list" endpoint of the "trade" service.
+func NewListResponseBody(res *tradeviews.StockbotTradeCollectionView) *ListResponseBody {
+	body := &ListResponseBody{}
+	if res.Trades != nil {
+		body.Trades = make([]*TradeResultResponseBody, len(res.Trades))
+		for i, val := range res.Trades {
+			if val == nil {
+				body.Trades[i] = nil
+				continue
+			}
+			body.Trades[i] = marshalTradeviewsTradeResultViewToTradeResultResponseBody(val)
+		}
+	} else {
+		body.Trades = []*TradeResultResponseBody{}
+	}
+	return body
+}
+
+// NewDailyPnlResponseBody builds the HTTP response body from the result of the
+// "daily_pnl" endpoint of the "trade" service.
+func NewDailyPnlResponseBody(res *tradeviews.StockbotDailyPnlCollectionView) *DailyPnlResponseBody {
+	body := &DailyPnlResponseBody{}
+	if res.Days != nil {
+		body.Days = make([]*DailyProfitLossResultResponseBody, len(res.Days))
+		for i, val := range res.Days {
+			if val == nil {
+				body.Days[i] = nil
+				continue
+			}
+			body.Days[i] = marshalTradeviewsDailyProfitLossResultViewToDailyProfitLossResultResponseBody(val)
+		}
+	} else {
+		body.Days = []*DailyProfitLossResultResponseBody{}
+	}
+	return body
+}
+
+// NewSyncResponseBody builds the HTTP response body from the result of the
+// "sync" endpoint of the "trade" service.
+func NewSyncResponseBody(res *tradeviews.StockbotExecutionSyncView) *SyncResponseBody {
+	body := &SyncResponseBody{
+		Orders:     *res.Orders,
+		Imported:   *res.Imported,
+		Executions: *res.Executions,
+		Lots:       *res.Lots,
+		Closed:     *res.Closed,
+		Skipped:    *res.Skipped,
+	}
+	return body
+}
+
+// NewListPayload builds a trade service list endpoint payload.
+func NewListPayload(symbol *string, method string, since *string, until *string, limit int) *trade.ListPayload {
+	v := &trade.ListPayload{}
+	v.Symbol = symbol
+	v.Method = method
+	v.Since = since
+	v.Until = until
+	v.Limit = limit
+
+	return v
+}
+
+// NewDailyPnlPayload builds a trade service daily_pnl endpoint payload.
+func NewDailyPnlPayload(method string, from *string, to *string) *trade.DailyPnlPayload {
+	v := &trade.DailyPnlPayload{}
+	v.Method = method
+	v.From = from
+	v.To = to
+
+	return v
+}
@@ -0,0 +1,48 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// margin client
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package margin
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "margin" service client.
+type Client struct {
+	GetEndpoint     goa.Endpoint
+	CollectEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "margin" service client given the endpoints.
+func NewClient(get, collect goa.Endpoint) *Client {
+	return &Client{
+		GetEndpoint:     get,
+		CollectEndpoint: collect,
+	}
+}
+
+// Get calls the "get" endpoint of the "margin" service.
+func (c *Client) Get(ctx context.Context, p *GetPayload) (res *StockbotMarginInfo, err error) {
+	var ires any
+	ires, err = c.GetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotMarginInfo), nil
+}
+
+// Collect calls the "collect" endpoint of the "margin" service.
+func (c *Client) Collect(ctx context.Context) (res *StockbotMarginCollect, err error) {
+	var ires any
+	ires, err = c.CollectEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotMarginCollect), nil
+}
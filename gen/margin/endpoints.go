@@ -0,0 +1,61 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// margin endpoints
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package margin
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "margin" service endpoints.
+type Endpoints struct {
+	Get     goa.Endpoint
+	Collect goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "margin" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		Get:     NewGetEndpoint(s),
+		Collect: NewCollectEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "margin" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Get = m(e.Get)
+	e.Collect = m(e.Collect)
+}
+
+// NewGetEndpoint returns an endpoint function that calls the method "get" of
+// service "margin".
+func NewGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetPayload)
+		res, err := s.Get(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotMarginInfo(res, "default")
+		return vres, nil
+	}
+}
+
+// NewCollectEndpoint returns an endpoint function that calls the method
+// "collect" of service "margin".
+func NewCollectEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		res, err := s.Collect(ctx)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotMarginCollect(res, "default")
+		return vres, nil
+	}
+}
@@ -0,0 +1,363 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// margin service
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package margin
+
+import (
+	"context"
+	marginviews "stock-bot/gen/margin/views"
+)
+
+// The margin service exposes the margin and short-interest data (証金残, 信用残,
+// 逆日歩) collected for the watched stocks.
+type Service interface {
+	// Get the stored margin time series of a stock, newest first.
+	Get(context.Context, *GetPayload) (res *StockbotMarginInfo, err error)
+	// Collect the margin data of the watched stocks now.
+	Collect(context.Context) (res *StockbotMarginCollect, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "stockbot"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "margin"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [2]string{"get", "collect"}
+
+// Weekly margin trading balance (信用残, 制度と一般の合算).
+type CreditBalanceResult struct {
+	// 信用残日付 (YYYYMMDD)
+	Date string
+	// 買残
+	LongBalance int64
+	// 買残前週比
+	LongChange int64
+	// 売残
+	ShortBalance int64
+	// 売残前週比
+	ShortChange int64
+	// 信用倍率
+	Ratio float64
+}
+
+// GetPayload is the payload type of the margin service get method.
+type GetPayload struct {
+	// 銘柄コード
+	Symbol string
+	// この日付以降のデータに絞り込む (YYYYMMDD)
+	Since *string
+	// 系列ごとの取得件数
+	Limit int
+}
+
+// Daily margin premium (逆日歩).
+type MarginPremiumResult struct {
+	// 取得した日 (YYYYMMDD)
+	Date string
+	// 逆日歩 (1株あたり・円)
+	Premium float64
+}
+
+// Daily balance at the securities finance company (証金残).
+type SecuritiesFinanceBalanceResult struct {
+	// 証金更新日 (YYYYMMDD)
+	Date string
+	// 速報の場合は true、確報の場合は false
+	Preliminary bool
+	// 融資残
+	LoanBalance int64
+	// 融資前日比
+	LoanChange int64
+	// 貸株残
+	StockLoanBalance int64
+	// 貸株前日比
+	StockLoanChange int64
+	// 差引残
+	NetBalance int64
+	// 差引残前日比
+	NetChange int64
+	// 貸借倍率
+	LoanRatio float64
+	// 回転日数
+	TurnoverDays float64
+}
+
+// StockbotMarginCollect is the result type of the margin service collect
+// method.
+type StockbotMarginCollect struct {
+	// 保存した証金残の件数
+	SecuritiesFinance int
+	// 保存した信用残の件数
+	Credit int
+	// 保存した逆日歩の件数
+	Premiums int
+}
+
+// StockbotMarginInfo is the result type of the margin service get method.
+type StockbotMarginInfo struct {
+	// 銘柄コード
+	Symbol string
+	// 証金残
+	SecuritiesFinance []*SecuritiesFinanceBalanceResult
+	// 信用残
+	Credit []*CreditBalanceResult
+	// 逆日歩
+	Premiums []*MarginPremiumResult
+}
+
+// NewStockbotMarginInfo initializes result type StockbotMarginInfo from viewed
+// result type StockbotMarginInfo.
+func NewStockbotMarginInfo(vres *marginviews.StockbotMarginInfo) *StockbotMarginInfo {
+	return newStockbotMarginInfo(vres.Projected)
+}
+
+// NewViewedStockbotMarginInfo initializes viewed result type
+// StockbotMarginInfo from result type StockbotMarginInfo using the given view.
+func NewViewedStockbotMarginInfo(res *StockbotMarginInfo, view string) *marginviews.StockbotMarginInfo {
+	p := newStockbotMarginInfoView(res)
+	return &marginviews.StockbotMarginInfo{Projected: p, View: "default"}
+}
+
+// NewStockbotMarginCollect initializes result type StockbotMarginCollect from
+// viewed result type StockbotMarginCollect.
+func NewStockbotMarginCollect(vres *marginviews.StockbotMarginCollect) *StockbotMarginCollect {
+	return newStockbotMarginCollect(vres.Projected)
+}
+
+// NewViewedStockbotMarginCollect initializes viewed result type
+// StockbotMarginCollect from result type StockbotMarginCollect using the given
+// view.
+func NewViewedStockbotMarginCollect(res *StockbotMarginCollect, view string) *marginviews.StockbotMarginCollect {
+	p := newStockbotMarginCollectView(res)
+	return &marginviews.StockbotMarginCollect{Projected: p, View: "default"}
+}
+
+// newStockbotMarginInfo converts projected type StockbotMarginInfo to service
+// type StockbotMarginInfo.
+func newStockbotMarginInfo(vres *marginviews.StockbotMarginInfoView) *StockbotMarginInfo {
+	res := &StockbotMarginInfo{}
+	if vres.Symbol != nil {
+		res.Symbol = *vres.Symbol
+	}
+	if vres.SecuritiesFinance != nil {
+		res.SecuritiesFinance = make([]*SecuritiesFinanceBalanceResult, len(vres.SecuritiesFinance))
+		for i, val := range vres.SecuritiesFinance {
+			if val == nil {
+				res.SecuritiesFinance[i] = nil
+				continue
+			}
+			res.SecuritiesFinance[i] = transformMarginviewsSecuritiesFinanceBalanceResultViewToSecuritiesFinanceBalanceResult(val)
+		}
+	}
+	if vres.Credit != nil {
+		res.Credit = make([]*CreditBalanceResult, len(vres.Credit))
+		for i, val := range vres.Credit {
+			if val == nil {
+				res.Credit[i] = nil
+				continue
+			}
+			res.Credit[i] = transformMarginviewsCreditBalanceResultViewToCreditBalanceResult(val)
+		}
+	}
+	if vres.Premiums != nil {
+		res.Premiums = make([]*MarginPremiumResult, len(vres.Premiums))
+		for i, val := range vres.Premiums {
+			if val == nil {
+				res.Premiums[i] = nil
+				continue
+			}
+			res.Premiums[i] = transformMarginviewsMarginPremiumResultViewToMarginPremiumResult(val)
+		}
+	}
+	return res
+}
+
+// newStockbotMarginInfoView projects result type StockbotMarginInfo to
+// projected type StockbotMarginInfoView using the "default" view.
+func newStockbotMarginInfoView(res *StockbotMarginInfo) *marginviews.StockbotMarginInfoView {
+	vres := &marginviews.StockbotMarginInfoView{
+		Symbol: &res.Symbol,
+	}
+	if res.SecuritiesFinance != nil {
+		vres.SecuritiesFinance = make([]*marginviews.SecuritiesFinanceBalanceResultView, len(res.SecuritiesFinance))
+		for i, val := range res.SecuritiesFinance {
+			if val == nil {
+				vres.SecuritiesFinance[i] = nil
+				continue
+			}
+			vres.SecuritiesFinance[i] = transformSecuritiesFinanceBalanceResultToMarginviewsSecuritiesFinanceBalanceResultView(val)
+		}
+	} else {
+		vres.SecuritiesFinance = []*marginviews.SecuritiesFinanceBalanceResultView{}
+	}
+	if res.Credit != nil {
+		vres.Credit = make([]*marginviews.CreditBalanceResultView, len(res.Credit))
+		for i, val := range res.Credit {
+			if val == nil {
+				vres.Credit[i] = nil
+				continue
+			}
+			vres.Credit[i] = transformCreditBalanceResultToMarginviewsCreditBalanceResultView(val)
+		}
+	} else {
+		vres.Credit = []*marginviews.CreditBalanceResultView{}
+	}
+	if res.Premiums != nil {
+		vres.Premiums = make([]*marginviews.MarginPremiumResultView, len(res.Premiums))
+		for i, val := range res.Premiums {
+			if val == nil {
+				vres.Premiums[i] = nil
+				continue
+			}
+			vres.Premiums[i] = transformMarginPremiumResultToMarginviewsMarginPremiumResultView(val)
+		}
+	} else {
+		vres.Premiums = []*marginviews.MarginPremiumResultView{}
+	}
+	return vres
+}
+
+// newStockbotMarginCollect converts projected type StockbotMarginCollect to
+// service type StockbotMarginCollect.
+func newStockbotMarginCollect(vres *marginviews.StockbotMarginCollectView) *StockbotMarginCollect {
+	res := &StockbotMarginCollect{}
+	if vres.SecuritiesFinance != nil {
+		res.SecuritiesFinance = *vres.SecuritiesFinance
+	}
+	if vres.Credit != nil {
+		res.Credit = *vres.Credit
+	}
+	if vres.Premiums != nil {
+		res.Premiums = *vres.Premiums
+	}
+	return res
+}
+
+// newStockbotMarginCollectView projects result type StockbotMarginCollect to
+// projected type StockbotMarginCollectView using the "default" view.
+func newStockbotMarginCollectView(res *StockbotMarginCollect) *marginviews.StockbotMarginCollectView {
+	vres := &marginviews.StockbotMarginCollectView{
+		SecuritiesFinance: &res.SecuritiesFinance,
+		Credit:            &res.Credit,
+		Premiums:          &res.Premiums,
+	}
+	return vres
+}
+
+// transformMarginviewsSecuritiesFinanceBalanceResultViewToSecuritiesFinanceBalanceResult
+// builds a value of type *SecuritiesFinanceBalanceResult from a value of type
+// *marginviews.SecuritiesFinanceBalanceResultView.
+func transformMarginviewsSecuritiesFinanceBalanceResultViewToSecuritiesFinanceBalanceResult(v *marginviews.SecuritiesFinanceBalanceResultView) *SecuritiesFinanceBalanceResult {
+	if v == nil {
+		return nil
+	}
+	res := &SecuritiesFinanceBalanceResult{
+		Date:             *v.Date,
+		Preliminary:      *v.Preliminary,
+		LoanBalance:      *v.LoanBalance,
+		LoanChange:       *v.LoanChange,
+		StockLoanBalance: *v.StockLoanBalance,
+		StockLoanChange:  *v.StockLoanChange,
+		NetBalance:       *v.NetBalance,
+		NetChange:        *v.NetChange,
+		LoanRatio:        *v.LoanRatio,
+		TurnoverDays:     *v.TurnoverDays,
+	}
+
+	return res
+}
+
+// transformMarginviewsCreditBalanceResultViewToCreditBalanceResult builds a
+// value of type *CreditBalanceResult from a value of type
+// *marginviews.CreditBalanceResultView.
+func transformMarginviewsCreditBalanceResultViewToCreditBalanceResult(v *marginviews.CreditBalanceResultView) *CreditBalanceResult {
+	if v == nil {
+		return nil
+	}
+	res := &CreditBalanceResult{
+		Date:         *v.Date,
+		LongBalance:  *v.LongBalance,
+		LongChange:   *v.LongChange,
+		ShortBalance: *v.ShortBalance,
+		ShortChange:  *v.ShortChange,
+		Ratio:        *v.Ratio,
+	}
+
+	return res
+}
+
+// transformMarginviewsMarginPremiumResultViewToMarginPremiumResult builds a
+// value of type *MarginPremiumResult from a value of type
+// *marginviews.MarginPremiumResultView.
+func transformMarginviewsMarginPremiumResultViewToMarginPremiumResult(v *marginviews.MarginPremiumResultView) *MarginPremiumResult {
+	if v == nil {
+		return nil
+	}
+	res := &MarginPremiumResult{
+		Date:    *v.Date,
+		Premium: *v.Premium,
+	}
+
+	return res
+}
+
+// transformSecuritiesFinanceBalanceResultToMarginviewsSecuritiesFinanceBalanceResultView
+// builds a value of type *marginviews.SecuritiesFinanceBalanceResultView from
+// a value of type *SecuritiesFinanceBalanceResult.
+func transformSecuritiesFinanceBalanceResultToMarginviewsSecuritiesFinanceBalanceResultView(v *SecuritiesFinanceBalanceResult) *marginviews.SecuritiesFinanceBalanceResultView {
+	res := &marginviews.SecuritiesFinanceBalanceResultView{
+		Date:             &v.Date,
+		Preliminary:      &v.Preliminary,
+		LoanBalance:      &v.LoanBalance,
+		LoanChange:       &v.LoanChange,
+		StockLoanBalance: &v.StockLoanBalance,
+		StockLoanChange:  &v.StockLoanChange,
+		NetBalance:       &v.NetBalance,
+		NetChange:        &v.NetChange,
+		LoanRatio:        &v.LoanRatio,
+		TurnoverDays:     &v.TurnoverDays,
+	}
+
+	return res
+}
+
+// transformCreditBalanceResultToMarginviewsCreditBalanceResultView builds a
+// value of type *marginviews.CreditBalanceResultView from a value of type
+// *CreditBalanceResult.
+func transformCreditBalanceResultToMarginviewsCreditBalanceResultView(v *CreditBalanceResult) *marginviews.CreditBalanceResultView {
+	res := &marginviews.CreditBalanceResultView{
+		Date:         &v.Date,
+		LongBalance:  &v.LongBalance,
+		LongChange:   &v.LongChange,
+		ShortBalance: &v.ShortBalance,
+		ShortChange:  &v.ShortChange,
+		Ratio:        &v.Ratio,
+	}
+
+	return res
+}
+
+// transformMarginPremiumResultToMarginviewsMarginPremiumResultView builds a
+// value of type *marginviews.MarginPremiumResultView from a value of type
+// *MarginPremiumResult.
+func transformMarginPremiumResultToMarginviewsMarginPremiumResultView(v *MarginPremiumResult) *marginviews.MarginPremiumResultView {
+	res := &marginviews.MarginPremiumResultView{
+		Date:    &v.Date,
+		Premium: &v.Premium,
+	}
+
+	return res
+}
@@ -0,0 +1,274 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// margin views
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package views
+
+import (
+	goa "goa.design/goa/v3/pkg"
+)
+
+// StockbotMarginInfo is the viewed result type that is projected based on a
+// view.
+type StockbotMarginInfo struct {
+	// Type to project
+	Projected *StockbotMarginInfoView
+	// View to render
+	View string
+}
+
+// StockbotMarginCollect is the viewed result type that is projected based on a
+// view.
+type StockbotMarginCollect struct {
+	// Type to project
+	Projected *StockbotMarginCollectView
+	// View to render
+	View string
+}
+
+// StockbotMarginInfoView is a type that runs validations on a projected type.
+type StockbotMarginInfoView struct {
+	// 銘柄コード
+	Symbol *string
+	// 証金残
+	SecuritiesFinance []*SecuritiesFinanceBalanceResultView
+	// 信用残
+	Credit []*CreditBalanceResultView
+	// 逆日歩
+	Premiums []*MarginPremiumResultView
+}
+
+// SecuritiesFinanceBalanceResultView is a type that runs validations on a
+// projected type.
+type SecuritiesFinanceBalanceResultView struct {
+	// 証金更新日 (YYYYMMDD)
+	Date *string
+	// 速報の場合は true、確報の場合は false
+	Preliminary *bool
+	// 融資残
+	LoanBalance *int64
+	// 融資前日比
+	LoanChange *int64
+	// 貸株残
+	StockLoanBalance *int64
+	// 貸株前日比
+	StockLoanChange *int64
+	// 差引残
+	NetBalance *int64
+	// 差引残前日比
+	NetChange *int64
+	// 貸借倍率
+	LoanRatio *float64
+	// 回転日数
+	TurnoverDays *float64
+}
+
+// CreditBalanceResultView is a type that runs validations on a projected type.
+type CreditBalanceResultView struct {
+	// 信用残日付 (YYYYMMDD)
+	Date *string
+	// 買残
+	LongBalance *int64
+	// 買残前週比
+	LongChange *int64
+	// 売残
+	ShortBalance *int64
+	// 売残前週比
+	ShortChange *int64
+	// 信用倍率
+	Ratio *float64
+}
+
+// MarginPremiumResultView is a type that runs validations on a projected type.
+type MarginPremiumResultView struct {
+	// 取得した日 (YYYYMMDD)
+	Date *string
+	// 逆日歩 (1株あたり・円)
+	Premium *float64
+}
+
+// StockbotMarginCollectView is a type that runs validations on a projected
+// type.
+type StockbotMarginCollectView struct {
+	// 保存した証金残の件数
+	SecuritiesFinance *int
+	// 保存した信用残の件数
+	Credit *int
+	// 保存した逆日歩の件数
+	Premiums *int
+}
+
+var (
+	// StockbotMarginInfoMap is a map indexing the attribute names of
+	// StockbotMarginInfo by view name.
+	StockbotMarginInfoMap = map[string][]string{
+		"default": {
+			"symbol",
+			"securities_finance",
+			"credit",
+			"premiums",
+		},
+	}
+	// StockbotMarginCollectMap is a map indexing the attribute names of
+	// StockbotMarginCollect by view name.
+	StockbotMarginCollectMap = map[string][]string{
+		"default": {
+			"securities_finance",
+			"credit",
+			"premiums",
+		},
+	}
+)
+
+// ValidateStockbotMarginInfo runs the validations defined on the viewed result
+// type StockbotMarginInfo.
+func ValidateStockbotMarginInfo(result *StockbotMarginInfo) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotMarginInfoView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotMarginCollect runs the validations defined on the viewed
+// result type StockbotMarginCollect.
+func ValidateStockbotMarginCollect(result *StockbotMarginCollect) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotMarginCollectView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotMarginInfoView runs the validations defined on
+// StockbotMarginInfoView using the "default" view.
+func ValidateStockbotMarginInfoView(result *StockbotMarginInfoView) (err error) {
+	if result.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "result"))
+	}
+	if result.SecuritiesFinance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("securities_finance", "result"))
+	}
+	if result.Credit == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("credit", "result"))
+	}
+	if result.Premiums == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("premiums", "result"))
+	}
+	for _, e := range result.SecuritiesFinance {
+		if e != nil {
+			if err2 := ValidateSecuritiesFinanceBalanceResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range result.Credit {
+		if e != nil {
+			if err2 := ValidateCreditBalanceResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range result.Premiums {
+		if e != nil {
+			if err2 := ValidateMarginPremiumResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSecuritiesFinanceBalanceResultView runs the validations defined on
+// SecuritiesFinanceBalanceResultView.
+func ValidateSecuritiesFinanceBalanceResultView(result *SecuritiesFinanceBalanceResultView) (err error) {
+	if result.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "result"))
+	}
+	if result.Preliminary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("preliminary", "result"))
+	}
+	if result.LoanBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loan_balance", "result"))
+	}
+	if result.LoanChange == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loan_change", "result"))
+	}
+	if result.StockLoanBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stock_loan_balance", "result"))
+	}
+	if result.StockLoanChange == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stock_loan_change", "result"))
+	}
+	if result.NetBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_balance", "result"))
+	}
+	if result.NetChange == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_change", "result"))
+	}
+	if result.LoanRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("loan_ratio", "result"))
+	}
+	if result.TurnoverDays == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("turnover_days", "result"))
+	}
+	return
+}
+
+// ValidateCreditBalanceResultView runs the validations defined on
+// CreditBalanceResultView.
+func ValidateCreditBalanceResultView(result *CreditBalanceResultView) (err error) {
+	if result.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "result"))
+	}
+	if result.LongBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_balance", "result"))
+	}
+	if result.LongChange == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_change", "result"))
+	}
+	if result.ShortBalance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_balance", "result"))
+	}
+	if result.ShortChange == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_change", "result"))
+	}
+	if result.Ratio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ratio", "result"))
+	}
+	return
+}
+
+// ValidateMarginPremiumResultView runs the validations defined on
+// MarginPremiumResultView.
+func ValidateMarginPremiumResultView(result *MarginPremiumResultView) (err error) {
+	if result.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "result"))
+	}
+	if result.Premium == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("premium", "result"))
+	}
+	return
+}
+
+// ValidateStockbotMarginCollectView runs the validations defined on
+// StockbotMarginCollectView using the "default" view.
+func ValidateStockbotMarginCollectView(result *StockbotMarginCollectView) (err error) {
+	if result.SecuritiesFinance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("securities_finance", "result"))
+	}
+	if result.Credit == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("credit", "result"))
+	}
+	if result.Premiums == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("premiums", "result"))
+	}
+	return
+}
@@ -15,15 +15,23 @@ import (
 
 // Client is the "master" service client.
 type Client struct {
-	GetStockEndpoint goa.Endpoint
-	UpdateEndpoint   goa.Endpoint
+	GetStockEndpoint        goa.Endpoint
+	GetFundamentalsEndpoint goa.Endpoint
+	ListStocksEndpoint      goa.Endpoint
+	ListIndustriesEndpoint  goa.Endpoint
+	UpdateEndpoint          goa.Endpoint
+	ListSyncRunsEndpoint    goa.Endpoint
 }
 
 // NewClient initializes a "master" service client given the endpoints.
-func NewClient(getStock, update goa.Endpoint) *Client {
+func NewClient(getStock, getFundamentals, listStocks, listIndustries, update, listSyncRuns goa.Endpoint) *Client {
 	return &Client{
-		GetStockEndpoint: getStock,
-		UpdateEndpoint:   update,
+		GetStockEndpoint:        getStock,
+		GetFundamentalsEndpoint: getFundamentals,
+		ListStocksEndpoint:      listStocks,
+		ListIndustriesEndpoint:  listIndustries,
+		UpdateEndpoint:          update,
+		ListSyncRunsEndpoint:    listSyncRuns,
 	}
 }
 
@@ -37,8 +45,56 @@ func (c *Client) GetStock(ctx context.Context, p *GetStockPayload) (res *Stockbo
 	return ires.(*StockbotStockMaster), nil
 }
 
+// GetFundamentals calls the "get_fundamentals" endpoint of the "master"
+// service.
+// GetFundamentals may return the following errors:
+//   - "not_found" (type *goa.ServiceError): 投資指標が見つからない
+//   - error: internal error
+func (c *Client) GetFundamentals(ctx context.Context, p *GetFundamentalsPayload) (res *StockbotStockFundamentals, err error) {
+	var ires any
+	ires, err = c.GetFundamentalsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotStockFundamentals), nil
+}
+
+// ListStocks calls the "list_stocks" endpoint of the "master" service.
+func (c *Client) ListStocks(ctx context.Context, p *ListStocksPayload) (res *StockbotStockMasterPage, err error) {
+	var ires any
+	ires, err = c.ListStocksEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotStockMasterPage), nil
+}
+
+// ListIndustries calls the "list_industries" endpoint of the "master" service.
+func (c *Client) ListIndustries(ctx context.Context) (res *StockbotIndustryCollection, err error) {
+	var ires any
+	ires, err = c.ListIndustriesEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotIndustryCollection), nil
+}
+
 // Update calls the "update" endpoint of the "master" service.
-func (c *Client) Update(ctx context.Context) (err error) {
-	_, err = c.UpdateEndpoint(ctx, nil)
-	return
+func (c *Client) Update(ctx context.Context) (res *StockbotMasterSyncSummary, err error) {
+	var ires any
+	ires, err = c.UpdateEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotMasterSyncSummary), nil
+}
+
+// ListSyncRuns calls the "list_sync_runs" endpoint of the "master" service.
+func (c *Client) ListSyncRuns(ctx context.Context, p *ListSyncRunsPayload) (res *StockbotMasterSyncRunCollection, err error) {
+	var ires any
+	ires, err = c.ListSyncRunsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotMasterSyncRunCollection), nil
 }
@@ -15,22 +15,34 @@ import (
 
 // Endpoints wraps the "master" service endpoints.
 type Endpoints struct {
-	GetStock goa.Endpoint
-	Update   goa.Endpoint
+	GetStock        goa.Endpoint
+	GetFundamentals goa.Endpoint
+	ListStocks      goa.Endpoint
+	ListIndustries  goa.Endpoint
+	Update          goa.Endpoint
+	ListSyncRuns    goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "master" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		GetStock: NewGetStockEndpoint(s),
-		Update:   NewUpdateEndpoint(s),
+		GetStock:        NewGetStockEndpoint(s),
+		GetFundamentals: NewGetFundamentalsEndpoint(s),
+		ListStocks:      NewListStocksEndpoint(s),
+		ListIndustries:  NewListIndustriesEndpoint(s),
+		Update:          NewUpdateEndpoint(s),
+		ListSyncRuns:    NewListSyncRunsEndpoint(s),
 	}
 }
 
 // Use applies the given middleware to all the "master" service endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetStock = m(e.GetStock)
+	e.GetFundamentals = m(e.GetFundamentals)
+	e.ListStocks = m(e.ListStocks)
+	e.ListIndustries = m(e.ListIndustries)
 	e.Update = m(e.Update)
+	e.ListSyncRuns = m(e.ListSyncRuns)
 }
 
 // NewGetStockEndpoint returns an endpoint function that calls the method
@@ -47,10 +59,70 @@ func NewGetStockEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewGetFundamentalsEndpoint returns an endpoint function that calls the
+// method "get_fundamentals" of service "master".
+func NewGetFundamentalsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetFundamentalsPayload)
+		res, err := s.GetFundamentals(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotStockFundamentals(res, "default")
+		return vres, nil
+	}
+}
+
+// NewListStocksEndpoint returns an endpoint function that calls the method
+// "list_stocks" of service "master".
+func NewListStocksEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListStocksPayload)
+		res, err := s.ListStocks(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotStockMasterPage(res, "default")
+		return vres, nil
+	}
+}
+
+// NewListIndustriesEndpoint returns an endpoint function that calls the method
+// "list_industries" of service "master".
+func NewListIndustriesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		res, err := s.ListIndustries(ctx)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotIndustryCollection(res, "default")
+		return vres, nil
+	}
+}
+
 // NewUpdateEndpoint returns an endpoint function that calls the method
 // "update" of service "master".
 func NewUpdateEndpoint(s Service) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		return nil, s.Update(ctx)
+		res, err := s.Update(ctx)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotMasterSyncSummary(res, "default")
+		return vres, nil
+	}
+}
+
+// NewListSyncRunsEndpoint returns an endpoint function that calls the method
+// "list_sync_runs" of service "master".
+func NewListSyncRunsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListSyncRunsPayload)
+		res, err := s.ListSyncRuns(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotMasterSyncRunCollection(res, "default")
+		return vres, nil
 	}
 }
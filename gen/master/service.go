@@ -10,14 +10,25 @@ package master
 import (
 	"context"
 	masterviews "stock-bot/gen/master/views"
+
+	goa "goa.design/goa/v3/pkg"
 )
 
 // The master service provides master data.
 type Service interface {
 	// Get basic master data for a single stock.
 	GetStock(context.Context, *GetStockPayload) (res *StockbotStockMaster, err error)
-	// Trigger a manual update of the master data.
-	Update(context.Context) (err error)
+	// Get today's fundamentals snapshot (BPS, EPS, dividends) of a stock with PER,
+	// PBR and dividend yield derived from the latest price.
+	GetFundamentals(context.Context, *GetFundamentalsPayload) (res *StockbotStockFundamentals, err error)
+	// Search stock master data with filters and paging, ordered by symbol.
+	ListStocks(context.Context, *ListStocksPayload) (res *StockbotStockMasterPage, err error)
+	// List industries with the number of stocks in each.
+	ListIndustries(context.Context) (res *StockbotIndustryCollection, err error)
+	// Trigger a manual update of the master data and report the changes.
+	Update(context.Context) (res *StockbotMasterSyncSummary, err error)
+	// List the most recent master data sync runs, newest first.
+	ListSyncRuns(context.Context, *ListSyncRunsPayload) (res *StockbotMasterSyncRunCollection, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -34,7 +45,14 @@ const ServiceName = "master"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"get_stock", "update"}
+var MethodNames = [6]string{"get_stock", "get_fundamentals", "list_stocks", "list_industries", "update", "list_sync_runs"}
+
+// GetFundamentalsPayload is the payload type of the master service
+// get_fundamentals method.
+type GetFundamentalsPayload struct {
+	// 銘柄コード
+	Symbol string
+}
 
 // GetStockPayload is the payload type of the master service get_stock method.
 type GetStockPayload struct {
@@ -42,6 +60,154 @@ type GetStockPayload struct {
 	Symbol string
 }
 
+// An industry and the number of stocks in it.
+type IndustryResult struct {
+	// 業種コード
+	IndustryCode string
+	// 業種コード名
+	IndustryName string
+	// 銘柄数
+	Count int64
+}
+
+// ListStocksPayload is the payload type of the master service list_stocks
+// method.
+type ListStocksPayload struct {
+	// 優先市場コードで絞り込む
+	Market *string
+	// 業種コードで絞り込む
+	IndustryCode *string
+	// 銘柄名・銘柄名（カナ）の部分一致で絞り込む
+	Q *string
+	// 売買単位で絞り込む
+	TradingUnit *int
+	// 取得開始位置
+	Offset int
+	// 取得件数
+	Limit int
+}
+
+// ListSyncRunsPayload is the payload type of the master service list_sync_runs
+// method.
+type ListSyncRunsPayload struct {
+	// 取得件数
+	Limit int
+}
+
+// The number of records a master data sync changed in one table.
+type MasterSyncCounts struct {
+	// 新規に追加した件数
+	Inserted int
+	// 更新した件数
+	Updated int
+	// 変更がなかった件数
+	Unchanged int
+	// 論理削除した件数
+	Deleted int
+}
+
+// A recorded master data sync run.
+type MasterSyncRun struct {
+	// 同期の実行履歴ID
+	ID uint
+	// 同期の契機 (startup, scheduled, manual)
+	Trigger string
+	// 同期の状態 (running, succeeded, failed)
+	Status string
+	// 開始日時 (RFC3339)
+	StartedAt string
+	// 終了日時 (RFC3339, 実行中は省略)
+	FinishedAt *string
+	// 失敗した場合のエラー内容
+	Error *string
+	// 反映した件数 (失敗した場合は失敗するまでに反映した件数)
+	Summary *StockbotMasterSyncSummary
+}
+
+// StockbotIndustryCollection is the result type of the master service
+// list_industries method.
+type StockbotIndustryCollection struct {
+	// 業種のリスト
+	Industries []*IndustryResult
+}
+
+// StockbotMasterSyncRunCollection is the result type of the master service
+// list_sync_runs method.
+type StockbotMasterSyncRunCollection struct {
+	// 同期の実行履歴のリスト
+	Runs []*MasterSyncRun
+}
+
+// StockbotMasterSyncSummary is the result type of the master service update
+// method.
+type StockbotMasterSyncSummary struct {
+	// 同期の実行履歴ID
+	RunID uint
+	// 同期範囲 (watched, full)
+	Scope string
+	// 銘柄マスタ
+	Stocks *MasterSyncCounts
+	// 株式銘柄市場マスタ
+	StockMarkets *MasterSyncCounts
+	// 呼値
+	TickRules *MasterSyncCounts
+	// 保証金マスタ
+	MarginMasters *MasterSyncCounts
+	// 銘柄別・市場別規制
+	Regulations *MasterSyncCounts
+	// 運用ステータス
+	OperationStatuses *MasterSyncCounts
+}
+
+// StockbotStockFundamentals is the result type of the master service
+// get_fundamentals method.
+type StockbotStockFundamentals struct {
+	// 銘柄コード
+	Symbol string
+	// スナップショットの日付 (YYYYMMDD)
+	SnapshotDate string
+	// スナップショットを取得した日時 (RFC3339)
+	FetchedAt string
+	// 一株資産 (実績・連結)
+	Bps *float64
+	// 一株利益 (予想・通期連結)
+	Eps *float64
+	// ROE (予想, %)
+	Roe *float64
+	// 一株配当 (予想, 配当利回りから算出)
+	DividendPerShare *float64
+	// PER (予想, 取得時点)
+	ReportedPer *float64
+	// PBR (実績, 取得時点)
+	ReportedPbr *float64
+	// 配当利回り (予想, %, 取得時点)
+	ReportedDividendYield *float64
+	// 株式益回り (予想, %)
+	EarningsYield *float64
+	// 配当権利落日 (本決算, YYYYMMDD)
+	ExDividendDate *string
+	// 中間配当権利落日 (YYYYMMDD)
+	InterimExDividendDate *string
+	// 最終落日 (決算期以外, YYYYMMDD)
+	LastExRightsDate *string
+	// 年初来高値
+	YearHigh *float64
+	// 年初来高値の更新日 (YYYYMMDD)
+	YearHighDate *string
+	// 年初来安値
+	YearLow *float64
+	// 年初来安値の更新日 (YYYYMMDD)
+	YearLowDate *string
+	// 指標の算出に使用した現在値 (取得できない場合は省略)
+	Price *float64
+	// 現在値による PER
+	Per *float64
+	// 現在値による PBR
+	Pbr *float64
+	// 現在値による配当利回り (%)
+	DividendYield *float64
+}
+
 // StockbotStockMaster is the result type of the master service get_stock
 // method.
 type StockbotStockMaster struct {
@@ -57,6 +223,30 @@ type StockbotStockMaster struct {
 	IndustryCode *string
 	// 業種コード名
 	IndustryName *string
+	// 値幅上限 (ストップ高)
+	UpperLimit *float64
+	// 値幅下限 (ストップ安)
+	LowerLimit *float64
+	// 売買単位
+	TradingUnit *int
+}
+
+// StockbotStockMasterPage is the result type of the master service list_stocks
+// method.
+type StockbotStockMasterPage struct {
+	// 銘柄マスタのリスト
+	Stocks []*StockbotStockMaster
+	// 検索条件に一致する銘柄の総数
+	Total int64
+	// 取得開始位置
+	Offset int
+	// 取得件数
+	Limit int
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
 }
 
 // NewStockbotStockMaster initializes result type StockbotStockMaster from
@@ -73,6 +263,78 @@ func NewViewedStockbotStockMaster(res *StockbotStockMaster, view string) *master
 	return &masterviews.StockbotStockMaster{Projected: p, View: "default"}
 }
 
+// NewStockbotStockFundamentals initializes result type
+// StockbotStockFundamentals from viewed result type StockbotStockFundamentals.
+func NewStockbotStockFundamentals(vres *masterviews.StockbotStockFundamentals) *StockbotStockFundamentals {
+	return newStockbotStockFundamentals(vres.Projected)
+}
+
+// NewViewedStockbotStockFundamentals initializes viewed result type
+// StockbotStockFundamentals from result type StockbotStockFundamentals using
+// the given view.
+func NewViewedStockbotStockFundamentals(res *StockbotStockFundamentals, view string) *masterviews.StockbotStockFundamentals {
+	p := newStockbotStockFundamentalsView(res)
+	return &masterviews.StockbotStockFundamentals{Projected: p, View: "default"}
+}
+
+// NewStockbotStockMasterPage initializes result type StockbotStockMasterPage
+// from viewed result type StockbotStockMasterPage.
+func NewStockbotStockMasterPage(vres *masterviews.StockbotStockMasterPage) *StockbotStockMasterPage {
+	return newStockbotStockMasterPage(vres.Projected)
+}
+
+// NewViewedStockbotStockMasterPage initializes viewed result type
+// StockbotStockMasterPage from result type StockbotStockMasterPage using the
+// given view.
+func NewViewedStockbotStockMasterPage(res *StockbotStockMasterPage, view string) *masterviews.StockbotStockMasterPage {
+	p := newStockbotStockMasterPageView(res)
+	return &masterviews.StockbotStockMasterPage{Projected: p, View: "default"}
+}
+
+// NewStockbotIndustryCollection initializes result type
+// StockbotIndustryCollection from viewed result type
+// StockbotIndustryCollection.
+func NewStockbotIndustryCollection(vres *masterviews.StockbotIndustryCollection) *StockbotIndustryCollection {
+	return newStockbotIndustryCollection(vres.Projected)
+}
+
+// NewViewedStockbotIndustryCollection initializes viewed result type
+// StockbotIndustryCollection from result type StockbotIndustryCollection using
+// the given view.
+func NewViewedStockbotIndustryCollection(res *StockbotIndustryCollection, view string) *masterviews.StockbotIndustryCollection {
+	p := newStockbotIndustryCollectionView(res)
+	return &masterviews.StockbotIndustryCollection{Projected: p, View: "default"}
+}
+
+// NewStockbotMasterSyncSummary initializes result type
+// StockbotMasterSyncSummary from viewed result type StockbotMasterSyncSummary.
+func NewStockbotMasterSyncSummary(vres *masterviews.StockbotMasterSyncSummary) *StockbotMasterSyncSummary {
+	return newStockbotMasterSyncSummary(vres.Projected)
+}
+
+// NewViewedStockbotMasterSyncSummary initializes viewed result type
+// StockbotMasterSyncSummary from result type StockbotMasterSyncSummary using
+// the given view.
+func NewViewedStockbotMasterSyncSummary(res *StockbotMasterSyncSummary, view string) *masterviews.StockbotMasterSyncSummary {
+	p := newStockbotMasterSyncSummaryView(res)
+	return &masterviews.StockbotMasterSyncSummary{Projected: p, View: "default"}
+}
+
+// NewStockbotMasterSyncRunCollection initializes result type
+// StockbotMasterSyncRunCollection from viewed result type
+// StockbotMasterSyncRunCollection.
+func NewStockbotMasterSyncRunCollection(vres *masterviews.StockbotMasterSyncRunCollection) *StockbotMasterSyncRunCollection {
+	return newStockbotMasterSyncRunCollection(vres.Projected)
+}
+
+// NewViewedStockbotMasterSyncRunCollection initializes viewed result type
+// StockbotMasterSyncRunCollection from result type
+// StockbotMasterSyncRunCollection using the given view.
+func NewViewedStockbotMasterSyncRunCollection(res *StockbotMasterSyncRunCollection, view string) *masterviews.StockbotMasterSyncRunCollection {
+	p := newStockbotMasterSyncRunCollectionView(res)
+	return &masterviews.StockbotMasterSyncRunCollection{Projected: p, View: "default"}
+}
+
 // newStockbotStockMaster converts projected type StockbotStockMaster to
 // service type StockbotStockMaster.
 func newStockbotStockMaster(vres *masterviews.StockbotStockMasterView) *StockbotStockMaster {
@@ -80,6 +342,9 @@ func newStockbotStockMaster(vres *masterviews.StockbotStockMasterView) *Stockbot
 		NameKana:     vres.NameKana,
 		IndustryCode: vres.IndustryCode,
 		IndustryName: vres.IndustryName,
+		UpperLimit:   vres.UpperLimit,
+		LowerLimit:   vres.LowerLimit,
+		TradingUnit:  vres.TradingUnit,
 	}
 	if vres.Symbol != nil {
 		res.Symbol = *vres.Symbol
@@ -103,6 +368,458 @@ func newStockbotStockMasterView(res *StockbotStockMaster) *masterviews.StockbotS
 		Market:       &res.Market,
 		IndustryCode: res.IndustryCode,
 		IndustryName: res.IndustryName,
+		UpperLimit:   res.UpperLimit,
+		LowerLimit:   res.LowerLimit,
+		TradingUnit:  res.TradingUnit,
+	}
+	return vres
+}
+
+// newStockbotStockFundamentals converts projected type
+// StockbotStockFundamentals to service type StockbotStockFundamentals.
+func newStockbotStockFundamentals(vres *masterviews.StockbotStockFundamentalsView) *StockbotStockFundamentals {
+	res := &StockbotStockFundamentals{
+		Bps:                   vres.Bps,
+		Eps:                   vres.Eps,
+		Roe:                   vres.Roe,
+		DividendPerShare:      vres.DividendPerShare,
+		ReportedPer:           vres.ReportedPer,
+		ReportedPbr:           vres.ReportedPbr,
+		ReportedDividendYield: vres.ReportedDividendYield,
+		EarningsYield:         vres.EarningsYield,
+		ExDividendDate:        vres.ExDividendDate,
+		InterimExDividendDate: vres.InterimExDividendDate,
+		LastExRightsDate:      vres.LastExRightsDate,
+		YearHigh:              vres.YearHigh,
+		YearHighDate:          vres.YearHighDate,
+		YearLow:               vres.YearLow,
+		YearLowDate:           vres.YearLowDate,
+		Price:                 vres.Price,
+		Per:                   vres.Per,
+		Pbr:                   vres.Pbr,
+		DividendYield:         vres.DividendYield,
+	}
+	if vres.Symbol != nil {
+		res.Symbol = *vres.Symbol
+	}
+	if vres.SnapshotDate != nil {
+		res.SnapshotDate = *vres.SnapshotDate
+	}
+	if vres.FetchedAt != nil {
+		res.FetchedAt = *vres.FetchedAt
+	}
+	return res
+}
+
+// newStockbotStockFundamentalsView projects result type
+// StockbotStockFundamentals to projected type StockbotStockFundamentalsView
+// using the "default" view.
+func newStockbotStockFundamentalsView(res *StockbotStockFundamentals) *masterviews.StockbotStockFundamentalsView {
+	vres := &masterviews.StockbotStockFundamentalsView{
+		Symbol:                &res.Symbol,
+		SnapshotDate:          &res.SnapshotDate,
+		FetchedAt:             &res.FetchedAt,
+		Bps:                   res.Bps,
+		Eps:                   res.Eps,
+		Roe:                   res.Roe,
+		DividendPerShare:      res.DividendPerShare,
+		ReportedPer:           res.ReportedPer,
+		ReportedPbr:           res.ReportedPbr,
+		ReportedDividendYield: res.ReportedDividendYield,
+		EarningsYield:         res.EarningsYield,
+		ExDividendDate:        res.ExDividendDate,
+		InterimExDividendDate: res.InterimExDividendDate,
+		LastExRightsDate:      res.LastExRightsDate,
+		YearHigh:              res.YearHigh,
+		YearHighDate:          res.YearHighDate,
+		YearLow:               res.YearLow,
+		YearLowDate:           res.YearLowDate,
+		Price:                 res.Price,
+		Per:                   res.Per,
+		Pbr:                   res.Pbr,
+		DividendYield:         res.DividendYield,
 	}
 	return vres
 }
+
+// newStockbotStockMasterPage converts projected type StockbotStockMasterPage
+// to service type StockbotStockMasterPage.
+func newStockbotStockMasterPage(vres *masterviews.StockbotStockMasterPageView) *StockbotStockMasterPage {
+	res := &StockbotStockMasterPage{}
+	if vres.Total != nil {
+		res.Total = *vres.Total
+	}
+	if vres.Offset != nil {
+		res.Offset = *vres.Offset
+	}
+	if vres.Limit != nil {
+		res.Limit = *vres.Limit
+	}
+	if vres.Stocks != nil {
+		res.Stocks = make([]*StockbotStockMaster, len(vres.Stocks))
+		for i, val := range vres.Stocks {
+			if val == nil {
+				res.Stocks[i] = nil
+				continue
+			}
+			res.Stocks[i] = transformMasterviewsStockbotStockMasterViewToStockbotStockMaster(val)
+		}
+	}
+	return res
+}
+
+// newStockbotStockMasterPageView projects result type StockbotStockMasterPage
+// to projected type StockbotStockMasterPageView using the "default" view.
+func newStockbotStockMasterPageView(res *StockbotStockMasterPage) *masterviews.StockbotStockMasterPageView {
+	vres := &masterviews.StockbotStockMasterPageView{
+		Total:  &res.Total,
+		Offset: &res.Offset,
+		Limit:  &res.Limit,
+	}
+	if res.Stocks != nil {
+		vres.Stocks = make([]*masterviews.StockbotStockMasterView, len(res.Stocks))
+		for i, val := range res.Stocks {
+			if val == nil {
+				vres.Stocks[i] = nil
+				continue
+			}
+			vres.Stocks[i] = transformStockbotStockMasterToMasterviewsStockbotStockMasterView(val)
+		}
+	} else {
+		vres.Stocks = []*masterviews.StockbotStockMasterView{}
+	}
+	return vres
+}
+
+// newStockbotIndustryCollection converts projected type
+// StockbotIndustryCollection to service type StockbotIndustryCollection.
+func newStockbotIndustryCollection(vres *masterviews.StockbotIndustryCollectionView) *StockbotIndustryCollection {
+	res := &StockbotIndustryCollection{}
+	if vres.Industries != nil {
+		res.Industries = make([]*IndustryResult, len(vres.Industries))
+		for i, val := range vres.Industries {
+			if val == nil {
+				res.Industries[i] = nil
+				continue
+			}
+			res.Industries[i] = transformMasterviewsIndustryResultViewToIndustryResult(val)
+		}
+	}
+	return res
+}
+
+// newStockbotIndustryCollectionView projects result type
+// StockbotIndustryCollection to projected type StockbotIndustryCollectionView
+// using the "default" view.
+func newStockbotIndustryCollectionView(res *StockbotIndustryCollection) *masterviews.StockbotIndustryCollectionView {
+	vres := &masterviews.StockbotIndustryCollectionView{}
+	if res.Industries != nil {
+		vres.Industries = make([]*masterviews.IndustryResultView, len(res.Industries))
+		for i, val := range res.Industries {
+			if val == nil {
+				vres.Industries[i] = nil
+				continue
+			}
+			vres.Industries[i] = transformIndustryResultToMasterviewsIndustryResultView(val)
+		}
+	} else {
+		vres.Industries = []*masterviews.IndustryResultView{}
+	}
+	return vres
+}
+
+// newStockbotMasterSyncSummary converts projected type
+// StockbotMasterSyncSummary to service type StockbotMasterSyncSummary.
+func newStockbotMasterSyncSummary(vres *masterviews.StockbotMasterSyncSummaryView) *StockbotMasterSyncSummary {
+	res := &StockbotMasterSyncSummary{}
+	if vres.RunID != nil {
+		res.RunID = *vres.RunID
+	}
+	if vres.Scope != nil {
+		res.Scope = *vres.Scope
+	}
+	if vres.Stocks != nil {
+		res.Stocks = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(vres.Stocks)
+	}
+	if vres.StockMarkets != nil {
+		res.StockMarkets = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(vres.StockMarkets)
+	}
+	if vres.TickRules != nil {
+		res.TickRules = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(vres.TickRules)
+	}
+	if vres.MarginMasters != nil {
+		res.MarginMasters = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(vres.MarginMasters)
+	}
+	if vres.Regulations != nil {
+		res.Regulations = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(vres.Regulations)
+	}
+	if vres.OperationStatuses != nil {
+		res.OperationStatuses = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(vres.OperationStatuses)
+	}
+	return res
+}
+
+// newStockbotMasterSyncSummaryView projects result type
+// StockbotMasterSyncSummary to projected type StockbotMasterSyncSummaryView
+// using the "default" view.
+func newStockbotMasterSyncSummaryView(res *StockbotMasterSyncSummary) *masterviews.StockbotMasterSyncSummaryView {
+	vres := &masterviews.StockbotMasterSyncSummaryView{
+		RunID: &res.RunID,
+		Scope: &res.Scope,
+	}
+	if res.Stocks != nil {
+		vres.Stocks = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(res.Stocks)
+	}
+	if res.StockMarkets != nil {
+		vres.StockMarkets = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(res.StockMarkets)
+	}
+	if res.TickRules != nil {
+		vres.TickRules = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(res.TickRules)
+	}
+	if res.MarginMasters != nil {
+		vres.MarginMasters = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(res.MarginMasters)
+	}
+	if res.Regulations != nil {
+		vres.Regulations = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(res.Regulations)
+	}
+	if res.OperationStatuses != nil {
+		vres.OperationStatuses = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(res.OperationStatuses)
+	}
+	return vres
+}
+
+// newStockbotMasterSyncRunCollection converts projected type
+// StockbotMasterSyncRunCollection to service type
+// StockbotMasterSyncRunCollection.
+func newStockbotMasterSyncRunCollection(vres *masterviews.StockbotMasterSyncRunCollectionView) *StockbotMasterSyncRunCollection {
+	res := &StockbotMasterSyncRunCollection{}
+	if vres.Runs != nil {
+		res.Runs = make([]*MasterSyncRun, len(vres.Runs))
+		for i, val := range vres.Runs {
+			if val == nil {
+				res.Runs[i] = nil
+				continue
+			}
+			res.Runs[i] = transformMasterviewsMasterSyncRunViewToMasterSyncRun(val)
+		}
+	}
+	return res
+}
+
+// newStockbotMasterSyncRunCollectionView projects result type
+// StockbotMasterSyncRunCollection to projected type
+// StockbotMasterSyncRunCollectionView using the "default" view.
+func newStockbotMasterSyncRunCollectionView(res *StockbotMasterSyncRunCollection) *masterviews.StockbotMasterSyncRunCollectionView {
+	vres := &masterviews.StockbotMasterSyncRunCollectionView{}
+	if res.Runs != nil {
+		vres.Runs = make([]*masterviews.MasterSyncRunView, len(res.Runs))
+		for i, val := range res.Runs {
+			if val == nil {
+				vres.Runs[i] = nil
+				continue
+			}
+			vres.Runs[i] = transformMasterSyncRunToMasterviewsMasterSyncRunView(val)
+		}
+	} else {
+		vres.Runs = []*masterviews.MasterSyncRunView{}
+	}
+	return vres
+}
+
+// transformMasterviewsStockbotStockMasterViewToStockbotStockMaster builds a
+// value of type *StockbotStockMaster from a value of type
+// *masterviews.StockbotStockMasterView.
+func transformMasterviewsStockbotStockMasterViewToStockbotStockMaster(v *masterviews.StockbotStockMasterView) *StockbotStockMaster {
+	if v == nil {
+		return nil
+	}
+	res := &StockbotStockMaster{
+		Symbol:       *v.Symbol,
+		Name:         *v.Name,
+		NameKana:     v.NameKana,
+		Market:       *v.Market,
+		IndustryCode: v.IndustryCode,
+		IndustryName: v.IndustryName,
+		UpperLimit:   v.UpperLimit,
+		LowerLimit:   v.LowerLimit,
+		TradingUnit:  v.TradingUnit,
+	}
+
+	return res
+}
+
+// transformStockbotStockMasterToMasterviewsStockbotStockMasterView builds a
+// value of type *masterviews.StockbotStockMasterView from a value of type
+// *StockbotStockMaster.
+func transformStockbotStockMasterToMasterviewsStockbotStockMasterView(v *StockbotStockMaster) *masterviews.StockbotStockMasterView {
+	res := &masterviews.StockbotStockMasterView{
+		Symbol:       &v.Symbol,
+		Name:         &v.Name,
+		NameKana:     v.NameKana,
+		Market:       &v.Market,
+		IndustryCode: v.IndustryCode,
+		IndustryName: v.IndustryName,
+		UpperLimit:   v.UpperLimit,
+		LowerLimit:   v.LowerLimit,
+		TradingUnit:  v.TradingUnit,
+	}
+
+	return res
+}
+
+// transformMasterviewsIndustryResultViewToIndustryResult builds a value of
+// type *IndustryResult from a value of type *masterviews.IndustryResultView.
+func transformMasterviewsIndustryResultViewToIndustryResult(v *masterviews.IndustryResultView) *IndustryResult {
+	if v == nil {
+		return nil
+	}
+	res := &IndustryResult{
+		IndustryCode: *v.IndustryCode,
+		IndustryName: *v.IndustryName,
+		Count:        *v.Count,
+	}
+
+	return res
+}
+
+// transformIndustryResultToMasterviewsIndustryResultView builds a value of
+// type *masterviews.IndustryResultView from a value of type *IndustryResult.
+func transformIndustryResultToMasterviewsIndustryResultView(v *IndustryResult) *masterviews.IndustryResultView {
+	res := &masterviews.IndustryResultView{
+		IndustryCode: &v.IndustryCode,
+		IndustryName: &v.IndustryName,
+		Count:        &v.Count,
+	}
+
+	return res
+}
+
+// transformMasterviewsMasterSyncCountsViewToMasterSyncCounts builds a value of
+// type *MasterSyncCounts from a value of type
+// *masterviews.MasterSyncCountsView.
+func transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(v *masterviews.MasterSyncCountsView) *MasterSyncCounts {
+	if v == nil {
+		return nil
+	}
+	res := &MasterSyncCounts{
+		Inserted:  *v.Inserted,
+		Updated:   *v.Updated,
+		Unchanged: *v.Unchanged,
+		Deleted:   *v.Deleted,
+	}
+
+	return res
+}
+
+// transformMasterSyncCountsToMasterviewsMasterSyncCountsView builds a value of
+// type *masterviews.MasterSyncCountsView from a value of type
+// *MasterSyncCounts.
+func transformMasterSyncCountsToMasterviewsMasterSyncCountsView(v *MasterSyncCounts) *masterviews.MasterSyncCountsView {
+	res := &masterviews.MasterSyncCountsView{
+		Inserted:  &v.Inserted,
+		Updated:   &v.Updated,
+		Unchanged: &v.Unchanged,
+		Deleted:   &v.Deleted,
+	}
+
+	return res
+}
+
+// transformMasterviewsMasterSyncRunViewToMasterSyncRun builds a value of type
+// *MasterSyncRun from a value of type *masterviews.MasterSyncRunView.
+func transformMasterviewsMasterSyncRunViewToMasterSyncRun(v *masterviews.MasterSyncRunView) *MasterSyncRun {
+	if v == nil {
+		return nil
+	}
+	res := &MasterSyncRun{
+		ID:         *v.ID,
+		Trigger:    *v.Trigger,
+		Status:     *v.Status,
+		StartedAt:  *v.StartedAt,
+		FinishedAt: v.FinishedAt,
+		Error:      v.Error,
+	}
+	if v.Summary != nil {
+		res.Summary = transformMasterviewsStockbotMasterSyncSummaryViewToStockbotMasterSyncSummary(v.Summary)
+	}
+
+	return res
+}
+
+// transformMasterviewsStockbotMasterSyncSummaryViewToStockbotMasterSyncSummary
+// builds a value of type *StockbotMasterSyncSummary from a value of type
+// *masterviews.StockbotMasterSyncSummaryView.
+func transformMasterviewsStockbotMasterSyncSummaryViewToStockbotMasterSyncSummary(v *masterviews.StockbotMasterSyncSummaryView) *StockbotMasterSyncSummary {
+	res := &StockbotMasterSyncSummary{
+		RunID: *v.RunID,
+		Scope: *v.Scope,
+	}
+	if v.Stocks != nil {
+		res.Stocks = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(v.Stocks)
+	}
+	if v.StockMarkets != nil {
+		res.StockMarkets = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(v.StockMarkets)
+	}
+	if v.TickRules != nil {
+		res.TickRules = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(v.TickRules)
+	}
+	if v.MarginMasters != nil {
+		res.MarginMasters = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(v.MarginMasters)
+	}
+	if v.Regulations != nil {
+		res.Regulations = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(v.Regulations)
+	}
+	if v.OperationStatuses != nil {
+		res.OperationStatuses = transformMasterviewsMasterSyncCountsViewToMasterSyncCounts(v.OperationStatuses)
+	}
+
+	return res
+}
+
+// transformMasterSyncRunToMasterviewsMasterSyncRunView builds a value of type
+// *masterviews.MasterSyncRunView from a value of type *MasterSyncRun.
+func transformMasterSyncRunToMasterviewsMasterSyncRunView(v *MasterSyncRun) *masterviews.MasterSyncRunView {
+	res := &masterviews.MasterSyncRunView{
+		ID:         &v.ID,
+		Trigger:    &v.Trigger,
+		Status:     &v.Status,
+		StartedAt:  &v.StartedAt,
+		FinishedAt: v.FinishedAt,
+		Error:      v.Error,
+	}
+	if v.Summary != nil {
+		res.Summary = transformStockbotMasterSyncSummaryToMasterviewsStockbotMasterSyncSummaryView(v.Summary)
+	}
+
+	return res
+}
+
+// transformStockbotMasterSyncSummaryToMasterviewsStockbotMasterSyncSummaryView
+// builds a value of type *masterviews.StockbotMasterSyncSummaryView from a
+// value of type *StockbotMasterSyncSummary.
+func transformStockbotMasterSyncSummaryToMasterviewsStockbotMasterSyncSummaryView(v *StockbotMasterSyncSummary) *masterviews.StockbotMasterSyncSummaryView {
+	res := &masterviews.StockbotMasterSyncSummaryView{
+		RunID: &v.RunID,
+		Scope: &v.Scope,
+	}
+	if v.Stocks != nil {
+		res.Stocks = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(v.Stocks)
+	}
+	if v.StockMarkets != nil {
+		res.StockMarkets = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(v.StockMarkets)
+	}
+	if v.TickRules != nil {
+		res.TickRules = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(v.TickRules)
+	}
+	if v.MarginMasters != nil {
+		res.MarginMasters = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(v.MarginMasters)
+	}
+	if v.Regulations != nil {
+		res.Regulations = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(v.Regulations)
+	}
+	if v.OperationStatuses != nil {
+		res.OperationStatuses = transformMasterSyncCountsToMasterviewsMasterSyncCountsView(v.OperationStatuses)
+	}
+
+	return res
+}
@@ -20,6 +20,51 @@ type StockbotStockMaster struct {
 	View string
 }
 
+// StockbotStockFundamentals is the viewed result type that is projected based
+// on a view.
+type StockbotStockFundamentals struct {
+	// Type to project
+	Projected *StockbotStockFundamentalsView
+	// View to render
+	View string
+}
+
+// StockbotStockMasterPage is the viewed result type that is projected based on
+// a view.
+type StockbotStockMasterPage struct {
+	// Type to project
+	Projected *StockbotStockMasterPageView
+	// View to render
+	View string
+}
+
+// StockbotIndustryCollection is the viewed result type that is projected based
+// on a view.
+type StockbotIndustryCollection struct {
+	// Type to project
+	Projected *StockbotIndustryCollectionView
+	// View to render
+	View string
+}
+
+// StockbotMasterSyncSummary is the viewed result type that is projected based
+// on a view.
+type StockbotMasterSyncSummary struct {
+	// Type to project
+	Projected *StockbotMasterSyncSummaryView
+	// View to render
+	View string
+}
+
+// StockbotMasterSyncRunCollection is the viewed result type that is projected
+// based on a view.
+type StockbotMasterSyncRunCollection struct {
+	// Type to project
+	Projected *StockbotMasterSyncRunCollectionView
+	// View to render
+	View string
+}
+
 // StockbotStockMasterView is a type that runs validations on a projected type.
 type StockbotStockMasterView struct {
 	// 銘柄コード
@@ -34,6 +79,149 @@ type StockbotStockMasterView struct {
 	IndustryCode *string
 	// 業種コード名
 	IndustryName *string
+	// 値幅上限 (ストップ高)
+	UpperLimit *float64
+	// 値幅下限 (ストップ安)
+	LowerLimit *float64
+	// 売買単位
+	TradingUnit *int
+}
+
+// StockbotStockFundamentalsView is a type that runs validations on a projected
+// type.
+type StockbotStockFundamentalsView struct {
+	// 銘柄コード
+	Symbol *string
+	// スナップショットの日付 (YYYYMMDD)
+	SnapshotDate *string
+	// スナップショットを取得した日時 (RFC3339)
+	FetchedAt *string
+	// 一株資産 (実績・連結)
+	Bps *float64
+	// 一株利益 (予想・通期連結)
+	Eps *float64
+	// ROE (予想, %)
+	Roe *float64
+	// 一株配当 (予想, 配当利回りから算出)
+	DividendPerShare *float64
+	// PER (予想, 取得時点)
+	ReportedPer *float64
+	// PBR (実績, 取得時点)
+	ReportedPbr *float64
+	// 配当利回り (予想, %, 取得時点)
+	ReportedDividendYield *float64
+	// 株式益回り (予想, %)
+	EarningsYield *float64
+	// 配当権利落日 (本決算, YYYYMMDD)
+	ExDividendDate *string
+	// 中間配当権利落日 (YYYYMMDD)
+	InterimExDividendDate *string
+	// 最終落日 (決算期以外, YYYYMMDD)
+	LastExRightsDate *string
+	// 年初来高値
+	YearHigh *float64
+	// 年初来高値の更新日 (YYYYMMDD)
+	YearHighDate *string
+	// 年初来安値
+	YearLow *float64
+	// 年初来安値の更新日 (YYYYMMDD)
+	YearLowDate *string
+	// 指標の算出に使用した現在値 (取得できない場合は省略)
+	Price *float64
+	// 現在値による PER
+	Per *float64
+	// 現在値による PBR
+	Pbr *float64
+	// 現在値による配当利回り (%)
+	DividendYield *float64
+}
+
+// StockbotStockMasterPageView is a type that runs validations on a projected
+// type.
+type StockbotStockMasterPageView struct {
+	// 銘柄マスタのリスト
+	Stocks []*StockbotStockMasterView
+	// 検索条件に一致する銘柄の総数
+	Total *int64
+	// 取得開始位置
+	Offset *int
+	// 取得件数
+	Limit *int
+}
+
+// StockbotIndustryCollectionView is a type that runs validations on a
+// projected type.
+type StockbotIndustryCollectionView struct {
+	// 業種のリスト
+	Industries []*IndustryResultView
+}
+
+// IndustryResultView is a type that runs validations on a projected type.
+type IndustryResultView struct {
+	// 業種コード
+	IndustryCode *string
+	// 業種コード名
+	IndustryName *string
+	// 銘柄数
+	Count *int64
+}
+
+// StockbotMasterSyncSummaryView is a type that runs validations on a projected
+// type.
+type StockbotMasterSyncSummaryView struct {
+	// 同期の実行履歴ID
+	RunID *uint
+	// 同期範囲 (watched, full)
+	Scope *string
+	// 銘柄マスタ
+	Stocks *MasterSyncCountsView
+	// 株式銘柄市場マスタ
+	StockMarkets *MasterSyncCountsView
+	// 呼値
+	TickRules *MasterSyncCountsView
+	// 保証金マスタ
+	MarginMasters *MasterSyncCountsView
+	// 銘柄別・市場別規制
+	Regulations *MasterSyncCountsView
+	// 運用ステータス
+	OperationStatuses *MasterSyncCountsView
+}
+
+// MasterSyncCountsView is a type that runs validations on a projected type.
+type MasterSyncCountsView struct {
+	// 新規に追加した件数
+	Inserted *int
+	// 更新した件数
+	Updated *int
+	// 変更がなかった件数
+	Unchanged *int
+	// 論理削除した件数
+	Deleted *int
+}
+
+// StockbotMasterSyncRunCollectionView is a type that runs validations on a
+// projected type.
+type StockbotMasterSyncRunCollectionView struct {
+	// 同期の実行履歴のリスト
+	Runs []*MasterSyncRunView
+}
+
+// MasterSyncRunView is a type that runs validations on a projected type.
+type MasterSyncRunView struct {
+	// 同期の実行履歴ID
+	ID *uint
+	// 同期の契機 (startup, scheduled, manual)
+	Trigger *string
+	// 同期の状態 (running, succeeded, failed)
+	Status *string
+	// 開始日時 (RFC3339)
+	StartedAt *string
+	// 終了日時 (RFC3339, 実行中は省略)
+	FinishedAt *string
+	// 失敗した場合のエラー内容
+	Error *string
+	// 反映した件数 (失敗した場合は失敗するまでに反映した件数)
+	Summary *StockbotMasterSyncSummaryView
 }
 
 var (
@@ -47,6 +235,75 @@ var (
 			"market",
 			"industry_code",
 			"industry_name",
+			"upper_limit",
+			"lower_limit",
+			"trading_unit",
+		},
+	}
+	// StockbotStockFundamentalsMap is a map indexing the attribute names of
+	// StockbotStockFundamentals by view name.
+	StockbotStockFundamentalsMap = map[string][]string{
+		"default": {
+			"symbol",
+			"snapshot_date",
+			"fetched_at",
+			"bps",
+			"eps",
+			"roe",
+			"dividend_per_share",
+			"reported_per",
+			"reported_pbr",
+			"reported_dividend_yield",
+			"earnings_yield",
+			"ex_dividend_date",
+			"interim_ex_dividend_date",
+			"last_ex_rights_date",
+			"year_high",
+			"year_high_date",
+			"year_low",
+			"year_low_date",
+			"price",
+			"per",
+			"pbr",
+			"dividend_yield",
+		},
+	}
+	// StockbotStockMasterPageMap is a map indexing the attribute names of
+	// StockbotStockMasterPage by view name.
+	StockbotStockMasterPageMap = map[string][]string{
+		"default": {
+			"stocks",
+			"total",
+			"offset",
+			"limit",
+		},
+	}
+	// StockbotIndustryCollectionMap is a map indexing the attribute names of
+	// StockbotIndustryCollection by view name.
+	StockbotIndustryCollectionMap = map[string][]string{
+		"default": {
+			"industries",
+		},
+	}
+	// StockbotMasterSyncSummaryMap is a map indexing the attribute names of
+	// StockbotMasterSyncSummary by view name.
+	StockbotMasterSyncSummaryMap = map[string][]string{
+		"default": {
+			"run_id",
+			"scope",
+			"stocks",
+			"stock_markets",
+			"tick_rules",
+			"margin_masters",
+			"regulations",
+			"operation_statuses",
+		},
+	}
+	// StockbotMasterSyncRunCollectionMap is a map indexing the attribute names of
+	// StockbotMasterSyncRunCollection by view name.
+	StockbotMasterSyncRunCollectionMap = map[string][]string{
+		"default": {
+			"runs",
 		},
 	}
 )
@@ -63,6 +320,66 @@ func ValidateStockbotStockMaster(result *StockbotStockMaster) (err error) {
 	return
 }
 
+// ValidateStockbotStockFundamentals runs the validations defined on the viewed
+// result type StockbotStockFundamentals.
+func ValidateStockbotStockFundamentals(result *StockbotStockFundamentals) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotStockFundamentalsView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotStockMasterPage runs the validations defined on the viewed
+// result type StockbotStockMasterPage.
+func ValidateStockbotStockMasterPage(result *StockbotStockMasterPage) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotStockMasterPageView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotIndustryCollection runs the validations defined on the
+// viewed result type StockbotIndustryCollection.
+func ValidateStockbotIndustryCollection(result *StockbotIndustryCollection) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotIndustryCollectionView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotMasterSyncSummary runs the validations defined on the viewed
+// result type StockbotMasterSyncSummary.
+func ValidateStockbotMasterSyncSummary(result *StockbotMasterSyncSummary) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotMasterSyncSummaryView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotMasterSyncRunCollection runs the validations defined on the
+// viewed result type StockbotMasterSyncRunCollection.
+func ValidateStockbotMasterSyncRunCollection(result *StockbotMasterSyncRunCollection) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotMasterSyncRunCollectionView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
 // ValidateStockbotStockMasterView runs the validations defined on
 // StockbotStockMasterView using the "default" view.
 func ValidateStockbotStockMasterView(result *StockbotStockMasterView) (err error) {
@@ -77,3 +394,193 @@ func ValidateStockbotStockMasterView(result *StockbotStockMasterView) (err error
 	}
 	return
 }
+
+// ValidateStockbotStockFundamentalsView runs the validations defined on
+// StockbotStockFundamentalsView using the "default" view.
+func ValidateStockbotStockFundamentalsView(result *StockbotStockFundamentalsView) (err error) {
+	if result.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "result"))
+	}
+	if result.SnapshotDate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("snapshot_date", "result"))
+	}
+	if result.FetchedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fetched_at", "result"))
+	}
+	return
+}
+
+// ValidateStockbotStockMasterPageView runs the validations defined on
+// StockbotStockMasterPageView using the "default" view.
+func ValidateStockbotStockMasterPageView(result *StockbotStockMasterPageView) (err error) {
+	if result.Stocks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stocks", "result"))
+	}
+	if result.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "result"))
+	}
+	if result.Offset == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("offset", "result"))
+	}
+	if result.Limit == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("limit", "result"))
+	}
+	for _, e := range result.Stocks {
+		if e != nil {
+			if err2 := ValidateStockbotStockMasterView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateStockbotIndustryCollectionView runs the validations defined on
+// StockbotIndustryCollectionView using the "default" view.
+func ValidateStockbotIndustryCollectionView(result *StockbotIndustryCollectionView) (err error) {
+	if result.Industries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("industries", "result"))
+	}
+	for _, e := range result.Industries {
+		if e != nil {
+			if err2 := ValidateIndustryResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateIndustryResultView runs the validations defined on
+// IndustryResultView.
+func ValidateIndustryResultView(result *IndustryResultView) (err error) {
+	if result.IndustryCode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("industry_code", "result"))
+	}
+	if result.IndustryName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("industry_name", "result"))
+	}
+	if result.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "result"))
+	}
+	return
+}
+
+// ValidateStockbotMasterSyncSummaryView runs the validations defined on
+// StockbotMasterSyncSummaryView using the "default" view.
+func ValidateStockbotMasterSyncSummaryView(result *StockbotMasterSyncSummaryView) (err error) {
+	if result.RunID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("run_id", "result"))
+	}
+	if result.Scope == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scope", "result"))
+	}
+	if result.Stocks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stocks", "result"))
+	}
+	if result.StockMarkets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("stock_markets", "result"))
+	}
+	if result.TickRules == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tick_rules", "result"))
+	}
+	if result.MarginMasters == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("margin_masters", "result"))
+	}
+	if result.Regulations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("regulations", "result"))
+	}
+	if result.OperationStatuses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operation_statuses", "result"))
+	}
+	if result.Stocks != nil {
+		if err2 := ValidateMasterSyncCountsView(result.Stocks); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.StockMarkets != nil {
+		if err2 := ValidateMasterSyncCountsView(result.StockMarkets); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.TickRules != nil {
+		if err2 := ValidateMasterSyncCountsView(result.TickRules); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.MarginMasters != nil {
+		if err2 := ValidateMasterSyncCountsView(result.MarginMasters); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.Regulations != nil {
+		if err2 := ValidateMasterSyncCountsView(result.Regulations); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.OperationStatuses != nil {
+		if err2 := ValidateMasterSyncCountsView(result.OperationStatuses); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateMasterSyncCountsView runs the validations defined on
+// MasterSyncCountsView.
+func ValidateMasterSyncCountsView(result *MasterSyncCountsView) (err error) {
+	if result.Inserted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("inserted", "result"))
+	}
+	if result.Updated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated", "result"))
+	}
+	if result.Unchanged == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unchanged", "result"))
+	}
+	if result.Deleted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deleted", "result"))
+	}
+	return
+}
+
+// ValidateStockbotMasterSyncRunCollectionView runs the validations defined on
+// StockbotMasterSyncRunCollectionView using the "default" view.
+func ValidateStockbotMasterSyncRunCollectionView(result *StockbotMasterSyncRunCollectionView) (err error) {
+	if result.Runs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("runs", "result"))
+	}
+	for _, e := range result.Runs {
+		if e != nil {
+			if err2 := ValidateMasterSyncRunView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateMasterSyncRunView runs the validations defined on MasterSyncRunView.
+func ValidateMasterSyncRunView(result *MasterSyncRunView) (err error) {
+	if result.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "result"))
+	}
+	if result.Trigger == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trigger", "result"))
+	}
+	if result.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "result"))
+	}
+	if result.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "result"))
+	}
+	if result.Summary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("summary", "result"))
+	}
+	if result.Summary != nil {
+		if err2 := ValidateStockbotMasterSyncSummaryView(result.Summary); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
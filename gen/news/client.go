@@ -0,0 +1,51 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// news client
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package news
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "news" service client.
+type Client struct {
+	ListEndpoint goa.Endpoint
+	GetEndpoint  goa.Endpoint
+}
+
+// NewClient initializes a "news" service client given the endpoints.
+func NewClient(list, get goa.Endpoint) *Client {
+	return &Client{
+		ListEndpoint: list,
+		GetEndpoint:  get,
+	}
+}
+
+// List calls the "list" endpoint of the "news" service.
+func (c *Client) List(ctx context.Context, p *ListPayload) (res *StockbotNewsCollection, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotNewsCollection), nil
+}
+
+// Get calls the "get" endpoint of the "news" service.
+// Get may return the following errors:
+//   - "not_found" (type *goa.ServiceError): ニュースが見つからない
+//   - error: internal error
+func (c *Client) Get(ctx context.Context, p *GetPayload) (res *NewsResult, err error) {
+	var ires any
+	ires, err = c.GetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*NewsResult), nil
+}
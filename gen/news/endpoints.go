@@ -0,0 +1,57 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// news endpoints
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package news
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "news" service endpoints.
+type Endpoints struct {
+	List goa.Endpoint
+	Get  goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "news" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		List: NewListEndpoint(s),
+		Get:  NewGetEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "news" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.List = m(e.List)
+	e.Get = m(e.Get)
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "list" of
+// service "news".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListPayload)
+		res, err := s.List(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotNewsCollection(res, "default")
+		return vres, nil
+	}
+}
+
+// NewGetEndpoint returns an endpoint function that calls the method "get" of
+// service "news".
+func NewGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetPayload)
+		return s.Get(ctx, p)
+	}
+}
@@ -0,0 +1,213 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// news service
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package news
+
+import (
+	"context"
+	newsviews "stock-bot/gen/news/views"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// The news service exposes news headlines and bodies polled for the watched
+// stocks.
+type Service interface {
+	// List stored news, newest first. The body is not included.
+	List(context.Context, *ListPayload) (res *StockbotNewsCollection, err error)
+	// Get a news item with its body. The body is fetched from the broker if it has
+	// not been stored yet.
+	Get(context.Context, *GetPayload) (res *NewsResult, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "stockbot"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "news"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [2]string{"list", "get"}
+
+// GetPayload is the payload type of the news service get method.
+type GetPayload struct {
+	// ニュースID
+	ID string
+}
+
+// ListPayload is the payload type of the news service list method.
+type ListPayload struct {
+	// 関連銘柄コードで絞り込む
+	Symbol *string
+	// この日時以降のニュースに絞り込む (RFC3339)
+	Since *string
+	// 取得件数
+	Limit int
+}
+
+// NewsResult is the result type of the news service get method.
+type NewsResult struct {
+	// ニュースID
+	ID string
+	// ニュース日時 (RFC3339)
+	PublishedAt string
+	// ニュースカテゴリ
+	Categories []string
+	// ニュースジャンル
+	Genres []string
+	// 関連銘柄コード
+	Symbols []string
+	// ヘッドライン
+	Headline string
+	// 本文 (未取得の場合は省略)
+	Body *string
+}
+
+// StockbotNewsCollection is the result type of the news service list method.
+type StockbotNewsCollection struct {
+	// ニュースのリスト
+	News []*NewsResult
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// NewStockbotNewsCollection initializes result type StockbotNewsCollection
+// from viewed result type StockbotNewsCollection.
+func NewStockbotNewsCollection(vres *newsviews.StockbotNewsCollection) *StockbotNewsCollection {
+	return newStockbotNewsCollection(vres.Projected)
+}
+
+// NewViewedStockbotNewsCollection initializes viewed result type
+// StockbotNewsCollection from result type StockbotNewsCollection using the
+// given view.
+func NewViewedStockbotNewsCollection(res *StockbotNewsCollection, view string) *newsviews.StockbotNewsCollection {
+	p := newStockbotNewsCollectionView(res)
+	return &newsviews.StockbotNewsCollection{Projected: p, View: "default"}
+}
+
+// newStockbotNewsCollection converts projected type StockbotNewsCollection to
+// service type StockbotNewsCollection.
+func newStockbotNewsCollection(vres *newsviews.StockbotNewsCollectionView) *StockbotNewsCollection {
+	res := &StockbotNewsCollection{}
+	if vres.News != nil {
+		res.News = make([]*NewsResult, len(vres.News))
+		for i, val := range vres.News {
+			if val == nil {
+				res.News[i] = nil
+				continue
+			}
+			res.News[i] = transformNewsviewsNewsResultViewToNewsResult(val)
+		}
+	}
+	return res
+}
+
+// newStockbotNewsCollectionView projects result type StockbotNewsCollection to
+// projected type StockbotNewsCollectionView using the "default" view.
+func newStockbotNewsCollectionView(res *StockbotNewsCollection) *newsviews.StockbotNewsCollectionView {
+	vres := &newsviews.StockbotNewsCollectionView{}
+	if res.News != nil {
+		vres.News = make([]*newsviews.NewsResultView, len(res.News))
+		for i, val := range res.News {
+			if val == nil {
+				vres.News[i] = nil
+				continue
+			}
+			vres.News[i] = transformNewsResultToNewsviewsNewsResultView(val)
+		}
+	} else {
+		vres.News = []*newsviews.NewsResultView{}
+	}
+	return vres
+}
+
+// transformNewsviewsNewsResultViewToNewsResult builds a value of type
+// *NewsResult from a value of type *newsviews.NewsResultView.
+func transformNewsviewsNewsResultViewToNewsResult(v *newsviews.NewsResultView) *NewsResult {
+	if v == nil {
+		return nil
+	}
+	res := &NewsResult{
+		ID:          *v.ID,
+		PublishedAt: *v.PublishedAt,
+		Headline:    *v.Headline,
+		Body:        v.Body,
+	}
+	if v.Categories != nil {
+		res.Categories = make([]string, len(v.Categories))
+		for i, val := range v.Categories {
+			res.Categories[i] = val
+		}
+	} else {
+		res.Categories = []string{}
+	}
+	if v.Genres != nil {
+		res.Genres = make([]string, len(v.Genres))
+		for i, val := range v.Genres {
+			res.Genres[i] = val
+		}
+	} else {
+		res.Genres = []string{}
+	}
+	if v.Symbols != nil {
+		res.Symbols = make([]string, len(v.Symbols))
+		for i, val := range v.Symbols {
+			res.Symbols[i] = val
+		}
+	} else {
+		res.Symbols = []string{}
+	}
+
+	return res
+}
+
+// transformNewsResultToNewsviewsNewsResultView builds a value of type
+// *newsviews.NewsResultView from a value of type *NewsResult.
+func transformNewsResultToNewsviewsNewsResultView(v *NewsResult) *newsviews.NewsResultView {
+	res := &newsviews.NewsResultView{
+		ID:          &v.ID,
+		PublishedAt: &v.PublishedAt,
+		Headline:    &v.Headline,
+		Body:        v.Body,
+	}
+	if v.Categories != nil {
+		res.Categories = make([]string, len(v.Categories))
+		for i, val := range v.Categories {
+			res.Categories[i] = val
+		}
+	} else {
+		res.Categories = []string{}
+	}
+	if v.Genres != nil {
+		res.Genres = make([]string, len(v.Genres))
+		for i, val := range v.Genres {
+			res.Genres[i] = val
+		}
+	} else {
+		res.Genres = []string{}
+	}
+	if v.Symbols != nil {
+		res.Symbols = make([]string, len(v.Symbols))
+		for i, val := range v.Symbols {
+			res.Symbols[i] = val
+		}
+	} else {
+		res.Symbols = []string{}
+	}
+
+	return res
+}
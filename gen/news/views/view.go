@@ -0,0 +1,107 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// news views
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package views
+
+import (
+	goa "goa.design/goa/v3/pkg"
+)
+
+// StockbotNewsCollection is the viewed result type that is projected based on
+// a view.
+type StockbotNewsCollection struct {
+	// Type to project
+	Projected *StockbotNewsCollectionView
+	// View to render
+	View string
+}
+
+// StockbotNewsCollectionView is a type that runs validations on a projected
+// type.
+type StockbotNewsCollectionView struct {
+	// ニュースのリスト
+	News []*NewsResultView
+}
+
+// NewsResultView is a type that runs validations on a projected type.
+type NewsResultView struct {
+	// ニュースID
+	ID *string
+	// ニュース日時 (RFC3339)
+	PublishedAt *string
+	// ニュースカテゴリ
+	Categories []string
+	// ニュースジャンル
+	Genres []string
+	// 関連銘柄コード
+	Symbols []string
+	// ヘッドライン
+	Headline *string
+	// 本文 (未取得の場合は省略)
+	Body *string
+}
+
+var (
+	// StockbotNewsCollectionMap is a map indexing the attribute names of
+	// StockbotNewsCollection by view name.
+	StockbotNewsCollectionMap = map[string][]string{
+		"default": {
+			"news",
+		},
+	}
+)
+
+// ValidateStockbotNewsCollection runs the validations defined on the viewed
+// result type StockbotNewsCollection.
+func ValidateStockbotNewsCollection(result *StockbotNewsCollection) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotNewsCollectionView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotNewsCollectionView runs the validations defined on
+// StockbotNewsCollectionView using the "default" view.
+func ValidateStockbotNewsCollectionView(result *StockbotNewsCollectionView) (err error) {
+	if result.News == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("news", "result"))
+	}
+	for _, e := range result.News {
+		if e != nil {
+			if err2 := ValidateNewsResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateNewsResultView runs the validations defined on NewsResultView.
+func ValidateNewsResultView(result *NewsResultView) (err error) {
+	if result.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "result"))
+	}
+	if result.PublishedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("published_at", "result"))
+	}
+	if result.Categories == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("categories", "result"))
+	}
+	if result.Genres == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("genres", "result"))
+	}
+	if result.Symbols == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbols", "result"))
+	}
+	if result.Headline == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("headline", "result"))
+	}
+	return
+}
@@ -26,6 +26,10 @@ func NewClient(create goa.Endpoint) *Client {
 }
 
 // Create calls the "create" endpoint of the "order" service.
+// Create may return the following errors:
+//   - "invalid_order" (type *goa.ServiceError): 注文内容が不正 (値幅制限の範囲外など)
+//   - "margin_entry_blocked" (type *goa.ServiceError): 委託保証金率が追証の水準に近いため新規信用建を停止中
+//   - error: internal error
 func (c *Client) Create(ctx context.Context, p *CreatePayload) (res *CreateResult, err error) {
 	var ires any
 	ires, err = c.CreateEndpoint(ctx, p)
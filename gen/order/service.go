@@ -9,6 +9,8 @@ package order
 
 import (
 	"context"
+
+	goa "goa.design/goa/v3/pkg"
 )
 
 // The order service handles placing stock orders.
@@ -54,3 +56,13 @@ type CreateResult struct {
 	// 受付済み注文ID
 	OrderID string
 }
+
+// MakeInvalidOrder builds a goa.ServiceError from an error.
+func MakeInvalidOrder(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_order", false, false, false)
+}
+
+// MakeMarginEntryBlocked builds a goa.ServiceError from an error.
+func MakeMarginEntryBlocked(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "margin_entry_blocked", false, false, false)
+}
@@ -0,0 +1,36 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// portfolio client
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package portfolio
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "portfolio" service client.
+type Client struct {
+	GetEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "portfolio" service client given the endpoints.
+func NewClient(get goa.Endpoint) *Client {
+	return &Client{
+		GetEndpoint: get,
+	}
+}
+
+// Get calls the "get" endpoint of the "portfolio" service.
+func (c *Client) Get(ctx context.Context) (res *StockbotPortfolio, err error) {
+	var ires any
+	ires, err = c.GetEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotPortfolio), nil
+}
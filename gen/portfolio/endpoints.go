@@ -0,0 +1,44 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// portfolio endpoints
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package portfolio
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "portfolio" service endpoints.
+type Endpoints struct {
+	Get goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "portfolio" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		Get: NewGetEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "portfolio" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Get = m(e.Get)
+}
+
+// NewGetEndpoint returns an endpoint function that calls the method "get" of
+// service "portfolio".
+func NewGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		res, err := s.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotPortfolio(res, "default")
+		return vres, nil
+	}
+}
@@ -0,0 +1,403 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// portfolio service
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package portfolio
+
+import (
+	"context"
+	portfolioviews "stock-bot/gen/portfolio/views"
+)
+
+// The portfolio service provides analytics over the current holdings.
+type Service interface {
+	// Get the totals, exposures, concentration and realized P&L of the portfolio.
+	Get(context.Context) (res *StockbotPortfolio, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "stockbot"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "portfolio"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [1]string{"get"}
+
+// How concentrated the portfolio is by symbol.
+type PortfolioConcentrationResult struct {
+	// 評価額が最も大きい銘柄
+	LargestSymbol string
+	// 評価額が最も大きい銘柄の割合 (0〜1)
+	LargestWeight float64
+	// 評価額の上位5銘柄の割合 (0〜1)
+	Top5Weight float64
+	// 銘柄ごとの割合のハーフィンダール・ハーシュマン指数 (0〜1)
+	Hhi float64
+}
+
+// The exposure of a position type or an industry.
+type PortfolioExposureResult struct {
+	// ポジション種別または業種コード (業種が不明な場合は空)
+	Code string
+	// 名称
+	Name string
+	// ポジション数
+	PositionCount int
+	// 評価額
+	MarketValue float64
+	// 評価額の合計に占める割合 (0〜1)
+	Weight float64
+	// 評価損益
+	UnrealizedPl float64
+}
+
+// The aggregate of all positions.
+type PortfolioTotalsResult struct {
+	// ポジション数
+	PositionCount int
+	// 評価額の合計 (買建と売建の合計)
+	MarketValue float64
+	// 買いポジションの評価額
+	LongValue float64
+	// 売建の評価額
+	ShortValue float64
+	// 買いポジションの評価額 - 売建の評価額
+	NetExposure float64
+	// 取得金額の合計
+	CostBasis float64
+	// 評価損益の合計
+	UnrealizedPl float64
+}
+
+// A single trading position.
+type PositionResult struct {
+	// 銘柄コード
+	Symbol string
+	// ポジション種別 (CASH, MARGIN_LONG, MARGIN_SHORT)
+	PositionType string
+	// 保有数量
+	Quantity float64
+	// 平均取得単価
+	AverageCost float64
+	// 現在値
+	CurrentPrice *float64
+	// 評価損益
+	UnrealizedPl *float64
+	// 評価損益率(%)
+	UnrealizedPlRate *float64
+	// 建日 (信用取引の場合 YYYYMMDD)
+	OpenedDate *string
+	// 解析できなかった値 (該当する項目は 0)
+	ParseErrors []string
+}
+
+// StockbotPortfolio is the result type of the portfolio service get method.
+type StockbotPortfolio struct {
+	// 保有ポジションのリスト
+	Positions []*PositionResult
+	// 合計
+	Totals *PortfolioTotalsResult
+	// 現物・信用買・信用売ごとの評価額
+	ByType []*PortfolioExposureResult
+	// 業種ごとの評価額 (評価額の大きい順)
+	ByIndustry []*PortfolioExposureResult
+	// 銘柄の集中度
+	Concentration *PortfolioConcentrationResult
+	// 保存済みの約定から移動平均法で計算した実現損益 (手数料控除後)
+	RealizedPl float64
+	// 本日の実現損益
+	RealizedPlToday float64
+	// 解析できなかった値 (合計では 0 として扱う)
+	ParseErrors []string
+}
+
+// NewStockbotPortfolio initializes result type StockbotPortfolio from viewed
+// result type StockbotPortfolio.
+func NewStockbotPortfolio(vres *portfolioviews.StockbotPortfolio) *StockbotPortfolio {
+	return newStockbotPortfolio(vres.Projected)
+}
+
+// NewViewedStockbotPortfolio initializes viewed result type StockbotPortfolio
+// from result type StockbotPortfolio using the given view.
+func NewViewedStockbotPortfolio(res *StockbotPortfolio, view string) *portfolioviews.StockbotPortfolio {
+	p := newStockbotPortfolioView(res)
+	return &portfolioviews.StockbotPortfolio{Projected: p, View: "default"}
+}
+
+// newStockbotPortfolio converts projected type StockbotPortfolio to service
+// type StockbotPortfolio.
+func newStockbotPortfolio(vres *portfolioviews.StockbotPortfolioView) *StockbotPortfolio {
+	res := &StockbotPortfolio{}
+	if vres.RealizedPl != nil {
+		res.RealizedPl = *vres.RealizedPl
+	}
+	if vres.RealizedPlToday != nil {
+		res.RealizedPlToday = *vres.RealizedPlToday
+	}
+	if vres.Positions != nil {
+		res.Positions = make([]*PositionResult, len(vres.Positions))
+		for i, val := range vres.Positions {
+			if val == nil {
+				res.Positions[i] = nil
+				continue
+			}
+			res.Positions[i] = transformPortfolioviewsPositionResultViewToPositionResult(val)
+		}
+	}
+	if vres.Totals != nil {
+		res.Totals = transformPortfolioviewsPortfolioTotalsResultViewToPortfolioTotalsResult(vres.Totals)
+	}
+	if vres.ByType != nil {
+		res.ByType = make([]*PortfolioExposureResult, len(vres.ByType))
+		for i, val := range vres.ByType {
+			if val == nil {
+				res.ByType[i] = nil
+				continue
+			}
+			res.ByType[i] = transformPortfolioviewsPortfolioExposureResultViewToPortfolioExposureResult(val)
+		}
+	}
+	if vres.ByIndustry != nil {
+		res.ByIndustry = make([]*PortfolioExposureResult, len(vres.ByIndustry))
+		for i, val := range vres.ByIndustry {
+			if val == nil {
+				res.ByIndustry[i] = nil
+				continue
+			}
+			res.ByIndustry[i] = transformPortfolioviewsPortfolioExposureResultViewToPortfolioExposureResult(val)
+		}
+	}
+	if vres.Concentration != nil {
+		res.Concentration = transformPortfolioviewsPortfolioConcentrationResultViewToPortfolioConcentrationResult(vres.Concentration)
+	}
+	if vres.ParseErrors != nil {
+		res.ParseErrors = make([]string, len(vres.ParseErrors))
+		for i, val := range vres.ParseErrors {
+			res.ParseErrors[i] = val
+		}
+	}
+	return res
+}
+
+// newStockbotPortfolioView projects result type StockbotPortfolio to projected
+// type StockbotPortfolioView using the "default" view.
+func newStockbotPortfolioView(res *StockbotPortfolio) *portfolioviews.StockbotPortfolioView {
+	vres := &portfolioviews.StockbotPortfolioView{
+		RealizedPl:      &res.RealizedPl,
+		RealizedPlToday: &res.RealizedPlToday,
+	}
+	if res.Positions != nil {
+		vres.Positions = make([]*portfolioviews.PositionResultView, len(res.Positions))
+		for i, val := range res.Positions {
+			if val == nil {
+				vres.Positions[i] = nil
+				continue
+			}
+			vres.Positions[i] = transformPositionResultToPortfolioviewsPositionResultView(val)
+		}
+	} else {
+		vres.Positions = []*portfolioviews.PositionResultView{}
+	}
+	if res.Totals != nil {
+		vres.Totals = transformPortfolioTotalsResultToPortfolioviewsPortfolioTotalsResultView(res.Totals)
+	}
+	if res.ByType != nil {
+		vres.ByType = make([]*portfolioviews.PortfolioExposureResultView, len(res.ByType))
+		for i, val := range res.ByType {
+			if val == nil {
+				vres.ByType[i] = nil
+				continue
+			}
+			vres.ByType[i] = transformPortfolioExposureResultToPortfolioviewsPortfolioExposureResultView(val)
+		}
+	} else {
+		vres.ByType = []*portfolioviews.PortfolioExposureResultView{}
+	}
+	if res.ByIndustry != nil {
+		vres.ByIndustry = make([]*portfolioviews.PortfolioExposureResultView, len(res.ByIndustry))
+		for i, val := range res.ByIndustry {
+			if val == nil {
+				vres.ByIndustry[i] = nil
+				continue
+			}
+			vres.ByIndustry[i] = transformPortfolioExposureResultToPortfolioviewsPortfolioExposureResultView(val)
+		}
+	} else {
+		vres.ByIndustry = []*portfolioviews.PortfolioExposureResultView{}
+	}
+	if res.Concentration != nil {
+		vres.Concentration = transformPortfolioConcentrationResultToPortfolioviewsPortfolioConcentrationResultView(res.Concentration)
+	}
+	if res.ParseErrors != nil {
+		vres.ParseErrors = make([]string, len(res.ParseErrors))
+		for i, val := range res.ParseErrors {
+			vres.ParseErrors[i] = val
+		}
+	} else {
+		vres.ParseErrors = []string{}
+	}
+	return vres
+}
+
+// transformPortfolioviewsPositionResultViewToPositionResult builds a value of
+// type *PositionResult from a value of type *portfolioviews.PositionResultView.
+func transformPortfolioviewsPositionResultViewToPositionResult(v *portfolioviews.PositionResultView) *PositionResult {
+	if v == nil {
+		return nil
+	}
+	res := &PositionResult{
+		Symbol:           *v.Symbol,
+		PositionType:     *v.PositionType,
+		Quantity:         *v.Quantity,
+		AverageCost:      *v.AverageCost,
+		CurrentPrice:     v.CurrentPrice,
+		UnrealizedPl:     v.UnrealizedPl,
+		UnrealizedPlRate: v.UnrealizedPlRate,
+		OpenedDate:       v.OpenedDate,
+	}
+	if v.ParseErrors != nil {
+		res.ParseErrors = make([]string, len(v.ParseErrors))
+		for i, val := range v.ParseErrors {
+			res.ParseErrors[i] = val
+		}
+	}
+
+	return res
+}
+
+// transformPortfolioviewsPortfolioTotalsResultViewToPortfolioTotalsResult
+// builds a value of type *PortfolioTotalsResult from a value of type
+// *portfolioviews.PortfolioTotalsResultView.
+func transformPortfolioviewsPortfolioTotalsResultViewToPortfolioTotalsResult(v *portfolioviews.PortfolioTotalsResultView) *PortfolioTotalsResult {
+	if v == nil {
+		return nil
+	}
+	res := &PortfolioTotalsResult{
+		PositionCount: *v.PositionCount,
+		MarketValue:   *v.MarketValue,
+		LongValue:     *v.LongValue,
+		ShortValue:    *v.ShortValue,
+		NetExposure:   *v.NetExposure,
+		CostBasis:     *v.CostBasis,
+		UnrealizedPl:  *v.UnrealizedPl,
+	}
+
+	return res
+}
+
+// transformPortfolioviewsPortfolioExposureResultViewToPortfolioExposureResult
+// builds a value of type *PortfolioExposureResult from a value of type
+// *portfolioviews.PortfolioExposureResultView.
+func transformPortfolioviewsPortfolioExposureResultViewToPortfolioExposureResult(v *portfolioviews.PortfolioExposureResultView) *PortfolioExposureResult {
+	if v == nil {
+		return nil
+	}
+	res := &PortfolioExposureResult{
+		Code:          *v.Code,
+		Name:          *v.Name,
+		PositionCount: *v.PositionCount,
+		MarketValue:   *v.MarketValue,
+		Weight:        *v.Weight,
+		UnrealizedPl:  *v.UnrealizedPl,
+	}
+
+	return res
+}
+
+// transformPortfolioviewsPortfolioConcentrationResultViewToPortfolioConcentrationResult
+// builds a value of type *PortfolioConcentrationResult from a value of type
+// *portfolioviews.PortfolioConcentrationResultView.
+func transformPortfolioviewsPortfolioConcentrationResultViewToPortfolioConcentrationResult(v *portfolioviews.PortfolioConcentrationResultView) *PortfolioConcentrationResult {
+	if v == nil {
+		return nil
+	}
+	res := &PortfolioConcentrationResult{
+		LargestSymbol: *v.LargestSymbol,
+		LargestWeight: *v.LargestWeight,
+		Top5Weight:    *v.Top5Weight,
+		Hhi:           *v.Hhi,
+	}
+
+	return res
+}
+
+// transformPositionResultToPortfolioviewsPositionResultView builds a value of
+// type *portfolioviews.PositionResultView from a value of type *PositionResult.
+func transformPositionResultToPortfolioviewsPositionResultView(v *PositionResult) *portfolioviews.PositionResultView {
+	res := &portfolioviews.PositionResultView{
+		Symbol:           &v.Symbol,
+		PositionType:     &v.PositionType,
+		Quantity:         &v.Quantity,
+		AverageCost:      &v.AverageCost,
+		CurrentPrice:     v.CurrentPrice,
+		UnrealizedPl:     v.UnrealizedPl,
+		UnrealizedPlRate: v.UnrealizedPlRate,
+		OpenedDate:       v.OpenedDate,
+	}
+	if v.ParseErrors != nil {
+		res.ParseErrors = make([]string, len(v.ParseErrors))
+		for i, val := range v.ParseErrors {
+			res.ParseErrors[i] = val
+		}
+	}
+
+	return res
+}
+
+// transformPortfolioTotalsResultToPortfolioviewsPortfolioTotalsResultView
+// builds a value of type *portfolioviews.PortfolioTotalsResultView from a
+// value of type *PortfolioTotalsResult.
+func transformPortfolioTotalsResultToPortfolioviewsPortfolioTotalsResultView(v *PortfolioTotalsResult) *portfolioviews.PortfolioTotalsResultView {
+	res := &portfolioviews.PortfolioTotalsResultView{
+		PositionCount: &v.PositionCount,
+		MarketValue:   &v.MarketValue,
+		LongValue:     &v.LongValue,
+		ShortValue:    &v.ShortValue,
+		NetExposure:   &v.NetExposure,
+		CostBasis:     &v.CostBasis,
+		UnrealizedPl:  &v.UnrealizedPl,
+	}
+
+	return res
+}
+
+// transformPortfolioExposureResultToPortfolioviewsPortfolioExposureResultView
+// builds a value of type *portfolioviews.PortfolioExposureResultView from a
+// value of type *PortfolioExposureResult.
+func transformPortfolioExposureResultToPortfolioviewsPortfolioExposureResultView(v *PortfolioExposureResult) *portfolioviews.PortfolioExposureResultView {
+	res := &portfolioviews.PortfolioExposureResultView{
+		Code:          &v.Code,
+		Name:          &v.Name,
+		PositionCount: &v.PositionCount,
+		MarketValue:   &v.MarketValue,
+		Weight:        &v.Weight,
+		UnrealizedPl:  &v.UnrealizedPl,
+	}
+
+	return res
+}
+
+// transformPortfolioConcentrationResultToPortfolioviewsPortfolioConcentrationResultView
+// builds a value of type *portfolioviews.PortfolioConcentrationResultView from
+// a value of type *PortfolioConcentrationResult.
+func transformPortfolioConcentrationResultToPortfolioviewsPortfolioConcentrationResultView(v *PortfolioConcentrationResult) *portfolioviews.PortfolioConcentrationResultView {
+	res := &portfolioviews.PortfolioConcentrationResultView{
+		LargestSymbol: &v.LargestSymbol,
+		LargestWeight: &v.LargestWeight,
+		Top5Weight:    &v.Top5Weight,
+		Hhi:           &v.Hhi,
+	}
+
+	return res
+}
@@ -0,0 +1,294 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// portfolio views
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package views
+
+import (
+	goa "goa.design/goa/v3/pkg"
+)
+
+// StockbotPortfolio is the viewed result type that is projected based on a
+// view.
+type StockbotPortfolio struct {
+	// Type to project
+	Projected *StockbotPortfolioView
+	// View to render
+	View string
+}
+
+// StockbotPortfolioView is a type that runs validations on a projected type.
+type StockbotPortfolioView struct {
+	// 保有ポジションのリスト
+	Positions []*PositionResultView
+	// 合計
+	Totals *PortfolioTotalsResultView
+	// 現物・信用買・信用売ごとの評価額
+	ByType []*PortfolioExposureResultView
+	// 業種ごとの評価額 (評価額の大きい順)
+	ByIndustry []*PortfolioExposureResultView
+	// 銘柄の集中度
+	Concentration *PortfolioConcentrationResultView
+	// 保存済みの約定から移動平均法で計算した実現損益 (手数料控除後)
+	RealizedPl *float64
+	// 本日の実現損益
+	RealizedPlToday *float64
+	// 解析できなかった値 (合計では 0 として扱う)
+	ParseErrors []string
+}
+
+// PositionResultView is a type that runs validations on a projected type.
+type PositionResultView struct {
+	// 銘柄コード
+	Symbol *string
+	// ポジション種別 (CASH, MARGIN_LONG, MARGIN_SHORT)
+	PositionType *string
+	// 保有数量
+	Quantity *float64
+	// 平均取得単価
+	AverageCost *float64
+	// 現在値
+	CurrentPrice *float64
+	// 評価損益
+	UnrealizedPl *float64
+	// 評価損益率(%)
+	UnrealizedPlRate *float64
+	// 建日 (信用取引の場合 YYYYMMDD)
+	OpenedDate *string
+	// 解析できなかった値 (該当する項目は 0)
+	ParseErrors []string
+}
+
+// PortfolioTotalsResultView is a type that runs validations on a projected
+// type.
+type PortfolioTotalsResultView struct {
+	// ポジション数
+	PositionCount *int
+	// 評価額の合計 (買建と売建の合計)
+	MarketValue *float64
+	// 買いポジションの評価額
+	LongValue *float64
+	// 売建の評価額
+	ShortValue *float64
+	// 買いポジションの評価額 - 売建の評価額
+	NetExposure *float64
+	// 取得金額の合計
+	CostBasis *float64
+	// 評価損益の合計
+	UnrealizedPl *float64
+}
+
+// PortfolioExposureResultView is a type that runs validations on a projected
+// type.
+type PortfolioExposureResultView struct {
+	// ポジション種別または業種コード (業種が不明な場合は空)
+	Code *string
+	// 名称
+	Name *string
+	// ポジション数
+	PositionCount *int
+	// 評価額
+	MarketValue *float64
+	// 評価額の合計に占める割合 (0〜1)
+	Weight *float64
+	// 評価損益
+	UnrealizedPl *float64
+}
+
+// PortfolioConcentrationResultView is a type that runs validations on a
+// projected type.
+type PortfolioConcentrationResultView struct {
+	// 評価額が最も大きい銘柄
+	LargestSymbol *string
+	// 評価額が最も大きい銘柄の割合 (0〜1)
+	LargestWeight *float64
+	// 評価額の上位5銘柄の割合 (0〜1)
+	Top5Weight *float64
+	// 銘柄ごとの割合のハーフィンダール・ハーシュマン指数 (0〜1)
+	Hhi *float64
+}
+
+var (
+	// StockbotPortfolioMap is a map indexing the attribute names of
+	// StockbotPortfolio by view name.
+	StockbotPortfolioMap = map[string][]string{
+		"default": {
+			"positions",
+			"totals",
+			"by_type",
+			"by_industry",
+			"concentration",
+			"realized_pl",
+			"realized_pl_today",
+			"parse_errors",
+		},
+	}
+)
+
+// ValidateStockbotPortfolio runs the validations defined on the viewed result
+// type StockbotPortfolio.
+func ValidateStockbotPortfolio(result *StockbotPortfolio) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotPortfolioView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotPortfolioView runs the validations defined on
+// StockbotPortfolioView using the "default" view.
+func ValidateStockbotPortfolioView(result *StockbotPortfolioView) (err error) {
+	if result.Positions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("positions", "result"))
+	}
+	if result.Totals == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("totals", "result"))
+	}
+	if result.ByType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("by_type", "result"))
+	}
+	if result.ByIndustry == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("by_industry", "result"))
+	}
+	if result.Concentration == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("concentration", "result"))
+	}
+	if result.RealizedPl == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("realized_pl", "result"))
+	}
+	if result.RealizedPlToday == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("realized_pl_today", "result"))
+	}
+	if result.ParseErrors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("parse_errors", "result"))
+	}
+	for _, e := range result.Positions {
+		if e != nil {
+			if err2 := ValidatePositionResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if result.Totals != nil {
+		if err2 := ValidatePortfolioTotalsResultView(result.Totals); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range result.ByType {
+		if e != nil {
+			if err2 := ValidatePortfolioExposureResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range result.ByIndustry {
+		if e != nil {
+			if err2 := ValidatePortfolioExposureResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if result.Concentration != nil {
+		if err2 := ValidatePortfolioConcentrationResultView(result.Concentration); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidatePositionResultView runs the validations defined on
+// PositionResultView.
+func ValidatePositionResultView(result *PositionResultView) (err error) {
+	if result.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "result"))
+	}
+	if result.PositionType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position_type", "result"))
+	}
+	if result.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "result"))
+	}
+	if result.AverageCost == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("average_cost", "result"))
+	}
+	if result.PositionType != nil {
+		if !(*result.PositionType == "CASH" || *result.PositionType == "MARGIN_LONG" || *result.PositionType == "MARGIN_SHORT") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.position_type", *result.PositionType, []any{"CASH", "MARGIN_LONG", "MARGIN_SHORT"}))
+		}
+	}
+	return
+}
+
+// ValidatePortfolioTotalsResultView runs the validations defined on
+// PortfolioTotalsResultView.
+func ValidatePortfolioTotalsResultView(result *PortfolioTotalsResultView) (err error) {
+	if result.PositionCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position_count", "result"))
+	}
+	if result.MarketValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_value", "result"))
+	}
+	if result.LongValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("long_value", "result"))
+	}
+	if result.ShortValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("short_value", "result"))
+	}
+	if result.NetExposure == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_exposure", "result"))
+	}
+	if result.CostBasis == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cost_basis", "result"))
+	}
+	if result.UnrealizedPl == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unrealized_pl", "result"))
+	}
+	return
+}
+
+// ValidatePortfolioExposureResultView runs the validations defined on
+// PortfolioExposureResultView.
+func ValidatePortfolioExposureResultView(result *PortfolioExposureResultView) (err error) {
+	if result.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "result"))
+	}
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.PositionCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position_count", "result"))
+	}
+	if result.MarketValue == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("market_value", "result"))
+	}
+	if result.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "result"))
+	}
+	if result.UnrealizedPl == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unrealized_pl", "result"))
+	}
+	return
+}
+
+// ValidatePortfolioConcentrationResultView runs the validations defined on
+// PortfolioConcentrationResultView.
+func ValidatePortfolioConcentrationResultView(result *PortfolioConcentrationResultView) (err error) {
+	if result.LargestSymbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("largest_symbol", "result"))
+	}
+	if result.LargestWeight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("largest_weight", "result"))
+	}
+	if result.Top5Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("top5_weight", "result"))
+	}
+	if result.Hhi == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hhi", "result"))
+	}
+	return
+}
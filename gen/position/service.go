@@ -58,6 +58,8 @@ type PositionResult struct {
 	UnrealizedPlRate *float64
 	// 建日 (信用取引の場合 YYYYMMDD)
 	OpenedDate *string
+	// 解析できなかった値 (該当する項目は 0)
+	ParseErrors []string
 }
 
 // StockbotPositionCollection is the result type of the position service list
@@ -135,6 +137,12 @@ func transformPositionviewsPositionResultViewToPositionResult(v *positionviews.P
 		UnrealizedPlRate: v.UnrealizedPlRate,
 		OpenedDate:       v.OpenedDate,
 	}
+	if v.ParseErrors != nil {
+		res.ParseErrors = make([]string, len(v.ParseErrors))
+		for i, val := range v.ParseErrors {
+			res.ParseErrors[i] = val
+		}
+	}
 
 	return res
 }
@@ -152,6 +160,12 @@ func transformPositionResultToPositionviewsPositionResultView(v *PositionResult)
 		UnrealizedPlRate: v.UnrealizedPlRate,
 		OpenedDate:       v.OpenedDate,
 	}
+	if v.ParseErrors != nil {
+		res.ParseErrors = make([]string, len(v.ParseErrors))
+		for i, val := range v.ParseErrors {
+			res.ParseErrors[i] = val
+		}
+	}
 
 	return res
 }
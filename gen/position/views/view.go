@@ -45,6 +45,8 @@ type PositionResultView struct {
 	UnrealizedPlRate *float64
 	// 建日 (信用取引の場合 YYYYMMDD)
 	OpenedDate *string
+	// 解析できなかった値 (該当する項目は 0)
+	ParseErrors []string
 }
 
 var (
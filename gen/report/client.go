@@ -0,0 +1,53 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// report client
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package report
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "report" service client.
+type Client struct {
+	DailyEndpoint    goa.Endpoint
+	GenerateEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "report" service client given the endpoints.
+func NewClient(daily, generate goa.Endpoint) *Client {
+	return &Client{
+		DailyEndpoint:    daily,
+		GenerateEndpoint: generate,
+	}
+}
+
+// Daily calls the "daily" endpoint of the "report" service.
+// Daily may return the following errors:
+//   - "not_found" (type *goa.ServiceError): この日のレポートが作成されていない
+//   - error: internal error
+func (c *Client) Daily(ctx context.Context, p *DailyPayload) (res *DailyResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.DailyEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*DailyResponseData)
+	return o.Result, o.Body, nil
+}
+
+// Generate calls the "generate" endpoint of the "report" service.
+func (c *Client) Generate(ctx context.Context, p *GeneratePayload) (res *StockbotDailyReportSummary, err error) {
+	var ires any
+	ires, err = c.GenerateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotDailyReportSummary), nil
+}
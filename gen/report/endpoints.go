@@ -0,0 +1,71 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// report endpoints
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package report
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "report" service endpoints.
+type Endpoints struct {
+	Daily    goa.Endpoint
+	Generate goa.Endpoint
+}
+
+// DailyResponseData holds both the result and the HTTP response body reader of
+// the "daily" method.
+type DailyResponseData struct {
+	// Result is the method result.
+	Result *DailyResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// NewEndpoints wraps the methods of the "report" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		Daily:    NewDailyEndpoint(s),
+		Generate: NewGenerateEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "report" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Daily = m(e.Daily)
+	e.Generate = m(e.Generate)
+}
+
+// NewDailyEndpoint returns an endpoint function that calls the method "daily"
+// of service "report".
+func NewDailyEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DailyPayload)
+		res, body, err := s.Daily(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &DailyResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewGenerateEndpoint returns an endpoint function that calls the method
+// "generate" of service "report".
+func NewGenerateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GeneratePayload)
+		res, err := s.Generate(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotDailyReportSummary(res, "default")
+		return vres, nil
+	}
+}
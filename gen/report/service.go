@@ -0,0 +1,175 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// report service
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package report
+
+import (
+	"context"
+	"io"
+	reportviews "stock-bot/gen/report/views"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// The report service serves the end-of-day reports of the bot's activity.
+type Service interface {
+	// Get the stored daily report as JSON or Markdown.
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	Daily(context.Context, *DailyPayload) (res *DailyResult, body io.ReadCloser, err error)
+	// Generate the daily report now, replacing the stored report of the day. The
+	// unrealized P&L is that of the current positions.
+	Generate(context.Context, *GeneratePayload) (res *StockbotDailyReportSummary, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "stockbot"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "report"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [2]string{"daily", "generate"}
+
+// DailyPayload is the payload type of the report service daily method.
+type DailyPayload struct {
+	// レポートの日付 (YYYY-MM-DD, 日本時間)
+	Date string
+	// 出力形式
+	Format string
+}
+
+// DailyResult is the result type of the report service daily method.
+type DailyResult struct {
+	// レポートの Content-Type
+	ContentType string
+}
+
+// GeneratePayload is the payload type of the report service generate method.
+type GeneratePayload struct {
+	// レポートの日付 (YYYY-MM-DD, 日本時間)
+	Date string
+}
+
+// StockbotDailyReportSummary is the result type of the report service generate
+// method.
+type StockbotDailyReportSummary struct {
+	// レポートの日付 (YYYY-MM-DD, 日本時間)
+	Date string
+	// 作成日時 (RFC3339)
+	GeneratedAt string
+	// エージェントが処理したシグナルの件数
+	SignalsConsumed int
+	// 発注した注文の件数
+	OrdersPlaced int
+	// 拒否された発注の件数
+	OrdersRejected int
+	// 約定の件数
+	Fills int
+	// リスクイベントの件数
+	RiskEvents int
+	// この日に決済した取引の実現損益 (手数料控除後)
+	RealizedProfitLoss float64
+	// 作成時点の建玉の評価損益 (当日のレポートのみ)
+	UnrealizedProfitLoss *float64
+	// 取得できずにレポートに含まれていない項目
+	Warnings []string
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// NewStockbotDailyReportSummary initializes result type
+// StockbotDailyReportSummary from viewed result type
+// StockbotDailyReportSummary.
+func NewStockbotDailyReportSummary(vres *reportviews.StockbotDailyReportSummary) *StockbotDailyReportSummary {
+	return newStockbotDailyReportSummary(vres.Projected)
+}
+
+// NewViewedStockbotDailyReportSummary initializes viewed result type
+// StockbotDailyReportSummary from result type StockbotDailyReportSummary using
+// the given view.
+func NewViewedStockbotDailyReportSummary(res *StockbotDailyReportSummary, view string) *reportviews.StockbotDailyReportSummary {
+	p := newStockbotDailyReportSummaryView(res)
+	return &reportviews.StockbotDailyReportSummary{Projected: p, View: "default"}
+}
+
+// newStockbotDailyReportSummary converts projected type
+// StockbotDailyReportSummary to service type StockbotDailyReportSummary.
+func newStockbotDailyReportSummary(vres *reportviews.StockbotDailyReportSummaryView) *StockbotDailyReportSummary {
+	res := &StockbotDailyReportSummary{
+		UnrealizedProfitLoss: vres.UnrealizedProfitLoss,
+	}
+	if vres.Date != nil {
+		res.Date = *vres.Date
+	}
+	if vres.GeneratedAt != nil {
+		res.GeneratedAt = *vres.GeneratedAt
+	}
+	if vres.SignalsConsumed != nil {
+		res.SignalsConsumed = *vres.SignalsConsumed
+	}
+	if vres.OrdersPlaced != nil {
+		res.OrdersPlaced = *vres.OrdersPlaced
+	}
+	if vres.OrdersRejected != nil {
+		res.OrdersRejected = *vres.OrdersRejected
+	}
+	if vres.Fills != nil {
+		res.Fills = *vres.Fills
+	}
+	if vres.RiskEvents != nil {
+		res.RiskEvents = *vres.RiskEvents
+	}
+	if vres.RealizedProfitLoss != nil {
+		res.RealizedProfitLoss = *vres.RealizedProfitLoss
+	}
+	if vres.Warnings != nil {
+		res.Warnings = make([]string, len(vres.Warnings))
+		for i, val := range vres.Warnings {
+			res.Warnings[i] = val
+		}
+	}
+	return res
+}
+
+// newStockbotDailyReportSummaryView projects result type
+// StockbotDailyReportSummary to projected type StockbotDailyReportSummaryView
+// using the "default" view.
+func newStockbotDailyReportSummaryView(res *StockbotDailyReportSummary) *reportviews.StockbotDailyReportSummaryView {
+	vres := &reportviews.StockbotDailyReportSummaryView{
+		Date:                 &res.Date,
+		GeneratedAt:          &res.GeneratedAt,
+		SignalsConsumed:      &res.SignalsConsumed,
+		OrdersPlaced:         &res.OrdersPlaced,
+		OrdersRejected:       &res.OrdersRejected,
+		Fills:                &res.Fills,
+		RiskEvents:           &res.RiskEvents,
+		RealizedProfitLoss:   &res.RealizedProfitLoss,
+		UnrealizedProfitLoss: res.UnrealizedProfitLoss,
+	}
+	if res.Warnings != nil {
+		vres.Warnings = make([]string, len(res.Warnings))
+		for i, val := range res.Warnings {
+			vres.Warnings[i] = val
+		}
+	} else {
+		vres.Warnings = []string{}
+	}
+	return vres
+}
@@ -0,0 +1,110 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// report views
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package views
+
+import (
+	goa "goa.design/goa/v3/pkg"
+)
+
+// StockbotDailyReportSummary is the viewed result type that is projected based
+// on a view.
+type StockbotDailyReportSummary struct {
+	// Type to project
+	Projected *StockbotDailyReportSummaryView
+	// View to render
+	View string
+}
+
+// StockbotDailyReportSummaryView is a type that runs validations on a
+// projected type.
+type StockbotDailyReportSummaryView struct {
+	// レポートの日付 (YYYY-MM-DD, 日本時間)
+	Date *string
+	// 作成日時 (RFC3339)
+	GeneratedAt *string
+	// エージェントが処理したシグナルの件数
+	SignalsConsumed *int
+	// 発注した注文の件数
+	OrdersPlaced *int
+	// 拒否された発注の件数
+	OrdersRejected *int
+	// 約定の件数
+	Fills *int
+	// リスクイベントの件数
+	RiskEvents *int
+	// この日に決済した取引の実現損益 (手数料控除後)
+	RealizedProfitLoss *float64
+	// 作成時点の建玉の評価損益 (当日のレポートのみ)
+	UnrealizedProfitLoss *float64
+	// 取得できずにレポートに含まれていない項目
+	Warnings []string
+}
+
+var (
+	// StockbotDailyReportSummaryMap is a map indexing the attribute names of
+	// StockbotDailyReportSummary by view name.
+	StockbotDailyReportSummaryMap = map[string][]string{
+		"default": {
+			"date",
+			"generated_at",
+			"signals_consumed",
+			"orders_placed",
+			"orders_rejected",
+			"fills",
+			"risk_events",
+			"realized_profit_loss",
+			"unrealized_profit_loss",
+			"warnings",
+		},
+	}
+)
+
+// ValidateStockbotDailyReportSummary runs the validations defined on the
+// viewed result type StockbotDailyReportSummary.
+func ValidateStockbotDailyReportSummary(result *StockbotDailyReportSummary) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotDailyReportSummaryView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotDailyReportSummaryView runs the validations defined on
+// StockbotDailyReportSummaryView using the "default" view.
+func ValidateStockbotDailyReportSummaryView(result *StockbotDailyReportSummaryView) (err error) {
+	if result.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "result"))
+	}
+	if result.GeneratedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("generated_at", "result"))
+	}
+	if result.SignalsConsumed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("signals_consumed", "result"))
+	}
+	if result.OrdersPlaced == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("orders_placed", "result"))
+	}
+	if result.OrdersRejected == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("orders_rejected", "result"))
+	}
+	if result.Fills == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fills", "result"))
+	}
+	if result.RiskEvents == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("risk_events", "result"))
+	}
+	if result.RealizedProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("realized_profit_loss", "result"))
+	}
+	if result.Warnings == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("warnings", "result"))
+	}
+	return
+}
@@ -0,0 +1,48 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// signal client
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package signal
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "signal" service client.
+type Client struct {
+	CreateEndpoint goa.Endpoint
+	ListEndpoint   goa.Endpoint
+}
+
+// NewClient initializes a "signal" service client given the endpoints.
+func NewClient(create, list goa.Endpoint) *Client {
+	return &Client{
+		CreateEndpoint: create,
+		ListEndpoint:   list,
+	}
+}
+
+// Create calls the "create" endpoint of the "signal" service.
+func (c *Client) Create(ctx context.Context, p *CreatePayload) (res *StockbotSignalIngest, err error) {
+	var ires any
+	ires, err = c.CreateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotSignalIngest), nil
+}
+
+// List calls the "list" endpoint of the "signal" service.
+func (c *Client) List(ctx context.Context, p *ListPayload) (res *StockbotSignalCollection, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotSignalCollection), nil
+}
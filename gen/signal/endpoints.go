@@ -0,0 +1,62 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// signal endpoints
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package signal
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "signal" service endpoints.
+type Endpoints struct {
+	Create goa.Endpoint
+	List   goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "signal" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		Create: NewCreateEndpoint(s),
+		List:   NewListEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "signal" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Create = m(e.Create)
+	e.List = m(e.List)
+}
+
+// NewCreateEndpoint returns an endpoint function that calls the method
+// "create" of service "signal".
+func NewCreateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreatePayload)
+		res, err := s.Create(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotSignalIngest(res, "default")
+		return vres, nil
+	}
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "list" of
+// service "signal".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListPayload)
+		res, err := s.List(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotSignalCollection(res, "default")
+		return vres, nil
+	}
+}
@@ -0,0 +1,324 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// signal service
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package signal
+
+import (
+	"context"
+	signalviews "stock-bot/gen/signal/views"
+)
+
+// The signal service ingests trading signals and exposes their history.
+type Service interface {
+	// Ingest a batch of trading signals.
+	Create(context.Context, *CreatePayload) (res *StockbotSignalIngest, err error)
+	// List received signals, newest first.
+	List(context.Context, *ListPayload) (res *StockbotSignalCollection, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "stockbot"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "signal"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [2]string{"create", "list"}
+
+// CreatePayload is the payload type of the signal service create method.
+type CreatePayload struct {
+	// シグナルのリスト
+	Signals []*SignalInput
+	// シグナル生成日時 (RFC3339, 省略時は受信日時)
+	GeneratedAt *string
+}
+
+// ListPayload is the payload type of the signal service list method.
+type ListPayload struct {
+	// 銘柄コードで絞り込む
+	Symbol *string
+	// 取得件数
+	Limit int
+}
+
+// A single trading signal to ingest.
+type SignalInput struct {
+	// 銘柄コード
+	Symbol string
+	// 売買区分 (BUY/SELL)
+	Side string
+	// 指値 (省略時は成行)
+	LimitPrice *float64
+	// 損切り価格
+	StopPrice *float64
+	// 利確目標価格
+	TargetPrice *float64
+	// 資金配分の重み (0〜1、省略時は1)
+	Weight *float64
+	// 有効期限 (RFC3339)
+	ValidUntil *string
+	// シグナルの根拠
+	Rationale *string
+}
+
+// A signal that was not accepted.
+type SignalRejection struct {
+	// リクエスト内での位置 (0始まり)
+	Index int
+	// 銘柄コード
+	Symbol string
+	// 却下理由
+	Reason string
+}
+
+// A stored trading signal.
+type SignalResult struct {
+	// シグナルID
+	ID uint
+	// 銘柄コード
+	Symbol string
+	// 売買区分 (BUY/SELL)
+	Side string
+	// シグナル生成日時 (RFC3339)
+	GeneratedAt string
+	// シグナルの根拠
+	Rationale *string
+	// 指値
+	LimitPrice *float64
+	// 損切り価格
+	StopPrice *float64
+	// 利確目標価格
+	TargetPrice *float64
+	// 資金配分の重み
+	Weight *float64
+	// 有効期限 (RFC3339)
+	ValidUntil *string
+	// 取り込み元 (FILE/HTTP)
+	Source string
+	// 取り込み元ファイル
+	SourceFile *string
+	// エージェントが処理した日時 (RFC3339)
+	ConsumedAt *string
+}
+
+// StockbotSignalCollection is the result type of the signal service list
+// method.
+type StockbotSignalCollection struct {
+	// シグナルのリスト
+	Signals []*SignalResult
+}
+
+// StockbotSignalIngest is the result type of the signal service create method.
+type StockbotSignalIngest struct {
+	// 受け付けたシグナル数
+	Accepted int
+	// 受け付けたシグナルのID
+	SignalIds []uint
+	// 却下されたシグナル
+	Rejected []*SignalRejection
+}
+
+// NewStockbotSignalIngest initializes result type StockbotSignalIngest from
+// viewed result type StockbotSignalIngest.
+func NewStockbotSignalIngest(vres *signalviews.StockbotSignalIngest) *StockbotSignalIngest {
+	return newStockbotSignalIngest(vres.Projected)
+}
+
+// NewViewedStockbotSignalIngest initializes viewed result type
+// StockbotSignalIngest from result type StockbotSignalIngest using the given
+// view.
+func NewViewedStockbotSignalIngest(res *StockbotSignalIngest, view string) *signalviews.StockbotSignalIngest {
+	p := newStockbotSignalIngestView(res)
+	return &signalviews.StockbotSignalIngest{Projected: p, View: "default"}
+}
+
+// NewStockbotSignalCollection initializes result type StockbotSignalCollection
+// from viewed result type StockbotSignalCollection.
+func NewStockbotSignalCollection(vres *signalviews.StockbotSignalCollection) *StockbotSignalCollection {
+	return newStockbotSignalCollection(vres.Projected)
+}
+
+// NewViewedStockbotSignalCollection initializes viewed result type
+// StockbotSignalCollection from result type StockbotSignalCollection using the
+// given view.
+func NewViewedStockbotSignalCollection(res *StockbotSignalCollection, view string) *signalviews.StockbotSignalCollection {
+	p := newStockbotSignalCollectionView(res)
+	return &signalviews.StockbotSignalCollection{Projected: p, View: "default"}
+}
+
+// newStockbotSignalIngest converts projected type StockbotSignalIngest to
+// service type StockbotSignalIngest.
+func newStockbotSignalIngest(vres *signalviews.StockbotSignalIngestView) *StockbotSignalIngest {
+	res := &StockbotSignalIngest{}
+	if vres.Accepted != nil {
+		res.Accepted = *vres.Accepted
+	}
+	if vres.SignalIds != nil {
+		res.SignalIds = make([]uint, len(vres.SignalIds))
+		for i, val := range vres.SignalIds {
+			res.SignalIds[i] = val
+		}
+	}
+	if vres.Rejected != nil {
+		res.Rejected = make([]*SignalRejection, len(vres.Rejected))
+		for i, val := range vres.Rejected {
+			if val == nil {
+				res.Rejected[i] = nil
+				continue
+			}
+			res.Rejected[i] = transformSignalviewsSignalRejectionViewToSignalRejection(val)
+		}
+	}
+	return res
+}
+
+// newStockbotSignalIngestView projects result type StockbotSignalIngest to
+// projected type StockbotSignalIngestView using the "default" view.
+func newStockbotSignalIngestView(res *StockbotSignalIngest) *signalviews.StockbotSignalIngestView {
+	vres := &signalviews.StockbotSignalIngestView{
+		Accepted: &res.Accepted,
+	}
+	if res.SignalIds != nil {
+		vres.SignalIds = make([]uint, len(res.SignalIds))
+		for i, val := range res.SignalIds {
+			vres.SignalIds[i] = val
+		}
+	} else {
+		vres.SignalIds = []uint{}
+	}
+	if res.Rejected != nil {
+		vres.Rejected = make([]*signalviews.SignalRejectionView, len(res.Rejected))
+		for i, val := range res.Rejected {
+			if val == nil {
+				vres.Rejected[i] = nil
+				continue
+			}
+			vres.Rejected[i] = transformSignalRejectionToSignalviewsSignalRejectionView(val)
+		}
+	} else {
+		vres.Rejected = []*signalviews.SignalRejectionView{}
+	}
+	return vres
+}
+
+// newStockbotSignalCollection converts projected type StockbotSignalCollection
+// to service type StockbotSignalCollection.
+func newStockbotSignalCollection(vres *signalviews.StockbotSignalCollectionView) *StockbotSignalCollection {
+	res := &StockbotSignalCollection{}
+	if vres.Signals != nil {
+		res.Signals = make([]*SignalResult, len(vres.Signals))
+		for i, val := range vres.Signals {
+			if val == nil {
+				res.Signals[i] = nil
+				continue
+			}
+			res.Signals[i] = transformSignalviewsSignalResultViewToSignalResult(val)
+		}
+	}
+	return res
+}
+
+// newStockbotSignalCollectionView projects result type
+// StockbotSignalCollection to projected type StockbotSignalCollectionView
+// using the "default" view.
+func newStockbotSignalCollectionView(res *StockbotSignalCollection) *signalviews.StockbotSignalCollectionView {
+	vres := &signalviews.StockbotSignalCollectionView{}
+	if res.Signals != nil {
+		vres.Signals = make([]*signalviews.SignalResultView, len(res.Signals))
+		for i, val := range res.Signals {
+			if val == nil {
+				vres.Signals[i] = nil
+				continue
+			}
+			vres.Signals[i] = transformSignalResultToSignalviewsSignalResultView(val)
+		}
+	} else {
+		vres.Signals = []*signalviews.SignalResultView{}
+	}
+	return vres
+}
+
+// transformSignalviewsSignalRejectionViewToSignalRejection builds a value of
+// type *SignalRejection from a value of type *signalviews.SignalRejectionView.
+func transformSignalviewsSignalRejectionViewToSignalRejection(v *signalviews.SignalRejectionView) *SignalRejection {
+	if v == nil {
+		return nil
+	}
+	res := &SignalRejection{
+		Index:  *v.Index,
+		Symbol: *v.Symbol,
+		Reason: *v.Reason,
+	}
+
+	return res
+}
+
+// transformSignalRejectionToSignalviewsSignalRejectionView builds a value of
+// type *signalviews.SignalRejectionView from a value of type *SignalRejection.
+func transformSignalRejectionToSignalviewsSignalRejectionView(v *SignalRejection) *signalviews.SignalRejectionView {
+	res := &signalviews.SignalRejectionView{
+		Index:  &v.Index,
+		Symbol: &v.Symbol,
+		Reason: &v.Reason,
+	}
+
+	return res
+}
+
+// transformSignalviewsSignalResultViewToSignalResult builds a value of type
+// *SignalResult from a value of type *signalviews.SignalResultView.
+func transformSignalviewsSignalResultViewToSignalResult(v *signalviews.SignalResultView) *SignalResult {
+	if v == nil {
+		return nil
+	}
+	res := &SignalResult{
+		ID:          *v.ID,
+		Symbol:      *v.Symbol,
+		Side:        *v.Side,
+		GeneratedAt: *v.GeneratedAt,
+		Rationale:   v.Rationale,
+		LimitPrice:  v.LimitPrice,
+		StopPrice:   v.StopPrice,
+		TargetPrice: v.TargetPrice,
+		Weight:      v.Weight,
+		ValidUntil:  v.ValidUntil,
+		Source:      *v.Source,
+		SourceFile:  v.SourceFile,
+		ConsumedAt:  v.ConsumedAt,
+	}
+
+	return res
+}
+
+// transformSignalResultToSignalviewsSignalResultView builds a value of type
+// *signalviews.SignalResultView from a value of type *SignalResult.
+func transformSignalResultToSignalviewsSignalResultView(v *SignalResult) *signalviews.SignalResultView {
+	res := &signalviews.SignalResultView{
+		ID:          &v.ID,
+		Symbol:      &v.Symbol,
+		Side:        &v.Side,
+		GeneratedAt: &v.GeneratedAt,
+		Rationale:   v.Rationale,
+		LimitPrice:  v.LimitPrice,
+		StopPrice:   v.StopPrice,
+		TargetPrice: v.TargetPrice,
+		Weight:      v.Weight,
+		ValidUntil:  v.ValidUntil,
+		Source:      &v.Source,
+		SourceFile:  v.SourceFile,
+		ConsumedAt:  v.ConsumedAt,
+	}
+
+	return res
+}
@@ -0,0 +1,203 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// signal views
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package views
+
+import (
+	goa "goa.design/goa/v3/pkg"
+)
+
+// StockbotSignalIngest is the viewed result type that is projected based on a
+// view.
+type StockbotSignalIngest struct {
+	// Type to project
+	Projected *StockbotSignalIngestView
+	// View to render
+	View string
+}
+
+// StockbotSignalCollection is the viewed result type that is projected based
+// on a view.
+type StockbotSignalCollection struct {
+	// Type to project
+	Projected *StockbotSignalCollectionView
+	// View to render
+	View string
+}
+
+// StockbotSignalIngestView is a type that runs validations on a projected type.
+type StockbotSignalIngestView struct {
+	// 受け付けたシグナル数
+	Accepted *int
+	// 受け付けたシグナルのID
+	SignalIds []uint
+	// 却下されたシグナル
+	Rejected []*SignalRejectionView
+}
+
+// SignalRejectionView is a type that runs validations on a projected type.
+type SignalRejectionView struct {
+	// リクエスト内での位置 (0始まり)
+	Index *int
+	// 銘柄コード
+	Symbol *string
+	// 却下理由
+	Reason *string
+}
+
+// StockbotSignalCollectionView is a type that runs validations on a projected
+// type.
+type StockbotSignalCollectionView struct {
+	// シグナルのリスト
+	Signals []*SignalResultView
+}
+
+// SignalResultView is a type that runs validations on a projected type.
+type SignalResultView struct {
+	// シグナルID
+	ID *uint
+	// 銘柄コード
+	Symbol *string
+	// 売買区分 (BUY/SELL)
+	Side *string
+	// シグナル生成日時 (RFC3339)
+	GeneratedAt *string
+	// シグナルの根拠
+	Rationale *string
+	// 指値
+	LimitPrice *float64
+	// 損切り価格
+	StopPrice *float64
+	// 利確目標価格
+	TargetPrice *float64
+	// 資金配分の重み
+	Weight *float64
+	// 有効期限 (RFC3339)
+	ValidUntil *string
+	// 取り込み元 (FILE/HTTP)
+	Source *string
+	// 取り込み元ファイル
+	SourceFile *string
+	// エージェントが処理した日時 (RFC3339)
+	ConsumedAt *string
+}
+
+var (
+	// StockbotSignalIngestMap is a map indexing the attribute names of
+	// StockbotSignalIngest by view name.
+	StockbotSignalIngestMap = map[string][]string{
+		"default": {
+			"accepted",
+			"signal_ids",
+			"rejected",
+		},
+	}
+	// StockbotSignalCollectionMap is a map indexing the attribute names of
+	// StockbotSignalCollection by view name.
+	StockbotSignalCollectionMap = map[string][]string{
+		"default": {
+			"signals",
+		},
+	}
+)
+
+// ValidateStockbotSignalIngest runs the validations defined on the viewed
+// result type StockbotSignalIngest.
+func ValidateStockbotSignalIngest(result *StockbotSignalIngest) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotSignalIngestView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotSignalCollection runs the validations defined on the viewed
+// result type StockbotSignalCollection.
+func ValidateStockbotSignalCollection(result *StockbotSignalCollection) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotSignalCollectionView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotSignalIngestView runs the validations defined on
+// StockbotSignalIngestView using the "default" view.
+func ValidateStockbotSignalIngestView(result *StockbotSignalIngestView) (err error) {
+	if result.Accepted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accepted", "result"))
+	}
+	if result.SignalIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("signal_ids", "result"))
+	}
+	if result.Rejected == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rejected", "result"))
+	}
+	for _, e := range result.Rejected {
+		if e != nil {
+			if err2 := ValidateSignalRejectionView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSignalRejectionView runs the validations defined on
+// SignalRejectionView.
+func ValidateSignalRejectionView(result *SignalRejectionView) (err error) {
+	if result.Index == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("index", "result"))
+	}
+	if result.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "result"))
+	}
+	if result.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "result"))
+	}
+	return
+}
+
+// ValidateStockbotSignalCollectionView runs the validations defined on
+// StockbotSignalCollectionView using the "default" view.
+func ValidateStockbotSignalCollectionView(result *StockbotSignalCollectionView) (err error) {
+	if result.Signals == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("signals", "result"))
+	}
+	for _, e := range result.Signals {
+		if e != nil {
+			if err2 := ValidateSignalResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSignalResultView runs the validations defined on SignalResultView.
+func ValidateSignalResultView(result *SignalResultView) (err error) {
+	if result.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "result"))
+	}
+	if result.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "result"))
+	}
+	if result.Side == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("side", "result"))
+	}
+	if result.GeneratedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("generated_at", "result"))
+	}
+	if result.Source == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("source", "result"))
+	}
+	return
+}
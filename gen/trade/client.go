@@ -0,0 +1,60 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// trade client
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package trade
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "trade" service client.
+type Client struct {
+	ListEndpoint     goa.Endpoint
+	DailyPnlEndpoint goa.Endpoint
+	SyncEndpoint     goa.Endpoint
+}
+
+// NewClient initializes a "trade" service client given the endpoints.
+func NewClient(list, dailyPnl, sync goa.Endpoint) *Client {
+	return &Client{
+		ListEndpoint:     list,
+		DailyPnlEndpoint: dailyPnl,
+		SyncEndpoint:     sync,
+	}
+}
+
+// List calls the "list" endpoint of the "trade" service.
+func (c *Client) List(ctx context.Context, p *ListPayload) (res *StockbotTradeCollection, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotTradeCollection), nil
+}
+
+// DailyPnl calls the "daily_pnl" endpoint of the "trade" service.
+func (c *Client) DailyPnl(ctx context.Context, p *DailyPnlPayload) (res *StockbotDailyPnlCollection, err error) {
+	var ires any
+	ires, err = c.DailyPnlEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotDailyPnlCollection), nil
+}
+
+// Sync calls the "sync" endpoint of the "trade" service.
+func (c *Client) Sync(ctx context.Context) (res *StockbotExecutionSync, err error) {
+	var ires any
+	ires, err = c.SyncEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*StockbotExecutionSync), nil
+}
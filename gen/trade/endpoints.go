@@ -0,0 +1,78 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// trade endpoints
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package trade
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "trade" service endpoints.
+type Endpoints struct {
+	List     goa.Endpoint
+	DailyPnl goa.Endpoint
+	Sync     goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "trade" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		List:     NewListEndpoint(s),
+		DailyPnl: NewDailyPnlEndpoint(s),
+		Sync:     NewSyncEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "trade" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.List = m(e.List)
+	e.DailyPnl = m(e.DailyPnl)
+	e.Sync = m(e.Sync)
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "list" of
+// service "trade".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListPayload)
+		res, err := s.List(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotTradeCollection(res, "default")
+		return vres, nil
+	}
+}
+
+// NewDailyPnlEndpoint returns an endpoint function that calls the method
+// "daily_pnl" of service "trade".
+func NewDailyPnlEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DailyPnlPayload)
+		res, err := s.DailyPnl(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotDailyPnlCollection(res, "default")
+		return vres, nil
+	}
+}
+
+// NewSyncEndpoint returns an endpoint function that calls the method "sync" of
+// service "trade".
+func NewSyncEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		res, err := s.Sync(ctx)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedStockbotExecutionSync(res, "default")
+		return vres, nil
+	}
+}
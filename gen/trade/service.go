@@ -0,0 +1,380 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// trade service
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package trade
+
+import (
+	"context"
+	tradeviews "stock-bot/gen/trade/views"
+)
+
+// The trade service exposes the round-trip trades and the realized P&L
+// computed from the stored executions.
+type Service interface {
+	// List the round-trip trades closed in a period, newest exit first.
+	List(context.Context, *ListPayload) (res *StockbotTradeCollection, err error)
+	// Get the realized P&L by the exit date (Japan time), newest first.
+	DailyPnl(context.Context, *DailyPnlPayload) (res *StockbotDailyPnlCollection, err error)
+	// Fetch the executions of today's orders from the broker and store them now.
+	Sync(context.Context) (res *StockbotExecutionSync, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "stockbot"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "trade"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [3]string{"list", "daily_pnl", "sync"}
+
+// DailyPnlPayload is the payload type of the trade service daily_pnl method.
+type DailyPnlPayload struct {
+	// 取得単価の計算方法 (FIFO: 先入先出法, AVERAGE: 移動平均法)
+	Method string
+	// この日以降の決済に絞り込む (YYYY-MM-DD, 日本時間)
+	From *string
+	// この日までの決済に絞り込む (YYYY-MM-DD, 日本時間, この日を含む)
+	To *string
+}
+
+// The realized P&L of the trades closed on a day (Japan time).
+type DailyProfitLossResult struct {
+	// 決済日 (YYYY-MM-DD)
+	Date string
+	// 決済した取引の件数
+	Trades int
+	// 手数料控除後の損益がプラスの取引の件数
+	Wins int
+	// 手数料控除後の損益がマイナスの取引の件数
+	Losses int
+	// 手数料控除前の損益
+	GrossProfitLoss float64
+	// 手数料
+	Commission float64
+	// 手数料控除後の損益
+	NetProfitLoss float64
+}
+
+// ListPayload is the payload type of the trade service list method.
+type ListPayload struct {
+	// 銘柄コードで絞り込む
+	Symbol *string
+	// 取得単価の計算方法 (FIFO: 先入先出法, AVERAGE: 移動平均法)
+	Method string
+	// この日時以降に決済した取引に絞り込む (RFC3339)
+	Since *string
+	// この日時より前に決済した取引に絞り込む (RFC3339)
+	Until *string
+	// 取得件数
+	Limit int
+}
+
+// StockbotDailyPnlCollection is the result type of the trade service daily_pnl
+// method.
+type StockbotDailyPnlCollection struct {
+	// 日ごとの実現損益
+	Days []*DailyProfitLossResult
+}
+
+// StockbotExecutionSync is the result type of the trade service sync method.
+type StockbotExecutionSync struct {
+	// 約定のあった注文の件数
+	Orders int
+	// ボット以外から発注され、新たに保存した注文の件数
+	Imported int
+	// 保存した約定の件数
+	Executions int
+	// 約定から作成し直したポジション台帳の未決済の建玉 (ロット) の件数
+	Lots int
+	// 証券会社で取消・失効・受付エラーになり、完了にした注文の件数 (注文一覧にない前日以前の注文の失効を含む)
+	Closed int
+	// 約定があるものの、内容を変換できずに保存しなかった注文の件数
+	Skipped int
+}
+
+// StockbotTradeCollection is the result type of the trade service list method.
+type StockbotTradeCollection struct {
+	// 往復取引のリスト
+	Trades []*TradeResult
+}
+
+// A round-trip trade matched from the stored executions (entry → exit).
+type TradeResult struct {
+	// 銘柄コード
+	Symbol string
+	// 信用取引の場合は true
+	IsMargin bool
+	// 方向 (LONG: 買い→売り, SHORT: 売建→買戻し)
+	Side string
+	// 数量
+	Quantity int
+	// 建てた注文のID (移動平均法では最初に建てた注文)
+	EntryOrderID string
+	// 決済した注文のID
+	ExitOrderID string
+	// 建てた日時 (RFC3339)
+	EntryTime string
+	// 決済した日時 (RFC3339)
+	ExitTime string
+	// 取得単価 (移動平均法では平均単価)
+	EntryPrice float64
+	// 決済単価
+	ExitPrice float64
+	// この取引の数量に按分した建てと決済の手数料
+	Commission float64
+	// 手数料控除前の損益
+	GrossProfitLoss float64
+	// 手数料控除後の損益
+	NetProfitLoss float64
+}
+
+// NewStockbotTradeCollection initializes result type StockbotTradeCollection
+// from viewed result type StockbotTradeCollection.
+func NewStockbotTradeCollection(vres *tradeviews.StockbotTradeCollection) *StockbotTradeCollection {
+	return newStockbotTradeCollection(vres.Projected)
+}
+
+// NewViewedStockbotTradeCollection initializes viewed result type
+// StockbotTradeCollection from result type StockbotTradeCollection using the
+// given view.
+func NewViewedStockbotTradeCollection(res *StockbotTradeCollection, view string) *tradeviews.StockbotTradeCollection {
+	p := newStockbotTradeCollectionView(res)
+	return &tradeviews.StockbotTradeCollection{Projected: p, View: "default"}
+}
+
+// NewStockbotDailyPnlCollection initializes result type
+// StockbotDailyPnlCollection from viewed result type
+// StockbotDailyPnlCollection.
+func NewStockbotDailyPnlCollection(vres *tradeviews.StockbotDailyPnlCollection) *StockbotDailyPnlCollection {
+	return newStockbotDailyPnlCollection(vres.Projected)
+}
+
+// NewViewedStockbotDailyPnlCollection initializes viewed result type
+// StockbotDailyPnlCollection from result type StockbotDailyPnlCollection using
+// the given view.
+func NewViewedStockbotDailyPnlCollection(res *StockbotDailyPnlCollection, view string) *tradeviews.StockbotDailyPnlCollection {
+	p := newStockbotDailyPnlCollectionView(res)
+	return &tradeviews.StockbotDailyPnlCollection{Projected: p, View: "default"}
+}
+
+// NewStockbotExecutionSync initializes result type StockbotExecutionSync from
+// viewed result type StockbotExecutionSync.
+func NewStockbotExecutionSync(vres *tradeviews.StockbotExecutionSync) *StockbotExecutionSync {
+	return newStockbotExecutionSync(vres.Projected)
+}
+
+// NewViewedStockbotExecutionSync initializes viewed result type
+// StockbotExecutionSync from result type StockbotExecutionSync using the given
+// view.
+func NewViewedStockbotExecutionSync(res *StockbotExecutionSync, view string) *tradeviews.StockbotExecutionSync {
+	p := newStockbotExecutionSyncView(res)
+	return &tradeviews.StockbotExecutionSync{Projected: p, View: "default"}
+}
+
+// newStockbotTradeCollection converts projected type StockbotTradeCollection
+// to service type StockbotTradeCollection.
+func newStockbotTradeCollection(vres *tradeviews.StockbotTradeCollectionView) *StockbotTradeCollection {
+	res := &StockbotTradeCollection{}
+	if vres.Trades != nil {
+		res.Trades = make([]*TradeResult, len(vres.Trades))
+		for i, val := range vres.Trades {
+			if val == nil {
+				res.Trades[i] = nil
+				continue
+			}
+			res.Trades[i] = transformTradeviewsTradeResultViewToTradeResult(val)
+		}
+	}
+	return res
+}
+
+// newStockbotTradeCollectionView projects result type StockbotTradeCollection
+// to projected type StockbotTradeCollectionView using the "default" view.
+func newStockbotTradeCollectionView(res *StockbotTradeCollection) *tradeviews.StockbotTradeCollectionView {
+	vres := &tradeviews.StockbotTradeCollectionView{}
+	if res.Trades != nil {
+		vres.Trades = make([]*tradeviews.TradeResultView, len(res.Trades))
+		for i, val := range res.Trades {
+			if val == nil {
+				vres.Trades[i] = nil
+				continue
+			}
+			vres.Trades[i] = transformTradeResultToTradeviewsTradeResultView(val)
+		}
+	} else {
+		vres.Trades = []*tradeviews.TradeResultView{}
+	}
+	return vres
+}
+
+// newStockbotDailyPnlCollection converts projected type
+// StockbotDailyPnlCollection to service type StockbotDailyPnlCollection.
+func newStockbotDailyPnlCollection(vres *tradeviews.StockbotDailyPnlCollectionView) *StockbotDailyPnlCollection {
+	res := &StockbotDailyPnlCollection{}
+	if vres.Days != nil {
+		res.Days = make([]*DailyProfitLossResult, len(vres.Days))
+		for i, val := range vres.Days {
+			if val == nil {
+				res.Days[i] = nil
+				continue
+			}
+			res.Days[i] = transformTradeviewsDailyProfitLossResultViewToDailyProfitLossResult(val)
+		}
+	}
+	return res
+}
+
+// newStockbotDailyPnlCollectionView projects result type
+// StockbotDailyPnlCollection to projected type StockbotDailyPnlCollectionView
+// using the "default" view.
+func newStockbotDailyPnlCollectionView(res *StockbotDailyPnlCollection) *tradeviews.StockbotDailyPnlCollectionView {
+	vres := &tradeviews.StockbotDailyPnlCollectionView{}
+	if res.Days != nil {
+		vres.Days = make([]*tradeviews.DailyProfitLossResultView, len(res.Days))
+		for i, val := range res.Days {
+			if val == nil {
+				vres.Days[i] = nil
+				continue
+			}
+			vres.Days[i] = transformDailyProfitLossResultToTradeviewsDailyProfitLossResultView(val)
+		}
+	} else {
+		vres.Days = []*tradeviews.DailyProfitLossResultView{}
+	}
+	return vres
+}
+
+// newStockbotExecutionSync converts projected type StockbotExecutionSync to
+// service type StockbotExecutionSync.
+func newStockbotExecutionSync(vres *tradeviews.StockbotExecutionSyncView) *StockbotExecutionSync {
+	res := &StockbotExecutionSync{}
+	if vres.Orders != nil {
+		res.Orders = *vres.Orders
+	}
+	if vres.Imported != nil {
+		res.Imported = *vres.Imported
+	}
+	if vres.Executions != nil {
+		res.Executions = *vres.Executions
+	}
+	if vres.Lots != nil {
+		res.Lots = *vres.Lots
+	}
+	if vres.Closed != nil {
+		res.Closed = *vres.Closed
+	}
+	if vres.Skipped != nil {
+		res.Skipped = *vres.Skipped
+	}
+	return res
+}
+
+// newStockbotExecutionSyncView projects result type StockbotExecutionSync to
+// projected type StockbotExecutionSyncView using the "default" view.
+func newStockbotExecutionSyncView(res *StockbotExecutionSync) *tradeviews.StockbotExecutionSyncView {
+	vres := &tradeviews.StockbotExecutionSyncView{
+		Orders:     &res.Orders,
+		Imported:   &res.Imported,
+		Executions: &res.Executions,
+		Lots:       &res.Lots,
+		Closed:     &res.Closed,
+		Skipped:    &res.Skipped,
+	}
+	return vres
+}
+
+// transformTradeviewsTradeResultViewToTradeResult builds a value of type
+// *TradeResult from a value of type *tradeviews.TradeResultView.
+func transformTradeviewsTradeResultViewToTradeResult(v *tradeviews.TradeResultView) *TradeResult {
+	if v == nil {
+		return nil
+	}
+	res := &TradeResult{
+		Symbol:          *v.Symbol,
+		IsMargin:        *v.IsMargin,
+		Side:            *v.Side,
+		Quantity:        *v.Quantity,
+		EntryOrderID:    *v.EntryOrderID,
+		ExitOrderID:     *v.ExitOrderID,
+		EntryTime:       *v.EntryTime,
+		ExitTime:        *v.ExitTime,
+		EntryPrice:      *v.EntryPrice,
+		ExitPrice:       *v.ExitPrice,
+		Commission:      *v.Commission,
+		GrossProfitLoss: *v.GrossProfitLoss,
+		NetProfitLoss:   *v.NetProfitLoss,
+	}
+
+	return res
+}
+
+// transformTradeResultToTradeviewsTradeResultView builds a value of type
+// *tradeviews.TradeResultView from a value of type *TradeResult.
+func transformTradeResultToTradeviewsTradeResultView(v *TradeResult) *tradeviews.TradeResultView {
+	res := &tradeviews.TradeResultView{
+		Symbol:          &v.Symbol,
+		IsMargin:        &v.IsMargin,
+		Side:            &v.Side,
+		Quantity:        &v.Quantity,
+		EntryOrderID:    &v.EntryOrderID,
+		ExitOrderID:     &v.ExitOrderID,
+		EntryTime:       &v.EntryTime,
+		ExitTime:        &v.ExitTime,
+		EntryPrice:      &v.EntryPrice,
+		ExitPrice:       &v.ExitPrice,
+		Commission:      &v.Commission,
+		GrossProfitLoss: &v.GrossProfitLoss,
+		NetProfitLoss:   &v.NetProfitLoss,
+	}
+
+	return res
+}
+
+// transformTradeviewsDailyProfitLossResultViewToDailyProfitLossResult builds a
+// value of type *DailyProfitLossResult from a value of type
+// *tradeviews.DailyProfitLossResultView.
+func transformTradeviewsDailyProfitLossResultViewToDailyProfitLossResult(v *tradeviews.DailyProfitLossResultView) *DailyProfitLossResult {
+	if v == nil {
+		return nil
+	}
+	res := &DailyProfitLossResult{
+		Date:            *v.Date,
+		Trades:          *v.Trades,
+		Wins:            *v.Wins,
+		Losses:          *v.Losses,
+		GrossProfitLoss: *v.GrossProfitLoss,
+		Commission:      *v.Commission,
+		NetProfitLoss:   *v.NetProfitLoss,
+	}
+
+	return res
+}
+
+// transformDailyProfitLossResultToTradeviewsDailyProfitLossResultView builds a
+// value of type *tradeviews.DailyProfitLossResultView from a value of type
+// *DailyProfitLossResult.
+func transformDailyProfitLossResultToTradeviewsDailyProfitLossResultView(v *DailyProfitLossResult) *tradeviews.DailyProfitLossResultView {
+	res := &tradeviews.DailyProfitLossResultView{
+		Date:            &v.Date,
+		Trades:          &v.Trades,
+		Wins:            &v.Wins,
+		Losses:          &v.Losses,
+		GrossProfitLoss: &v.GrossProfitLoss,
+		Commission:      &v.Commission,
+		NetProfitLoss:   &v.NetProfitLoss,
+	}
+
+	return res
+}
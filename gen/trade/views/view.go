@@ -0,0 +1,316 @@
+// Code generated by goa v3.23.1, DO NOT EDIT.
+//
+// trade views
+//
+// Command:
+// $ goa gen stock-bot/design
+
+package views
+
+import (
+	goa "goa.design/goa/v3/pkg"
+)
+
+// StockbotTradeCollection is the viewed result type that is projected based on
+// a view.
+type StockbotTradeCollection struct {
+	// Type to project
+	Projected *StockbotTradeCollectionView
+	// View to render
+	View string
+}
+
+// StockbotDailyPnlCollection is the viewed result type that is projected based
+// on a view.
+type StockbotDailyPnlCollection struct {
+	// Type to project
+	Projected *StockbotDailyPnlCollectionView
+	// View to render
+	View string
+}
+
+// StockbotExecutionSync is the viewed result type that is projected based on a
+// view.
+type StockbotExecutionSync struct {
+	// Type to project
+	Projected *StockbotExecutionSyncView
+	// View to render
+	View string
+}
+
+// StockbotTradeCollectionView is a type that runs validations on a projected
+// type.
+type StockbotTradeCollectionView struct {
+	// 往復取引のリスト
+	Trades []*TradeResultView
+}
+
+// TradeResultView is a type that runs validations on a projected type.
+type TradeResultView struct {
+	// 銘柄コード
+	Symbol *string
+	// 信用取引の場合は true
+	IsMargin *bool
+	// 方向 (LONG: 買い→売り, SHORT: 売建→買戻し)
+	Side *string
+	// 数量
+	Quantity *int
+	// 建てた注文のID (移動平均法では最初に建てた注文)
+	EntryOrderID *string
+	// 決済した注文のID
+	ExitOrderID *string
+	// 建てた日時 (RFC3339)
+	EntryTime *string
+	// 決済した日時 (RFC3339)
+	ExitTime *string
+	// 取得単価 (移動平均法では平均単価)
+	EntryPrice *float64
+	// 決済単価
+	ExitPrice *float64
+	// この取引の数量に按分した建てと決済の手数料
+	Commission *float64
+	// 手数料控除前の損益
+	GrossProfitLoss *float64
+	// 手数料控除後の損益
+	NetProfitLoss *float64
+}
+
+// StockbotDailyPnlCollectionView is a type that runs validations on a
+// projected type.
+type StockbotDailyPnlCollectionView struct {
+	// 日ごとの実現損益
+	Days []*DailyProfitLossResultView
+}
+
+// DailyProfitLossResultView is a type that runs validations on a projected
+// type.
+type DailyProfitLossResultView struct {
+	// 決済日 (YYYY-MM-DD)
+	Date *string
+	// 決済した取引の件数
+	Trades *int
+	// 手数料控除後の損益がプラスの取引の件数
+	Wins *int
+	// 手数料控除後の損益がマイナスの取引の件数
+	Losses *int
+	// 手数料控除前の損益
+	GrossProfitLoss *float64
+	// 手数料
+	Commission *float64
+	// 手数料控除後の損益
+	NetProfitLoss *float64
+}
+
+// StockbotExecutionSyncView is a type that runs validations on a projected
+// type.
+type StockbotExecutionSyncView struct {
+	// 約定のあった注文の件数
+	Orders *int
+	// ボット以外から発注され、新たに保存した注文の件数
+	Imported *int
+	// 保存した約定の件数
+	Executions *int
+	// 約定から作成し直したポジション台帳の未決済の建玉 (ロット) の件数
+	Lots *int
+	// 証券会社で取消・失効・受付エラーになり、完了にした注文の件数 (注文一覧にない前日以前の注文の失効を含む)
+	Closed *int
+	// 約定があるものの、内容を変換できずに保存しなかった注文の件数
+	Skipped *int
+}
+
+var (
+	// StockbotTradeCollectionMap is a map indexing the attribute names of
+	// StockbotTradeCollection by view name.
+	StockbotTradeCollectionMap = map[string][]string{
+		"default": {
+			"trades",
+		},
+	}
+	// StockbotDailyPnlCollectionMap is a map indexing the attribute names of
+	// StockbotDailyPnlCollection by view name.
+	StockbotDailyPnlCollectionMap = map[string][]string{
+		"default": {
+			"days",
+		},
+	}
+	// StockbotExecutionSyncMap is a map indexing the attribute names of
+	// StockbotExecutionSync by view name.
+	StockbotExecutionSyncMap = map[string][]string{
+		"default": {
+			"orders",
+			"imported",
+			"executions",
+			"lots",
+			"closed",
+			"skipped",
+		},
+	}
+)
+
+// ValidateStockbotTradeCollection runs the validations defined on the viewed
+// result type StockbotTradeCollection.
+func ValidateStockbotTradeCollection(result *StockbotTradeCollection) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotTradeCollectionView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotDailyPnlCollection runs the validations defined on the
+// viewed result type StockbotDailyPnlCollection.
+func ValidateStockbotDailyPnlCollection(result *StockbotDailyPnlCollection) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotDailyPnlCollectionView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotExecutionSync runs the validations defined on the viewed
+// result type StockbotExecutionSync.
+func ValidateStockbotExecutionSync(result *StockbotExecutionSync) (err error) {
+	switch result.View {
+	case "default", "":
+		err = ValidateStockbotExecutionSyncView(result.Projected)
+	default:
+		err = goa.InvalidEnumValueError("view", result.View, []any{"default"})
+	}
+	return
+}
+
+// ValidateStockbotTradeCollectionView runs the validations defined on
+// StockbotTradeCollectionView using the "default" view.
+func ValidateStockbotTradeCollectionView(result *StockbotTradeCollectionView) (err error) {
+	if result.Trades == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trades", "result"))
+	}
+	for _, e := range result.Trades {
+		if e != nil {
+			if err2 := ValidateTradeResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateTradeResultView runs the validations defined on TradeResultView.
+func ValidateTradeResultView(result *TradeResultView) (err error) {
+	if result.Symbol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("symbol", "result"))
+	}
+	if result.IsMargin == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_margin", "result"))
+	}
+	if result.Side == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("side", "result"))
+	}
+	if result.Quantity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("quantity", "result"))
+	}
+	if result.EntryOrderID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entry_order_id", "result"))
+	}
+	if result.ExitOrderID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exit_order_id", "result"))
+	}
+	if result.EntryTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entry_time", "result"))
+	}
+	if result.ExitTime == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exit_time", "result"))
+	}
+	if result.EntryPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entry_price", "result"))
+	}
+	if result.ExitPrice == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exit_price", "result"))
+	}
+	if result.Commission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("commission", "result"))
+	}
+	if result.GrossProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gross_profit_loss", "result"))
+	}
+	if result.NetProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_profit_loss", "result"))
+	}
+	if result.Side != nil {
+		if !(*result.Side == "LONG" || *result.Side == "SHORT") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.side", *result.Side, []any{"LONG", "SHORT"}))
+		}
+	}
+	return
+}
+
+// ValidateStockbotDailyPnlCollectionView runs the validations defined on
+// StockbotDailyPnlCollectionView using the "default" view.
+func ValidateStockbotDailyPnlCollectionView(result *StockbotDailyPnlCollectionView) (err error) {
+	if result.Days == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("days", "result"))
+	}
+	for _, e := range result.Days {
+		if e != nil {
+			if err2 := ValidateDailyProfitLossResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDailyProfitLossResultView runs the validations defined on
+// DailyProfitLossResultView.
+func ValidateDailyProfitLossResultView(result *DailyProfitLossResultView) (err error) {
+	if result.Date == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("date", "result"))
+	}
+	if result.Trades == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("trades", "result"))
+	}
+	if result.Wins == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("wins", "result"))
+	}
+	if result.Losses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("losses", "result"))
+	}
+	if result.GrossProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("gross_profit_loss", "result"))
+	}
+	if result.Commission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("commission", "result"))
+	}
+	if result.NetProfitLoss == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("net_profit_loss", "result"))
+	}
+	return
+}
+
+// ValidateStockbotExecutionSyncView runs the validations defined on
+// StockbotExecutionSyncView using the "default" view.
+func ValidateStockbotExecutionSyncView(result *StockbotExecutionSyncView) (err error) {
+	if result.Orders == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("orders", "result"))
+	}
+	if result.Imported == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("imported", "result"))
+	}
+	if result.Executions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("executions", "result"))
+	}
+	if result.Lots == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lots", "result"))
+	}
+	if result.Closed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("closed", "result"))
+	}
+	if result.Skipped == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("skipped", "result"))
+	}
+	return
+}
@@ -12,6 +12,7 @@ require (
 	go.uber.org/zap v1.27.0
 	goa.design/goa v2.2.5+incompatible
 	goa.design/goa/v3 v3.23.2
+	golang.org/x/sys v0.38.0
 	golang.org/x/text v0.31.0
 	gorm.io/driver/postgres v1.5.11
 	gorm.io/gorm v1.25.12
@@ -72,7 +73,6 @@ require (
 	go.uber.org/multierr v1.10.0 // indirect
 	golang.org/x/crypto v0.45.0 // indirect
 	golang.org/x/sync v0.18.0 // indirect
-	golang.org/x/sys v0.38.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1
 )
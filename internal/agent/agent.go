@@ -2,12 +2,14 @@ package agent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
 	"os"
 	"path/filepath"
 	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
 	"time"
 )
 
@@ -19,13 +21,31 @@ type Agent struct {
 	ctx           context.Context
 	cancel        context.CancelFunc
 	signalPattern string
-	state         *State         // <<<<<<<<<<<<<<<< 追加
+	state         *State       // <<<<<<<<<<<<<<<< 追加
 	tradeService  TradeService // <<<<<<<<<<<<<<<< 追加
+	signalRepo    repository.SignalRepository
+	// masterRepo は銘柄マスタ (値幅制限など) の参照に使用する
+	masterRepo repository.MasterRepository
+	// newsRepo は新規買いの前に関連ニュース (適時開示など) の有無を確認するために使用する。nilの場合は確認しない
+	newsRepo repository.NewsRepository
+	// eventRepo は発注の拒否とリスク管理による見送りを日次レポートのために記録する。nilの場合は記録しない
+	eventRepo repository.TradingEventRepository
+	// processedFiles はこのプロセス内で処理済みのシグナルファイルのハッシュ
+	// DBへの処理済み記録に失敗した場合でも、同じファイルから二重に発注しないために保持する
+	processedFiles map[string]struct{}
+	// watcher はシグナルファイルの書き込み完了を検知する。nilの場合はtickごとにファイルを探す
+	watcher *SignalWatcher
+	// wakeCh はHTTP経由でシグナルを受け付けた際に、次のtickを待たずに処理を開始するための通知チャネル
+	wakeCh chan struct{}
 }
 
 // NewAgent は新しいエージェントのインスタンスを作成する
 // tradeService はトレードサービス（Go APIラッパー）の実装
-func NewAgent(configPath string, tradeService TradeService) (*Agent, error) { // <<<<<<<<<<<<<<<< 引数追加
+// signalRepo は読み込んだシグナルと処理済みシグナルファイルの永続化に使用する
+// masterRepo は発注前の値幅制限の確認に使用する
+// newsRepo は新規買いの前の関連ニュースの確認に使用する
+// eventRepo は発注の拒否とリスク管理による見送りの記録に使用する
+func NewAgent(configPath string, tradeService TradeService, signalRepo repository.SignalRepository, masterRepo repository.MasterRepository, newsRepo repository.NewsRepository, eventRepo repository.TradingEventRepository) (*Agent, error) { // <<<<<<<<<<<<<<<< 引数追加
 	// 先に設定を読み込んでおく
 	cfg, err := LoadAgentConfig(configPath)
 	if err != nil {
@@ -36,15 +56,33 @@ func NewAgent(configPath string, tradeService TradeService) (*Agent, error) { //
 
 	ctx, cancel := context.WithCancel(context.Background())
 
+	var watcher *SignalWatcher
+	settings := cfg.StrategySettings.Swingtrade
+	if settings.SignalWatchMode != SignalWatchOff {
+		watcher = NewSignalWatcher(SignalWatcherConfig{
+			Pattern:      settings.SignalFilePattern,
+			Mode:         settings.SignalWatchMode,
+			StableFor:    settings.SignalStableDuration,
+			PollInterval: settings.SignalPollInterval,
+		}, logger)
+	}
+
 	return &Agent{
-		configPath:    configPath,
-		config:        cfg,
-		logger:        logger,
-		ctx:           ctx,
-		cancel:        cancel,
-		signalPattern: cfg.StrategySettings.Swingtrade.SignalFilePattern, // とりあえずスイングトレードに固定
-		state:         NewState(), // <<<<<<<<<<<<<<<< 追加
-		tradeService:  tradeService, // <<<<<<<<<<<<<<<< 追加
+		configPath:     configPath,
+		config:         cfg,
+		logger:         logger,
+		ctx:            ctx,
+		cancel:         cancel,
+		signalPattern:  cfg.StrategySettings.Swingtrade.SignalFilePattern, // とりあえずスイングトレードに固定
+		state:          NewState(),                                        // <<<<<<<<<<<<<<<< 追加
+		tradeService:   tradeService,                                      // <<<<<<<<<<<<<<<< 追加
+		signalRepo:     signalRepo,
+		masterRepo:     masterRepo,
+		newsRepo:       newsRepo,
+		eventRepo:      eventRepo,
+		processedFiles: make(map[string]struct{}),
+		watcher:        watcher,
+		wakeCh:         make(chan struct{}, 1),
 	}, nil
 }
 
@@ -57,6 +95,17 @@ func (a *Agent) Start() {
 	// 起動時に初期状態を同期
 	a.syncInitialState() // <<<<<<<<<<<<<<<< 追加
 
+	// シグナルファイルのウォッチャーを起動
+	var signalFiles <-chan []ReadySignalFile
+	if a.watcher != nil {
+		signalFiles = a.watcher.Events()
+		go func() {
+			if err := a.watcher.Run(a.ctx); err != nil {
+				a.logger.Error("signal file watcher stopped", "error", err)
+			}
+		}()
+	}
+
 	// 起動時に一度実行 (初期状態同期後にtickを実行)
 	a.tick()
 
@@ -64,6 +113,12 @@ func (a *Agent) Start() {
 		select {
 		case <-ticker.C:
 			a.tick()
+		case files := <-signalFiles:
+			a.logger.Info("signal files ready", "count", len(files))
+			a.processSignalFiles(files)
+		case <-a.wakeCh:
+			a.logger.Info("agent woken up by pushed signals")
+			a.processPendingSignalsWithTimeout()
 		case <-a.ctx.Done():
 			a.logger.Info("agent stopping...")
 			return
@@ -77,6 +132,15 @@ func (a *Agent) Stop() {
 	a.cancel()
 }
 
+// NotifySignals はHTTP経由で受け付けたシグナルがあることをエージェントに通知する
+// 通知は合流されるため、処理中に複数回呼ばれても未処理のシグナルはまとめて処理される
+func (a *Agent) NotifySignals() {
+	select {
+	case a.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
 // syncInitialState はエージェント起動時にトレードサービスから状態を取得し、内部状態を同期する
 func (a *Agent) syncInitialState() { // <<<<<<<<<<<<<<<< 追加
 	a.logger.Info("synchronizing initial state...")
@@ -90,7 +154,7 @@ func (a *Agent) syncInitialState() { // <<<<<<<<<<<<<<<< 追加
 		// エージェントの起動は継続するが、残高情報がない状態で動作することになるため、適切にハンドリングする必要がある
 	} else {
 		a.state.UpdateBalance(balance)
-		a.logger.Info("initial balance synchronized", "cash", balance.Cash, "buying_power", balance.BuyingPower)
+		a.logger.Info("initial balance synchronized", "withdrawable_cash", balance.WithdrawableCash, "buying_power", balance.CashBuyingPower, "maintenance_rate", balance.MaintenanceRate, "has_margin_call", balance.HasMarginCall)
 	}
 
 	// ポジションの同期
@@ -114,7 +178,6 @@ func (a *Agent) syncInitialState() { // <<<<<<<<<<<<<<<< 追加
 	a.logger.Info("initial state synchronization completed.")
 }
 
-
 // tick はループごとに実行される処理
 func (a *Agent) tick() {
 	a.logger.Info("agent tick")
@@ -125,9 +188,12 @@ func (a *Agent) tick() {
 	orderCtx, orderCancel := context.WithTimeout(a.ctx, 10*time.Second)
 	defer orderCancel() // tick関数が終了する際にコンテキストをキャンセル
 
+	// 約定・取消などで完了した注文が発注中として残り続けないよう、発注中注文を取得し直す
+	a.refreshOrders(orderCtx)
+
 	// 状態の確認（ログ出力のみ）
 	balance := a.state.GetBalance()
-	a.logger.Info("current balance", "cash", balance.Cash, "buying_power", balance.BuyingPower)
+	a.logger.Info("current balance", "withdrawable_cash", balance.WithdrawableCash, "buying_power", balance.CashBuyingPower, "maintenance_rate", balance.MaintenanceRate, "has_margin_call", balance.HasMarginCall)
 	positions := a.state.GetPositions()
 	a.logger.Info("current positions", "count", len(positions))
 	for _, p := range positions {
@@ -139,8 +205,15 @@ func (a *Agent) tick() {
 		a.logger.Info("  order detail", "order_id", o.OrderID, "symbol", o.Symbol, "trade_type", o.TradeType, "status", o.OrderStatus)
 	}
 
-	// TODO: シグナルファイルが複数見つかった場合の処理 (最新のものを一つ選ぶなど)
-	// 現状はFindSignalFileが一つだけ返すことを期待
+	// HTTP経由で受け付けた未処理のシグナルを先に処理する
+	a.processPendingSignals(orderCtx)
+
+	// ウォッチャーが有効な場合、シグナルファイルはウォッチャーからの通知で処理する
+	if a.watcher != nil {
+		return
+	}
+
+	// ウォッチャーを使わない場合は、最も新しいシグナルファイルを一つだけ処理する
 	signalFilePath, err := FindSignalFile(a.signalPattern)
 	if err != nil {
 		a.logger.Error("failed to find signal file", "error", err)
@@ -151,98 +224,463 @@ func (a *Agent) tick() {
 		return
 	}
 
+	a.processSignalFile(orderCtx, signalFilePath)
+}
+
+// refreshOrders はトレードサービスから発注中注文を取得し直し、内部状態を更新する
+// 完了した注文は含まれないため、その銘柄・売買区分の発注を再び受け付けるようになる
+// 取得に失敗した場合は二重発注を避けるため、これまでの状態を維持する
+func (a *Agent) refreshOrders(ctx context.Context) {
+	orders, err := a.tradeService.GetOrders(ctx)
+	if err != nil {
+		a.logger.Error("failed to refresh orders, keeping the previous state", "error", err)
+		return
+	}
+	a.state.UpdateOrders(orders)
+}
+
+// processSignalFiles はウォッチャーから通知されたシグナルファイルを通知順に処理する
+func (a *Agent) processSignalFiles(files []ReadySignalFile) {
+	for _, f := range files {
+		if a.ctx.Err() != nil {
+			return
+		}
+		orderCtx, orderCancel := context.WithTimeout(a.ctx, 10*time.Second)
+		a.processSignalFile(orderCtx, f.Path)
+		orderCancel()
+	}
+}
+
+// processSignalFile はシグナルファイル1つを読み込み、各シグナルを処理する
+func (a *Agent) processSignalFile(orderCtx context.Context, signalFilePath string) {
 	a.logger.Info("found signal file", "path", signalFilePath)
 
-	signals, err := ReadSignalFile(signalFilePath)
+	// ハッシュとシグナルは1度の読み込みで得た同じ内容から求める
+	// (別々に開くと、その間にファイルが置き換えられた場合に新しい内容を古いハッシュで処理してしまう)
+	content, err := ReadSignalFileContent(signalFilePath)
+	if err != nil {
+		a.logger.Error("failed to read signal file", "path", signalFilePath, "error", err)
+		return
+	}
+
+	// 同じ内容のファイルから二重に発注しないよう、ファイルハッシュで処理済みかを確認する
+	fileHash := content.Hash
+	if a.isSignalFileProcessed(orderCtx, fileHash) {
+		a.logger.Info("signal file already processed, skipping this tick", "path", signalFilePath, "file_hash", fileHash)
+		// 前回のアーカイブに失敗していた場合に備え、処理済みファイルもアーカイブを試みる
+		a.archiveSignalFile(signalFilePath)
+		return
+	}
+
+	batch, err := content.Decode()
 	if err != nil {
 		a.logger.Error("failed to read signal file", "path", signalFilePath, "error", err)
 		return
 	}
+	signals := batch.Records
+
+	a.logger.Info("signals loaded", "count", len(signals), "format_version", batch.Version)
+
+	// v1フォーマットには生成日時が含まれないため、ファイルの更新日時を生成日時とみなす
+	readAt := time.Now()
+	generatedAt := batch.GeneratedAt
+	if generatedAt.IsZero() {
+		generatedAt = content.ModTime
+	}
+
+	// レコード単位の重複判定は取引日単位で行う
+	// シグナルメーカーがファイルを再生成した場合でも、同じ日の同じシグナルでは再発注しない
+	tradingDay := readAt.In(a.location()).Format("20060102")
+	seen := make(map[string]struct{}, len(signals))
+	var pending []*model.Signal
 
-	a.logger.Info("signals loaded", "count", len(signals))
 	for _, s := range signals {
-		a.logger.Info("signal detail", "symbol", s.Symbol, "signal", s.Signal)
-		symbolStr := fmt.Sprintf("%d", s.Symbol)
-
-		// 意思決定ロジック
-		if s.Signal == BuySignal {
-			if _, ok := a.state.GetPosition(symbolStr); ok {
-				a.logger.Info("skipping buy signal for already held position", "symbol", symbolStr)
-				continue
-			}
-			a.logger.Info("preparing to place buy order", "symbol", symbolStr)
-
-			// 買付余力と現在価格を取得
-			balance := a.state.GetBalance()
-			currentPrice, err := a.tradeService.GetPrice(orderCtx, symbolStr)
-			if err != nil {
-				a.logger.Error("failed to get price for sizing", "symbol", symbolStr, "error", err)
-				continue
-			}
-			if currentPrice == 0 {
-				a.logger.Warn("skipping buy signal because current price is zero", "symbol", symbolStr)
-				continue
-			}
+		a.logger.Info("signal detail", "symbol", s.Symbol, "signal", s.Signal, "limit_price", s.LimitPrice, "valid_until", s.ValidUntil)
+		symbolStr := s.Symbol
+		if s.IsExpired(readAt) {
+			a.logger.Info("skipping expired signal", "symbol", symbolStr, "valid_until", s.ValidUntil)
+			continue
+		}
+		recordKey := model.SignalRecordKey(tradingDay, symbolStr, s.Signal.SignalType())
 
-			// リスクベースで注文数量を計算
-			riskPercentage := a.config.StrategySettings.Swingtrade.TradeRiskPercentage
-			unitSize := float64(a.config.StrategySettings.Swingtrade.UnitSize)
-			
-			tradeValue := balance.BuyingPower * riskPercentage
-			quantity := math.Floor(tradeValue/currentPrice/unitSize) * unitSize
+		if _, ok := seen[recordKey]; ok {
+			a.logger.Info("skipping duplicate signal record in file", "symbol", symbolStr, "record_key", recordKey)
+			continue
+		}
+		seen[recordKey] = struct{}{}
 
-			a.logger.Info("calculated order quantity", "symbol", symbolStr, "buying_power", balance.BuyingPower, "risk_percentage", riskPercentage, "current_price", currentPrice, "calculated_quantity", quantity)
+		exists, err := a.signalRepo.ExistsByRecordKey(orderCtx, recordKey)
+		if err != nil {
+			// 重複判定ができない場合は二重発注を避けるため見送る
+			a.logger.Error("failed to check signal record key, skipping", "symbol", symbolStr, "record_key", recordKey, "error", err)
+			continue
+		}
+		if exists {
+			a.logger.Info("skipping signal already consumed today", "symbol", symbolStr, "record_key", recordKey)
+			continue
+		}
 
-			if quantity <= 0 {
-				a.logger.Info("skipping buy signal due to zero calculated quantity", "symbol", symbolStr)
-				continue
-			}
+		// 読み込んだシグナルを永続化し、発注との紐付けに使用する
+		signal := &model.Signal{
+			Symbol:      symbolStr,
+			SignalType:  s.Signal.SignalType(),
+			GeneratedAt: generatedAt,
+			SourceFile:  signalFilePath,
+			FileHash:    fileHash,
+			ReadAt:      readAt,
+			RecordKey:   recordKey,
+			Source:      model.SignalSourceFile,
+			ConsumedAt:  &readAt,
+			Price:       s.LimitPrice,
+			StopPrice:   s.StopPrice,
+			TargetPrice: s.TargetPrice,
+			Weight:      s.Weight,
+		}
+		if !s.ValidUntil.IsZero() {
+			validUntil := s.ValidUntil
+			signal.ExpiresAt = &validUntil
+		}
+		pending = append(pending, signal)
+	}
 
-			// 注文リクエストを作成
-			req := &PlaceOrderRequest{
-				Symbol:    symbolStr,
-				TradeType: model.TradeTypeBuy,
-				OrderType: model.OrderTypeMarket,
-				Quantity:  int(quantity),
-				Price:     0, // 成行注文のため価格は0
-			}
+	// シグナルとファイルの処理済み記録は1つのトランザクションで保存する
+	// 保存に失敗した場合はどちらも残らないため、発注せずに次のティックでファイルごと処理し直す
+	file := &model.SignalFile{
+		FileHash:    fileHash,
+		Path:        signalFilePath,
+		RecordCount: len(signals),
+		ProcessedAt: time.Now(),
+	}
+	if err := a.signalRepo.SaveBatch(orderCtx, pending, file); err != nil {
+		a.logger.Error("failed to save signals of signal file, retrying next tick", "path", signalFilePath, "file_hash", fileHash, "error", err)
+		return
+	}
+	a.processedFiles[fileHash] = struct{}{}
+	a.logger.Info("signal file marked as processed", "path", signalFilePath, "file_hash", fileHash, "record_count", file.RecordCount, "saved", len(pending))
 
-			// 注文を発行
-			order, err := a.tradeService.PlaceOrder(orderCtx, req)
-			if err != nil {
-				a.logger.Error("failed to place buy order", "symbol", symbolStr, "error", err)
-				continue // 次のシグナルへ
-			}
-			a.logger.Info("successfully placed buy order", "symbol", symbolStr, "order_id", order.OrderID)
-			a.state.AddOrder(order) // 発注成功後、内部状態を更新する
-
-		} else if s.Signal == SellSignal {
-			position, ok := a.state.GetPosition(symbolStr)
-			if !ok {
-				a.logger.Info("skipping sell signal for non-held position", "symbol", symbolStr)
-				continue
-			}
-			a.logger.Info("preparing to place sell order", "symbol", symbolStr, "quantity", position.Quantity)
-
-			// 注文リクエストを作成
-			req := &PlaceOrderRequest{
-				Symbol:    symbolStr,
-				TradeType: model.TradeTypeSell,
-				OrderType: model.OrderTypeMarket,
-				Quantity:  position.Quantity, // 保有する全数量を売却
-				Price:     0,                 // 成行注文のため価格は0
-			}
+	for _, signal := range pending {
+		a.processSignal(orderCtx, signal)
+	}
+	a.archiveSignalFile(signalFilePath)
+}
+
+// processPendingSignalsWithTimeout はタイムアウト付きのコンテキストで未処理のシグナルを処理する
+func (a *Agent) processPendingSignalsWithTimeout() {
+	orderCtx, orderCancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer orderCancel()
+	a.processPendingSignals(orderCtx)
+}
+
+// processPendingSignals はHTTP経由で受け付けた未処理のシグナルを処理する
+// 二重発注を避けるため、処理済みの記録に成功したシグナルのみ発注する
+func (a *Agent) processPendingSignals(orderCtx context.Context) {
+	signals, err := a.signalRepo.FindUnconsumed(orderCtx)
+	if err != nil {
+		a.logger.Error("failed to find pending signals", "error", err)
+		return
+	}
+	if len(signals) == 0 {
+		return
+	}
+	a.logger.Info("pending signals loaded", "count", len(signals))
+
+	now := time.Now()
+	for _, signal := range signals {
+		if err := a.signalRepo.MarkConsumed(orderCtx, signal.ID, now); err != nil {
+			a.logger.Error("failed to mark signal as consumed, skipping", "signal_id", signal.ID, "symbol", signal.Symbol, "error", err)
+			continue
+		}
+		consumedAt := now
+		signal.ConsumedAt = &consumedAt
+
+		if signal.IsExpired(now) {
+			a.logger.Info("skipping expired signal", "signal_id", signal.ID, "symbol", signal.Symbol, "valid_until", *signal.ExpiresAt)
+			continue
+		}
+		a.processSignal(orderCtx, signal)
+	}
+}
+
+// processSignal は永続化済みのシグナル1件に対して意思決定を行い、必要であれば発注する
+func (a *Agent) processSignal(orderCtx context.Context, signal *model.Signal) {
+	symbolStr := signal.Symbol
+
+	// 意思決定ロジック
+	if signal.SignalType == model.SignalTypeBuy {
+		if _, ok := a.state.GetPosition(symbolStr); ok {
+			a.logger.Info("skipping buy signal for already held position", "symbol", symbolStr)
+			return
+		}
+		if a.state.HasPendingOrder(symbolStr, model.TradeTypeBuy) {
+			a.logger.Info("skipping buy signal because a buy order is still working", "symbol", symbolStr)
+			return
+		}
+		if a.hasFreshNews(orderCtx, symbolStr) {
+			a.recordEvent(orderCtx, model.TradingEventRiskBlocked, signal, "fresh news within the news block window")
+			return
+		}
+		a.logger.Info("preparing to place buy order", "symbol", symbolStr)
 
-			// 注文を発行
-			order, err := a.tradeService.PlaceOrder(orderCtx, req)
-			if err != nil {
-				a.logger.Error("failed to place sell order", "symbol", symbolStr, "error", err)
-				continue // 次のシグナルへ
+		// 買付余力と現在価格を取得
+		balance := a.state.GetBalance()
+		currentPrice, err := a.tradeService.GetPrice(orderCtx, symbolStr)
+		if err != nil {
+			a.logger.Error("failed to get price for sizing", "symbol", symbolStr, "error", err)
+			return
+		}
+		if currentPrice == 0 {
+			a.logger.Warn("skipping buy signal because current price is zero", "symbol", symbolStr)
+			return
+		}
+
+		// 値幅制限を確認し、発注価格を決定する
+		stock := a.findStockMaster(orderCtx, symbolStr)
+		limitPrice, ok := a.applyPriceLimits(stock, symbolStr, signal.Price, currentPrice)
+		if !ok {
+			a.recordEvent(orderCtx, model.TradingEventRiskBlocked, signal, "blocked by the daily price limits")
+			return
+		}
+
+		// 指値が指定されている場合は指値で数量を計算する
+		sizingPrice := currentPrice
+		if limitPrice > 0 {
+			sizingPrice = limitPrice
+		}
+
+		// リスクベースで注文数量を計算
+		// シグナルの重みが指定されている場合は、発注金額に重みを掛ける
+		riskPercentage := a.config.StrategySettings.Swingtrade.TradeRiskPercentage
+		unitSize := float64(a.tradingUnit(stock, symbolStr))
+		// 上限を検証する前に保存されたシグナルでも、1 を超える重みで発注金額を膨らませない
+		weight := min(signal.Weight, 1)
+		if weight <= 0 {
+			weight = 1
+		}
+
+		buyingPower := balance.SizingBuyingPower()
+		tradeValue := buyingPower * riskPercentage * weight
+		quantity := math.Floor(tradeValue/sizingPrice/unitSize) * unitSize
+
+		a.logger.Info("calculated order quantity", "symbol", symbolStr, "buying_power", buyingPower, "risk_percentage", riskPercentage, "weight", weight, "trading_unit", unitSize, "current_price", currentPrice, "sizing_price", sizingPrice, "calculated_quantity", quantity)
+
+		if quantity <= 0 {
+			a.logger.Info("skipping buy signal due to zero calculated quantity", "symbol", symbolStr)
+			return
+		}
+
+		// 注文リクエストを作成
+		req := newSignalOrderRequest(signal, model.TradeTypeBuy, int(quantity), limitPrice)
+
+		// 注文を発行
+		order, err := a.tradeService.PlaceOrder(orderCtx, req)
+		if err != nil {
+			a.logger.Error("failed to place buy order", "symbol", symbolStr, "error", err)
+			a.recordEvent(orderCtx, model.TradingEventOrderRejected, signal, err.Error())
+			return
+		}
+		a.logger.Info("successfully placed buy order", "symbol", symbolStr, "order_id", order.OrderID, "signal_id", signal.ID)
+		a.state.AddOrder(order) // 発注成功後、内部状態を更新する
+
+	} else if signal.SignalType == model.SignalTypeSell {
+		position, ok := a.state.GetPosition(symbolStr)
+		if !ok {
+			// 保有していない銘柄の売りシグナルは新規売り (空売り) にあたる
+			// 新規売りは未対応のため発注しない。リスク管理で見送った発注ではないため、イベントは記録しない
+			a.logger.Info("skipping sell signal for non-held position", "symbol", symbolStr)
+			return
+		}
+		if a.state.HasPendingOrder(symbolStr, model.TradeTypeSell) {
+			a.logger.Info("skipping sell signal because a sell order is still working", "symbol", symbolStr)
+			return
+		}
+		a.logger.Info("preparing to place sell order", "symbol", symbolStr, "quantity", position.Quantity)
+
+		// 値幅制限を確認し、発注価格を決定する
+		// 現在価格が取得できない場合でも、手仕舞いを妨げないよう価格の確認なしで発注する
+		currentPrice, err := a.tradeService.GetPrice(orderCtx, symbolStr)
+		if err != nil {
+			a.logger.Warn("failed to get price for limit check, placing sell order without it", "symbol", symbolStr, "error", err)
+			currentPrice = 0
+		}
+		stock := a.findStockMaster(orderCtx, symbolStr)
+		limitPrice, ok := a.applyPriceLimits(stock, symbolStr, signal.Price, currentPrice)
+		if !ok {
+			a.recordEvent(orderCtx, model.TradingEventRiskBlocked, signal, "blocked by the daily price limits")
+			return
+		}
+
+		// 注文リクエストを作成 (保有する全数量を売却。売却可能数量を超える分はトレードサービスが抑える)
+		req := newSignalOrderRequest(signal, model.TradeTypeSell, position.Quantity, limitPrice)
+		if stock != nil {
+			req.TradingUnit = stock.TradingUnit
+		}
+
+		// 注文を発行
+		order, err := a.tradeService.PlaceOrder(orderCtx, req)
+		if err != nil {
+			if errors.Is(err, model.ErrNoSellableQuantity) {
+				a.logger.Info("skipping sell signal because there is no sellable quantity", "symbol", symbolStr, "error", err)
+				return
 			}
-			a.logger.Info("successfully placed sell order", "symbol", symbolStr, "order_id", order.OrderID)
-			a.state.AddOrder(order) // 発注成功後、内部状態を更新する
+			a.logger.Error("failed to place sell order", "symbol", symbolStr, "error", err)
+			a.recordEvent(orderCtx, model.TradingEventOrderRejected, signal, err.Error())
+			return
+		}
+		a.logger.Info("successfully placed sell order", "symbol", symbolStr, "order_id", order.OrderID, "signal_id", signal.ID)
+		a.state.AddOrder(order) // 発注成功後、内部状態を更新する
+	}
+}
+
+// applyPriceLimits は銘柄の値幅制限に基づいて発注価格を決定する
+// 指値は値幅制限の範囲内に丸める。成行注文はストップ高・ストップ安では約定が見込めないため見送る
+// 発注を見送る場合は false を返す。銘柄マスタがない場合 (stock が nil) は価格をそのまま返す
+func (a *Agent) applyPriceLimits(stock *model.StockMaster, symbol string, limitPrice, currentPrice float64) (float64, bool) {
+	if stock == nil || !stock.HasPriceLimits() {
+		return limitPrice, true
+	}
+
+	if limitPrice <= 0 {
+		if stock.IsLimitUp(currentPrice) || stock.IsLimitDown(currentPrice) {
+			a.logger.Info("skipping market order because the stock is at its daily price limit", "symbol", symbol, "current_price", currentPrice, "upper_limit", stock.UpperLimit, "lower_limit", stock.LowerLimit)
+			return 0, false
+		}
+		return limitPrice, true
+	}
+
+	clamped, err := stock.ApplyPriceLimits(limitPrice, model.PriceLimitClamp)
+	if err != nil {
+		a.logger.Error("failed to apply price limits", "symbol", symbol, "price", limitPrice, "error", err)
+		return 0, false
+	}
+	if clamped != limitPrice {
+		a.logger.Info("clamped limit price to the daily price limits", "symbol", symbol, "price", limitPrice, "clamped_price", clamped, "upper_limit", stock.UpperLimit, "lower_limit", stock.LowerLimit)
+	}
+	return clamped, true
+}
+
+// tradingUnit は銘柄の売買単位を返す
+// 銘柄マスタがない、または売買単位が設定されていない場合は設定ファイルの unit_size を使用する
+func (a *Agent) tradingUnit(stock *model.StockMaster, symbol string) int {
+	if stock != nil && stock.TradingUnit > 0 {
+		return stock.TradingUnit
+	}
+	a.logger.Warn("trading unit is unknown, falling back to configured unit size", "symbol", symbol, "unit_size", a.config.StrategySettings.Swingtrade.UnitSize)
+	return a.config.StrategySettings.Swingtrade.UnitSize
+}
+
+// hasFreshNews は news_block_window 以内に銘柄の関連ニュースがあるかどうかを返す
+// news_block_categories が指定されている場合は、そのカテゴリのニュースのみを対象とする
+// ニュースを取得できない場合は、新規買いを妨げないよう false を返す
+func (a *Agent) hasFreshNews(ctx context.Context, symbol string) bool {
+	settings := a.config.StrategySettings.Swingtrade
+	if a.newsRepo == nil || settings.NewsBlockWindow <= 0 {
+		return false
+	}
+	news, err := a.newsRepo.FindNews(ctx, repository.NewsQuery{
+		Symbol: symbol,
+		Since:  time.Now().Add(-settings.NewsBlockWindow),
+	})
+	if err != nil {
+		a.logger.Warn("failed to find news, skipping news check", "symbol", symbol, "error", err)
+		return false
+	}
+	for _, n := range news {
+		if n.HasAnyCategory(settings.NewsBlockCategories) {
+			a.logger.Info("skipping buy signal because of fresh news", "symbol", symbol, "news_id", n.ID, "published_at", n.PublishedAt, "headline", n.Headline)
+			return true
 		}
 	}
+	return false
+}
+
+// recordEvent は日次レポートのために取引イベントを記録する。記録に失敗してもログに出力して続行する
+func (a *Agent) recordEvent(ctx context.Context, eventType model.TradingEventType, signal *model.Signal, message string) {
+	if a.eventRepo == nil {
+		return
+	}
+	signalID := signal.ID
+	event := &model.TradingEvent{
+		OccurredAt: time.Now(),
+		Type:       eventType,
+		Symbol:     signal.Symbol,
+		SignalID:   &signalID,
+		Message:    message,
+	}
+	if err := a.eventRepo.Save(ctx, event); err != nil {
+		a.logger.Warn("failed to record trading event", "type", eventType, "symbol", signal.Symbol, "signal_id", signal.ID, "error", err)
+	}
+}
+
+// findStockMaster は銘柄マスタを取得する。取得できない場合は nil を返す
+func (a *Agent) findStockMaster(ctx context.Context, symbol string) *model.StockMaster {
+	if a.masterRepo == nil {
+		return nil
+	}
+	entity, err := a.masterRepo.FindByIssueCode(ctx, symbol, "StockMaster")
+	if err != nil {
+		a.logger.Warn("failed to find stock master", "symbol", symbol, "error", err)
+		return nil
+	}
+	stock, _ := entity.(*model.StockMaster)
+	return stock
+}
+
+// newSignalOrderRequest はシグナルから注文リクエストを作成する
+// limitPrice が指定されている場合は指値注文、そうでなければ成行注文とする
+func newSignalOrderRequest(signal *model.Signal, tradeType model.TradeType, quantity int, limitPrice float64) *PlaceOrderRequest {
+	req := &PlaceOrderRequest{
+		Symbol:    signal.Symbol,
+		TradeType: tradeType,
+		OrderType: model.OrderTypeMarket,
+		Quantity:  quantity,
+		Price:     0, // 成行注文のため価格は0
+		SignalID:  &signal.ID,
+	}
+	if limitPrice > 0 {
+		req.OrderType = model.OrderTypeLimit
+		req.Price = limitPrice
+	}
+	return req
+}
+
+// isSignalFileProcessed は指定したハッシュのシグナルファイルが処理済みかどうかを返す
+// DBの確認に失敗した場合は、二重発注を避けるため処理済みとみなす
+func (a *Agent) isSignalFileProcessed(ctx context.Context, fileHash string) bool {
+	if _, ok := a.processedFiles[fileHash]; ok {
+		return true
+	}
+	processed, err := a.signalRepo.IsFileProcessed(ctx, fileHash)
+	if err != nil {
+		a.logger.Error("failed to check whether signal file is processed", "file_hash", fileHash, "error", err)
+		return true
+	}
+	if processed {
+		a.processedFiles[fileHash] = struct{}{}
+	}
+	return processed
+}
+
+// archiveSignalFile は設定が有効な場合、処理済みのシグナルファイルをアーカイブディレクトリへ移動する
+func (a *Agent) archiveSignalFile(path string) {
+	settings := a.config.StrategySettings.Swingtrade
+	if !settings.ArchiveProcessed {
+		return
+	}
+	dest, err := ArchiveSignalFile(path, settings.ProcessedDir)
+	if err != nil {
+		a.logger.Error("failed to archive signal file", "path", path, "error", err)
+		return
+	}
+	a.logger.Info("signal file archived", "path", path, "archived_to", dest)
+}
+
+// location は設定されたタイムゾーンを返す。読み込めない場合はローカルタイムゾーンを使用する
+func (a *Agent) location() *time.Location {
+	loc, err := time.LoadLocation(a.config.Agent.Timezone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
 }
 
 // FindSignalFile は指定されたパターンに一致するシグナルファイルを探し、最も新しい更新日時を持つファイルを返す
@@ -1,8 +1,13 @@
 package agent
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"stock-bot/domain/model"
+	"strings"
 	"testing"
 	"time"
 )
@@ -88,3 +93,610 @@ func TestFindSignalFile(t *testing.T) {
 		// For now, rely on previous 'no signal files' test for this case.
 	})
 }
+
+// writeV1SignalFile はテスト用に v1 フォーマットのシグナルファイルを書き出す
+func writeV1SignalFile(t *testing.T, path string, records []SignalRecord) {
+	t.Helper()
+	ptrs := make([]*SignalRecord, 0, len(records))
+	for i := range records {
+		ptrs = append(ptrs, &records[i])
+	}
+	if err := WriteSignalFile(path, SignalFormatV1, time.Time{}, ptrs); err != nil {
+		t.Fatalf("failed to write signal file: %v", err)
+	}
+}
+
+func TestAgentTick_PersistsSignalsAndLinksOrders(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "signal.bin")
+	writeV1SignalFile(t, path, []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal},
+		{Symbol: "9984", Signal: SellSignal}, // 未保有のため発注されない
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1000
+	signalRepo := newFakeSignalRepository()
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, signalRepo)
+	a.state.UpdateBalance(testBalance(1000000))
+
+	a.tick()
+
+	expectedHash, err := HashSignalFile(path)
+	if err != nil {
+		t.Fatalf("failed to hash signal file: %v", err)
+	}
+
+	saved, _ := signalRepo.FindByFileHash(context.Background(), expectedHash)
+	if len(saved) != 2 {
+		t.Fatalf("expected 2 saved signals, got %d", len(saved))
+	}
+	for _, s := range saved {
+		if s.SourceFile != path {
+			t.Errorf("expected source file %s, got %s", path, s.SourceFile)
+		}
+		if s.ReadAt.IsZero() {
+			t.Errorf("expected ReadAt to be set for signal %d", s.ID)
+		}
+	}
+	if saved[0].Symbol != "7203" || saved[0].SignalType != model.SignalTypeBuy {
+		t.Errorf("unexpected first signal: %+v", saved[0])
+	}
+
+	requests := tradeService.placedRequests()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 order, got %d", len(requests))
+	}
+	if requests[0].SignalID == nil || *requests[0].SignalID != saved[0].ID {
+		t.Errorf("expected order to be linked to signal %d, got %v", saved[0].ID, requests[0].SignalID)
+	}
+
+	processed, _ := signalRepo.IsFileProcessed(context.Background(), expectedHash)
+	if !processed {
+		t.Errorf("expected signal file to be marked processed")
+	}
+}
+
+func TestAgentTick_SkipsProcessedSignalFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal.bin"), []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal},
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1000
+	signalRepo := newFakeSignalRepository()
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, signalRepo)
+	a.state.UpdateBalance(testBalance(1000000))
+
+	a.tick()
+	a.tick()
+
+	// 新しいエージェント (再起動相当) でもDBの記録により再処理されないこと
+	restarted := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, signalRepo)
+	restarted.state.UpdateBalance(testBalance(1000000))
+	restarted.tick()
+
+	if got := len(tradeService.placedRequests()); got != 1 {
+		t.Errorf("expected exactly 1 order across ticks, got %d", got)
+	}
+	if got := len(signalRepo.signals); got != 1 {
+		t.Errorf("expected signals to be saved once, got %d", got)
+	}
+}
+
+func TestAgentTick_SignalSaveFailureRetriesWholeFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal.bin"), []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal},
+		{Symbol: "6758", Signal: BuySignal},
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1000
+	tradeService.prices["6758"] = 1000
+	signalRepo := newFakeSignalRepository()
+	signalRepo.saveBatchErr = errors.New("db down")
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, signalRepo)
+	a.state.UpdateBalance(testBalance(10000000))
+
+	a.tick()
+
+	// シグナルもファイルの処理済み記録も残らず、発注もしない
+	if got := len(tradeService.placedRequests()); got != 0 {
+		t.Fatalf("expected no orders while signals cannot be saved, got %d", got)
+	}
+	if got := len(signalRepo.files); got != 0 {
+		t.Fatalf("expected signal file not to be marked processed, got %d", got)
+	}
+
+	// 保存できるようになれば、次のティックでファイル全体を処理する
+	signalRepo.saveBatchErr = nil
+	a.tick()
+
+	if got := len(signalRepo.signals); got != 2 {
+		t.Errorf("expected 2 saved signals, got %d", got)
+	}
+	if got := len(signalRepo.files); got != 1 {
+		t.Errorf("expected signal file to be marked processed, got %d", got)
+	}
+	if got := len(tradeService.placedRequests()); got != 2 {
+		t.Errorf("expected 2 orders, got %d", got)
+	}
+}
+
+func TestAgentTick_DeduplicatesRecordsAcrossFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal_1.bin"), []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal},
+		{Symbol: "7203", Signal: BuySignal}, // 同一ファイル内の重複
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1000
+	tradeService.prices["6758"] = 2000
+	signalRepo := newFakeSignalRepository()
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, signalRepo)
+	a.state.UpdateBalance(testBalance(1000000))
+
+	a.tick()
+
+	// シグナルメーカーが同じ日にレコードを追加してファイルを再生成したケース
+	// 注文が約定済みで発注中注文がない状態でも、同じ日の同じシグナルでは再発注しない
+	tradeService.setOrderStatus("order-1", model.OrderStatusFilled)
+	newer := filepath.Join(tmpDir, "signal_2.bin")
+	writeV1SignalFile(t, newer, []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal},
+		{Symbol: "6758", Signal: BuySignal},
+	})
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(newer, future, future); err != nil {
+		t.Fatalf("failed to set mod time: %v", err)
+	}
+
+	a.tick()
+
+	requests := tradeService.placedRequests()
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 orders, got %d", len(requests))
+	}
+	if requests[0].Symbol != "7203" || requests[1].Symbol != "6758" {
+		t.Errorf("unexpected order symbols: %s, %s", requests[0].Symbol, requests[1].Symbol)
+	}
+}
+
+func TestAgentTick_SkipsSymbolWithPendingOrder(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal.bin"), []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal},
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1000
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, newFakeSignalRepository())
+	a.state.UpdateBalance(testBalance(1000000))
+	tradeService.addOrder(&model.Order{OrderID: "working-1", Symbol: "7203", TradeType: model.TradeTypeBuy, OrderStatus: model.OrderStatusNew})
+
+	a.tick()
+
+	if got := len(tradeService.placedRequests()); got != 0 {
+		t.Errorf("expected no order while a buy order is working, got %d", got)
+	}
+}
+
+func TestAgentTick_FilledOrderNoLongerBlocksSymbol(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal.bin"), []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal},
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1000
+	signalRepo := newFakeSignalRepository()
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, signalRepo)
+	a.state.UpdateBalance(testBalance(1000000))
+
+	a.tick()
+	if got := len(tradeService.placedRequests()); got != 1 {
+		t.Fatalf("expected 1 order, got %d", got)
+	}
+
+	// HTTP経由で同じ銘柄の買いシグナルが届く
+	pushSignal := func() {
+		t.Helper()
+		if err := signalRepo.Save(context.Background(), &model.Signal{Symbol: "7203", SignalType: model.SignalTypeBuy, GeneratedAt: time.Now(), Source: model.SignalSourceHTTP}); err != nil {
+			t.Fatalf("failed to save pushed signal: %v", err)
+		}
+	}
+
+	// 最初の注文が発注中の間は発注しない
+	pushSignal()
+	a.tick()
+	if got := len(tradeService.placedRequests()); got != 1 {
+		t.Fatalf("expected no order while the first order is working, got %d total", got)
+	}
+
+	// 最初の注文が約定すると、次のシグナルで発注する
+	tradeService.setOrderStatus("order-1", model.OrderStatusFilled)
+	pushSignal()
+	a.tick()
+	requests := tradeService.placedRequests()
+	if len(requests) != 2 {
+		t.Fatalf("expected a second order after the first one filled, got %d total", len(requests))
+	}
+	if requests[1].Symbol != "7203" || requests[1].TradeType != model.TradeTypeBuy {
+		t.Errorf("unexpected second order: %+v", requests[1])
+	}
+}
+
+func TestAgentTick_ArchivesProcessedFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "signal.bin")
+	writeV1SignalFile(t, path, []SignalRecord{
+		{Symbol: "7203", Signal: SellSignal},
+	})
+
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), newFakeTradeService(), newFakeSignalRepository())
+	a.config.StrategySettings.Swingtrade.ArchiveProcessed = true
+
+	a.tick()
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected signal file to be moved, stat error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, DefaultProcessedDirName, "signal.bin")); err != nil {
+		t.Errorf("expected archived signal file: %v", err)
+	}
+}
+
+func TestArchiveSignalFile_AvoidsOverwrite(t *testing.T) {
+	tmpDir := t.TempDir()
+	processedDir := filepath.Join(tmpDir, "archive")
+
+	for i := 0; i < 2; i++ {
+		path := filepath.Join(tmpDir, "signal.bin")
+		if err := os.WriteFile(path, []byte{byte(i)}, 0644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		if _, err := ArchiveSignalFile(path, processedDir); err != nil {
+			t.Fatalf("failed to archive: %v", err)
+		}
+	}
+
+	entries, err := os.ReadDir(processedDir)
+	if err != nil {
+		t.Fatalf("failed to read processed dir: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("expected 2 archived files, got %d", len(entries))
+	}
+}
+
+func TestAgentTick_V2SignalsUseLimitPriceAndExpiry(t *testing.T) {
+	tmpDir := t.TempDir()
+	now := time.Now()
+	err := WriteSignalFile(filepath.Join(tmpDir, "signal.bin"), SignalFormatV2, now.Add(-time.Hour), []*SignalRecord{
+		{Symbol: "7203", Signal: BuySignal, LimitPrice: 990, Weight: 0.5, ValidUntil: now.Add(time.Hour)},
+		{Symbol: "6758", Signal: BuySignal, ValidUntil: now.Add(-time.Minute)}, // 期限切れ
+	})
+	if err != nil {
+		t.Fatalf("failed to write signal file: %v", err)
+	}
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1000
+	tradeService.prices["6758"] = 2000
+	signalRepo := newFakeSignalRepository()
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, signalRepo)
+	a.state.UpdateBalance(testBalance(1000000))
+
+	a.tick()
+
+	requests := tradeService.placedRequests()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 order, got %d", len(requests))
+	}
+	req := requests[0]
+	if req.OrderType != model.OrderTypeLimit || req.Price != 990 {
+		t.Errorf("expected limit order at 990, got %s at %v", req.OrderType, req.Price)
+	}
+	// 1,000,000 * 0.25 * 0.5 / 990 = 126.2... → 100株
+	if req.Quantity != 100 {
+		t.Errorf("expected quantity 100, got %d", req.Quantity)
+	}
+
+	if len(signalRepo.signals) != 1 || signalRepo.signals[0].ExpiresAt == nil {
+		t.Errorf("expected only the valid signal to be saved with its expiry")
+	}
+}
+
+func TestAgent_ProcessesPushedSignals(t *testing.T) {
+	tmpDir := t.TempDir()
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 2500
+	signalRepo := newFakeSignalRepository()
+
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, signalRepo)
+	a.state.UpdateBalance(testBalance(1000000))
+
+	// HTTP経由で受け付けたシグナル (未処理)
+	tradingDay := time.Now().In(a.location()).Format("20060102")
+	pushed := &model.Signal{
+		Symbol:      "7203",
+		SignalType:  model.SignalTypeBuy,
+		GeneratedAt: time.Now(),
+		RecordKey:   model.SignalRecordKey(tradingDay, "7203", model.SignalTypeBuy),
+		Source:      model.SignalSourceHTTP,
+	}
+	if err := signalRepo.Save(context.Background(), pushed); err != nil {
+		t.Fatalf("failed to save pushed signal: %v", err)
+	}
+
+	// 通知は合流され、チャネルには一つだけ積まれる
+	a.NotifySignals()
+	a.NotifySignals()
+	if len(a.wakeCh) != 1 {
+		t.Fatalf("expected one pending wake-up, got %d", len(a.wakeCh))
+	}
+	<-a.wakeCh
+	a.processPendingSignalsWithTimeout()
+
+	requests := tradeService.placedRequests()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 order, got %d", len(requests))
+	}
+	if requests[0].SignalID == nil || *requests[0].SignalID != pushed.ID {
+		t.Errorf("expected order to be linked to signal %d", pushed.ID)
+	}
+	if pushed.ConsumedAt == nil {
+		t.Error("expected pushed signal to be marked as consumed")
+	}
+
+	// 同じ日に同じシグナルがファイルで届いても再発注しない
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal.bin"), []SignalRecord{{Symbol: "7203", Signal: BuySignal}})
+	a.tick()
+	if got := len(tradeService.placedRequests()); got != 1 {
+		t.Errorf("expected no additional orders, got %d total", got)
+	}
+}
+
+func TestAgent_ClampsStoredWeightAboveOne(t *testing.T) {
+	tmpDir := t.TempDir()
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 2500
+	signalRepo := newFakeSignalRepository()
+
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, signalRepo)
+	a.state.UpdateBalance(testBalance(1000000))
+
+	// 重みの上限を検証する前に保存されたシグナル
+	tradingDay := time.Now().In(a.location()).Format("20060102")
+	if err := signalRepo.Save(context.Background(), &model.Signal{
+		Symbol:      "7203",
+		SignalType:  model.SignalTypeBuy,
+		GeneratedAt: time.Now(),
+		RecordKey:   model.SignalRecordKey(tradingDay, "7203", model.SignalTypeBuy),
+		Source:      model.SignalSourceHTTP,
+		Weight:      50,
+	}); err != nil {
+		t.Fatalf("failed to save pushed signal: %v", err)
+	}
+
+	a.processPendingSignalsWithTimeout()
+
+	requests := tradeService.placedRequests()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 order, got %d", len(requests))
+	}
+	// 1,000,000 * 0.25 * 1 / 2500 = 100株 (重み 50 のままなら 5000株)
+	if requests[0].Quantity != 100 {
+		t.Errorf("expected quantity 100, got %d", requests[0].Quantity)
+	}
+}
+
+func TestAgentTick_SkipsMarketOrderAtPriceLimit(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal.bin"), []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal}, // ストップ高
+		{Symbol: "6758", Signal: BuySignal}, // 値幅の範囲内
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1300
+	tradeService.prices["6758"] = 2000
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, newFakeSignalRepository())
+	a.masterRepo = newFakeMasterRepository(
+		&model.StockMaster{IssueCode: "7203", UpperLimit: 1300, LowerLimit: 700},
+		&model.StockMaster{IssueCode: "6758", UpperLimit: 2500, LowerLimit: 1500},
+	)
+	a.state.UpdateBalance(testBalance(1000000))
+
+	a.tick()
+
+	requests := tradeService.placedRequests()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 order, got %d", len(requests))
+	}
+	if requests[0].Symbol != "6758" || requests[0].OrderType != model.OrderTypeMarket {
+		t.Errorf("expected market order for 6758, got %s %s", requests[0].Symbol, requests[0].OrderType)
+	}
+}
+
+func TestAgentTick_RecordsRejectedOrdersAndRiskBlocks(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal.bin"), []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal}, // ストップ高
+		{Symbol: "6758", Signal: BuySignal}, // 証券会社が拒否
+		{Symbol: "9984", Signal: BuySignal}, // 発注できる
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1300
+	tradeService.prices["6758"] = 2000
+	tradeService.prices["9984"] = 1000
+	tradeService.rejects["6758"] = fmt.Errorf("order failed with result code 11104: insufficient buying power")
+	signalRepo := newFakeSignalRepository()
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, signalRepo)
+	a.masterRepo = newFakeMasterRepository(&model.StockMaster{IssueCode: "7203", UpperLimit: 1300, LowerLimit: 700})
+	events := &fakeTradingEventRepository{}
+	a.eventRepo = events
+	a.state.UpdateBalance(testBalance(1000000))
+
+	a.tick()
+
+	if len(events.events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events.events))
+	}
+	blocked, rejected := events.events[0], events.events[1]
+	if blocked.Type != model.TradingEventRiskBlocked || blocked.Symbol != "7203" {
+		t.Errorf("expected a risk block for 7203, got %s %s", blocked.Type, blocked.Symbol)
+	}
+	if rejected.Type != model.TradingEventOrderRejected || rejected.Symbol != "6758" || !strings.Contains(rejected.Message, "11104") {
+		t.Errorf("expected a rejection for 6758 with the broker message, got %s %s %q", rejected.Type, rejected.Symbol, rejected.Message)
+	}
+	saved, _ := signalRepo.FindBySymbol(context.Background(), "6758")
+	if len(saved) != 1 || rejected.SignalID == nil || *rejected.SignalID != saved[0].ID {
+		t.Errorf("expected the rejection to be linked to the signal of 6758")
+	}
+}
+
+func TestAgentTick_ClampsLimitPriceToPriceLimits(t *testing.T) {
+	tmpDir := t.TempDir()
+	err := WriteSignalFile(filepath.Join(tmpDir, "signal.bin"), SignalFormatV2, time.Now(), []*SignalRecord{
+		{Symbol: "7203", Signal: BuySignal, LimitPrice: 1500},
+	})
+	if err != nil {
+		t.Fatalf("failed to write signal file: %v", err)
+	}
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1200
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, newFakeSignalRepository())
+	a.masterRepo = newFakeMasterRepository(&model.StockMaster{IssueCode: "7203", UpperLimit: 1300, LowerLimit: 700})
+	a.state.UpdateBalance(testBalance(1000000))
+
+	a.tick()
+
+	requests := tradeService.placedRequests()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 order, got %d", len(requests))
+	}
+	if requests[0].OrderType != model.OrderTypeLimit || requests[0].Price != 1300 {
+		t.Errorf("expected limit order clamped to 1300, got %s at %v", requests[0].OrderType, requests[0].Price)
+	}
+	// 1,000,000 * 0.25 / 1300 = 192.3... → 100株
+	if requests[0].Quantity != 100 {
+		t.Errorf("expected quantity 100, got %d", requests[0].Quantity)
+	}
+}
+
+func TestAgentTick_SizesByStockTradingUnit(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal.bin"), []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal}, // 売買単位 1株
+		{Symbol: "6758", Signal: BuySignal}, // 銘柄マスタなし (設定の unit_size を使用)
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1000
+	tradeService.prices["6758"] = 1000
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, newFakeSignalRepository())
+	a.masterRepo = newFakeMasterRepository(&model.StockMaster{IssueCode: "7203", TradingUnit: 1})
+	a.state.UpdateBalance(testBalance(1000000))
+
+	a.tick()
+
+	// 1,000,000 * 0.25 / 1000 = 250株
+	quantities := make(map[string]int)
+	for _, req := range tradeService.placedRequests() {
+		quantities[req.Symbol] = req.Quantity
+	}
+	if quantities["7203"] != 250 {
+		t.Errorf("expected 250 shares for 7203 with trading unit 1, got %d", quantities["7203"])
+	}
+	if quantities["6758"] != 200 {
+		t.Errorf("expected 200 shares for 6758 with configured unit size, got %d", quantities["6758"])
+	}
+}
+
+func TestAgentTick_SkipsBuyOnFreshNews(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal.bin"), []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal}, // 直近に適時開示あり
+		{Symbol: "6758", Signal: BuySignal}, // 対象外カテゴリのニュースのみ
+		{Symbol: "9984", Signal: BuySignal}, // 古いニュースのみ
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1000
+	tradeService.prices["6758"] = 1000
+	tradeService.prices["9984"] = 1000
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, newFakeSignalRepository())
+	a.config.StrategySettings.Swingtrade.NewsBlockWindow = time.Hour
+	a.config.StrategySettings.Swingtrade.NewsBlockCategories = []string{"TDNET"}
+	now := time.Now()
+	a.newsRepo = newFakeNewsRepository(
+		&model.News{ID: "N1", PublishedAt: now.Add(-10 * time.Minute), Categories: []string{"TDNET"}, Symbols: []string{"7203"}},
+		&model.News{ID: "N2", PublishedAt: now.Add(-10 * time.Minute), Categories: []string{"MARKET"}, Symbols: []string{"6758"}},
+		&model.News{ID: "N3", PublishedAt: now.Add(-2 * time.Hour), Categories: []string{"TDNET"}, Symbols: []string{"9984"}},
+	)
+	a.state.UpdateBalance(testBalance(1000000))
+
+	a.tick()
+
+	symbols := make(map[string]bool)
+	for _, req := range tradeService.placedRequests() {
+		symbols[req.Symbol] = true
+	}
+	if symbols["7203"] {
+		t.Errorf("expected buy for 7203 to be skipped because of fresh news")
+	}
+	if !symbols["6758"] || !symbols["9984"] {
+		t.Errorf("expected buys for 6758 and 9984, got %v", symbols)
+	}
+}
+
+func TestAgentTick_NewsLookupFailureDoesNotBlockBuy(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal.bin"), []SignalRecord{
+		{Symbol: "7203", Signal: BuySignal},
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1000
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, newFakeSignalRepository())
+	a.config.StrategySettings.Swingtrade.NewsBlockWindow = time.Hour
+	newsRepo := newFakeNewsRepository()
+	newsRepo.err = errors.New("db error")
+	a.newsRepo = newsRepo
+	a.state.UpdateBalance(testBalance(1000000))
+
+	a.tick()
+
+	if len(tradeService.placedRequests()) != 1 {
+		t.Errorf("expected buy order despite news lookup failure, got %d orders", len(tradeService.placedRequests()))
+	}
+}
+
+func TestAgentTick_NonHeldSellIsNotRecordedAsRiskEvent(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeV1SignalFile(t, filepath.Join(tmpDir, "signal.bin"), []SignalRecord{
+		{Symbol: "7203", Signal: SellSignal}, // 未保有 (新規売り)
+	})
+
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 1000
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, newFakeSignalRepository())
+	events := &fakeTradingEventRepository{}
+	a.eventRepo = events
+
+	a.tick()
+
+	// 新規売りはそもそも発注しないため、リスクイベントとして数えない
+	if len(events.events) != 0 {
+		t.Errorf("expected no trading events, got %d", len(events.events))
+	}
+	if got := len(tradeService.placedRequests()); got != 0 {
+		t.Errorf("expected no orders, got %d", got)
+	}
+}
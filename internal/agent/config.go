@@ -24,6 +24,13 @@ type AgentConfig struct {
 			ProfitTakeRate    float64   `yaml:"profit_take_rate"`
 			StopLossRate      float64   `yaml:"stop_loss_rate"`
 			SignalFilePattern string    `yaml:"signal_file_pattern"` // シグナルファイルのパターンを追加
+			ArchiveProcessed  bool      `yaml:"archive_processed"`   // 処理済みシグナルファイルをアーカイブするか
+			ProcessedDir      string    `yaml:"processed_dir"`       // アーカイブ先ディレクトリ (空の場合はシグナルファイルと同じ階層の processed/)
+			SignalWatchMode   SignalWatchMode `yaml:"signal_watch_mode"`   // シグナルファイルの検知方式 (auto, inotify, poll, off)
+			SignalStableDuration time.Duration `yaml:"signal_stable_duration"` // サイズと更新日時がこの時間変化しなければ書き込み完了とみなす
+			SignalPollInterval time.Duration `yaml:"signal_poll_interval"` // ポーリング方式での走査間隔
+			NewsBlockWindow time.Duration `yaml:"news_block_window"` // この時間以内に関連ニュースが出た銘柄への新規買いを見送る (0 の場合は見送らない)
+			NewsBlockCategories []string `yaml:"news_block_categories"` // 見送りの対象とするニュースカテゴリ (空の場合は全カテゴリ)
 		} `yaml:"swingtrade"`
 		Daytrade struct {
 			// デイトレード戦略用の設定
@@ -61,6 +68,15 @@ func LoadAgentConfig(configPath string) (*AgentConfig, error) {
 		// デフォルトのシグナルファイルパターン
 		cfg.StrategySettings.Swingtrade.SignalFilePattern = "./signals/*.bin"
 	}
+	if cfg.StrategySettings.Swingtrade.SignalWatchMode == "" {
+		cfg.StrategySettings.Swingtrade.SignalWatchMode = SignalWatchAuto
+	}
+	if cfg.StrategySettings.Swingtrade.SignalStableDuration == 0 {
+		cfg.StrategySettings.Swingtrade.SignalStableDuration = DefaultSignalStableDuration
+	}
+	if cfg.StrategySettings.Swingtrade.SignalPollInterval == 0 {
+		cfg.StrategySettings.Swingtrade.SignalPollInterval = DefaultSignalPollInterval
+	}
 	if cfg.StrategySettings.Swingtrade.TradeRiskPercentage == 0 {
 		cfg.StrategySettings.Swingtrade.TradeRiskPercentage = 0.25 // デフォルトは25%
 	}
@@ -0,0 +1,457 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"sync"
+	"time"
+)
+
+// fakeTradeService はテスト用の TradeService 実装
+type fakeTradeService struct {
+	mu        sync.Mutex
+	prices    map[string]float64
+	requests  []*PlaceOrderRequest
+	nextOrder int
+	// rejects は銘柄ごとに PlaceOrder が返すエラー
+	rejects map[string]error
+	// orders は発注した注文 (GetOrders は完了していない注文を返す)
+	orders []*model.Order
+}
+
+func newFakeTradeService() *fakeTradeService {
+	return &fakeTradeService{prices: make(map[string]float64), rejects: make(map[string]error)}
+}
+
+// addOrder はトレードサービス側に注文を登録する
+func (f *fakeTradeService) addOrder(order *model.Order) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.orders = append(f.orders, order)
+}
+
+// setOrderStatus は約定照会などで注文の状態が更新されたことを模擬する
+func (f *fakeTradeService) setOrderStatus(orderID string, status model.OrderStatus) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, o := range f.orders {
+		if o.OrderID == orderID {
+			o.OrderStatus = status
+		}
+	}
+}
+
+func (f *fakeTradeService) GetPositions(ctx context.Context) ([]*model.Position, error) {
+	return []*model.Position{}, nil
+}
+
+func (f *fakeTradeService) GetOrders(ctx context.Context) ([]*model.Order, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	orders := []*model.Order{}
+	for _, o := range f.orders {
+		if !o.OrderStatus.IsTerminal() {
+			copied := *o
+			orders = append(orders, &copied)
+		}
+	}
+	return orders, nil
+}
+
+func (f *fakeTradeService) GetBalance(ctx context.Context) (*Balance, error) {
+	return &Balance{}, nil
+}
+
+func (f *fakeTradeService) GetPrice(ctx context.Context, symbol string) (float64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	price, ok := f.prices[symbol]
+	if !ok {
+		return 0, fmt.Errorf("no price for %s", symbol)
+	}
+	return price, nil
+}
+
+func (f *fakeTradeService) PlaceOrder(ctx context.Context, req *PlaceOrderRequest) (*model.Order, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, req)
+	if err, ok := f.rejects[req.Symbol]; ok {
+		return nil, err
+	}
+	f.nextOrder++
+	order := &model.Order{
+		OrderID:     fmt.Sprintf("order-%d", f.nextOrder),
+		Symbol:      req.Symbol,
+		TradeType:   req.TradeType,
+		OrderType:   req.OrderType,
+		Quantity:    req.Quantity,
+		Price:       req.Price,
+		OrderStatus: model.OrderStatusNew,
+		SignalID:    req.SignalID,
+	}
+	stored := *order
+	f.orders = append(f.orders, &stored)
+	return order, nil
+}
+
+func (f *fakeTradeService) CancelOrder(ctx context.Context, orderID string) error {
+	return nil
+}
+
+func (f *fakeTradeService) placedRequests() []*PlaceOrderRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*PlaceOrderRequest(nil), f.requests...)
+}
+
+// fakeSignalRepository はテスト用のインメモリ SignalRepository 実装
+type fakeSignalRepository struct {
+	mu      sync.Mutex
+	signals []*model.Signal
+	files   map[string]*model.SignalFile
+
+	saveBatchErr error // SaveBatch が返すエラー (何も保存しない)
+}
+
+func newFakeSignalRepository() *fakeSignalRepository {
+	return &fakeSignalRepository{files: make(map[string]*model.SignalFile)}
+}
+
+func (r *fakeSignalRepository) Save(ctx context.Context, signal *model.Signal) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	signal.ID = uint(len(r.signals) + 1)
+	r.signals = append(r.signals, signal)
+	return nil
+}
+
+func (r *fakeSignalRepository) SaveBatch(ctx context.Context, signals []*model.Signal, file *model.SignalFile) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.saveBatchErr != nil {
+		return r.saveBatchErr
+	}
+	for _, signal := range signals {
+		signal.ID = uint(len(r.signals) + 1)
+		r.signals = append(r.signals, signal)
+	}
+	if file != nil {
+		if _, ok := r.files[file.FileHash]; !ok {
+			r.files[file.FileHash] = file
+		}
+	}
+	return nil
+}
+
+func (r *fakeSignalRepository) FindByID(ctx context.Context, id uint) (*model.Signal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.signals {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeSignalRepository) FindBySymbol(ctx context.Context, symbol string) ([]*model.Signal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var found []*model.Signal
+	for _, s := range r.signals {
+		if s.Symbol == symbol {
+			found = append(found, s)
+		}
+	}
+	return found, nil
+}
+
+func (r *fakeSignalRepository) FindByFileHash(ctx context.Context, fileHash string) ([]*model.Signal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var found []*model.Signal
+	for _, s := range r.signals {
+		if s.FileHash == fileHash {
+			found = append(found, s)
+		}
+	}
+	return found, nil
+}
+
+func (r *fakeSignalRepository) IsFileProcessed(ctx context.Context, fileHash string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.files[fileHash]
+	return ok, nil
+}
+
+func (r *fakeSignalRepository) ExistsByRecordKey(ctx context.Context, recordKey string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.signals {
+		if s.RecordKey == recordKey {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeSignalRepository) FindRecent(ctx context.Context, symbol string, limit int) ([]*model.Signal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var found []*model.Signal
+	for i := len(r.signals) - 1; i >= 0; i-- {
+		s := r.signals[i]
+		if symbol != "" && s.Symbol != symbol {
+			continue
+		}
+		found = append(found, s)
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].GeneratedAt.After(found[j].GeneratedAt) })
+	if limit > 0 && len(found) > limit {
+		found = found[:limit]
+	}
+	return found, nil
+}
+
+func (r *fakeSignalRepository) FindUnconsumed(ctx context.Context) ([]*model.Signal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var found []*model.Signal
+	for _, s := range r.signals {
+		if s.ConsumedAt == nil {
+			found = append(found, s)
+		}
+	}
+	return found, nil
+}
+
+func (r *fakeSignalRepository) MarkConsumed(ctx context.Context, id uint, consumedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.signals {
+		if s.ID == id && s.ConsumedAt == nil {
+			s.ConsumedAt = &consumedAt
+			return nil
+		}
+	}
+	return fmt.Errorf("signal %d not found or already consumed", id)
+}
+
+func (r *fakeSignalRepository) FindConsumed(ctx context.Context, since, until time.Time) ([]*model.Signal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []*model.Signal
+	for _, s := range r.signals {
+		if s.ConsumedAt != nil && !s.ConsumedAt.Before(since) && s.ConsumedAt.Before(until) {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+// fakeMasterRepository はテスト用のインメモリ MasterRepository 実装
+type fakeMasterRepository struct {
+	mu     sync.Mutex
+	stocks map[string]*model.StockMaster
+}
+
+func newFakeMasterRepository(stocks ...*model.StockMaster) *fakeMasterRepository {
+	f := &fakeMasterRepository{stocks: make(map[string]*model.StockMaster)}
+	for _, s := range stocks {
+		f.stocks[s.IssueCode] = s
+	}
+	return f
+}
+
+func (f *fakeMasterRepository) Save(ctx context.Context, entity interface{}) error {
+	return nil
+}
+
+func (f *fakeMasterRepository) SaveAll(ctx context.Context, entities []interface{}) error {
+	return nil
+}
+
+func (f *fakeMasterRepository) FindByIssueCode(ctx context.Context, issueCode string, entityType string) (interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if entityType != "StockMaster" {
+		return nil, fmt.Errorf("unsupported entity type %s", entityType)
+	}
+	stock, ok := f.stocks[issueCode]
+	if !ok {
+		return nil, nil
+	}
+	return stock, nil
+}
+
+func (f *fakeMasterRepository) UpsertStockMasters(ctx context.Context, stocks []*model.StockMaster) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, s := range stocks {
+		f.stocks[s.IssueCode] = s
+	}
+	return nil
+}
+
+func (f *fakeMasterRepository) UpsertTickRules(ctx context.Context, tickRules []*model.TickRule) error {
+	return nil
+}
+
+func (f *fakeMasterRepository) FindTickRule(ctx context.Context, tickUnitNumber string) (*model.TickRule, error) {
+	return nil, nil
+}
+
+func (f *fakeMasterRepository) FindStockMasters(ctx context.Context, query repository.StockMasterQuery) ([]*model.StockMaster, int64, error) {
+	return nil, 0, nil
+}
+
+func (f *fakeMasterRepository) CountStocksByIndustry(ctx context.Context) ([]*repository.IndustryCount, error) {
+	return nil, nil
+}
+
+func (f *fakeMasterRepository) SyncStockMasters(ctx context.Context, stocks []*model.StockMaster, deleteMissing bool, syncRunID uint) (model.MasterSyncCounts, error) {
+	return model.MasterSyncCounts{}, f.UpsertStockMasters(ctx, stocks)
+}
+
+func (f *fakeMasterRepository) SyncStockMarketMasters(ctx context.Context, markets []*model.StockMarketMaster) (model.MasterSyncCounts, error) {
+	return model.MasterSyncCounts{}, nil
+}
+
+func (f *fakeMasterRepository) SyncTickRules(ctx context.Context, tickRules []*model.TickRule) (model.MasterSyncCounts, error) {
+	return model.MasterSyncCounts{}, nil
+}
+
+func (f *fakeMasterRepository) SyncMarginMasters(ctx context.Context, margins []*model.MarginMaster) (model.MasterSyncCounts, error) {
+	return model.MasterSyncCounts{}, nil
+}
+
+func (f *fakeMasterRepository) SyncStockIssueRegulations(ctx context.Context, regulations []*model.StockIssueRegulation) (model.MasterSyncCounts, error) {
+	return model.MasterSyncCounts{}, nil
+}
+
+func (f *fakeMasterRepository) SyncOperationStatuses(ctx context.Context, statuses []*model.OperationStatus) (model.MasterSyncCounts, error) {
+	return model.MasterSyncCounts{}, nil
+}
+
+func (f *fakeMasterRepository) CreateMasterSyncRun(ctx context.Context, run *model.MasterSyncRun) error {
+	return nil
+}
+
+func (f *fakeMasterRepository) UpdateMasterSyncRun(ctx context.Context, run *model.MasterSyncRun) error {
+	return nil
+}
+
+func (f *fakeMasterRepository) FindMasterSyncRuns(ctx context.Context, limit int) ([]*model.MasterSyncRun, error) {
+	return nil, nil
+}
+
+// fakeTradingEventRepository はテスト用のインメモリ TradingEventRepository 実装
+type fakeTradingEventRepository struct {
+	mu     sync.Mutex
+	events []*model.TradingEvent
+}
+
+func (r *fakeTradingEventRepository) Save(ctx context.Context, event *model.TradingEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *fakeTradingEventRepository) FindEvents(ctx context.Context, query repository.TradingEventQuery) ([]*model.TradingEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*model.TradingEvent(nil), r.events...), nil
+}
+
+// newTestAgent は設定ファイルを介さずにテスト用のエージェントを作成する
+func newTestAgent(signalPattern string, tradeService TradeService, signalRepo *fakeSignalRepository) *Agent {
+	cfg := &AgentConfig{}
+	cfg.Agent.Timezone = "Asia/Tokyo"
+	cfg.StrategySettings.Swingtrade.SignalFilePattern = signalPattern
+	cfg.StrategySettings.Swingtrade.TradeRiskPercentage = 0.25
+	cfg.StrategySettings.Swingtrade.UnitSize = 100
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Agent{
+		config:         cfg,
+		logger:         slog.New(slog.NewTextHandler(io.Discard, nil)),
+		ctx:            ctx,
+		cancel:         cancel,
+		signalPattern:  signalPattern,
+		state:          NewState(),
+		tradeService:   tradeService,
+		signalRepo:     signalRepo,
+		masterRepo:     newFakeMasterRepository(),
+		processedFiles: make(map[string]struct{}),
+		wakeCh:         make(chan struct{}, 1),
+	}
+}
+
+// fakeNewsRepository はテスト用のインメモリ NewsRepository 実装
+type fakeNewsRepository struct {
+	mu   sync.Mutex
+	news []*model.News
+	err  error
+}
+
+func newFakeNewsRepository(news ...*model.News) *fakeNewsRepository {
+	return &fakeNewsRepository{news: news}
+}
+
+func (f *fakeNewsRepository) SaveNews(ctx context.Context, news []*model.News) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.news = append(f.news, news...)
+	return len(news), nil
+}
+
+func (f *fakeNewsRepository) FindByID(ctx context.Context, id string) (*model.News, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, n := range f.news {
+		if n.ID == id {
+			return n, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeNewsRepository) FindNews(ctx context.Context, query repository.NewsQuery) ([]*model.News, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	var result []*model.News
+	for _, n := range f.news {
+		if query.Symbol != "" && !n.HasSymbol(query.Symbol) {
+			continue
+		}
+		if !query.Since.IsZero() && n.PublishedAt.Before(query.Since) {
+			continue
+		}
+		result = append(result, n)
+	}
+	return result, nil
+}
+
+func (f *fakeNewsRepository) FindWithoutBody(ctx context.Context, limit int) ([]*model.News, error) {
+	return nil, nil
+}
+
+func (f *fakeNewsRepository) SaveBody(ctx context.Context, id string, body string, fetchedAt time.Time) error {
+	return nil
+}
+
+// testBalance は現物買付可能額だけを設定したテスト用の残高を返す
+func testBalance(buyingPower float64) *Balance {
+	return &Balance{AccountBalance: model.AccountBalance{CashBuyingPower: buyingPower}}
+}
@@ -6,18 +6,26 @@ import (
 	"log/slog"
 	"stock-bot/domain/model"
 	"stock-bot/domain/repository"
+	"stock-bot/internal/app"
 	"stock-bot/internal/infrastructure/client"
 	"stock-bot/internal/infrastructure/client/dto/price/request"
 	"strconv"
+	"time"
 	// "stock-bot/internal/infrastructure/client/dto/balance/request"
 )
 
+// buyingPowerDays は買付可能額を取得する最後の営業日 (T+N)
+// 国内株式の受渡は T+2 なので、そこまでの買付可能額を見れば未受渡の資金を考慮できる
+const buyingPowerDays = 2
+
 // GoaTradeService は TradeService インターフェースのGoaクライアント実装
 type GoaTradeService struct {
 	balanceClient client.BalanceClient
 	orderClient   client.OrderClient
 	priceClient   client.PriceInfoClient
 	orderRepo     repository.OrderRepository
+	tickRounder   TickRounder
+	sellReserver  SellReserver // nilの場合は売却可能数量を確認しない
 	appSession    *client.Session
 	logger        *slog.Logger
 }
@@ -28,6 +36,8 @@ func NewGoaTradeService(
 	orderClient client.OrderClient,
 	priceClient client.PriceInfoClient,
 	orderRepo repository.OrderRepository,
+	tickRounder TickRounder,
+	sellReserver SellReserver,
 	appSession *client.Session,
 	logger *slog.Logger,
 ) *GoaTradeService {
@@ -36,6 +46,8 @@ func NewGoaTradeService(
 		orderClient:   orderClient,
 		priceClient:   priceClient,
 		orderRepo:     orderRepo,
+		tickRounder:   tickRounder,
+		sellReserver:  sellReserver,
 		appSession:    appSession,
 		logger:        logger,
 	}
@@ -87,8 +99,17 @@ func (s *GoaTradeService) GetPositions(ctx context.Context) ([]*model.Position,
 // GetOrders は発注中の注文を取得する
 func (s *GoaTradeService) GetOrders(ctx context.Context) ([]*model.Order, error) {
 	s.logger.Info("GoaTradeService.GetOrders called")
-    // TODO: orderClient を使って発注中注文を取得し、model.Order に変換する
-	return []*model.Order{}, nil // ダミー実装
+
+	// 注文の状態は約定照会 (ExecutionPoller) が証券会社の注文一覧と照合して更新するため、DBに保存された未完了の注文を返す
+	var orders []*model.Order
+	for _, status := range []model.OrderStatus{model.OrderStatusNew, model.OrderStatusPartiallyFilled} {
+		found, err := s.orderRepo.FindByStatus(ctx, status)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find %s orders: %w", status, err)
+		}
+		orders = append(orders, found...)
+	}
+	return orders, nil
 }
 
 // GetBalance は口座残高を取得する
@@ -100,26 +121,43 @@ func (s *GoaTradeService) GetBalance(ctx context.Context) (*Balance, error) {
 		return nil, fmt.Errorf("failed to get zan kai summary: %w", err)
 	}
 
-	// stringからfloat64への変換
-	cash, err := strconv.ParseFloat(summary.Syukkin, 64)
+	// HTTP API の残高と同じパーサーで変換する
+	account, err := app.ParseAccountBalance(summary)
 	if err != nil {
-		s.logger.Error("failed to parse cash (Syukkin)", "raw", summary.Syukkin, "error", err)
-		cash = 0
-	}
-
-	buyingPower, err := strconv.ParseFloat(summary.GenbutuKabuKaituke, 64)
-	if err != nil {
-		s.logger.Error("failed to parse buying power (GenbutuKabuKaituke)", "raw", summary.GenbutuKabuKaituke, "error", err)
-		buyingPower = 0
+		return nil, fmt.Errorf("failed to parse zan kai summary: %w", err)
 	}
 
 	agentBalance := &Balance{
-		Cash:        cash,
-		BuyingPower: buyingPower,
+		AccountBalance:   *account,
+		BuyingPowerByDay: s.getBuyingPowerByDay(ctx),
 	}
 	return agentBalance, nil
 }
 
+// getBuyingPowerByDay は T+0..T+buyingPowerDays の現物買付可能額を取得する
+// 取得に失敗した営業日以降は含めない (サマリーの買付余力だけで数量を計算する)
+func (s *GoaTradeService) getBuyingPowerByDay(ctx context.Context) []float64 {
+	byDay := make([]float64, 0, buyingPowerDays+1)
+	for day := 0; day <= buyingPowerDays; day++ {
+		detail, err := s.balanceClient.GetZanKaiGenbutuKaitukeSyousai(ctx, s.appSession, day)
+		if err != nil {
+			s.logger.Warn("failed to get buying power details", "day", day, "error", err)
+			break
+		}
+		if detail.ResultCode != "0" {
+			s.logger.Warn("buying power details returned an error", "day", day, "result_code", detail.ResultCode, "result_text", detail.ResultText)
+			break
+		}
+		buyingPower, err := strconv.ParseFloat(detail.GenbutuKaitukeKanougaku, 64)
+		if err != nil {
+			s.logger.Warn("failed to parse buying power details (GenbutuKaitukeKanougaku)", "day", day, "raw", detail.GenbutuKaitukeKanougaku, "error", err)
+			break
+		}
+		byDay = append(byDay, buyingPower)
+	}
+	return byDay
+}
+
 // GetPrice は指定した銘柄の現在価格を取得する
 func (s *GoaTradeService) GetPrice(ctx context.Context, symbol string) (float64, error) {
 	s.logger.Info("GoaTradeService.GetPrice called", "symbol", symbol)
@@ -175,17 +213,48 @@ func (s *GoaTradeService) PlaceOrder(ctx context.Context, req *PlaceOrderRequest
 
 	// OrderPrice のマッピング
 	var orderPrice string
+	price := req.Price
 	switch req.OrderType {
 	case model.OrderTypeMarket:
 		orderPrice = "0" // 成行
 	case model.OrderTypeLimit:
-		orderPrice = strconv.FormatFloat(req.Price, 'f', -1, 64)
+		// 指値は呼値の単位に合わせる (買いは切り下げ、売りは切り上げ)
+		rounded, err := s.tickRounder.RoundPrice(ctx, req.Symbol, time.Now(), req.Price, model.TickRoundingForSide(req.TradeType))
+		if err != nil {
+			return nil, fmt.Errorf("failed to round limit price to tick: %w", err)
+		}
+		if rounded != req.Price {
+			s.logger.Info("limit price rounded to tick", "symbol", req.Symbol, "price", req.Price, "rounded", rounded)
+		}
+		price = rounded
+		orderPrice = model.FormatTickPrice(rounded)
 	default:
 		return nil, fmt.Errorf("unknown order type: %s", req.OrderType)
 	}
 
+	// 売り注文は売却可能数量 (他の売り注文で拘束されている数量を除く) までに抑え、発注が終わるまで数量を予約する
+	// 売却可能数量を取得できない場合は、売り越しを避けるため HTTP API の発注と同じく発注しない
+	orderPlaced := false
+	if req.TradeType == model.TradeTypeSell && s.sellReserver != nil {
+		sellable, done, err := s.sellReserver.Reserve(ctx, s.appSession, req.Symbol, req.Quantity, req.TradingUnit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check sellable quantity: %w", err)
+		}
+		if sellable == 0 {
+			return nil, fmt.Errorf("%w: %s", model.ErrNoSellableQuantity, req.Symbol)
+		}
+		defer func() { done(orderPlaced) }()
+		if sellable < req.Quantity {
+			s.logger.Info("sell quantity capped to the sellable quantity", "symbol", req.Symbol, "quantity", req.Quantity, "sellable", sellable)
+			capped := *req
+			capped.Quantity = sellable
+			req = &capped
+		}
+	}
+
 	// APIクライアントに渡すパラメータを作成
 	params := client.NewOrderParams{
+		ZyoutoekiKazeiC:    "1", // 特定口座 (売却可能数量も特定口座の数量を確認する)
 		IssueCode:          req.Symbol,
 		SizyouC:            "00", // 東証
 		BaibaiKubun:        baibaiKubun,
@@ -204,6 +273,7 @@ func (s *GoaTradeService) PlaceOrder(ctx context.Context, req *PlaceOrderRequest
 	if res.ResultCode != "0" {
 		return nil, fmt.Errorf("new order api returned error: code=%s, text=%s", res.ResultCode, res.ResultText)
 	}
+	orderPlaced = true
 
 	// レスポンスをドメインモデルに変換
 	newOrder := &model.Order{
@@ -212,8 +282,9 @@ func (s *GoaTradeService) PlaceOrder(ctx context.Context, req *PlaceOrderRequest
 		TradeType:   req.TradeType,
 		OrderType:   req.OrderType,
 		Quantity:    req.Quantity,
-		Price:       req.Price,
+		Price:       price,
 		OrderStatus: model.OrderStatusNew,
+		SignalID:    req.SignalID,
 		// TimeInForce はgormのデフォルト値'DAY'に任せる
 	}
 
@@ -0,0 +1,177 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"stock-bot/internal/infrastructure/client"
+	balanceresponse "stock-bot/internal/infrastructure/client/dto/balance/response"
+	"stock-bot/internal/infrastructure/client/dto/order/response"
+	"testing"
+)
+
+// fakeOrderClient は NewOrder のみを実装したテスト用の OrderClient
+type fakeOrderClient struct {
+	client.OrderClient
+	params []client.NewOrderParams
+}
+
+func (f *fakeOrderClient) NewOrder(ctx context.Context, session *client.Session, params client.NewOrderParams) (*response.ResNewOrder, error) {
+	f.params = append(f.params, params)
+	return &response.ResNewOrder{ResultCode: "0", OrderNumber: "1"}, nil
+}
+
+// fakeBalanceClient は残高サマリーと現物株式買付可能額詳細のみを実装したテスト用の BalanceClient
+type fakeBalanceClient struct {
+	client.BalanceClient
+	byDay map[int]string // 営業日ごとの現物株式買付可能額 (ない営業日はエラー)
+}
+
+func (f *fakeBalanceClient) GetZanKaiSummary(ctx context.Context, session *client.Session) (*balanceresponse.ResZanKaiSummary, error) {
+	return &balanceresponse.ResZanKaiSummary{ResultCode: "0", Syukkin: "1000000", GenbutuKabuKaituke: "800000", SinyouSinkidate: "2000000", HosyouKinritu: "0.00", OisyouHasseiFlg: "0"}, nil
+}
+
+func (f *fakeBalanceClient) GetZanKaiGenbutuKaitukeSyousai(ctx context.Context, session *client.Session, tradingDay int) (*balanceresponse.ResZanKaiGenbutuKaitukeSyousai, error) {
+	v, ok := f.byDay[tradingDay]
+	if !ok {
+		return nil, errors.New("api error")
+	}
+	return &balanceresponse.ResZanKaiGenbutuKaitukeSyousai{ResultCode: "0", GenbutuKaitukeKanougaku: v}, nil
+}
+
+// fakeOrderRepository は Save と FindByStatus のみを実装したテスト用の OrderRepository
+type fakeOrderRepository struct {
+	repository.OrderRepository
+	orders []*model.Order
+}
+
+func (f *fakeOrderRepository) Save(ctx context.Context, order *model.Order) error {
+	return nil
+}
+
+func (f *fakeOrderRepository) FindByStatus(ctx context.Context, status model.OrderStatus) ([]*model.Order, error) {
+	var found []*model.Order
+	for _, o := range f.orders {
+		if o.OrderStatus == status {
+			found = append(found, o)
+		}
+	}
+	return found, nil
+}
+
+// fakeSellReserver は固定の売却可能数量を返すテスト用の SellReserver
+type fakeSellReserver struct {
+	sellable int
+	err      error
+	placed   []bool
+}
+
+func (f *fakeSellReserver) Reserve(ctx context.Context, session *client.Session, symbol string, quantity int, tradingUnit int) (int, func(placed bool), error) {
+	if f.err != nil {
+		return 0, nil, f.err
+	}
+	quantity = min(quantity, f.sellable)
+	if tradingUnit > 0 {
+		quantity -= quantity % tradingUnit
+	}
+	return quantity, func(placed bool) { f.placed = append(f.placed, placed) }, nil
+}
+
+func newTestGoaTradeService(orderClient client.OrderClient, sellReserver SellReserver) *GoaTradeService {
+	return NewGoaTradeService(nil, orderClient, nil, &fakeOrderRepository{}, nil, sellReserver, &client.Session{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestGoaTradeServicePlaceOrder_SellCappedToSellable(t *testing.T) {
+	orderClient := &fakeOrderClient{}
+	reserver := &fakeSellReserver{sellable: 250}
+	s := newTestGoaTradeService(orderClient, reserver)
+
+	order, err := s.PlaceOrder(context.Background(), &PlaceOrderRequest{Symbol: "7203", TradeType: model.TradeTypeSell, OrderType: model.OrderTypeMarket, Quantity: 300, TradingUnit: 100})
+	if err != nil {
+		t.Fatalf("PlaceOrder returned error: %v", err)
+	}
+	if order.Quantity != 200 || orderClient.params[0].OrderSuryou != "200" {
+		t.Errorf("expected sell quantity to be capped to 200, got order %d, params %s", order.Quantity, orderClient.params[0].OrderSuryou)
+	}
+	if len(reserver.placed) != 1 || !reserver.placed[0] {
+		t.Errorf("expected the reservation to be kept as placed, got %v", reserver.placed)
+	}
+}
+
+func TestGoaTradeServicePlaceOrder_NoSellableQuantity(t *testing.T) {
+	orderClient := &fakeOrderClient{}
+	s := newTestGoaTradeService(orderClient, &fakeSellReserver{sellable: 0})
+
+	_, err := s.PlaceOrder(context.Background(), &PlaceOrderRequest{Symbol: "7203", TradeType: model.TradeTypeSell, OrderType: model.OrderTypeMarket, Quantity: 100})
+	if !errors.Is(err, model.ErrNoSellableQuantity) {
+		t.Errorf("expected ErrNoSellableQuantity, got %v", err)
+	}
+	if len(orderClient.params) != 0 {
+		t.Errorf("expected no order to be placed, got %d", len(orderClient.params))
+	}
+}
+
+func TestGoaTradeServicePlaceOrder_SellableLookupFailureRejectsSell(t *testing.T) {
+	orderClient := &fakeOrderClient{}
+	s := newTestGoaTradeService(orderClient, &fakeSellReserver{err: errors.New("api error")})
+
+	// HTTP API の発注と同じく、売却可能数量を確認できない売り注文は発注しない
+	_, err := s.PlaceOrder(context.Background(), &PlaceOrderRequest{Symbol: "7203", TradeType: model.TradeTypeSell, OrderType: model.OrderTypeMarket, Quantity: 100})
+	if err == nil {
+		t.Fatal("expected an error when the sellable quantity cannot be checked")
+	}
+	if len(orderClient.params) != 0 {
+		t.Errorf("expected no order to be placed, got %d", len(orderClient.params))
+	}
+}
+
+func TestGoaTradeServiceGetOrders_ReturnsOpenOrders(t *testing.T) {
+	orderRepo := &fakeOrderRepository{orders: []*model.Order{
+		{OrderID: "1", OrderStatus: model.OrderStatusNew},
+		{OrderID: "2", OrderStatus: model.OrderStatusPartiallyFilled},
+		{OrderID: "3", OrderStatus: model.OrderStatusFilled},
+		{OrderID: "4", OrderStatus: model.OrderStatusCanceled},
+	}}
+	s := NewGoaTradeService(nil, nil, nil, orderRepo, nil, nil, &client.Session{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	orders, err := s.GetOrders(context.Background())
+	if err != nil {
+		t.Fatalf("GetOrders returned error: %v", err)
+	}
+	if len(orders) != 2 || orders[0].OrderID != "1" || orders[1].OrderID != "2" {
+		t.Errorf("expected only the working orders, got %+v", orders)
+	}
+}
+
+func TestGoaTradeServiceGetBalance_BuyingPowerByDay(t *testing.T) {
+	balanceClient := &fakeBalanceClient{byDay: map[int]string{0: "800000", 1: "800000", 2: "500000"}}
+	s := NewGoaTradeService(balanceClient, nil, nil, &fakeOrderRepository{}, nil, nil, &client.Session{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	balance, err := s.GetBalance(context.Background())
+	if err != nil {
+		t.Fatalf("GetBalance returned error: %v", err)
+	}
+	if balance.CashBuyingPower != 800000 || len(balance.BuyingPowerByDay) != 3 {
+		t.Fatalf("unexpected balance: %+v", balance)
+	}
+	if got := balance.SizingBuyingPower(); got != 500000 {
+		t.Errorf("expected sizing buying power of T+2, got %v", got)
+	}
+}
+
+func TestGoaTradeServiceGetBalance_BuyingPowerByDayError(t *testing.T) {
+	// T+1 の取得に失敗した場合は T+0 までで打ち切る
+	balanceClient := &fakeBalanceClient{byDay: map[int]string{0: "800000", 2: "500000"}}
+	s := NewGoaTradeService(balanceClient, nil, nil, &fakeOrderRepository{}, nil, nil, &client.Session{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	balance, err := s.GetBalance(context.Background())
+	if err != nil {
+		t.Fatalf("GetBalance returned error: %v", err)
+	}
+	if len(balance.BuyingPowerByDay) != 1 || balance.SizingBuyingPower() != 800000 {
+		t.Errorf("unexpected balance: %+v", balance)
+	}
+}
@@ -0,0 +1,35 @@
+package agent
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultProcessedDirName は処理済みシグナルファイルのデフォルトのアーカイブ先ディレクトリ名
+const DefaultProcessedDirName = "processed"
+
+// ArchiveSignalFile はシグナルファイルを処理済みディレクトリへ移動し、移動先のパスを返す
+// processedDir が空の場合は、シグナルファイルと同じ階層の processed/ を使用する
+// 移動先に同名のファイルがある場合は、タイムスタンプを付与して上書きを避ける
+func ArchiveSignalFile(path string, processedDir string) (string, error) {
+	if processedDir == "" {
+		processedDir = filepath.Join(filepath.Dir(path), DefaultProcessedDirName)
+	}
+	if err := os.MkdirAll(processedDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create processed directory %s: %w", processedDir, err)
+	}
+
+	dest := filepath.Join(processedDir, filepath.Base(path))
+	if _, err := os.Stat(dest); err == nil {
+		ext := filepath.Ext(path)
+		base := filepath.Base(path[:len(path)-len(ext)])
+		dest = filepath.Join(processedDir, fmt.Sprintf("%s_%s%s", base, time.Now().Format("20060102150405.000000000"), ext))
+	}
+
+	if err := os.Rename(path, dest); err != nil {
+		return "", fmt.Errorf("failed to move signal file %s to %s: %w", path, dest, err)
+	}
+	return dest, nil
+}
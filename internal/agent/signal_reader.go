@@ -1,10 +1,20 @@
 package agent
 
 import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
+	"math"
 	"os"
+	"stock-bot/domain/model"
+	"strconv"
+	"time"
 )
 
 // TradeSignal は売買区分を表す型
@@ -15,37 +25,119 @@ const (
 	SellSignal TradeSignal = 0x02
 )
 
+// SignalType は売買区分をドメインモデルのシグナル種別に変換する
+func (s TradeSignal) SignalType() model.SignalType {
+	if s == SellSignal {
+		return model.SignalTypeSell
+	}
+	return model.SignalTypeBuy
+}
+
+// シグナルファイルのフォーマットバージョン
+const (
+	SignalFormatV1 = 1
+	SignalFormatV2 = 2
+)
+
+// SignalFileMagic は v2 以降のシグナルファイルの先頭に置かれるマジックナンバー
+// v1 として解釈すると売買区分が 'S'(0x53) となり不正値になるため、v1 と衝突しない
+var SignalFileMagic = [4]byte{'S', 'B', 'S', 'G'}
+
+// MaxSignalSymbolLength は v2 レコードの銘柄コードの最大長
+const MaxSignalSymbolLength = 16
+
+// v2ヘッダーのサイズ (magic 4 + version 2 + reserved 2 + generated_at 8 + record_count 4 + crc32 4)
+const signalV2HeaderSize = 24
+
+// v2ヘッダーのうち CRC32 の対象となる部分 (crc32 より前のフィールド) のサイズ
+const signalV2ChecksummedHeaderSize = signalV2HeaderSize - 4
+
+// v2レコードの最小サイズ (銘柄コード長 1 + 銘柄コード 1 + 固定長部分 41)
+const signalV2MinRecordSize = 1 + 1 + 41
+
 // SignalRecord はシグナルファイル内の1レコードを表す
+// v1 では Symbol と Signal のみが設定され、その他のフィールドはゼロ値となる
 type SignalRecord struct {
-	Symbol uint16
-	Signal TradeSignal
+	Symbol      string      // 銘柄コード (例: "7203", "130A")
+	Signal      TradeSignal // 売買区分
+	LimitPrice  float64     // 指値 (0の場合は成行)
+	StopPrice   float64     // 損切り価格 (0の場合は指定なし)
+	TargetPrice float64     // 利確目標価格 (0の場合は指定なし)
+	Weight      float64     // 発注サイズの重み (0〜1、0の場合は1として扱う)
+	ValidUntil  time.Time   // 有効期限 (ゼロ値の場合は無期限)
+}
+
+// IsExpired はレコードが指定時刻の時点で有効期限切れかどうかを返す
+func (r *SignalRecord) IsExpired(now time.Time) bool {
+	return !r.ValidUntil.IsZero() && now.After(r.ValidUntil)
+}
+
+// SignalBatch はシグナルファイル1つ分の内容を表す
+type SignalBatch struct {
+	Version     int
+	GeneratedAt time.Time // シグナルの生成日時 (v1の場合はゼロ値)
+	Records     []*SignalRecord
 }
 
 // ReadSignalFile は指定されたバイナリファイルからシグナルを読み込む
-// ファイルフォーマット: [銘柄コード(uint16)][売買区分(uint8)] が連続
+// フォーマットは先頭のマジックナンバーで v1/v2 を自動判別する
 func ReadSignalFile(filePath string) ([]*SignalRecord, error) {
-	file, err := os.Open(filePath)
+	batch, err := ReadSignalBatch(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open signal file %s: %w", filePath, err)
+		return nil, err
 	}
-	defer file.Close()
+	return batch.Records, nil
+}
+
+// ReadSignalBatch は指定されたバイナリファイルからヘッダー情報を含めてシグナルを読み込む
+// エージェントと同じく ReadSignalFileContent で読み込んだ内容から読み込む
+func ReadSignalBatch(filePath string) (*SignalBatch, error) {
+	content, err := ReadSignalFileContent(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return content.Decode()
+}
 
+// DecodeSignals はリーダーからシグナルを読み込む。フォーマットは自動判別する
+func DecodeSignals(r io.Reader) (*SignalBatch, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(len(SignalFileMagic))
+	if err == nil && bytes.Equal(head, SignalFileMagic[:]) {
+		return decodeSignalsV2(br)
+	}
+	// マジックナンバーがない、または4バイト未満のファイルは v1 として扱う
+	records, err := decodeSignalsV1(br)
+	if err != nil {
+		return nil, err
+	}
+	return &SignalBatch{Version: SignalFormatV1, Records: records}, nil
+}
+
+// decodeSignalsV1 は v1 フォーマットのシグナルを読み込む
+// ファイルフォーマット: [銘柄コード(uint16)][売買区分(uint8)] が連続
+func decodeSignalsV1(r io.Reader) ([]*SignalRecord, error) {
 	signals := make([]*SignalRecord, 0)
 	// リトルエンディアンで読み込む
 	// 3バイトずつ読み込む
 	for {
-		record := &SignalRecord{}
+		var symbol uint16
 		// 銘柄コードの読み込み
-		err := binary.Read(file, binary.LittleEndian, &record.Symbol)
+		err := binary.Read(r, binary.LittleEndian, &symbol)
 		if err != nil {
 			if err == io.EOF {
 				break // ファイルの終端に達したら終了
 			}
+			if err == io.ErrUnexpectedEOF {
+				return nil, fmt.Errorf("incomplete record found at end of signal file: symbol is truncated")
+			}
 			return nil, fmt.Errorf("failed to read symbol from signal file: %w", err)
 		}
 
+		record := &SignalRecord{Symbol: strconv.Itoa(int(symbol))}
+
 		// 売買区分の読み込み
-		err = binary.Read(file, binary.LittleEndian, &record.Signal)
+		err = binary.Read(r, binary.LittleEndian, &record.Signal)
 		if err != nil {
 			// Symbolを読んだ直後にEOFになるケース
 			if err == io.EOF {
@@ -53,7 +145,7 @@ func ReadSignalFile(filePath string) ([]*SignalRecord, error) {
 			}
 			return nil, fmt.Errorf("failed to read signal from signal file: %w", err)
 		}
-		
+
 		// 不正なシグナル値でないかチェック
 		if record.Signal != BuySignal && record.Signal != SellSignal {
 			return nil, fmt.Errorf("invalid trade signal value found: 0x%02x", record.Signal)
@@ -64,3 +156,191 @@ func ReadSignalFile(filePath string) ([]*SignalRecord, error) {
 
 	return signals, nil
 }
+
+// signalV2Header は v2 フォーマットのファイルヘッダー
+type signalV2Header struct {
+	Magic       [4]byte
+	Version     uint16
+	Reserved    uint16
+	GeneratedAt int64 // UNIXミリ秒
+	RecordCount uint32
+	CRC32       uint32 // crc32 より前のヘッダーとレコード部のCRC32 (IEEE)
+}
+
+// checksum は crc32 より前のヘッダーとレコード部の CRC32 (IEEE) を計算する
+func (h *signalV2Header) checksum(body []byte) uint32 {
+	buf := make([]byte, 0, signalV2ChecksummedHeaderSize)
+	buf = append(buf, h.Magic[:]...)
+	buf = binary.LittleEndian.AppendUint16(buf, h.Version)
+	buf = binary.LittleEndian.AppendUint16(buf, h.Reserved)
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(h.GeneratedAt))
+	buf = binary.LittleEndian.AppendUint32(buf, h.RecordCount)
+	return crc32.Update(crc32.ChecksumIEEE(buf), crc32.IEEETable, body)
+}
+
+// signalV2Fixed は v2 レコードの銘柄コード以降の固定長部分
+type signalV2Fixed struct {
+	Signal      TradeSignal
+	LimitPrice  float64
+	StopPrice   float64
+	TargetPrice float64
+	Weight      float64
+	ValidUntil  int64 // UNIXミリ秒 (0は無期限)
+}
+
+// decodeSignalsV2 は v2 フォーマットのシグナルを読み込む
+// ファイルフォーマット: [ヘッダー(24バイト)][レコード] x record_count
+// ヘッダーの crc32 は、crc32 より前のヘッダー (20バイト) とレコード部を続けた内容の CRC32
+// レコード: [銘柄コード長(uint8)][銘柄コード(ASCII)][売買区分(uint8)][指値(float64)][損切り(float64)][利確(float64)][重み(float64)][有効期限(int64)]
+func decodeSignalsV2(r io.Reader) (*SignalBatch, error) {
+	var header signalV2Header
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return nil, fmt.Errorf("failed to read v2 signal file header: %w", err)
+	}
+	if header.Version != SignalFormatV2 {
+		return nil, fmt.Errorf("unsupported signal file version: %d", header.Version)
+	}
+
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read v2 signal records: %w", err)
+	}
+	if crc := header.checksum(body); crc != header.CRC32 {
+		return nil, fmt.Errorf("signal file checksum mismatch: header=0x%08x, computed=0x%08x", header.CRC32, crc)
+	}
+	// レコード数はファイルの内容から検証してから使用する (不正なレコード数でメモリを確保しないため)
+	if maxRecords := len(body) / signalV2MinRecordSize; uint64(header.RecordCount) > uint64(maxRecords) {
+		return nil, fmt.Errorf("signal file declares %d records but the body can hold at most %d", header.RecordCount, maxRecords)
+	}
+
+	batch := &SignalBatch{
+		Version:     SignalFormatV2,
+		GeneratedAt: time.UnixMilli(header.GeneratedAt),
+		Records:     make([]*SignalRecord, 0, header.RecordCount),
+	}
+
+	br := bytes.NewReader(body)
+	for i := uint32(0); i < header.RecordCount; i++ {
+		record, err := decodeSignalRecordV2(br)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read v2 signal record %d: %w", i, err)
+		}
+		batch.Records = append(batch.Records, record)
+	}
+	if br.Len() != 0 {
+		return nil, fmt.Errorf("unexpected %d trailing bytes after %d records in signal file", br.Len(), header.RecordCount)
+	}
+
+	return batch, nil
+}
+
+// decodeSignalRecordV2 は v2 レコードを1件読み込む
+func decodeSignalRecordV2(r io.Reader) (*SignalRecord, error) {
+	var symbolLen uint8
+	if err := binary.Read(r, binary.LittleEndian, &symbolLen); err != nil {
+		return nil, fmt.Errorf("incomplete record found: %w", err)
+	}
+	symbol := make([]byte, symbolLen)
+	if _, err := io.ReadFull(r, symbol); err != nil {
+		return nil, fmt.Errorf("incomplete record found: symbol is truncated: %w", err)
+	}
+	if err := ValidateSignalSymbol(string(symbol)); err != nil {
+		return nil, err
+	}
+
+	var fixed signalV2Fixed
+	if err := binary.Read(r, binary.LittleEndian, &fixed); err != nil {
+		return nil, fmt.Errorf("incomplete record found: %w", err)
+	}
+	if fixed.Signal != BuySignal && fixed.Signal != SellSignal {
+		return nil, fmt.Errorf("invalid trade signal value found: 0x%02x", fixed.Signal)
+	}
+	for name, v := range map[string]float64{"limit_price": fixed.LimitPrice, "stop_price": fixed.StopPrice, "target_price": fixed.TargetPrice, "weight": fixed.Weight} {
+		if math.IsNaN(v) || math.IsInf(v, 0) || v < 0 {
+			return nil, fmt.Errorf("invalid %s value found for symbol %s: %v", name, symbol, v)
+		}
+	}
+	// 重みは trade_risk_percentage に対する割合のため、1 を超える値は受け付けない
+	if fixed.Weight > 1 {
+		return nil, fmt.Errorf("invalid weight value found for symbol %s: %v exceeds 1", symbol, fixed.Weight)
+	}
+
+	record := &SignalRecord{
+		Symbol:      string(symbol),
+		Signal:      fixed.Signal,
+		LimitPrice:  fixed.LimitPrice,
+		StopPrice:   fixed.StopPrice,
+		TargetPrice: fixed.TargetPrice,
+		Weight:      fixed.Weight,
+	}
+	if fixed.ValidUntil != 0 {
+		record.ValidUntil = time.UnixMilli(fixed.ValidUntil)
+	}
+	return record, nil
+}
+
+// ValidateSignalSymbol は銘柄コードが v2 レコードとして有効かを検証する
+// 東証の英数字コード (例: "130A") に対応するため、数字と英大文字のみを許可する
+func ValidateSignalSymbol(symbol string) error {
+	if symbol == "" {
+		return errors.New("invalid symbol found: symbol is empty")
+	}
+	if len(symbol) > MaxSignalSymbolLength {
+		return fmt.Errorf("invalid symbol found: %q exceeds %d characters", symbol, MaxSignalSymbolLength)
+	}
+	for _, c := range symbol {
+		if (c < '0' || c > '9') && (c < 'A' || c > 'Z') {
+			return fmt.Errorf("invalid symbol found: %q contains unsupported character %q", symbol, c)
+		}
+	}
+	return nil
+}
+
+// SignalFileContent は1度の読み込みで得たシグナルファイルの内容
+// ハッシュとシグナルを同じ内容から求めるため、ファイルを開き直さずにこの内容を使う
+type SignalFileContent struct {
+	Data    []byte
+	Hash    string    // 内容の SHA-256 ハッシュ (16進文字列)
+	ModTime time.Time // 読み込んだファイルの更新日時
+}
+
+// ReadSignalFileContent はシグナルファイルを1度だけ開いて内容を読み込み、ハッシュを計算する
+// 読み込みの間にファイルが置き換えられても、ハッシュとシグナルが別の内容から求められることはない
+func ReadSignalFileContent(filePath string) (*SignalFileContent, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open signal file %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat signal file %s: %w", filePath, err)
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signal file %s: %w", filePath, err)
+	}
+	return &SignalFileContent{Data: data, Hash: HashSignals(data), ModTime: info.ModTime()}, nil
+}
+
+// Decode は読み込んだ内容からシグナルを読み込む。フォーマットは自動判別する
+func (c *SignalFileContent) Decode() (*SignalBatch, error) {
+	return DecodeSignals(bytes.NewReader(c.Data))
+}
+
+// HashSignals はシグナルファイルの内容のSHA-256ハッシュを16進文字列で返す
+// 同じ内容のファイルを二重に処理しないための識別子として使用する
+func HashSignals(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// HashSignalFile はシグナルファイルの内容のSHA-256ハッシュを16進文字列で返す
+func HashSignalFile(filePath string) (string, error) {
+	content, err := ReadSignalFileContent(filePath)
+	if err != nil {
+		return "", err
+	}
+	return content.Hash, nil
+}
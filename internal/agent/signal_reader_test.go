@@ -3,9 +3,12 @@ package agent_test
 import (
 	"bytes"
 	"encoding/binary"
+	"hash/crc32"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
+	"time"
 
 	"stock-bot/internal/agent"
 	"github.com/stretchr/testify/assert"
@@ -20,7 +23,9 @@ func createDummySignalFile(t *testing.T, dir string, filename string, records []
 
 	buf := new(bytes.Buffer)
 	for _, rec := range records {
-		err := binary.Write(buf, binary.LittleEndian, rec.Symbol)
+		symbol, err := strconv.ParseUint(rec.Symbol, 10, 16)
+		assert.NoError(t, err)
+		err = binary.Write(buf, binary.LittleEndian, uint16(symbol))
 		assert.NoError(t, err)
 		err = binary.Write(buf, binary.LittleEndian, rec.Signal)
 		assert.NoError(t, err)
@@ -34,9 +39,9 @@ func createDummySignalFile(t *testing.T, dir string, filename string, records []
 func TestReadSignalFile_Success(t *testing.T) {
 	// テストデータ
 	records := []agent.SignalRecord{
-		{Symbol: 7203, Signal: agent.BuySignal},  // トヨタ
-		{Symbol: 9984, Signal: agent.SellSignal}, // ソフトバンクG
-		{Symbol: 6758, Signal: agent.BuySignal},  // ソニー
+		{Symbol: "7203", Signal: agent.BuySignal},  // トヨタ
+		{Symbol: "9984", Signal: agent.SellSignal}, // ソフトバンクG
+		{Symbol: "6758", Signal: agent.BuySignal},  // ソニー
 	}
 
 	// ダミーファイル作成
@@ -99,7 +104,7 @@ func TestReadSignalFile_IncompleteRecord(t *testing.T) {
 func TestReadSignalFile_InvalidSignalValue(t *testing.T) {
 	// 不正なシグナル値を持つレコード
 	records := []agent.SignalRecord{
-		{Symbol: 7203, Signal: 0x03}, // 0x01, 0x02 以外
+		{Symbol: "7203", Signal: 0x03}, // 0x01, 0x02 以外
 	}
 
 	// ダミーファイル作成
@@ -112,3 +117,173 @@ func TestReadSignalFile_InvalidSignalValue(t *testing.T) {
 	assert.Nil(t, readRecords)
 	assert.Contains(t, err.Error(), "invalid trade signal value found")
 }
+
+func TestReadSignalFile_V2WeightAboveOne(t *testing.T) {
+	// 重みが 1 を超えるレコードは読み込まない
+	filePath := filepath.Join(t.TempDir(), "heavy.bin")
+	err := agent.WriteSignalFile(filePath, agent.SignalFormatV2, time.Now(), []*agent.SignalRecord{
+		{Symbol: "7203", Signal: agent.BuySignal, Weight: 50},
+	})
+	assert.NoError(t, err)
+
+	readRecords, err := agent.ReadSignalFile(filePath)
+	assert.Error(t, err)
+	assert.Nil(t, readRecords)
+	assert.Contains(t, err.Error(), "invalid weight value found")
+}
+
+func TestReadSignalFile_V2RoundTrip(t *testing.T) {
+	generatedAt := time.Date(2025, 12, 22, 8, 30, 0, 0, time.UTC)
+	validUntil := time.Date(2025, 12, 22, 15, 0, 0, 0, time.UTC)
+	records := []*agent.SignalRecord{
+		{Symbol: "7203", Signal: agent.BuySignal, LimitPrice: 2850.5, StopPrice: 2790, TargetPrice: 3000, Weight: 0.5, ValidUntil: validUntil},
+		{Symbol: "130A", Signal: agent.SellSignal}, // 英数字コード
+		{Symbol: "25935", Signal: agent.BuySignal}, // 5桁コード
+	}
+
+	path := filepath.Join(t.TempDir(), "signals_v2.bin")
+	assert.NoError(t, agent.WriteSignalFile(path, agent.SignalFormatV2, generatedAt, records))
+
+	batch, err := agent.ReadSignalBatch(path)
+	assert.NoError(t, err)
+	assert.Equal(t, agent.SignalFormatV2, batch.Version)
+	assert.True(t, generatedAt.Equal(batch.GeneratedAt))
+	assert.Len(t, batch.Records, len(records))
+
+	first := batch.Records[0]
+	assert.Equal(t, "7203", first.Symbol)
+	assert.Equal(t, agent.BuySignal, first.Signal)
+	assert.Equal(t, 2850.5, first.LimitPrice)
+	assert.Equal(t, 2790.0, first.StopPrice)
+	assert.Equal(t, 3000.0, first.TargetPrice)
+	assert.Equal(t, 0.5, first.Weight)
+	assert.True(t, validUntil.Equal(first.ValidUntil))
+
+	assert.Equal(t, "130A", batch.Records[1].Symbol)
+	assert.True(t, batch.Records[1].ValidUntil.IsZero())
+	assert.Equal(t, "25935", batch.Records[2].Symbol)
+
+	// ReadSignalFile も v2 を自動判別できること
+	readRecords, err := agent.ReadSignalFile(path)
+	assert.NoError(t, err)
+	assert.Len(t, readRecords, len(records))
+}
+
+func TestReadSignalFile_V1Detected(t *testing.T) {
+	path := createDummySignalFile(t, t.TempDir(), "signals_v1.bin", []agent.SignalRecord{
+		{Symbol: "7203", Signal: agent.BuySignal},
+	})
+
+	batch, err := agent.ReadSignalBatch(path)
+	assert.NoError(t, err)
+	assert.Equal(t, agent.SignalFormatV1, batch.Version)
+	assert.True(t, batch.GeneratedAt.IsZero())
+	assert.Equal(t, "7203", batch.Records[0].Symbol)
+}
+
+func TestReadSignalFile_V2ChecksumMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "corrupt.bin")
+	records := []*agent.SignalRecord{{Symbol: "7203", Signal: agent.BuySignal}}
+	assert.NoError(t, agent.WriteSignalFile(path, agent.SignalFormatV2, time.Now(), records))
+
+	// レコード部の1バイトを書き換える
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	data[len(data)-1] ^= 0xFF
+	assert.NoError(t, os.WriteFile(path, data, 0644))
+
+	readRecords, err := agent.ReadSignalFile(path)
+	assert.Error(t, err)
+	assert.Nil(t, readRecords)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+}
+
+func TestReadSignalFile_V2HeaderChecksumMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tampered.bin")
+	records := []*agent.SignalRecord{{Symbol: "7203", Signal: agent.BuySignal}}
+	assert.NoError(t, agent.WriteSignalFile(path, agent.SignalFormatV2, time.Now(), records))
+
+	// ヘッダーの生成日時を書き換える (CRC32 はヘッダーも対象とする)
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	data[8] ^= 0xFF
+	assert.NoError(t, os.WriteFile(path, data, 0644))
+
+	_, err = agent.ReadSignalFile(path)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+}
+
+func TestReadSignalFile_V2RecordCountExceedsBody(t *testing.T) {
+	// magic SBSG, version 2, record_count 0xFFFFFFFF のレコード部が空のファイル
+	header := func(crc uint32) []byte {
+		data := append([]byte("SBSG"), 2, 0, 0, 0)
+		data = binary.LittleEndian.AppendUint64(data, 0)
+		data = binary.LittleEndian.AppendUint32(data, 0xFFFFFFFF)
+		return binary.LittleEndian.AppendUint32(data, crc)
+	}
+
+	t.Run("レコード部のみのCRC32では読み込まない", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "huge.bin")
+		assert.NoError(t, os.WriteFile(path, header(0), 0644)) // 空のレコード部の CRC32 は 0
+
+		_, err := agent.ReadSignalFile(path)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "checksum mismatch")
+	})
+
+	t.Run("CRC32が正しくてもレコード部に収まらないレコード数では読み込まない", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "huge.bin")
+		data := header(0)
+		assert.NoError(t, os.WriteFile(path, header(crc32.ChecksumIEEE(data[:20])), 0644))
+
+		_, err := agent.ReadSignalFile(path)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "declares 4294967295 records")
+	})
+}
+
+func TestReadSignalFile_V2Truncated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "truncated.bin")
+	records := []*agent.SignalRecord{{Symbol: "7203", Signal: agent.BuySignal}}
+	assert.NoError(t, agent.WriteSignalFile(path, agent.SignalFormatV2, time.Now(), records))
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(path, data[:10], 0644)) // ヘッダー途中で切れたファイル
+
+	_, err = agent.ReadSignalFile(path)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "header")
+}
+
+func TestWriteSignalFile_V1RejectsAlphanumericSymbol(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "v1.bin")
+	err := agent.WriteSignalFile(path, agent.SignalFormatV1, time.Time{}, []*agent.SignalRecord{{Symbol: "130A", Signal: agent.BuySignal}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be represented in v1 format")
+	_, statErr := os.Stat(path)
+	assert.True(t, os.IsNotExist(statErr))
+}
+
+func TestReadSignalFileContent_HashAndRecordsFromSameRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "signals.bin")
+	original := []*agent.SignalRecord{{Symbol: "7203", Signal: agent.BuySignal}}
+	assert.NoError(t, agent.WriteSignalFile(path, agent.SignalFormatV2, time.Now(), original))
+	originalHash, err := agent.HashSignalFile(path)
+	assert.NoError(t, err)
+
+	content, err := agent.ReadSignalFileContent(path)
+	assert.NoError(t, err)
+
+	// 読み込んだ後にシグナルメーカーがファイルを置き換える
+	replaced := []*agent.SignalRecord{{Symbol: "6758", Signal: agent.SellSignal}}
+	assert.NoError(t, agent.WriteSignalFile(path, agent.SignalFormatV2, time.Now(), replaced))
+
+	batch, err := content.Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, originalHash, content.Hash)
+	if assert.Len(t, batch.Records, 1) {
+		assert.Equal(t, "7203", batch.Records[0].Symbol)
+	}
+}
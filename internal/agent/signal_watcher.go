@@ -0,0 +1,339 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SignalWatchMode はシグナルファイルの検知方式
+type SignalWatchMode string
+
+const (
+	SignalWatchAuto    SignalWatchMode = "auto"    // inotifyを使用し、使えない場合はポーリングにフォールバックする
+	SignalWatchInotify SignalWatchMode = "inotify" // inotifyのみを使用する
+	SignalWatchPoll    SignalWatchMode = "poll"    // ポーリングのみを使用する
+	SignalWatchOff     SignalWatchMode = "off"     // ウォッチャーを使わず、tickごとにファイルを探す
+)
+
+const (
+	// DefaultSignalStableDuration は、書き込み完了とみなすまでにサイズと更新日時が変化しない時間のデフォルト値
+	DefaultSignalStableDuration = 500 * time.Millisecond
+	// DefaultSignalPollInterval はポーリング方式でのディレクトリ走査間隔のデフォルト値
+	DefaultSignalPollInterval = 1 * time.Second
+)
+
+// errInotifyUnsupported はinotifyが利用できない環境で返される
+var errInotifyUnsupported = errors.New("inotify is not supported on this platform")
+
+// SignalWatcherConfig はシグナルファイルウォッチャーの設定
+type SignalWatcherConfig struct {
+	Pattern      string          // 監視するシグナルファイルのパターン (例: ./signals/*.bin)
+	Mode         SignalWatchMode // 検知方式
+	StableFor    time.Duration   // サイズと更新日時がこの時間変化しなければ書き込み完了とみなす
+	PollInterval time.Duration   // ポーリング方式での走査間隔
+}
+
+// ReadySignalFile は書き込みが完了したシグナルファイルを表す
+type ReadySignalFile struct {
+	Path    string
+	Size    int64
+	ModTime time.Time
+}
+
+// fileState はファイルのサイズと更新日時の組
+type fileState struct {
+	size    int64
+	modTime time.Time
+}
+
+func (s fileState) equal(other fileState) bool {
+	return s.size == other.size && s.modTime.Equal(other.modTime)
+}
+
+// pendingSignalFile は書き込み完了待ちのファイル
+type pendingSignalFile struct {
+	state   fileState
+	since   time.Time // 現在の state を最初に観測した時刻
+	renamed bool      // 別名からリネームされてきたファイルかどうか
+}
+
+// watchEvent はinotifyから受け取ったイベントを表す
+type watchEvent struct {
+	name     string // ディレクトリ内のファイル名
+	renamed  bool   // リネームで現れたファイル
+	removed  bool   // 削除またはリネームで消えたファイル
+	overflow bool   // イベントキューが溢れたため、再走査が必要
+}
+
+// SignalWatcher はシグナルファイルの書き込み完了を検知し、チャネルでエージェントに通知する
+//
+// 書き込み完了の判定規則:
+//   - 別名で書き込んでからリネームされたファイルは、リネームの時点で完了とみなす
+//   - それ以外のファイルは、サイズと更新日時が StableFor の間変化しなければ完了とみなす
+//
+// 複数のファイルが同時に完了した場合は、更新日時の古い順 (同時刻ならパスの昇順) に1つのバッチとして通知する
+// 隠しファイル (先頭が "." のファイル) とパターンに一致しないファイルは無視する
+type SignalWatcher struct {
+	config  SignalWatcherConfig
+	logger  *slog.Logger
+	dir     string
+	base    string
+	events  chan []ReadySignalFile
+	pending map[string]*pendingSignalFile
+	emitted map[string]fileState // 通知済みのファイルと通知時点の状態
+}
+
+// NewSignalWatcher は新しいシグナルファイルウォッチャーを作成する
+func NewSignalWatcher(config SignalWatcherConfig, logger *slog.Logger) *SignalWatcher {
+	if config.Mode == "" {
+		config.Mode = SignalWatchAuto
+	}
+	if config.StableFor <= 0 {
+		config.StableFor = DefaultSignalStableDuration
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultSignalPollInterval
+	}
+	return &SignalWatcher{
+		config:  config,
+		logger:  logger,
+		dir:     filepath.Dir(config.Pattern),
+		base:    filepath.Base(config.Pattern),
+		events:  make(chan []ReadySignalFile, 1),
+		pending: make(map[string]*pendingSignalFile),
+		emitted: make(map[string]fileState),
+	}
+}
+
+// Events は書き込みが完了したシグナルファイルのバッチを受け取るチャネルを返す
+func (w *SignalWatcher) Events() <-chan []ReadySignalFile {
+	return w.events
+}
+
+// Run はコンテキストがキャンセルされるまでシグナルファイルを監視する
+func (w *SignalWatcher) Run(ctx context.Context) error {
+	switch w.config.Mode {
+	case SignalWatchPoll:
+		return w.runPolling(ctx)
+	case SignalWatchInotify, SignalWatchAuto:
+		source, err := w.newInotify()
+		if err != nil {
+			if w.config.Mode == SignalWatchInotify {
+				return fmt.Errorf("failed to start inotify watcher for %s: %w", w.dir, err)
+			}
+			w.logger.Warn("inotify is unavailable, falling back to polling", "dir", w.dir, "error", err)
+			return w.runPolling(ctx)
+		}
+		return w.runInotify(ctx, source)
+	default:
+		return fmt.Errorf("unsupported signal watch mode %q", w.config.Mode)
+	}
+}
+
+// newInotify はパターンのディレクトリを監視するinotifyを作成する
+// ディレクトリ部分にワイルドカードを含むパターンは監視できない
+func (w *SignalWatcher) newInotify() (*inotifySource, error) {
+	if strings.ContainsAny(w.dir, "*?[") {
+		return nil, fmt.Errorf("directory part of pattern %q contains wildcards", w.config.Pattern)
+	}
+	return newInotifySource(w.dir)
+}
+
+// runPolling は一定間隔でディレクトリを走査してシグナルファイルを監視する
+func (w *SignalWatcher) runPolling(ctx context.Context) error {
+	w.logger.Info("watching signal files by polling", "pattern", w.config.Pattern, "interval", w.config.PollInterval)
+	ticker := time.NewTicker(w.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.scan(time.Now())
+		if !w.flush(ctx, time.Now()) {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// runInotify はinotifyのイベントを受けてシグナルファイルを監視する
+// 書き込み中のファイルの安定判定のため、StableFor より短い間隔でも状態を確認する
+func (w *SignalWatcher) runInotify(ctx context.Context, source *inotifySource) error {
+	w.logger.Info("watching signal files with inotify", "dir", w.dir, "pattern", w.config.Pattern)
+
+	// inotifyは source.run が終了時に閉じる。戻る前に停止させ、終了を待つ
+	runCtx, cancel := context.WithCancel(ctx)
+	notify := make(chan watchEvent, 64)
+	go source.run(runCtx, notify)
+	defer func() {
+		cancel()
+		for range notify {
+		}
+	}()
+
+	check := time.NewTicker(w.config.StableFor / 2)
+	defer check.Stop()
+
+	// 起動前から存在するファイルも対象にする
+	w.scan(time.Now())
+	for {
+		if !w.flush(ctx, time.Now()) {
+			return nil
+		}
+		select {
+		case ev, ok := <-notify:
+			if !ok {
+				return errors.New("inotify watcher stopped unexpectedly")
+			}
+			w.handleEvent(ev, time.Now())
+		case <-check.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// handleEvent はinotifyのイベントを待機中のファイルに反映する
+func (w *SignalWatcher) handleEvent(ev watchEvent, now time.Time) {
+	if ev.overflow {
+		w.logger.Warn("inotify event queue overflowed, rescanning signal directory", "dir", w.dir)
+		w.scan(now)
+		return
+	}
+	if !w.matches(ev.name) {
+		return
+	}
+	path := filepath.Join(w.dir, ev.name)
+	if ev.removed {
+		delete(w.pending, path)
+		delete(w.emitted, path)
+		return
+	}
+	w.observe(path, ev.renamed, now)
+}
+
+// scan はパターンに一致するファイルをすべて確認する
+func (w *SignalWatcher) scan(now time.Time) {
+	paths, err := filepath.Glob(w.config.Pattern)
+	if err != nil {
+		w.logger.Error("failed to glob signal files", "pattern", w.config.Pattern, "error", err)
+		return
+	}
+	found := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		if !w.matches(filepath.Base(path)) {
+			continue
+		}
+		found[path] = struct{}{}
+		w.observe(path, false, now)
+	}
+	// 消えたファイルの記録を破棄する (同じ名前で再作成された場合に再度通知するため)
+	for path := range w.emitted {
+		if _, ok := found[path]; !ok {
+			delete(w.emitted, path)
+		}
+	}
+	for path := range w.pending {
+		if _, ok := found[path]; !ok {
+			delete(w.pending, path)
+		}
+	}
+}
+
+// observe はファイルの現在の状態を記録する
+func (w *SignalWatcher) observe(path string, renamed bool, now time.Time) {
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		delete(w.pending, path)
+		return
+	}
+	state := fileState{size: info.Size(), modTime: info.ModTime()}
+	if emitted, ok := w.emitted[path]; ok && emitted.equal(state) {
+		return
+	}
+	p, ok := w.pending[path]
+	if !ok {
+		w.pending[path] = &pendingSignalFile{state: state, since: now, renamed: renamed}
+		return
+	}
+	if !p.state.equal(state) {
+		p.state = state
+		p.since = now
+	}
+	p.renamed = p.renamed || renamed
+}
+
+// flush は書き込みが完了したファイルをまとめて通知する
+// コンテキストがキャンセルされた場合は false を返す
+func (w *SignalWatcher) flush(ctx context.Context, now time.Time) bool {
+	ready := w.collectReady(now)
+	if len(ready) == 0 {
+		return true
+	}
+	select {
+	case w.events <- ready:
+		for _, f := range ready {
+			w.emitted[f.Path] = fileState{size: f.Size, modTime: f.ModTime}
+		}
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// collectReady は書き込みが完了したファイルを待機中の一覧から取り出し、通知順に並べて返す
+func (w *SignalWatcher) collectReady(now time.Time) []ReadySignalFile {
+	var ready []ReadySignalFile
+	for path, p := range w.pending {
+		info, err := os.Stat(path)
+		if err != nil {
+			delete(w.pending, path)
+			continue
+		}
+		state := fileState{size: info.Size(), modTime: info.ModTime()}
+		if !p.state.equal(state) {
+			// まだ書き込み中
+			p.state = state
+			p.since = now
+			if !p.renamed {
+				continue
+			}
+		}
+		if !p.renamed && now.Sub(p.since) < w.config.StableFor {
+			continue
+		}
+		ready = append(ready, ReadySignalFile{Path: path, Size: state.size, ModTime: state.modTime})
+		delete(w.pending, path)
+	}
+	SortReadySignalFiles(ready)
+	return ready
+}
+
+// matches はファイル名が監視対象かどうかを返す
+func (w *SignalWatcher) matches(name string) bool {
+	if strings.HasPrefix(name, ".") {
+		return false
+	}
+	ok, err := filepath.Match(w.base, name)
+	return err == nil && ok
+}
+
+// SortReadySignalFiles はシグナルファイルを処理順 (更新日時の古い順、同時刻ならパスの昇順) に並べ替える
+// 同じ取引日・銘柄・売買区分のシグナルは先に処理したものが採用されるため、古いファイルを優先する
+func SortReadySignalFiles(files []ReadySignalFile) {
+	sort.Slice(files, func(i, j int) bool {
+		if !files[i].ModTime.Equal(files[j].ModTime) {
+			return files[i].ModTime.Before(files[j].ModTime)
+		}
+		return files[i].Path < files[j].Path
+	})
+}
@@ -0,0 +1,108 @@
+//go:build linux
+
+package agent
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// inotifyWatchMask は監視するinotifyイベント
+const inotifyWatchMask = unix.IN_CREATE | unix.IN_MODIFY | unix.IN_CLOSE_WRITE |
+	unix.IN_MOVED_TO | unix.IN_MOVED_FROM | unix.IN_DELETE
+
+// inotifyPollTimeoutMillis はコンテキストのキャンセルを確認する間隔
+const inotifyPollTimeoutMillis = 200
+
+// inotifySource はディレクトリ1つ分のinotifyを表す
+type inotifySource struct {
+	fd int
+}
+
+// newInotifySource は指定したディレクトリを監視するinotifyを作成する
+func newInotifySource(dir string) (*inotifySource, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize inotify: %w", err)
+	}
+	if _, err := unix.InotifyAddWatch(fd, dir, inotifyWatchMask); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to watch directory %s: %w", dir, err)
+	}
+	return &inotifySource{fd: fd}, nil
+}
+
+// run はinotifyのイベントを読み取り、out に送る
+// コンテキストがキャンセルされるか読み取りに失敗すると、inotifyと out を閉じて終了する
+// inotifyは読み取りを行うこのゴルーチンだけが閉じる (読み取り中に閉じると、再利用された番号の別のファイルを読みかねない)
+func (s *inotifySource) run(ctx context.Context, out chan<- watchEvent) {
+	defer close(out)
+	defer unix.Close(s.fd)
+
+	buf := make([]byte, 64*(unix.SizeofInotifyEvent+unix.NAME_MAX+1))
+	fds := []unix.PollFd{{Fd: int32(s.fd), Events: unix.POLLIN}}
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+		n, err := unix.Poll(fds, inotifyPollTimeoutMillis)
+		if err != nil {
+			if errors.Is(err, unix.EINTR) {
+				continue
+			}
+			return
+		}
+		if n == 0 {
+			continue
+		}
+
+		n, err = unix.Read(s.fd, buf)
+		if err != nil {
+			if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+				continue
+			}
+			return
+		}
+		for _, ev := range parseInotifyEvents(buf[:n]) {
+			select {
+			case out <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// parseInotifyEvents はinotifyから読み取ったバイト列をイベントに変換する
+func parseInotifyEvents(buf []byte) []watchEvent {
+	var events []watchEvent
+	for offset := 0; offset+unix.SizeofInotifyEvent <= len(buf); {
+		mask := binary.NativeEndian.Uint32(buf[offset+4 : offset+8])
+		nameLen := int(binary.NativeEndian.Uint32(buf[offset+12 : offset+16]))
+		nameStart := offset + unix.SizeofInotifyEvent
+		if nameStart+nameLen > len(buf) {
+			break
+		}
+		name := string(bytes.TrimRight(buf[nameStart:nameStart+nameLen], "\x00"))
+		offset = nameStart + nameLen
+
+		switch {
+		case mask&unix.IN_Q_OVERFLOW != 0:
+			events = append(events, watchEvent{overflow: true})
+		case name == "":
+			// ディレクトリ自体のイベントは無視する
+		case mask&(unix.IN_DELETE|unix.IN_MOVED_FROM) != 0:
+			events = append(events, watchEvent{name: name, removed: true})
+		case mask&unix.IN_MOVED_TO != 0:
+			events = append(events, watchEvent{name: name, renamed: true})
+		default:
+			events = append(events, watchEvent{name: name})
+		}
+	}
+	return events
+}
@@ -0,0 +1,17 @@
+//go:build !linux
+
+package agent
+
+import "context"
+
+// inotifySource はinotifyを利用できない環境でのスタブ
+type inotifySource struct{}
+
+// newInotifySource はinotifyを利用できない環境では常にエラーを返す
+func newInotifySource(dir string) (*inotifySource, error) {
+	return nil, errInotifyUnsupported
+}
+
+func (s *inotifySource) run(ctx context.Context, out chan<- watchEvent) {
+	close(out)
+}
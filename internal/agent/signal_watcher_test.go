@@ -0,0 +1,218 @@
+package agent
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// startTestWatcher はテスト用の短い間隔でウォッチャーを起動する
+func startTestWatcher(t *testing.T, pattern string, mode SignalWatchMode) *SignalWatcher {
+	t.Helper()
+	w := NewSignalWatcher(SignalWatcherConfig{
+		Pattern:      pattern,
+		Mode:         mode,
+		StableFor:    100 * time.Millisecond,
+		PollInterval: 20 * time.Millisecond,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- w.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("watcher returned error: %v", err)
+		}
+	})
+	return w
+}
+
+// waitForSignalFiles はウォッチャーから次のバッチを受け取る
+func waitForSignalFiles(t *testing.T, w *SignalWatcher, timeout time.Duration) []ReadySignalFile {
+	t.Helper()
+	select {
+	case files := <-w.Events():
+		return files
+	case <-time.After(timeout):
+		t.Fatal("timed out waiting for signal files")
+		return nil
+	}
+}
+
+// assertNoSignalFiles は指定時間内にバッチが届かないことを確認する
+func assertNoSignalFiles(t *testing.T, w *SignalWatcher, wait time.Duration) {
+	t.Helper()
+	select {
+	case files := <-w.Events():
+		t.Fatalf("expected no signal files, got %v", files)
+	case <-time.After(wait):
+	}
+}
+
+func testSignalWatcherModes(t *testing.T, fn func(t *testing.T, mode SignalWatchMode)) {
+	modes := []SignalWatchMode{SignalWatchPoll}
+	if runtime.GOOS == "linux" {
+		modes = append(modes, SignalWatchInotify)
+	}
+	for _, mode := range modes {
+		t.Run(string(mode), func(t *testing.T) { fn(t, mode) })
+	}
+}
+
+func TestSignalWatcher_RenamedFileIsReady(t *testing.T) {
+	testSignalWatcherModes(t, func(t *testing.T, mode SignalWatchMode) {
+		dir := t.TempDir()
+		w := startTestWatcher(t, filepath.Join(dir, "*.bin"), mode)
+
+		// WriteSignalFile は一時ファイルに書き込んでからリネームする
+		path := filepath.Join(dir, "signal.bin")
+		if err := WriteSignalFile(path, SignalFormatV1, time.Time{}, []*SignalRecord{{Symbol: "7203", Signal: BuySignal}}); err != nil {
+			t.Fatalf("failed to write signal file: %v", err)
+		}
+
+		files := waitForSignalFiles(t, w, 2*time.Second)
+		if len(files) != 1 || files[0].Path != path {
+			t.Fatalf("expected [%s], got %v", path, files)
+		}
+
+		// 同じ内容のままでは再通知しない
+		assertNoSignalFiles(t, w, 300*time.Millisecond)
+	})
+}
+
+func TestSignalWatcher_WaitsUntilFileIsStable(t *testing.T) {
+	testSignalWatcherModes(t, func(t *testing.T, mode SignalWatchMode) {
+		dir := t.TempDir()
+		w := startTestWatcher(t, filepath.Join(dir, "*.bin"), mode)
+
+		// シグナルメーカーが書き込み中のファイルを模擬する
+		path := filepath.Join(dir, "signal.bin")
+		f, err := os.Create(path)
+		if err != nil {
+			t.Fatalf("failed to create signal file: %v", err)
+		}
+		for i := 0; i < 5; i++ {
+			if _, err := f.Write([]byte{0x1C, 0x23, 0x01}); err != nil {
+				t.Fatalf("failed to write signal file: %v", err)
+			}
+			select {
+			case files := <-w.Events():
+				t.Fatalf("file reported before writing finished: %v", files)
+			case <-time.After(40 * time.Millisecond):
+			}
+		}
+		f.Close()
+
+		files := waitForSignalFiles(t, w, 2*time.Second)
+		if len(files) != 1 || files[0].Size != 15 {
+			t.Fatalf("expected complete 15 byte file, got %v", files)
+		}
+	})
+}
+
+func TestSignalWatcher_IgnoresTemporaryAndUnmatchedFiles(t *testing.T) {
+	testSignalWatcherModes(t, func(t *testing.T, mode SignalWatchMode) {
+		dir := t.TempDir()
+		w := startTestWatcher(t, filepath.Join(dir, "*.bin"), mode)
+
+		for _, name := range []string{".signal.bin", "signal.bin.tmp-123", "notes.txt"} {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644); err != nil {
+				t.Fatalf("failed to write %s: %v", name, err)
+			}
+		}
+		assertNoSignalFiles(t, w, 400*time.Millisecond)
+	})
+}
+
+func TestSignalWatcher_ReportsExistingFilesOldestFirst(t *testing.T) {
+	testSignalWatcherModes(t, func(t *testing.T, mode SignalWatchMode) {
+		dir := t.TempDir()
+		now := time.Now()
+		names := map[string]time.Duration{
+			"b.bin": -3 * time.Minute,
+			"a.bin": -1 * time.Minute,
+			"c.bin": -2 * time.Minute,
+		}
+		for name, age := range names {
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte{0x1C, 0x23, 0x01}, 0644); err != nil {
+				t.Fatalf("failed to write %s: %v", name, err)
+			}
+			if err := os.Chtimes(path, now.Add(age), now.Add(age)); err != nil {
+				t.Fatalf("failed to set mtime of %s: %v", name, err)
+			}
+		}
+
+		w := startTestWatcher(t, filepath.Join(dir, "*.bin"), mode)
+
+		files := waitForSignalFiles(t, w, 2*time.Second)
+		var got []string
+		for _, f := range files {
+			got = append(got, filepath.Base(f.Path))
+		}
+		want := []string{"b.bin", "c.bin", "a.bin"}
+		if len(got) != len(want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("expected %v, got %v", want, got)
+			}
+		}
+	})
+}
+
+func TestSignalWatcher_InotifyRejectsWildcardDirectory(t *testing.T) {
+	w := NewSignalWatcher(SignalWatcherConfig{
+		Pattern: filepath.Join(t.TempDir(), "*", "*.bin"),
+		Mode:    SignalWatchInotify,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	if err := w.Run(context.Background()); err == nil {
+		t.Fatal("expected error for wildcard directory in inotify mode")
+	}
+}
+
+func TestAgent_ProcessSignalFilesInWatcherOrder(t *testing.T) {
+	tmpDir := t.TempDir()
+	tradeService := newFakeTradeService()
+	tradeService.prices["7203"] = 2500
+	signalRepo := newFakeSignalRepository()
+
+	a := newTestAgent(filepath.Join(tmpDir, "*.bin"), tradeService, signalRepo)
+	a.state.UpdateBalance(testBalance(1000000))
+
+	older := filepath.Join(tmpDir, "older.bin")
+	newer := filepath.Join(tmpDir, "newer.bin")
+	writeV2SignalFileForTest(t, older, []*SignalRecord{{Symbol: "7203", Signal: BuySignal, LimitPrice: 2400}})
+	writeV2SignalFileForTest(t, newer, []*SignalRecord{{Symbol: "7203", Signal: BuySignal, LimitPrice: 2600}})
+
+	files := []ReadySignalFile{
+		{Path: newer, ModTime: time.Now()},
+		{Path: older, ModTime: time.Now().Add(-time.Minute)},
+	}
+	SortReadySignalFiles(files)
+	a.processSignalFiles(files)
+
+	// 同じ取引日・銘柄・売買区分のシグナルは古いファイルのものが採用される
+	requests := tradeService.placedRequests()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 order, got %d", len(requests))
+	}
+	if requests[0].Price != 2400 {
+		t.Errorf("expected order from older file (2400), got %v", requests[0].Price)
+	}
+}
+
+func writeV2SignalFileForTest(t *testing.T, path string, records []*SignalRecord) {
+	t.Helper()
+	if err := WriteSignalFile(path, SignalFormatV2, time.Now(), records); err != nil {
+		t.Fatalf("failed to write signal file: %v", err)
+	}
+}
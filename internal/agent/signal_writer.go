@@ -0,0 +1,109 @@
+package agent
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// EncodeSignalsV1 は v1 フォーマットでシグナルを書き出す
+// v1 は数値の銘柄コード (0-65535) と売買区分のみを表現できる
+func EncodeSignalsV1(w io.Writer, records []*SignalRecord) error {
+	buf := new(bytes.Buffer)
+	for _, rec := range records {
+		symbol, err := strconv.ParseUint(rec.Symbol, 10, 16)
+		if err != nil {
+			return fmt.Errorf("symbol %q cannot be represented in v1 format: %w", rec.Symbol, err)
+		}
+		if err := binary.Write(buf, binary.LittleEndian, uint16(symbol)); err != nil {
+			return err
+		}
+		if err := binary.Write(buf, binary.LittleEndian, rec.Signal); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// EncodeSignalsV2 は v2 フォーマットでシグナルを書き出す
+func EncodeSignalsV2(w io.Writer, generatedAt time.Time, records []*SignalRecord) error {
+	body := new(bytes.Buffer)
+	for _, rec := range records {
+		if err := ValidateSignalSymbol(rec.Symbol); err != nil {
+			return err
+		}
+		if rec.Signal != BuySignal && rec.Signal != SellSignal {
+			return fmt.Errorf("invalid trade signal value for symbol %s: 0x%02x", rec.Symbol, rec.Signal)
+		}
+		fixed := signalV2Fixed{
+			Signal:      rec.Signal,
+			LimitPrice:  rec.LimitPrice,
+			StopPrice:   rec.StopPrice,
+			TargetPrice: rec.TargetPrice,
+			Weight:      rec.Weight,
+		}
+		if !rec.ValidUntil.IsZero() {
+			fixed.ValidUntil = rec.ValidUntil.UnixMilli()
+		}
+		body.WriteByte(byte(len(rec.Symbol)))
+		body.WriteString(rec.Symbol)
+		if err := binary.Write(body, binary.LittleEndian, fixed); err != nil {
+			return err
+		}
+	}
+
+	header := signalV2Header{
+		Magic:       SignalFileMagic,
+		Version:     SignalFormatV2,
+		GeneratedAt: generatedAt.UnixMilli(),
+		RecordCount: uint32(len(records)),
+	}
+	header.CRC32 = header.checksum(body.Bytes())
+	if err := binary.Write(w, binary.LittleEndian, header); err != nil {
+		return err
+	}
+	_, err := w.Write(body.Bytes())
+	return err
+}
+
+// WriteSignalFile はシグナルファイルを指定フォーマットで書き出す
+// 書き込み途中のファイルをエージェントが読まないよう、一時ファイルに書き出してからリネームする
+func WriteSignalFile(path string, version int, generatedAt time.Time, records []*SignalRecord) error {
+	buf := new(bytes.Buffer)
+	switch version {
+	case SignalFormatV1:
+		if err := EncodeSignalsV1(buf, records); err != nil {
+			return err
+		}
+	case SignalFormatV2:
+		if err := EncodeSignalsV2(buf, generatedAt, records); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported signal file version: %d", version)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary signal file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // リネーム成功後は存在しないため、失敗時の後始末のみ
+
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write temporary signal file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary signal file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to rename signal file to %s: %w", path, err)
+	}
+	return nil
+}
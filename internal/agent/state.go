@@ -1,14 +1,28 @@
 package agent
 
 import (
+	"slices"
 	"stock-bot/domain/model"
 	"sync"
 )
 
 // Balance は口座残高の情報を保持する
+// 可能額サマリーの値は HTTP API の残高と同じ model.AccountBalance を使う
 type Balance struct {
-	Cash        float64 // 現金残高
-	BuyingPower float64 // 買付余力
+	model.AccountBalance
+	// BuyingPowerByDay は営業日ごとの現物買付可能額 (添字 0 が T+0)
+	// 受渡前の売却代金や買付代金は営業日によって買付可能額に反映されたりされなかったりする
+	BuyingPowerByDay []float64
+}
+
+// SizingBuyingPower は発注数量の計算に使う買付余力を返す
+// 未受渡の資金を使い過ぎないよう、T+0..T+N の買付可能額のうち最も小さい値を使う
+func (b *Balance) SizingBuyingPower() float64 {
+	buyingPower := b.CashBuyingPower
+	for _, v := range b.BuyingPowerByDay {
+		buyingPower = min(buyingPower, v)
+	}
+	return max(buyingPower, 0)
 }
 
 // State はエージェントの内部状態を管理する
@@ -81,6 +95,23 @@ func (s *State) AddOrder(order *model.Order) {
 	s.orders[order.OrderID] = order
 }
 
+// HasPendingOrder は指定した銘柄・売買区分の未約定(発注中・一部約定)注文があるかどうかを返す
+// 発注直後で約定前の注文がある間に、同じシグナルで重複して発注しないために使用する
+func (s *State) HasPendingOrder(symbol string, tradeType model.TradeType) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, o := range s.orders {
+		if o.Symbol != symbol || o.TradeType != tradeType {
+			continue
+		}
+		if !o.OrderStatus.IsTerminal() {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateBalance は口座残高の情報を更新する
 func (s *State) UpdateBalance(balance *Balance) {
 	s.mutex.Lock()
@@ -94,6 +125,7 @@ func (s *State) GetBalance() *Balance {
 	defer s.mutex.RUnlock()
 	// 読み取り専用で返すためにコピーを返す
 	b := *s.balance
+	b.BuyingPowerByDay = slices.Clone(s.balance.BuyingPowerByDay)
 	return &b
 }
 
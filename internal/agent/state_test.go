@@ -89,23 +89,43 @@ func TestState_Balance(t *testing.T) {
 	// 初期状態の確認 (0値の構造体)
 	balance := state.GetBalance()
 	assert.NotNil(t, balance)
-	assert.Equal(t, 0.0, balance.Cash)
-	assert.Equal(t, 0.0, balance.BuyingPower)
+	assert.Equal(t, 0.0, balance.WithdrawableCash)
+	assert.Equal(t, 0.0, balance.CashBuyingPower)
 
 	// 残高の更新
-	newBalance := &agent.Balance{Cash: 1000000, BuyingPower: 500000}
+	newBalance := &agent.Balance{AccountBalance: model.AccountBalance{WithdrawableCash: 1000000, CashBuyingPower: 500000}}
 	state.UpdateBalance(newBalance)
 
 	// 更新後の取得確認
 	updatedBalance := state.GetBalance()
 	assert.NotNil(t, updatedBalance)
-	assert.Equal(t, 1000000.0, updatedBalance.Cash)
-	assert.Equal(t, 500000.0, updatedBalance.BuyingPower)
+	assert.Equal(t, 1000000.0, updatedBalance.WithdrawableCash)
+	assert.Equal(t, 500000.0, updatedBalance.CashBuyingPower)
 
 	// GetBalanceがコピーを返すかの確認
-	updatedBalance.Cash = 999 // 取得したものを変更してみる
+	updatedBalance.WithdrawableCash = 999 // 取得したものを変更してみる
 	originalBalance := state.GetBalance()
-	assert.Equal(t, 1000000.0, originalBalance.Cash) // 元の値は変わらないはず
+	assert.Equal(t, 1000000.0, originalBalance.WithdrawableCash) // 元の値は変わらないはず
+
+	// 営業日ごとの買付可能額もコピーされる
+	state.UpdateBalance(&agent.Balance{AccountBalance: model.AccountBalance{CashBuyingPower: 500000}, BuyingPowerByDay: []float64{500000, 300000}})
+	byDay := state.GetBalance().BuyingPowerByDay
+	byDay[0] = 0
+	assert.Equal(t, []float64{500000, 300000}, state.GetBalance().BuyingPowerByDay)
+}
+
+func TestBalance_SizingBuyingPower(t *testing.T) {
+	// 営業日ごとの買付可能額がない場合はサマリーの買付余力を使う
+	assert.Equal(t, 500000.0, (&agent.Balance{AccountBalance: model.AccountBalance{CashBuyingPower: 500000}}).SizingBuyingPower())
+
+	// 未受渡の買付代金で後の営業日の買付可能額が小さい場合はその値を使う
+	assert.Equal(t, 300000.0, (&agent.Balance{AccountBalance: model.AccountBalance{CashBuyingPower: 500000}, BuyingPowerByDay: []float64{500000, 450000, 300000}}).SizingBuyingPower())
+
+	// 未受渡の売却代金で後の営業日が大きくても当日の値を超えない
+	assert.Equal(t, 500000.0, (&agent.Balance{AccountBalance: model.AccountBalance{CashBuyingPower: 500000}, BuyingPowerByDay: []float64{500000, 800000}}).SizingBuyingPower())
+
+	// マイナスの場合は 0
+	assert.Equal(t, 0.0, (&agent.Balance{AccountBalance: model.AccountBalance{CashBuyingPower: 500000}, BuyingPowerByDay: []float64{-10000}}).SizingBuyingPower())
 }
 
 func TestState_ThreadSafety(t *testing.T) {
@@ -120,7 +140,7 @@ func TestState_ThreadSafety(t *testing.T) {
 			defer wg.Done()
 			
 			// 書き込み
-			state.UpdateBalance(&agent.Balance{Cash: float64(i), BuyingPower: float64(i)})
+			state.UpdateBalance(&agent.Balance{AccountBalance: model.AccountBalance{WithdrawableCash: float64(i), CashBuyingPower: float64(i)}})
 			
 			positions := []*model.Position{
 				{Symbol: "7203", Quantity: i},
@@ -139,3 +159,17 @@ func TestState_ThreadSafety(t *testing.T) {
 	// ここでは単純にパニックが起きずに終了することを確認
 	t.Log("Thread safety test completed without panic.")
 }
+
+func TestState_HasPendingOrder(t *testing.T) {
+	state := agent.NewState()
+	assert.False(t, state.HasPendingOrder("7203", model.TradeTypeBuy))
+
+	state.UpdateOrders([]*model.Order{
+		{OrderID: "order-001", Symbol: "7203", TradeType: model.TradeTypeBuy, OrderStatus: model.OrderStatusPartiallyFilled},
+		{OrderID: "order-002", Symbol: "9984", TradeType: model.TradeTypeSell, OrderStatus: model.OrderStatusFilled},
+	})
+
+	assert.True(t, state.HasPendingOrder("7203", model.TradeTypeBuy))
+	assert.False(t, state.HasPendingOrder("7203", model.TradeTypeSell)) // 売買区分が異なる
+	assert.False(t, state.HasPendingOrder("9984", model.TradeTypeSell)) // 約定済み
+}
@@ -3,6 +3,8 @@ package agent
 import (
 	"context"
 	"stock-bot/domain/model"
+	"stock-bot/internal/infrastructure/client"
+	"time"
 )
 
 // TradeService はエージェントがトレードサービス（Go APIラッパー）と連携するためのインターフェース
@@ -29,4 +31,18 @@ type PlaceOrderRequest struct {
 	OrderType model.OrderType
 	Quantity  int
 	Price     float64 // 指値の場合のみ
+	SignalID  *uint   // 発注の契機となったシグナルのID (シグナル起因でない場合はnil)
+	// TradingUnit は売買単位。売り注文の数量を売却可能数量に抑える際に単元未満を切り捨てるために使用する (0 の場合は切り捨てない)
+	TradingUnit int
+}
+
+// TickRounder は価格を銘柄・日付に応じた呼値の単位に丸める
+type TickRounder interface {
+	RoundPrice(ctx context.Context, symbol string, date time.Time, price float64, rounding model.TickRounding) (float64, error)
+}
+
+// SellReserver は売り注文の数量を売却可能数量に抑え、発注が終わるまで予約する (app.SellQuantityReserver が実装する)
+// 予約した数量と、発注後に呼び出す関数 (発注できた場合は placed に true を渡す) を返す
+type SellReserver interface {
+	Reserve(ctx context.Context, session *client.Session, symbol string, quantity int, tradingUnit int) (int, func(placed bool), error)
 }
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"fmt"
+	"stock-bot/domain/model"
+	"stock-bot/internal/infrastructure/client/dto/balance/response"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseAccountBalance converts the balance summary (CLMZanKaiSummary) into an AccountBalance.
+// It is the only parser of the summary, shared by the HTTP API and the agent.
+//
+// The summary is parsed strictly: an error result code, a missing or malformed
+// buying power, maintenance rate or withdrawable cash, and an unknown flag value are all errors,
+// because sizing orders on a silently zeroed balance is worse than skipping them.
+func ParseAccountBalance(summary *response.ResZanKaiSummary) (*model.AccountBalance, error) {
+	if summary == nil {
+		return nil, fmt.Errorf("balance summary is nil")
+	}
+	// The API returns "0" for success. Any other code is an error.
+	if summary.ResultCode != "0" {
+		return nil, fmt.Errorf("client returned error: result_code=%s, text=%s", summary.ResultCode, summary.ResultText)
+	}
+
+	p := summaryParser{}
+	balance := &model.AccountBalance{
+		CashBuyingPower:        p.required("GenbutuKabuKaituke", summary.GenbutuKabuKaituke),
+		MarginBuyingPower:      p.required("SinyouSinkidate", summary.SinyouSinkidate),
+		MarginDeliveryCapacity: p.optional("SinyouGenbiki", summary.SinyouGenbiki),
+		MaintenanceRate:        p.required("HosyouKinritu", summary.HosyouKinritu),
+		WithdrawableCash:       p.required("Syukkin", summary.Syukkin),
+		NisaGrowthCapacity:     p.optional("NseityouTousiKanougaku", summary.NseityouTousiKanougaku),
+		Shortfall:              p.optional("Fusokugaku", summary.Fusokugaku),
+		HasMarginCall:          p.flag("OisyouHasseiFlg", summary.OisyouHasseiFlg),
+		HasAdvance:             p.flag("TatekaekinHasseiFlg", summary.TatekaekinHasseiFlg),
+	}
+	if summary.UpdateDate != "" {
+		updatedAt, err := time.ParseInLocation("200601021504", summary.UpdateDate, marketLocation())
+		if err != nil && p.err == nil {
+			p.err = fmt.Errorf("failed to parse UpdateDate '%s': %w", summary.UpdateDate, err)
+		}
+		balance.UpdatedAt = updatedAt
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return balance, nil
+}
+
+// summaryParser keeps the first parse error so that the fields can be parsed in a single literal.
+type summaryParser struct {
+	err error
+}
+
+// required parses a value that the summary always reports.
+func (p *summaryParser) required(name, s string) float64 {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil && p.err == nil {
+		p.err = fmt.Errorf("failed to parse %s '%s': %w", name, s, err)
+	}
+	return v
+}
+
+// optional parses a value that is empty for accounts without the service (e.g. NISA).
+func (p *summaryParser) optional(name, s string) float64 {
+	if s == "" {
+		return 0
+	}
+	return p.required(name, s)
+}
+
+// detail parses a value of the balance detail APIs, which may contain thousands separators.
+// Empty values and "-" (not applicable, e.g. no margin positions) are treated as 0.
+func (p *summaryParser) detail(name, s string) float64 {
+	s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+	if s == "" || s == "-" {
+		return 0
+	}
+	return p.required(name, s)
+}
+
+// flag parses a "1"/"0" flag. An empty flag is treated as "0".
+func (p *summaryParser) flag(name, s string) bool {
+	switch s {
+	case "1":
+		return true
+	case "0", "":
+		return false
+	}
+	if p.err == nil {
+		p.err = fmt.Errorf("failed to parse %s '%s': unknown flag value", name, s)
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"stock-bot/domain/model"
+	"stock-bot/internal/infrastructure/client"
+	"sync"
+	"time"
+)
+
+// ErrMarginEntryBlocked is returned when new margin positions are not allowed because the
+// maintenance rate (委託保証金率) is close to the margin-call level.
+var ErrMarginEntryBlocked = errors.New("new margin entries are blocked")
+
+// MarginEntryGuard reports whether new margin positions may be opened.
+type MarginEntryGuard interface {
+	// CheckMarginEntry returns an error wrapping ErrMarginEntryBlocked when new margin positions must not be opened.
+	CheckMarginEntry() error
+}
+
+// BalanceMonitor takes balance snapshots at a fixed interval and watches the maintenance rate.
+// When the account has a margin call or the rate of an account with margin positions falls below
+// the alert rate, it logs an alert and blocks new margin entries until the rate recovers.
+type BalanceMonitor struct {
+	balanceUseCase BalanceUseCase
+	session        *client.Session
+	interval       time.Duration
+	alertRate      float64
+
+	mu     sync.Mutex
+	latest *model.BalanceSnapshot // the last successful snapshot (nil until the first one)
+	alert  bool
+}
+
+// NewBalanceMonitor creates a monitor that takes a snapshot every interval and alerts below alertRate (%).
+// An alertRate of 0 disables the alerts except for margin calls reported by the broker.
+func NewBalanceMonitor(balanceUseCase BalanceUseCase, session *client.Session, interval time.Duration, alertRate float64) (*BalanceMonitor, error) {
+	if interval <= 0 {
+		return nil, errors.New("balance snapshot interval must be positive")
+	}
+	if alertRate < 0 {
+		return nil, fmt.Errorf("margin alert rate must not be negative: %v", alertRate)
+	}
+	return &BalanceMonitor{
+		balanceUseCase: balanceUseCase,
+		session:        session,
+		interval:       interval,
+		alertRate:      alertRate,
+	}, nil
+}
+
+// Run takes a snapshot immediately and then every interval until the context is canceled.
+// A failed snapshot is logged and retried at the next interval; the last known state is kept meanwhile.
+func (m *BalanceMonitor) Run(ctx context.Context) {
+	runPeriodically(ctx, m.interval, func(ctx context.Context) {
+		if _, err := m.Check(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("Balance snapshot failed", "error", err)
+		}
+	})
+}
+
+// Check takes a snapshot and updates the alert state.
+func (m *BalanceMonitor) Check(ctx context.Context) (*model.BalanceSnapshot, error) {
+	snapshot, err := m.balanceUseCase.SnapshotBalance(ctx, m.session)
+	if err != nil {
+		return nil, err
+	}
+	alert := snapshot.BelowMaintenanceRate(m.alertRate)
+
+	m.mu.Lock()
+	wasAlert := m.alert
+	m.latest = snapshot
+	m.alert = alert
+	m.mu.Unlock()
+
+	// alerts are logged on state changes so that a long drawdown does not flood the log
+	switch {
+	case alert && !wasAlert:
+		slog.Error("Margin maintenance rate alert: new margin entries are blocked",
+			"maintenance_rate", snapshot.MaintenanceRate, "alert_rate", m.alertRate,
+			"has_margin_call", snapshot.HasMarginCall, "margin_call_surplus", snapshot.MarginCallSurplus)
+	case !alert && wasAlert:
+		slog.Info("Margin maintenance rate recovered: new margin entries are allowed",
+			"maintenance_rate", snapshot.MaintenanceRate, "alert_rate", m.alertRate)
+	default:
+		slog.Debug("Balance snapshot taken", "maintenance_rate", snapshot.MaintenanceRate, "position_value", snapshot.PositionValue)
+	}
+	return snapshot, nil
+}
+
+// CheckMarginEntry implements MarginEntryGuard.
+// New margin entries are allowed until the first snapshot has been taken.
+func (m *BalanceMonitor) CheckMarginEntry() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.alert {
+		return nil
+	}
+	if m.latest.HasMarginCall {
+		return fmt.Errorf("%w: the account has a margin call", ErrMarginEntryBlocked)
+	}
+	return fmt.Errorf("%w: maintenance rate %.2f%% is below %.2f%%", ErrMarginEntryBlocked, m.latest.MaintenanceRate, m.alertRate)
+}
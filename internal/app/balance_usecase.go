@@ -2,20 +2,83 @@ package app
 
 import (
 	"context"
+	"stock-bot/domain/model"
 	"stock-bot/internal/infrastructure/client"
+	"time"
 )
 
-// BalanceResult is the result type for the GetBalance use case.
-// It contains curated balance information.
-type BalanceResult struct {
-	AvailableCashForStock         float64
-	AvailableMarginForNewPosition float64
-	MarginMaintenanceRate         float64
-	WithdrawableCash              float64
-	HasMarginCall                 bool
+// BalanceDay is the balance of a business day reported by the broker (可能額推移).
+type BalanceDay struct {
+	Date              string // YYYYMMDD
+	CashBuyingPower   float64
+	MarginBuyingPower float64
+	WithdrawableCash  float64
+	DepositedMargin   float64 // 受入保証金
+	RequiredMargin    float64 // 必要保証金
+	MaintenanceRate   float64 // 委託保証金率(%)
+	MarginCallSurplus float64 // 追証余力
+	Shortfall         float64 // 追証/立替金/保証金不足額
+}
+
+// BalanceHistoryResult contains the broker's daily history of the last 6 business days
+// and the snapshots stored by the balance monitor, both newest first.
+type BalanceHistoryResult struct {
+	Daily     []*BalanceDay
+	Snapshots []*model.BalanceSnapshot
+}
+
+// MaxBuyingPowerDay is the last business-day index (T+N) the broker reports buying power details for.
+const MaxBuyingPowerDay = 5
+
+// BuyingPowerDetail is the breakdown of the cash buying power on a business day (現物株式買付可能額詳細).
+type BuyingPowerDetail struct {
+	Day                int    // business-day index (0: T+0)
+	Date               string // YYYYMMDD
+	CashBuyingPower    float64
+	MarginBuyingPower  float64 // 保証金からの現物株式買付可能額
+	DepositBalance     float64 // 預り金
+	OrderReserved      float64 // 発注済み注文充当金
+	DayTradeRestricted float64 // 日計り拘束金
+	OtherRestricted    float64 // その他拘束金
+	CashMargin         float64 // 現金保証金
+	RequiredCashMargin float64 // 必要現金保証金
+	CollateralValue    float64 // 代用証券評価額
+	PositionProfitLoss float64 // 建株評価損益
+	DepositedMargin    float64 // 受入保証金
+	RequiredMargin     float64 // 必要保証金
+	MarginSurplus      float64 // 保証金余力
+}
+
+// MarginCapacityDetail is the breakdown of the buying power for new margin positions on a business day (信用新規建可能額詳細).
+type MarginCapacityDetail struct {
+	Day                int    // business-day index (0: T+0)
+	Date               string // YYYYMMDD
+	MarginBuyingPower  float64
+	DepositedMargin    float64 // 受入保証金
+	RequiredMargin     float64 // 必要保証金
+	MarginSurplus      float64 // 保証金余力
+	CollectionRate     float64 // 保証金徴収率(%)
+	DepositBalance     float64 // 預り金
+	OrderReserved      float64 // 発注済み注文充当金
+	CashMargin         float64 // 現金保証金
+	CollateralValue    float64 // 代用証券評価額
+	PositionValue      float64 // 建株代金
+	OrderPositionValue float64 // 発注分建株代金
+	PositionProfitLoss float64 // 建株評価損益
+	MaintenanceRate    float64 // 委託保証金率(%)
+	TodayProfitLoss    float64 // 本日決済損益合計
 }
 
 // BalanceUseCase defines the interface for balance-related use cases.
 type BalanceUseCase interface {
-	GetBalance(ctx context.Context, session *client.Session) (*BalanceResult, error)
+	// GetBalance returns the balance summary parsed by ParseAccountBalance.
+	GetBalance(ctx context.Context, session *client.Session) (*model.AccountBalance, error)
+	// GetBalanceHistory returns the daily history from the broker and the stored snapshots since the given time.
+	GetBalanceHistory(ctx context.Context, session *client.Session, since time.Time, limit int) (*BalanceHistoryResult, error)
+	// SnapshotBalance fetches the balance summary and the real-time maintenance rate and stores them.
+	SnapshotBalance(ctx context.Context, session *client.Session) (*model.BalanceSnapshot, error)
+	// GetBuyingPower returns the cash buying power details of the given business day (0..MaxBuyingPowerDay).
+	GetBuyingPower(ctx context.Context, session *client.Session, day int) (*BuyingPowerDetail, error)
+	// GetMarginCapacity returns the margin buying power details of the given business day (0..MaxBuyingPowerDay).
+	GetMarginCapacity(ctx context.Context, session *client.Session, day int) (*MarginCapacityDetail, error)
 }
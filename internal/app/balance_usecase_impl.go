@@ -3,64 +3,184 @@ package app
 import (
 	"context"
 	"fmt"
+	"slices"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
 	"stock-bot/internal/infrastructure/client"
-	"strconv"
+	"stock-bot/internal/infrastructure/client/dto/balance/request"
+	"strings"
+	"time"
 )
 
 // balanceUseCaseImpl implements the BalanceUseCase interface.
 type balanceUseCaseImpl struct {
 	balanceClient client.BalanceClient
+	balanceRepo   repository.BalanceRepository
 }
 
 // NewBalanceUseCaseImpl creates a new BalanceUseCase.
-func NewBalanceUseCaseImpl(balanceClient client.BalanceClient) BalanceUseCase {
-	return &balanceUseCaseImpl{balanceClient: balanceClient}
+func NewBalanceUseCaseImpl(balanceClient client.BalanceClient, balanceRepo repository.BalanceRepository) BalanceUseCase {
+	return &balanceUseCaseImpl{balanceClient: balanceClient, balanceRepo: balanceRepo}
 }
 
 // GetBalance retrieves the account balance summary, parses it, and returns it.
-func (uc *balanceUseCaseImpl) GetBalance(ctx context.Context, session *client.Session) (*BalanceResult, error) {
-	// Call the client to get the raw summary data
+func (uc *balanceUseCaseImpl) GetBalance(ctx context.Context, session *client.Session) (*model.AccountBalance, error) {
 	summary, err := uc.balanceClient.GetZanKaiSummary(ctx, session)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get balance summary from client: %w", err)
 	}
+	return ParseAccountBalance(summary)
+}
 
-	// The API returns "0" for success. Any other code is an error.
-	if summary.ResultCode != "0" {
-		return nil, fmt.Errorf("client returned error: result_code=%s, text=%s", summary.ResultCode, summary.ResultText)
+// GetBalanceHistory returns the daily history from the broker and the stored snapshots.
+func (uc *balanceUseCaseImpl) GetBalanceHistory(ctx context.Context, session *client.Session, since time.Time, limit int) (*BalanceHistoryResult, error) {
+	res, err := uc.balanceClient.GetZanKaiKanougakuSuii(ctx, session, request.ReqZanKaiKanougakuSuii{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance history from client: %w", err)
+	}
+	if res.SResultCode != "0" {
+		return nil, fmt.Errorf("client returned error: result_code=%s, text=%s", res.SResultCode, res.SResultText)
 	}
 
-	// Parse string fields into proper types.
-	availableCashForStock, err := strconv.ParseFloat(summary.GenbutuKabuKaituke, 64)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse AvailableCashForStock '%s': %w", summary.GenbutuKabuKaituke, err)
+	p := summaryParser{}
+	daily := make([]*BalanceDay, 0, len(res.AKanougakuSuiiList))
+	for _, item := range res.AKanougakuSuiiList {
+		if item.SHituke == "" {
+			continue
+		}
+		daily = append(daily, &BalanceDay{
+			Date:              item.SHituke,
+			CashBuyingPower:   p.detail("sGenbutuKaitukeKanougaku", item.SGenbutuKaitukeKanougaku),
+			MarginBuyingPower: p.detail("sSinyouSinkidateKanougaku", item.SSinyouSinkidateKanougaku),
+			WithdrawableCash:  p.detail("sSyukkinKanougaku", item.SSyukkinKanougaku),
+			DepositedMargin:   p.detail("sUkeireHosyoukin", item.SUkeireHosyoukin),
+			RequiredMargin:    p.detail("sHituyouHosyoukin", item.SHituyouHosyoukin),
+			MaintenanceRate:   p.detail("sItakuHosyoukinRitu", item.SItakuHosyoukinRitu),
+			MarginCallSurplus: p.detail("sOisyouYoryoku", item.SOisyouYoryoku),
+			Shortfall:         p.detail("sFusokugaku", item.SFusokugaku),
+		})
+	}
+	if p.err != nil {
+		return nil, fmt.Errorf("failed to parse balance history: %w", p.err)
 	}
+	// the broker does not guarantee the order of the list
+	slices.SortFunc(daily, func(a, b *BalanceDay) int { return strings.Compare(b.Date, a.Date) })
 
-	availableMarginForNewPosition, err := strconv.ParseFloat(summary.SinyouSinkidate, 64)
+	snapshots, err := uc.balanceRepo.FindSnapshots(ctx, repository.BalanceSnapshotQuery{Since: since, Limit: limit})
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse AvailableMarginForNewPosition '%s': %w", summary.SinyouSinkidate, err)
+		return nil, fmt.Errorf("failed to find balance snapshots: %w", err)
 	}
+	return &BalanceHistoryResult{Daily: daily, Snapshots: snapshots}, nil
+}
 
-	marginMaintenanceRate, err := strconv.ParseFloat(summary.HosyouKinritu, 64)
+// SnapshotBalance fetches the balance summary and the real-time maintenance rate and stores them.
+// The maintenance rate of the summary is only updated when positions are marked to market,
+// so the real-time rate is stored instead.
+func (uc *balanceUseCaseImpl) SnapshotBalance(ctx context.Context, session *client.Session) (*model.BalanceSnapshot, error) {
+	balance, err := uc.GetBalance(ctx, session)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse MarginMaintenanceRate '%s': %w", summary.HosyouKinritu, err)
+		return nil, err
 	}
-
-	withdrawableCash, err := strconv.ParseFloat(summary.Syukkin, 64)
+	rate, err := uc.balanceClient.GetZanRealHosyoukinRitu(ctx, session, request.ReqZanRealHosyoukinRitu{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse WithdrawableCash '%s': %w", summary.Syukkin, err)
+		return nil, fmt.Errorf("failed to get real-time maintenance rate from client: %w", err)
+	}
+	if rate.SResultCode != "0" {
+		return nil, fmt.Errorf("client returned error: result_code=%s, text=%s", rate.SResultCode, rate.SResultText)
 	}
 
-	hasMarginCall := summary.OisyouHasseiFlg == "1"
+	// a malformed rate must not be stored as 0, which would pass the margin checks
+	p := summaryParser{}
+	snapshot := &model.BalanceSnapshot{
+		TakenAt:             time.Now(),
+		CashBuyingPower:     balance.CashBuyingPower,
+		MarginBuyingPower:   balance.MarginBuyingPower,
+		WithdrawableCash:    balance.WithdrawableCash,
+		DepositedMargin:     p.detail("sUkeireHosyoukin", rate.SUkeireHosyoukin),
+		PositionValue:       p.detail("sTateKabuDaikin", rate.STateKabuDaikin),
+		ValuationProfitLoss: p.detail("sHyoukaSonEki", rate.SHyoukaSonEki),
+		MaintenanceRate:     p.detail("sItakuHosyoukinRitu", rate.SItakuHosyoukinRitu),
+		MarginCallSurplus:   p.detail("sOisyouYoryoku", rate.SOisyouYoryoku),
+		HasMarginCall:       balance.HasMarginCall,
+	}
+	if p.err != nil {
+		return nil, fmt.Errorf("failed to parse real-time maintenance rate: %w", p.err)
+	}
+	if err := uc.balanceRepo.SaveSnapshot(ctx, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to save balance snapshot: %w", err)
+	}
+	return snapshot, nil
+}
 
-	// Create and return the result struct
-	result := &BalanceResult{
-		AvailableCashForStock:         availableCashForStock,
-		AvailableMarginForNewPosition: availableMarginForNewPosition,
-		MarginMaintenanceRate:         marginMaintenanceRate,
-		WithdrawableCash:              withdrawableCash,
-		HasMarginCall:                 hasMarginCall,
+// GetBuyingPower returns the cash buying power details of the given business day.
+func (uc *balanceUseCaseImpl) GetBuyingPower(ctx context.Context, session *client.Session, day int) (*BuyingPowerDetail, error) {
+	if day < 0 || day > MaxBuyingPowerDay {
+		return nil, fmt.Errorf("business day index must be between 0 and %d: %d", MaxBuyingPowerDay, day)
 	}
+	res, err := uc.balanceClient.GetZanKaiGenbutuKaitukeSyousai(ctx, session, day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get buying power details from client: %w", err)
+	}
+	if res.ResultCode != "0" {
+		return nil, fmt.Errorf("client returned error: result_code=%s, text=%s", res.ResultCode, res.ResultText)
+	}
+	p := summaryParser{}
+	detail := &BuyingPowerDetail{
+		Day:                day,
+		Date:               res.Hituke,
+		CashBuyingPower:    p.detail("sGenbutuKaitukeKanougaku", res.GenbutuKaitukeKanougaku),
+		MarginBuyingPower:  p.detail("sHosyoukinGenbutuKaitukeKanouga", res.HosyoukinGenbutuKaitukeKanouga),
+		DepositBalance:     p.detail("sAzukariKin", res.AzukariKin),
+		OrderReserved:      p.detail("sHattyuZyutoukin", res.HattyuZyutoukin),
+		DayTradeRestricted: p.detail("sHibakariKousokukin", res.HibakariKousokukin),
+		OtherRestricted:    p.detail("sSonotaKousokukin", res.SonotaKousokukin),
+		CashMargin:         p.detail("sGenkinHosyoukin", res.GenkinHosyoukin),
+		RequiredCashMargin: p.detail("sHituyouGenkinHosyoukin", res.HituyouGenkinHosyoukin),
+		CollateralValue:    p.detail("sDaiyouHyoukagaku", res.DaiyouHyoukagaku),
+		PositionProfitLoss: p.detail("sTatekabuHyoukaSoneki", res.TatekabuHyoukaSoneki),
+		DepositedMargin:    p.detail("sUkeireHosyoukin", res.UkeireHosyoukin),
+		RequiredMargin:     p.detail("sHituyouHosyoukin", res.HituyouHosyoukin),
+		MarginSurplus:      p.detail("sHosyoukinYoryoku", res.HosyoukinYoryoku),
+	}
+	if p.err != nil {
+		return nil, fmt.Errorf("failed to parse buying power details: %w", p.err)
+	}
+	return detail, nil
+}
 
-	return result, nil
+// GetMarginCapacity returns the margin buying power details of the given business day.
+func (uc *balanceUseCaseImpl) GetMarginCapacity(ctx context.Context, session *client.Session, day int) (*MarginCapacityDetail, error) {
+	if day < 0 || day > MaxBuyingPowerDay {
+		return nil, fmt.Errorf("business day index must be between 0 and %d: %d", MaxBuyingPowerDay, day)
+	}
+	res, err := uc.balanceClient.GetZanKaiSinyouSinkidateSyousai(ctx, session, day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get margin capacity details from client: %w", err)
+	}
+	if res.SResultCode != "0" {
+		return nil, fmt.Errorf("client returned error: result_code=%s, text=%s", res.SResultCode, res.SResultText)
+	}
+	p := summaryParser{}
+	detail := &MarginCapacityDetail{
+		Day:                day,
+		Date:               res.SHituke,
+		MarginBuyingPower:  p.detail("sSinyouSinkidateKanougaku", res.SSinyouSinkidateKanougaku),
+		DepositedMargin:    p.detail("sUkeireHosyoukin", res.SUkeireHosyoukin),
+		RequiredMargin:     p.detail("sHituyouHosyoukin", res.SHituyouHosyoukin),
+		MarginSurplus:      p.detail("sHosyoukinYoryoku", res.SHosyoukinYoryoku),
+		CollectionRate:     p.detail("sHosyoukinTyousyuRitu", res.SHosyoukinTyousyuRitu),
+		DepositBalance:     p.detail("sAzukariKin", res.SAzukariKin),
+		OrderReserved:      p.detail("sHattyuZyutoukin", res.SHattyuZyutoukin),
+		CashMargin:         p.detail("sGenkinHosyoukin", res.SGenkinHosyoukin),
+		CollateralValue:    p.detail("sDaiyouHyoukagaku", res.SDaiyouHyoukagaku),
+		PositionValue:      p.detail("sMikessaiTateDaikin", res.SMikessaiTateDaikin),
+		OrderPositionValue: p.detail("sHattyuTateDaikin", res.SHattyuTateDaikin),
+		PositionProfitLoss: p.detail("sTatekabuHyoukaSoneki", res.STatekabuHyoukaSoneki),
+		MaintenanceRate:    p.detail("sItakuHosyoukinRitu", res.SItakuHosyoukinRitu),
+		TodayProfitLoss:    p.detail("sKessaiTotalToday", res.SKessaiTotalToday),
+	}
+	if p.err != nil {
+		return nil, fmt.Errorf("failed to parse margin capacity details: %w", p.err)
+	}
+	return detail, nil
 }
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// DailyReportScheduler generates the daily report every weekday at a fixed time of day in the market
+// timezone, e.g. after the market closes. A report of a holiday is generated as well and is simply empty.
+type DailyReportScheduler struct {
+	reportUseCase ReportUseCase
+	hour, minute  int
+	location      *time.Location
+}
+
+// NewDailyReportScheduler creates a scheduler that generates the report every weekday at the given
+// time of day (HH:MM, market timezone).
+func NewDailyReportScheduler(reportUseCase ReportUseCase, at string) (*DailyReportScheduler, error) {
+	t, err := time.Parse("15:04", at)
+	if err != nil {
+		return nil, fmt.Errorf("invalid report time %q: %w", at, err)
+	}
+	return &DailyReportScheduler{
+		reportUseCase: reportUseCase,
+		hour:          t.Hour(),
+		minute:        t.Minute(),
+		location:      marketLocation(),
+	}, nil
+}
+
+// NextRun returns the next scheduled report time after now.
+func (s *DailyReportScheduler) NextRun(now time.Time) time.Time {
+	local := now.In(s.location)
+	next := time.Date(local.Year(), local.Month(), local.Day(), s.hour, s.minute, 0, 0, s.location)
+	if !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	for next.Weekday() == time.Saturday || next.Weekday() == time.Sunday {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// Run generates the report of the day at each scheduled time until the context is canceled.
+// A failed report is logged and the next one is generated at the next scheduled time.
+func (s *DailyReportScheduler) Run(ctx context.Context) {
+	for {
+		now := time.Now()
+		next := s.NextRun(now)
+		slog.Info("Next scheduled daily report", "at", next)
+
+		timer := time.NewTimer(next.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		date := next.Format(time.DateOnly)
+		report, err := s.reportUseCase.GenerateDailyReport(ctx, date)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			slog.Error("Daily report failed", "date", date, "error", err)
+			continue
+		}
+		slog.Info("Daily report generated", "date", date, "signals", report.Summary.SignalsConsumed, "orders", report.Summary.OrdersPlaced,
+			"rejected", report.Summary.OrdersRejected, "fills", report.Summary.Fills, "realized_pl", report.ProfitLoss.Realized, "warnings", len(report.Warnings))
+	}
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// ExecutionPoller stores the executions of the broker's orders at a fixed interval.
+type ExecutionPoller struct {
+	tradeUseCase TradeUseCase
+	interval     time.Duration
+}
+
+// NewExecutionPoller creates a poller that syncs the executions every interval.
+func NewExecutionPoller(tradeUseCase TradeUseCase, interval time.Duration) (*ExecutionPoller, error) {
+	if interval <= 0 {
+		return nil, errors.New("execution poll interval must be positive")
+	}
+	return &ExecutionPoller{tradeUseCase: tradeUseCase, interval: interval}, nil
+}
+
+// Run syncs the executions immediately and then every interval until the context is canceled.
+// A failed sync is logged and retried at the next interval.
+func (p *ExecutionPoller) Run(ctx context.Context) {
+	runPeriodically(ctx, p.interval, p.poll)
+}
+
+func (p *ExecutionPoller) poll(ctx context.Context) {
+	summary, err := p.tradeUseCase.SyncExecutions(ctx)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		slog.Error("Execution sync failed", "error", err)
+		return
+	}
+	slog.Info("Execution sync completed", "orders", summary.Orders, "imported", summary.Imported, "executions", summary.Executions, "closed", summary.Closed, "skipped", summary.Skipped)
+}
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"context"
+	"stock-bot/internal/infrastructure/client"
+	"time"
+)
+
+// FundamentalsResult holds a fundamentals snapshot of a stock and the ratios derived from the latest price.
+// Numeric values of the snapshot are 0 when they are not reported.
+type FundamentalsResult struct {
+	Symbol                string
+	SnapshotDate          string // YYYYMMDD
+	FetchedAt             time.Time
+	BPS                   float64
+	EPS                   float64
+	ROE                   float64
+	DividendPerShare      float64
+	ReportedPER           float64 // as reported when the snapshot was fetched
+	ReportedPBR           float64 // as reported when the snapshot was fetched
+	ReportedDividendYield float64 // as reported when the snapshot was fetched
+	EarningsYield         float64
+	ExDividendDate        string
+	InterimExDividendDate string
+	LastExRightsDate      string
+	YearHigh              float64
+	YearHighDate          string
+	YearLow               float64
+	YearLowDate           string
+
+	// Price is the latest price the ratios below are derived from. nil when the price is not available.
+	Price         *float64
+	PER           *float64 // nil when EPS is not positive
+	PBR           *float64 // nil when BPS is not positive
+	DividendYield *float64 // percent, nil when the dividend per share is unknown
+}
+
+// FundamentalsUseCase defines the interface for stock fundamentals related use cases.
+type FundamentalsUseCase interface {
+	// GetFundamentals returns today's fundamentals snapshot of the stock with the ratios derived from the latest price.
+	// Today's snapshot is fetched and stored when it has not been stored yet.
+	// ErrNotFound is returned when no fundamentals are available for the stock.
+	GetFundamentals(ctx context.Context, session *client.Session, symbol string) (*FundamentalsResult, error)
+	// SnapshotFundamentals fetches and stores today's fundamentals snapshot of the stocks
+	// and returns the number of snapshots stored.
+	SnapshotFundamentals(ctx context.Context, session *client.Session, symbols []string) (int, error)
+}
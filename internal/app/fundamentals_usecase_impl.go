@@ -0,0 +1,224 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"stock-bot/internal/infrastructure/client"
+	"stock-bot/internal/infrastructure/client/dto/master/request"
+	"stock-bot/internal/infrastructure/client/dto/master/response"
+	pricerequest "stock-bot/internal/infrastructure/client/dto/price/request"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fundamentalsBatchSize is the number of stocks requested at once from the issue detail API.
+const fundamentalsBatchSize = 50
+
+// fundamentalsUseCaseImpl implements the FundamentalsUseCase interface.
+type fundamentalsUseCaseImpl struct {
+	masterClient    client.MasterDataClient
+	priceInfoClient client.PriceInfoClient
+	fundamentalRepo repository.FundamentalRepository
+}
+
+// NewFundamentalsUseCaseImpl creates a new FundamentalsUseCase.
+func NewFundamentalsUseCaseImpl(masterClient client.MasterDataClient, priceInfoClient client.PriceInfoClient, fundamentalRepo repository.FundamentalRepository) FundamentalsUseCase {
+	return &fundamentalsUseCaseImpl{
+		masterClient:    masterClient,
+		priceInfoClient: priceInfoClient,
+		fundamentalRepo: fundamentalRepo,
+	}
+}
+
+// GetFundamentals returns today's fundamentals snapshot of the stock with the ratios derived from the latest price.
+// When today's snapshot cannot be fetched, the latest stored snapshot is used instead.
+// When the latest price cannot be fetched, the derived ratios are omitted.
+func (uc *fundamentalsUseCaseImpl) GetFundamentals(ctx context.Context, session *client.Session, symbol string) (*FundamentalsResult, error) {
+	today := time.Now().In(marketLocation()).Format("20060102")
+	fundamental, err := uc.fundamentalRepo.FindFundamental(ctx, symbol, today)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stock fundamentals: %w", err)
+	}
+	if fundamental == nil {
+		fetched, err := uc.fetchFundamentals(ctx, session, []string{symbol}, today)
+		if err == nil && len(fetched) > 0 {
+			if err := uc.fundamentalRepo.UpsertFundamentals(ctx, fetched); err != nil {
+				return nil, fmt.Errorf("failed to save stock fundamentals: %w", err)
+			}
+			fundamental = fetched[0]
+		} else {
+			if err != nil {
+				slog.Warn("failed to fetch stock fundamentals, falling back to the latest snapshot", "symbol", symbol, "error", err)
+			}
+			fundamental, err = uc.fundamentalRepo.FindLatestFundamental(ctx, symbol)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find latest stock fundamentals: %w", err)
+			}
+		}
+	}
+	if fundamental == nil {
+		return nil, ErrNotFound
+	}
+
+	result := toFundamentalsResult(fundamental)
+	price, err := uc.latestPrice(ctx, session, symbol)
+	if err != nil {
+		slog.Warn("failed to get latest price for fundamentals ratios", "symbol", symbol, "error", err)
+		return result, nil
+	}
+	result.Price = &price
+	if per, ok := fundamental.PER(price); ok {
+		result.PER = &per
+	}
+	if pbr, ok := fundamental.PBR(price); ok {
+		result.PBR = &pbr
+	}
+	if dividendYield, ok := fundamental.DividendYield(price); ok {
+		result.DividendYield = &dividendYield
+	}
+	return result, nil
+}
+
+// SnapshotFundamentals fetches and stores today's fundamentals snapshot of the stocks in batches.
+func (uc *fundamentalsUseCaseImpl) SnapshotFundamentals(ctx context.Context, session *client.Session, symbols []string) (int, error) {
+	today := time.Now().In(marketLocation()).Format("20060102")
+	stored := 0
+	for start := 0; start < len(symbols); start += fundamentalsBatchSize {
+		batch := symbols[start:min(start+fundamentalsBatchSize, len(symbols))]
+		fundamentals, err := uc.fetchFundamentals(ctx, session, batch, today)
+		if err != nil {
+			return stored, err
+		}
+		if err := uc.fundamentalRepo.UpsertFundamentals(ctx, fundamentals); err != nil {
+			return stored, fmt.Errorf("failed to save stock fundamentals: %w", err)
+		}
+		stored += len(fundamentals)
+	}
+	return stored, nil
+}
+
+// fetchFundamentals fetches the issue details of the stocks as snapshots of the given date.
+// Stocks without issue details are not included.
+func (uc *fundamentalsUseCaseImpl) fetchFundamentals(ctx context.Context, session *client.Session, symbols []string, snapshotDate string) ([]*model.StockFundamental, error) {
+	res, err := uc.masterClient.GetIssueDetail(ctx, session, request.ReqGetIssueDetail{
+		TargetIssueCodes: strings.Join(symbols, ","),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue detail: %w", err)
+	}
+
+	fetchedAt := time.Now()
+	fundamentals := make([]*model.StockFundamental, 0, len(res.CLMMfdsIssueDetail))
+	for _, item := range res.CLMMfdsIssueDetail {
+		if item.IssueCode == "" {
+			continue
+		}
+		fundamentals = append(fundamentals, toStockFundamental(item, snapshotDate, fetchedAt))
+	}
+	return fundamentals, nil
+}
+
+// latestPrice returns the current price of the stock.
+func (uc *fundamentalsUseCaseImpl) latestPrice(ctx context.Context, session *client.Session, symbol string) (float64, error) {
+	res, err := uc.priceInfoClient.GetPriceInfo(ctx, session, pricerequest.ReqGetPriceInfo{
+		CLMID:           "CLMMfdsGetMarketPrice",
+		TargetIssueCode: symbol,
+		TargetColumn:    "CurrentPrice",
+	})
+	if err != nil {
+		return 0, err
+	}
+	if res == nil || len(res.CLMMfdsMarketPrice) == 0 {
+		return 0, fmt.Errorf("no price info found for symbol %s", symbol)
+	}
+	priceStr, ok := res.CLMMfdsMarketPrice[0].Values["CurrentPrice"]
+	if !ok {
+		return 0, fmt.Errorf("CurrentPrice not found in response for symbol %s", symbol)
+	}
+	price, err := strconv.ParseFloat(priceStr, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse price '%s' for symbol %s: %w", priceStr, symbol, err)
+	}
+	if price <= 0 {
+		return 0, fmt.Errorf("no current price for symbol %s", symbol)
+	}
+	return price, nil
+}
+
+// toStockFundamental maps an issue detail to a fundamentals snapshot.
+func toStockFundamental(item response.ResIssueDetailListItem, snapshotDate string, fetchedAt time.Time) *model.StockFundamental {
+	f := &model.StockFundamental{
+		IssueCode:             item.IssueCode,
+		SnapshotDate:          snapshotDate,
+		BPS:                   parseDetailFloat(item.PBPSB),
+		EPS:                   parseDetailFloat(item.PEPSF),
+		ROE:                   parseDetailFloat(item.PROEL),
+		ReportedPER:           parseDetailFloat(item.PRPER),
+		ReportedPBR:           parseDetailFloat(item.PSPBR),
+		EarningsYield:         parseDetailFloat(item.PSPRO),
+		ReportedDividendYield: parseDetailFloat(item.PSYIE),
+		ExDividendDate:        parseDetailDate(item.PCLOE),
+		InterimExDividendDate: parseDetailDate(item.PIDVE),
+		LastExRightsDate:      parseDetailDate(item.PEXRD),
+		YearHigh:              parseDetailFloat(item.PYHPR),
+		YearHighDate:          parseDetailDate(item.PYHPD),
+		YearLow:               parseDetailFloat(item.PYLPR),
+		YearLowDate:           parseDetailDate(item.PYLPD),
+		FetchedAt:             fetchedAt,
+	}
+	f.DividendPerShare = model.ImpliedDividendPerShare(f.ReportedDividendYield, f.ReportedPER, f.EPS, f.ReportedPBR, f.BPS)
+	return f
+}
+
+// toFundamentalsResult maps a fundamentals snapshot to a FundamentalsResult without the derived ratios.
+func toFundamentalsResult(f *model.StockFundamental) *FundamentalsResult {
+	return &FundamentalsResult{
+		Symbol:                f.IssueCode,
+		SnapshotDate:          f.SnapshotDate,
+		FetchedAt:             f.FetchedAt,
+		BPS:                   f.BPS,
+		EPS:                   f.EPS,
+		ROE:                   f.ROE,
+		DividendPerShare:      f.DividendPerShare,
+		ReportedPER:           f.ReportedPER,
+		ReportedPBR:           f.ReportedPBR,
+		ReportedDividendYield: f.ReportedDividendYield,
+		EarningsYield:         f.EarningsYield,
+		ExDividendDate:        f.ExDividendDate,
+		InterimExDividendDate: f.InterimExDividendDate,
+		LastExRightsDate:      f.LastExRightsDate,
+		YearHigh:              f.YearHigh,
+		YearHighDate:          f.YearHighDate,
+		YearLow:               f.YearLow,
+		YearLowDate:           f.YearLowDate,
+	}
+}
+
+// parseDetailFloat parses a numeric value of the issue detail and margin data APIs.
+// Empty and malformed values (e.g. "-") are treated as 0.
+func parseDetailFloat(s string) float64 {
+	v, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(s), ",", ""), 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// parseDetailInt64 parses an integer value of the issue detail and margin data APIs.
+// Empty and malformed values (e.g. "-") are treated as 0.
+func parseDetailInt64(s string) int64 {
+	return int64(parseDetailFloat(s))
+}
+
+// parseDetailDate converts an issue detail date (YYYY/MM/DD) to YYYYMMDD. Malformed dates are treated as empty.
+func parseDetailDate(s string) string {
+	t, err := time.Parse("2006/01/02", strings.TrimSpace(s))
+	if err != nil {
+		return ""
+	}
+	return t.Format("20060102")
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"context"
+	"stock-bot/domain/model"
+)
+
+// MarginInfoResult holds the margin time series of a stock, newest first.
+type MarginInfoResult struct {
+	Symbol            string
+	SecuritiesFinance []*model.SecuritiesFinanceBalance // 証金残 (daily)
+	Credit            []*model.CreditBalance            // 信用残 (weekly)
+	Premiums          []*model.MarginPremiumRecord      // 逆日歩 (daily)
+}
+
+// MarginCollectSummary reports how many records of each series a collection stored.
+type MarginCollectSummary struct {
+	SecuritiesFinance int
+	Credit            int
+	Premiums          int
+	// MalformedPremiums is the number of premiums skipped because their value could not be parsed.
+	MalformedPremiums int
+}
+
+// MarginUseCase defines the interface for margin and short-interest data (証金残, 信用残, 逆日歩).
+type MarginUseCase interface {
+	// GetMarginInfo returns the stored time series of the stock since the given date (YYYYMMDD, empty for all),
+	// with at most limit records per series.
+	GetMarginInfo(ctx context.Context, symbol string, since string, limit int) (*MarginInfoResult, error)
+	// CollectMarginInfo fetches the margin data of the watched stocks and stores it.
+	CollectMarginInfo(ctx context.Context) (*MarginCollectSummary, error)
+}
@@ -0,0 +1,166 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"stock-bot/internal/infrastructure/client"
+	"stock-bot/internal/infrastructure/client/dto/master/request"
+	"strings"
+	"time"
+)
+
+// marginInfoBatchSize is the number of stocks requested at once from the margin data APIs.
+const marginInfoBatchSize = 50
+
+// marginUseCaseImpl implements the MarginUseCase interface.
+type marginUseCaseImpl struct {
+	masterClient   client.MasterDataClient
+	marginInfoRepo repository.MarginInfoRepository
+	session        *client.Session
+	symbols        []string
+}
+
+// NewMarginUseCaseImpl creates a new MarginUseCase that collects the margin data of the given stocks.
+func NewMarginUseCaseImpl(masterClient client.MasterDataClient, marginInfoRepo repository.MarginInfoRepository, session *client.Session, symbols []string) MarginUseCase {
+	return &marginUseCaseImpl{
+		masterClient:   masterClient,
+		marginInfoRepo: marginInfoRepo,
+		session:        session,
+		symbols:        symbols,
+	}
+}
+
+// GetMarginInfo returns the stored time series of the stock.
+func (uc *marginUseCaseImpl) GetMarginInfo(ctx context.Context, symbol string, since string, limit int) (*MarginInfoResult, error) {
+	query := repository.MarginInfoQuery{IssueCode: symbol, Since: since, Limit: limit}
+	securitiesFinance, err := uc.marginInfoRepo.FindSecuritiesFinanceBalances(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find securities finance balances: %w", err)
+	}
+	credit, err := uc.marginInfoRepo.FindCreditBalances(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find credit balances: %w", err)
+	}
+	premiums, err := uc.marginInfoRepo.FindMarginPremiums(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find margin premiums: %w", err)
+	}
+	return &MarginInfoResult{
+		Symbol:            symbol,
+		SecuritiesFinance: securitiesFinance,
+		Credit:            credit,
+		Premiums:          premiums,
+	}, nil
+}
+
+// CollectMarginInfo fetches 証金残, 信用残 and 逆日歩 of the watched stocks in batches and stores them.
+// 逆日歩 has no date in the response, so it is stored with the collection day (market timezone).
+func (uc *marginUseCaseImpl) CollectMarginInfo(ctx context.Context) (*MarginCollectSummary, error) {
+	summary := &MarginCollectSummary{}
+	today := time.Now().In(marketLocation()).Format("20060102")
+	for start := 0; start < len(uc.symbols); start += marginInfoBatchSize {
+		codes := strings.Join(uc.symbols[start:min(start+marginInfoBatchSize, len(uc.symbols))], ",")
+		fetchedAt := time.Now()
+
+		marginRes, err := uc.masterClient.GetMarginInfo(ctx, uc.session, request.ReqGetMarginInfo{TargetIssueCodes: codes})
+		if err != nil {
+			return summary, fmt.Errorf("failed to get securities finance balances: %w", err)
+		}
+		var securitiesFinance []*model.SecuritiesFinanceBalance
+		for _, item := range marginRes.CLMMfdsSyoukinZan {
+			recordDate := parseDetailDate(item.PSFD)
+			if item.IssueCode == "" || recordDate == "" {
+				continue
+			}
+			securitiesFinance = append(securitiesFinance, &model.SecuritiesFinanceBalance{
+				IssueCode:        item.IssueCode,
+				RecordDate:       recordDate,
+				Preliminary:      item.PSFKS == "1",
+				LoanBalance:      parseDetailInt64(item.PSFF6),
+				LoanChange:       parseDetailInt64(item.PSFG6),
+				LoanNew:          parseDetailInt64(item.PSFL6),
+				LoanRepaid:       parseDetailInt64(item.PSFP6),
+				StockLoanBalance: parseDetailInt64(item.PSFS6),
+				StockLoanChange:  parseDetailInt64(item.PSSG6),
+				StockLoanNew:     parseDetailInt64(item.PSSL6),
+				StockLoanRepaid:  parseDetailInt64(item.PSSP6),
+				NetBalance:       parseDetailInt64(item.PSFN6),
+				NetChange:        parseDetailInt64(item.PSFC6),
+				LoanRatio:        parseDetailFloat(item.PSFR6),
+				TurnoverDays:     parseDetailFloat(item.PSFD6),
+				FetchedAt:        fetchedAt,
+			})
+		}
+		if err := uc.marginInfoRepo.UpsertSecuritiesFinanceBalances(ctx, securitiesFinance); err != nil {
+			return summary, fmt.Errorf("failed to save securities finance balances: %w", err)
+		}
+		summary.SecuritiesFinance += len(securitiesFinance)
+
+		creditRes, err := uc.masterClient.GetCreditInfo(ctx, uc.session, request.ReqGetCreditInfo{TargetIssueCodes: codes})
+		if err != nil {
+			return summary, fmt.Errorf("failed to get credit balances: %w", err)
+		}
+		var credit []*model.CreditBalance
+		for _, item := range creditRes.CLMMfdsShinyouZan {
+			recordDate := parseDetailDate(item.PMBD)
+			if item.IssueCode == "" || recordDate == "" {
+				continue
+			}
+			credit = append(credit, &model.CreditBalance{
+				IssueCode:              item.IssueCode,
+				RecordDate:             recordDate,
+				LongBalance:            parseDetailInt64(item.PMBBQ),
+				LongChange:             parseDetailInt64(item.PMBNQ),
+				ShortBalance:           parseDetailInt64(item.PMBSQ),
+				ShortChange:            parseDetailInt64(item.PMBCQ),
+				Ratio:                  parseDetailFloat(item.PMBRQ),
+				StandardLongBalance:    parseDetailInt64(item.PMBB6),
+				StandardShortBalance:   parseDetailInt64(item.PMBS6),
+				NegotiableLongBalance:  parseDetailInt64(item.PMBB3),
+				NegotiableShortBalance: parseDetailInt64(item.PMBS3),
+				FetchedAt:              fetchedAt,
+			})
+		}
+		if err := uc.marginInfoRepo.UpsertCreditBalances(ctx, credit); err != nil {
+			return summary, fmt.Errorf("failed to save credit balances: %w", err)
+		}
+		summary.Credit += len(credit)
+
+		premiumRes, err := uc.masterClient.GetMarginPremiumInfo(ctx, uc.session, request.ReqGetMarginPremiumInfo{TargetIssueCodes: codes})
+		if err != nil {
+			return summary, fmt.Errorf("failed to get margin premiums: %w", err)
+		}
+		var premiums []*model.MarginPremiumRecord
+		for _, item := range premiumRes.CLMMfdsHibuInfo {
+			value := strings.ReplaceAll(strings.TrimSpace(item.PBWRQ), ",", "")
+			if item.IssueCode == "" || value == "" || value == "-" {
+				continue
+			}
+			// a malformed premium is skipped instead of being stored as 0, which would read as "no premium"
+			p := &summaryParser{}
+			premium := p.required("PBWRQ", value)
+			if p.err == nil && premium < 0 {
+				p.err = fmt.Errorf("negative PBWRQ '%s'", value)
+			}
+			if p.err != nil {
+				slog.Warn("Skipping margin premium with a malformed value", "issue_code", item.IssueCode, "error", p.err)
+				summary.MalformedPremiums++
+				continue
+			}
+			premiums = append(premiums, &model.MarginPremiumRecord{
+				IssueCode:  item.IssueCode,
+				RecordDate: today,
+				Premium:    premium,
+				FetchedAt:  fetchedAt,
+			})
+		}
+		if err := uc.marginInfoRepo.UpsertMarginPremiums(ctx, premiums); err != nil {
+			return summary, fmt.Errorf("failed to save margin premiums: %w", err)
+		}
+		summary.Premiums += len(premiums)
+	}
+	return summary, nil
+}
@@ -0,0 +1,17 @@
+package app
+
+import "time"
+
+// marketTimezone is the timezone of the Tokyo Stock Exchange.
+// Trading days are derived in this timezone so that they match the agent's default timezone.
+const marketTimezone = "Asia/Tokyo"
+
+// marketLocation returns the location of marketTimezone, falling back to a fixed JST offset
+// when the timezone database is not available.
+func marketLocation() *time.Location {
+	location, err := time.LoadLocation(marketTimezone)
+	if err != nil {
+		return time.FixedZone("JST", 9*60*60)
+	}
+	return location
+}
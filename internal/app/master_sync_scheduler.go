@@ -0,0 +1,138 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"stock-bot/domain/model"
+	"stock-bot/internal/infrastructure/client"
+	"sync"
+	"time"
+)
+
+// maxScheduleLookahead bounds the search for the next business day.
+const maxScheduleLookahead = 31
+
+// MasterSyncScheduler refreshes the master data every business day at a fixed time of day
+// in the market timezone, e.g. before the market opens.
+//
+// Weekends are never business days. Holidays are known from the business days following the current
+// day in the date information of the last sync; days beyond that calendar are assumed to be business days.
+type MasterSyncScheduler struct {
+	masterUseCase MasterUseCase
+	session       *client.Session
+	hour, minute  int
+	location      *time.Location
+
+	// afterSync are run after each successful scheduled sync, e.g. to take daily snapshots
+	afterSync []func(ctx context.Context)
+
+	mu           sync.Mutex
+	businessDays map[string]struct{} // YYYYMMDD
+	calendarFrom string              // the calendar covers the days after calendarFrom ...
+	calendarTo   string              // ... up to and including calendarTo
+}
+
+// NewMasterSyncScheduler creates a scheduler that syncs the master data every business day at the given
+// time of day (HH:MM, market timezone).
+func NewMasterSyncScheduler(masterUseCase MasterUseCase, session *client.Session, at string) (*MasterSyncScheduler, error) {
+	t, err := time.Parse("15:04", at)
+	if err != nil {
+		return nil, fmt.Errorf("invalid master sync time %q: %w", at, err)
+	}
+	return &MasterSyncScheduler{
+		masterUseCase: masterUseCase,
+		session:       session,
+		hour:          t.Hour(),
+		minute:        t.Minute(),
+		location:      marketLocation(),
+	}, nil
+}
+
+// AfterSync registers a function run after each successful scheduled sync.
+// It must be called before Run.
+func (s *MasterSyncScheduler) AfterSync(fn func(ctx context.Context)) {
+	s.afterSync = append(s.afterSync, fn)
+}
+
+// UpdateCalendar records the business days reported by a sync so that holidays are skipped.
+func (s *MasterSyncScheduler) UpdateCalendar(summary *MasterSyncSummary) {
+	if summary == nil || summary.CurrentDay == "" || len(summary.NextBusinessDays) == 0 {
+		return
+	}
+	days := make(map[string]struct{}, len(summary.NextBusinessDays))
+	last := ""
+	for _, day := range summary.NextBusinessDays {
+		days[day] = struct{}{}
+		if day > last {
+			last = day
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.businessDays = days
+	s.calendarFrom = summary.CurrentDay
+	s.calendarTo = last
+}
+
+// NextRun returns the next scheduled sync time after now.
+func (s *MasterSyncScheduler) NextRun(now time.Time) time.Time {
+	local := now.In(s.location)
+	next := time.Date(local.Year(), local.Month(), local.Day(), s.hour, s.minute, 0, 0, s.location)
+	if !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	for i := 0; i < maxScheduleLookahead && !s.isBusinessDay(next); i++ {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// isBusinessDay reports whether the day is a business day according to the known calendar.
+func (s *MasterSyncScheduler) isBusinessDay(day time.Time) bool {
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return false
+	}
+	key := day.Format("20060102")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.businessDays == nil || key <= s.calendarFrom || key > s.calendarTo {
+		return true
+	}
+	_, ok := s.businessDays[key]
+	return ok
+}
+
+// Run syncs the master data at each scheduled time until the context is canceled.
+// A failed sync is logged and retried at the next scheduled time.
+func (s *MasterSyncScheduler) Run(ctx context.Context) {
+	for {
+		now := time.Now()
+		next := s.NextRun(now)
+		slog.Info("Next scheduled master data sync", "at", next)
+
+		timer := time.NewTimer(next.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		summary, err := s.masterUseCase.DownloadAndStoreMasterData(ctx, s.session, model.MasterSyncTriggerScheduled)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			slog.Error("Scheduled master data sync failed", "error", err)
+			continue
+		}
+		s.UpdateCalendar(summary)
+		for _, fn := range s.afterSync {
+			fn(ctx)
+		}
+	}
+}
@@ -3,7 +3,9 @@ package app
 import (
 	"context"
 	"errors"
+	"stock-bot/domain/model"
 	"stock-bot/internal/infrastructure/client"
+	"time"
 )
 
 // ErrNotFound is returned when a resource is not found.
@@ -17,10 +19,89 @@ type StockMasterResult struct {
 	Market       string
 	IndustryCode string
 	IndustryName string
+	UpperLimit   float64 // 値幅上限 (0の場合は未設定)
+	LowerLimit   float64 // 値幅下限 (0の場合は未設定)
+	TradingUnit  int     // 売買単位 (0の場合は未設定)
+}
+
+// StockSearchParams holds the filters and paging for searching stock master data.
+// Empty strings and zero values are not used as filters.
+type StockSearchParams struct {
+	Market       string
+	IndustryCode string
+	Keyword      string // matched against the name, short name and kana name
+	TradingUnit  int
+	Offset       int
+	Limit        int
+}
+
+// StockSearchResult holds a page of stock master search results.
+type StockSearchResult struct {
+	Stocks []*StockMasterResult
+	Total  int64 // number of stocks matching the filters
+	Offset int
+	Limit  int
+}
+
+// IndustryResult holds an industry and the number of stocks in it.
+type IndustryResult struct {
+	Code  string
+	Name  string
+	Count int64
+}
+
+// MasterSyncScope selects which master data DownloadAndStoreMasterData stores.
+type MasterSyncScope string
+
+const (
+	// MasterSyncWatched stores the stock master of the watched stocks and the tick rules.
+	MasterSyncWatched MasterSyncScope = "watched"
+	// MasterSyncFull stores every stock with its market listings, the tick rules, the margin master,
+	// the regulations and the operation status, and soft-deletes stocks that are no longer listed.
+	MasterSyncFull MasterSyncScope = "full"
+)
+
+// MasterSyncConfig configures master data synchronization.
+type MasterSyncConfig struct {
+	Scope MasterSyncScope
+	// WatchedSymbols are the stocks stored in the watched scope.
+	// When empty, every stock master is stored (without soft-deleting delisted stocks).
+	WatchedSymbols []string
+}
+
+// MasterSyncSummary reports how many records of each master table a sync changed.
+type MasterSyncSummary struct {
+	RunID             uint // ID of the recorded sync run
+	Scope             MasterSyncScope
+	Stocks            model.MasterSyncCounts
+	StockMarkets      model.MasterSyncCounts
+	TickRules         model.MasterSyncCounts
+	MarginMasters     model.MasterSyncCounts
+	Regulations       model.MasterSyncCounts
+	OperationStatuses model.MasterSyncCounts
+	// CurrentDay and NextBusinessDays (YYYYMMDD) come from the downloaded date information.
+	CurrentDay       string
+	NextBusinessDays []string
+}
+
+// MasterSyncRunResult holds a recorded master data sync run.
+type MasterSyncRunResult struct {
+	ID         uint
+	Trigger    model.MasterSyncTrigger
+	Status     model.MasterSyncStatus
+	StartedAt  time.Time
+	FinishedAt *time.Time // nil while the sync is running
+	Error      string
+	Summary    MasterSyncSummary
 }
 
 // MasterUseCase defines the interface for master data related use cases.
 type MasterUseCase interface {
 	GetStock(ctx context.Context, symbol string) (*StockMasterResult, error)
-	DownloadAndStoreMasterData(ctx context.Context, session *client.Session) error
+	ListStocks(ctx context.Context, params StockSearchParams) (*StockSearchResult, error)
+	ListIndustries(ctx context.Context) ([]*IndustryResult, error)
+	// DownloadAndStoreMasterData syncs the master data and records the run with the given trigger.
+	DownloadAndStoreMasterData(ctx context.Context, session *client.Session, trigger model.MasterSyncTrigger) (*MasterSyncSummary, error)
+	// ListSyncRuns returns the most recent master data sync runs, newest first.
+	ListSyncRuns(ctx context.Context, limit int) ([]*MasterSyncRunResult, error)
 }
\ No newline at end of file
@@ -10,65 +10,259 @@ import (
 	"stock-bot/internal/infrastructure/client/dto/master/request"
 	"stock-bot/internal/infrastructure/client/dto/master/response"
 	"strconv"
+	"sync"
+	"time"
 )
 
 // masterUseCaseImpl implements the MasterUseCase interface.
 type masterUseCaseImpl struct {
 	masterClient client.MasterDataClient
 	masterRepo   repository.MasterRepository
+	syncConfig   MasterSyncConfig
+	syncMu       sync.Mutex // 同期が同時に実行されないようにする
 }
 
 // NewMasterUseCaseImpl creates a new MasterUseCase.
-func NewMasterUseCaseImpl(masterClient client.MasterDataClient, masterRepo repository.MasterRepository) MasterUseCase {
+func NewMasterUseCaseImpl(masterClient client.MasterDataClient, masterRepo repository.MasterRepository, syncConfig MasterSyncConfig) MasterUseCase {
+	if syncConfig.Scope == "" {
+		syncConfig.Scope = MasterSyncWatched
+	}
 	return &masterUseCaseImpl{
 		masterClient: masterClient,
 		masterRepo:   masterRepo,
+		syncConfig:   syncConfig,
 	}
 }
 
-// DownloadAndStoreMasterData downloads all master data and stores the watched stocks into the database.
-func (uc *masterUseCaseImpl) DownloadAndStoreMasterData(ctx context.Context, session *client.Session) error {
-	slog.Info("Starting master data download...")
-	// 1. Call DownloadMasterData
-	res, err := uc.masterClient.DownloadMasterData(ctx, session, request.ReqDownloadMaster{})
-	if err != nil {
+// DownloadAndStoreMasterData downloads all master data and stores it into the database
+// according to the configured sync scope. Only records that changed are written.
+// Every run is recorded with its trigger, its counts and, when it fails, the error.
+func (uc *masterUseCaseImpl) DownloadAndStoreMasterData(ctx context.Context, session *client.Session, trigger model.MasterSyncTrigger) (*MasterSyncSummary, error) {
+	// Serialize syncs, e.g. a scheduled refresh and POST /master/update.
+	uc.syncMu.Lock()
+	defer uc.syncMu.Unlock()
+
+	run := &model.MasterSyncRun{
+		Scope:     string(uc.syncConfig.Scope),
+		Trigger:   trigger,
+		Status:    model.MasterSyncRunning,
+		StartedAt: time.Now(),
+	}
+	if err := uc.masterRepo.CreateMasterSyncRun(ctx, run); err != nil {
+		return nil, fmt.Errorf("failed to record master sync run: %w", err)
+	}
+
+	summary := &MasterSyncSummary{RunID: run.ID, Scope: uc.syncConfig.Scope}
+	syncErr := uc.syncMasterData(ctx, session, summary)
+
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	run.Status = model.MasterSyncSucceeded
+	if syncErr != nil {
+		run.Status = model.MasterSyncFailed
+		run.Error = syncErr.Error()
+	}
+	run.Stocks = summary.Stocks
+	run.StockMarkets = summary.StockMarkets
+	run.TickRules = summary.TickRules
+	run.MarginMasters = summary.MarginMasters
+	run.Regulations = summary.Regulations
+	run.OperationStatuses = summary.OperationStatuses
+	// Record the result even when the request that triggered the sync has been canceled.
+	if err := uc.masterRepo.UpdateMasterSyncRun(context.WithoutCancel(ctx), run); err != nil {
+		if syncErr != nil {
+			slog.Error("Failed to record failed master sync run", "run_id", run.ID, "error", err)
+			return nil, syncErr
+		}
+		return nil, fmt.Errorf("failed to record master sync run: %w", err)
+	}
+	if syncErr != nil {
+		return nil, syncErr
+	}
+
+	slog.Info("Master data sync completed.",
+		"run_id", summary.RunID,
+		"trigger", trigger,
+		"scope", summary.Scope,
+		"stocks", summary.Stocks,
+		"stock_markets", summary.StockMarkets,
+		"tick_rules", summary.TickRules,
+		"margin_masters", summary.MarginMasters,
+		"regulations", summary.Regulations,
+		"operation_statuses", summary.OperationStatuses,
+	)
+	return summary, nil
+}
+
+// syncMasterData streams the master data and syncs it, filling in the counts of the summary as it goes.
+// The records are consumed one by one as they arrive, and only the records that the sync stores are kept.
+func (uc *masterUseCaseImpl) syncMasterData(ctx context.Context, session *client.Session, summary *MasterSyncSummary) error {
+	slog.Info("Starting master data download...", "scope", uc.syncConfig.Scope)
+	// 1. Stream the master data
+	records := uc.newMasterSyncRecords()
+	if err := uc.masterClient.StreamMasterData(ctx, session, request.ReqDownloadMaster{}, records.handle); err != nil {
 		return fmt.Errorf("failed to download master data: %w", err)
 	}
-	slog.Info("Master data download completed.", "system_status", res.SystemStatus.SystemStatus)
+	res := &records.res
+	slog.Info("Master data download completed.", "system_status", res.SystemStatus.SystemStatus, "stocks", len(res.StockMaster))
 
-	// MarketMasterを銘柄コードで検索できるようにマップに変換
-	marketMasterMap := make(map[string]response.ResStockMarketMaster)
-	for _, mm := range res.StockMarketMaster {
-		marketMasterMap[mm.IssueCode] = mm
+	summary.CurrentDay, summary.NextBusinessDays = businessCalendar(res.DateInfo)
+	full := records.full
+
+	// 2. Stock masters
+	stocks := toStockMasters(res.StockMaster, res.StockMarketMaster)
+	// 全銘柄を取得した場合のみ、含まれない銘柄を上場廃止として論理削除する
+	var err error
+	summary.Stocks, err = uc.masterRepo.SyncStockMasters(ctx, stocks, full, summary.RunID)
+	if err != nil {
+		return fmt.Errorf("failed to sync stock masters: %w", err)
 	}
 
-	var modelsToUpsert []*model.StockMaster
-	slog.Info("Converting stock master data to domain models...", "count", len(res.StockMaster))
-	for _, sm := range res.StockMaster {
-		// string to int/float conversions with error handling
-		tradingUnit, err := strconv.Atoi(sm.TradingUnit)
+	// 3. Tick rules
+	summary.TickRules, err = uc.masterRepo.SyncTickRules(ctx, toTickRules(res.TickRule))
+	if err != nil {
+		return fmt.Errorf("failed to sync tick rules: %w", err)
+	}
+
+	if full {
+		// 4. Market listings, margin master, regulations and operation status
+		summary.StockMarkets, err = uc.masterRepo.SyncStockMarketMasters(ctx, toStockMarketMasters(res.StockMarketMaster))
 		if err != nil {
-			slog.Warn("Failed to parse TradingUnit, setting 0", "value", sm.TradingUnit, "error", err)
-			tradingUnit = 0
+			return fmt.Errorf("failed to sync stock market masters: %w", err)
 		}
-		listedShares, err := strconv.ParseInt(sm.ListedSharesOutstanding, 10, 64)
+		summary.MarginMasters, err = uc.masterRepo.SyncMarginMasters(ctx, toMarginMasters(res.MarginMaster))
 		if err != nil {
-			slog.Warn("Failed to parse ListedSharesOutstanding, setting 0", "value", sm.ListedSharesOutstanding, "error", err)
-			listedShares = 0
+			return fmt.Errorf("failed to sync margin masters: %w", err)
 		}
+		summary.Regulations, err = uc.masterRepo.SyncStockIssueRegulations(ctx, toStockIssueRegulations(res.StockIssueRegulation))
+		if err != nil {
+			return fmt.Errorf("failed to sync stock issue regulations: %w", err)
+		}
+		statuses := toOperationStatuses(res.OperationStatus, res.OperationStatusStock, res.OperationStatusDerivative)
+		summary.OperationStatuses, err = uc.masterRepo.SyncOperationStatuses(ctx, statuses)
+		if err != nil {
+			return fmt.Errorf("failed to sync operation statuses: %w", err)
+		}
+	}
 
-		var upperLimit, lowerLimit float64
-		if mm, ok := marketMasterMap[sm.IssueCode]; ok {
-			upperLimit, err = strconv.ParseFloat(mm.UpperLimit, 64)
-			if err != nil {
-				slog.Warn("Failed to parse UpperLimit", "value", mm.UpperLimit, "error", err)
-			}
-			lowerLimit, err = strconv.ParseFloat(mm.LowerLimit, 64)
-			if err != nil {
-				slog.Warn("Failed to parse LowerLimit", "value", mm.LowerLimit, "error", err)
+	return nil
+}
+
+// masterSyncRecords keeps the records of the master data stream that the sync stores.
+// Records outside the sync scope (unwatched stocks and their listings, and the market-wide masters of the watched scope)
+// and masters that are never stored (derivatives, collateral rates, error reasons) are dropped as they arrive,
+// instead of buffering the whole download.
+type masterSyncRecords struct {
+	full    bool
+	watched map[string]struct{} // nil keeps every stock
+	res     response.ResDownloadMaster
+}
+
+// newMasterSyncRecords creates the records of a sync in the configured scope.
+// When no stocks are watched, every stock is kept.
+func (uc *masterUseCaseImpl) newMasterSyncRecords() *masterSyncRecords {
+	records := &masterSyncRecords{full: uc.syncConfig.Scope == MasterSyncFull}
+	if records.full {
+		return records
+	}
+	if len(uc.syncConfig.WatchedSymbols) == 0 {
+		slog.Warn("No watched stocks are configured; storing every stock master")
+		return records
+	}
+	records.watched = make(map[string]struct{}, len(uc.syncConfig.WatchedSymbols))
+	for _, symbol := range uc.syncConfig.WatchedSymbols {
+		records.watched[symbol] = struct{}{}
+	}
+	return records
+}
+
+// handle is the client.MasterRecordHandler of the sync.
+func (r *masterSyncRecords) handle(clmid string, record any) error {
+	switch v := record.(type) {
+	case response.ResSystemStatus:
+		r.res.SystemStatus = v
+	case response.ResDateInfo:
+		r.res.DateInfo = append(r.res.DateInfo, v)
+	case response.ResTickRule:
+		r.res.TickRule = append(r.res.TickRule, v)
+	case response.ResStockMaster:
+		if r.keeps(v.IssueCode) {
+			r.res.StockMaster = append(r.res.StockMaster, v)
+		}
+	case response.ResStockMarketMaster:
+		// the listings of the watched stocks are kept for their price limits and tick unit numbers
+		if r.keeps(v.IssueCode) {
+			r.res.StockMarketMaster = append(r.res.StockMarketMaster, v)
+		}
+	case response.ResMarginMaster:
+		if r.full {
+			r.res.MarginMaster = append(r.res.MarginMaster, v)
+		}
+	case response.ResStockIssueRegulation:
+		if r.full {
+			r.res.StockIssueRegulation = append(r.res.StockIssueRegulation, v)
+		}
+	case response.ResOperationStatus:
+		if !r.full {
+			break
+		}
+		switch clmid {
+		case "CLMUnyouStatusKabu":
+			r.res.OperationStatusStock = append(r.res.OperationStatusStock, v)
+		case "CLMUnyouStatusHasei":
+			r.res.OperationStatusDerivative = append(r.res.OperationStatusDerivative, v)
+		default:
+			r.res.OperationStatus = append(r.res.OperationStatus, v)
+		}
+	}
+	return nil
+}
+
+// keeps reports whether the stock is in the sync scope.
+func (r *masterSyncRecords) keeps(issueCode string) bool {
+	if r.watched == nil {
+		return true
+	}
+	_, ok := r.watched[issueCode]
+	return ok
+}
+
+// businessCalendar returns the current day and the following business days (YYYYMMDD) in the date information.
+func businessCalendar(dateInfo []response.ResDateInfo) (string, []string) {
+	for _, d := range dateInfo {
+		if d.DayKey != "001" { // 当日基準の日付情報のみを使用する
+			continue
+		}
+		var days []string
+		for _, day := range []string{
+			d.NextBusinessDay1, d.NextBusinessDay2, d.NextBusinessDay3, d.NextBusinessDay4, d.NextBusinessDay5,
+			d.NextBusinessDay6, d.NextBusinessDay7, d.NextBusinessDay8, d.NextBusinessDay9, d.NextBusinessDay10,
+		} {
+			if day != "" {
+				days = append(days, day)
 			}
 		}
+		return d.CurrentDay, days
+	}
+	return "", nil
+}
 
+// toStockMasters converts the downloaded stock masters into domain models.
+// Price limits and tick unit numbers are taken from the listing on the preferred market.
+func toStockMasters(stockMasters []response.ResStockMaster, marketMasters []response.ResStockMarketMaster) []*model.StockMaster {
+	// MarketMasterを銘柄コードで検索できるようにマップに変換 (優先市場の情報を優先する)
+	marketMasterMap := make(map[string]response.ResStockMarketMaster)
+	listings := make(map[string]response.ResStockMarketMaster)
+	for _, mm := range marketMasters {
+		listings[mm.IssueCode+"/"+mm.ListingMarket] = mm
+		if _, ok := marketMasterMap[mm.IssueCode]; !ok {
+			marketMasterMap[mm.IssueCode] = mm
+		}
+	}
+
+	modelsToUpsert := make([]*model.StockMaster, 0, len(stockMasters))
+	slog.Info("Converting stock master data to domain models...", "count", len(stockMasters))
+	for _, sm := range stockMasters {
 		m := &model.StockMaster{
 			IssueCode:               sm.IssueCode,
 			IssueName:               sm.IssueName,
@@ -78,27 +272,50 @@ func (uc *masterUseCaseImpl) DownloadAndStoreMasterData(ctx context.Context, ses
 			MarketCode:              sm.PreferredMarket,
 			IndustryCode:            sm.IndustryCode,
 			IndustryName:            sm.IndustryName,
-			TradingUnit:             tradingUnit,
-			ListedSharesOutstanding: listedShares,
-			UpperLimit:              upperLimit,
-			LowerLimit:              lowerLimit,
+			TradingUnit:             parseMasterInt("TradingUnit", sm.TradingUnit),
+			ListedSharesOutstanding: parseMasterInt64("ListedSharesOutstanding", sm.ListedSharesOutstanding),
+		}
+
+		mm, ok := listings[sm.IssueCode+"/"+sm.PreferredMarket]
+		if !ok {
+			mm, ok = marketMasterMap[sm.IssueCode]
+		}
+		if ok {
+			m.TickUnitNumber = mm.TickUnitNumber
+			m.NextTickUnitNumber = mm.NextTickUnitNumber
+			m.UpperLimit = parseMasterFloat("UpperLimit", mm.UpperLimit)
+			m.LowerLimit = parseMasterFloat("LowerLimit", mm.LowerLimit)
 		}
 		modelsToUpsert = append(modelsToUpsert, m)
 	}
 	slog.Info("Finished converting stocks.", "upsert_count", len(modelsToUpsert))
+	return modelsToUpsert
+}
 
-	// 4. Call uc.masterRepo.UpsertStockMasters
-	if len(modelsToUpsert) > 0 {
-		slog.Info("Upserting stock masters to database...")
-		if err := uc.masterRepo.UpsertStockMasters(ctx, modelsToUpsert); err != nil {
-			return fmt.Errorf("failed to upsert stock masters: %w", err)
-		}
-		slog.Info("Successfully upserted stock masters.")
+// toStockMarketMasters converts the downloaded market listings into domain models.
+func toStockMarketMasters(marketMasters []response.ResStockMarketMaster) []*model.StockMarketMaster {
+	markets := make([]*model.StockMarketMaster, 0, len(marketMasters))
+	for _, mm := range marketMasters {
+		markets = append(markets, &model.StockMarketMaster{
+			IssueCode:          mm.IssueCode,
+			ListingMarket:      mm.ListingMarket,
+			PreviousClose:      parseMasterFloat("PreviousClose", mm.PreviousClose),
+			UpperLimit:         parseMasterFloat("UpperLimit", mm.UpperLimit),
+			LowerLimit:         parseMasterFloat("LowerLimit", mm.LowerLimit),
+			TradingUnit:        parseMasterInt("MarketTradingUnit", mm.MarketTradingUnit),
+			TickUnitNumber:     mm.TickUnitNumber,
+			NextTickUnitNumber: mm.NextTickUnitNumber,
+			MarginEligibility:  mm.MarginEligibility,
+			DelistingDate:      mm.DelistingDate,
+		})
 	}
+	return markets
+}
 
-	// 5. Process and Upsert TickRules
+// toTickRules converts the downloaded tick rules into domain models.
+func toTickRules(tickRules []response.ResTickRule) []*model.TickRule {
 	var tickRulesToUpsert []*model.TickRule
-	for _, rt := range res.TickRule {
+	for _, rt := range tickRules {
 		tickRule := &model.TickRule{
 			TickUnitNumber: rt.TickUnitNumber,
 			ApplicableDate: rt.ApplicableDate,
@@ -145,16 +362,100 @@ func (uc *masterUseCaseImpl) DownloadAndStoreMasterData(ctx context.Context, ses
 		}
 		tickRulesToUpsert = append(tickRulesToUpsert, tickRule)
 	}
+	return tickRulesToUpsert
+}
 
-	if len(tickRulesToUpsert) > 0 {
-		slog.Info("Upserting tick rules to database...")
-		if err := uc.masterRepo.UpsertTickRules(ctx, tickRulesToUpsert); err != nil {
-			return fmt.Errorf("failed to upsert tick rules: %w", err)
+// toMarginMasters converts the downloaded margin master into domain models.
+func toMarginMasters(marginMasters []response.ResMarginMaster) []*model.MarginMaster {
+	margins := make([]*model.MarginMaster, 0, len(marginMasters))
+	for _, mm := range marginMasters {
+		margins = append(margins, &model.MarginMaster{
+			IssueCode:      mm.IssueCode,
+			ListingMarket:  mm.ListingMarket,
+			ChangeDate:     mm.ChangeDate,
+			CollateralRate: parseMasterFloat("CollateralRate", mm.CollateralRate),
+			CashMarginRate: parseMasterFloat("CashMarginRate", mm.CashMarginRate),
+		})
+	}
+	return margins
+}
+
+// toStockIssueRegulations converts the downloaded regulations into domain models.
+func toStockIssueRegulations(regulations []response.ResStockIssueRegulation) []*model.StockIssueRegulation {
+	result := make([]*model.StockIssueRegulation, 0, len(regulations))
+	for _, r := range regulations {
+		result = append(result, &model.StockIssueRegulation{
+			IssueCode:                  r.IssueCode,
+			ListingMarket:              r.ListingMarket,
+			TradingHalt:                model.TradingRegulation(r.TradingHaltCategory),
+			CashBuy:                    model.TradingRegulation(r.CashBuy),
+			CashSell:                   model.TradingRegulation(r.CashSell),
+			MarginNewBuy:               model.TradingRegulation(r.InstitutionalMarginNewBuy),
+			MarginNewSell:              model.TradingRegulation(r.InstitutionalMarginNewSell),
+			GeneralMarginNewBuy:        model.TradingRegulation(r.GeneralMarginNewBuy),
+			GeneralMarginNewSell:       model.TradingRegulation(r.GeneralMarginNewSell),
+			PreAdjustment:              r.PreAdjustmentFlag == "1",
+			SameDaySettlement:          r.SameDaySettlementRegulation == "1",
+			ConcentratedMarginCategory: r.ConcentratedMarginCategory,
+		})
+	}
+	return result
+}
+
+// toOperationStatuses converts the downloaded operation status lists into domain models.
+// Entries flagged as deleted are skipped.
+func toOperationStatuses(lists ...[]response.ResOperationStatus) []*model.OperationStatus {
+	var statuses []*model.OperationStatus
+	for _, list := range lists {
+		for _, st := range list {
+			if st.DeleteFlag == "1" {
+				continue
+			}
+			statuses = append(statuses, &model.OperationStatus{
+				Kind:              st.CLMID,
+				OperationCategory: st.OperationCategory,
+				OperationUnit:     st.OperationUnit,
+				TargetBusiness:    st.TargetBusiness,
+				BusinessDayFlag:   st.BusinessDayFlag,
+				Status:            st.OperationStatus,
+				BusinessStatus:    st.BusinessStatus,
+				EventName:         st.EventName,
+				EstimatedTime:     st.EstimatedTime,
+			})
 		}
-		slog.Info("Successfully upserted tick rules.")
 	}
+	return statuses
+}
 
-	return nil
+// parseMasterInt parses an integer field of the master data, returning 0 when it is empty or invalid.
+func parseMasterInt(field, value string) int {
+	return int(parseMasterInt64(field, value))
+}
+
+// parseMasterInt64 parses an integer field of the master data, returning 0 when it is empty or invalid.
+func parseMasterInt64(field, value string) int64 {
+	if value == "" {
+		return 0
+	}
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		slog.Warn("Failed to parse master data field, setting 0", "field", field, "value", value, "error", err)
+		return 0
+	}
+	return v
+}
+
+// parseMasterFloat parses a numeric field of the master data, returning 0 when it is empty or invalid.
+func parseMasterFloat(field, value string) float64 {
+	if value == "" {
+		return 0
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		slog.Warn("Failed to parse master data field, setting 0", "field", field, "value", value, "error", err)
+		return 0
+	}
+	return v
 }
 
 // GetStock retrieves basic master data for a single stock from the local database.
@@ -175,14 +476,94 @@ func (uc *masterUseCaseImpl) GetStock(ctx context.Context, symbol string) (*Stoc
 		return nil, fmt.Errorf("unexpected type returned from repository for StockMaster")
 	}
 
-	result := &StockMasterResult{
+	return newStockMasterResult(stock), nil
+}
+
+// ListStocks searches stock master data in the local database.
+func (uc *masterUseCaseImpl) ListStocks(ctx context.Context, params StockSearchParams) (*StockSearchResult, error) {
+	stocks, total, err := uc.masterRepo.FindStockMasters(ctx, repository.StockMasterQuery{
+		MarketCode:   params.Market,
+		IndustryCode: params.IndustryCode,
+		Keyword:      params.Keyword,
+		TradingUnit:  params.TradingUnit,
+		Offset:       params.Offset,
+		Limit:        params.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search stock masters from repository: %w", err)
+	}
+
+	result := &StockSearchResult{
+		Stocks: make([]*StockMasterResult, 0, len(stocks)),
+		Total:  total,
+		Offset: params.Offset,
+		Limit:  params.Limit,
+	}
+	for _, stock := range stocks {
+		result.Stocks = append(result.Stocks, newStockMasterResult(stock))
+	}
+	return result, nil
+}
+
+// ListIndustries returns the industries of the stocks in the local database with their stock counts.
+func (uc *masterUseCaseImpl) ListIndustries(ctx context.Context) ([]*IndustryResult, error) {
+	counts, err := uc.masterRepo.CountStocksByIndustry(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count stocks by industry from repository: %w", err)
+	}
+
+	industries := make([]*IndustryResult, 0, len(counts))
+	for _, c := range counts {
+		industries = append(industries, &IndustryResult{
+			Code:  c.IndustryCode,
+			Name:  c.IndustryName,
+			Count: c.Count,
+		})
+	}
+	return industries, nil
+}
+
+// ListSyncRuns returns the most recent master data sync runs, newest first.
+func (uc *masterUseCaseImpl) ListSyncRuns(ctx context.Context, limit int) ([]*MasterSyncRunResult, error) {
+	runs, err := uc.masterRepo.FindMasterSyncRuns(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find master sync runs from repository: %w", err)
+	}
+
+	results := make([]*MasterSyncRunResult, 0, len(runs))
+	for _, run := range runs {
+		results = append(results, &MasterSyncRunResult{
+			ID:         run.ID,
+			Trigger:    run.Trigger,
+			Status:     run.Status,
+			StartedAt:  run.StartedAt,
+			FinishedAt: run.FinishedAt,
+			Error:      run.Error,
+			Summary: MasterSyncSummary{
+				RunID:             run.ID,
+				Scope:             MasterSyncScope(run.Scope),
+				Stocks:            run.Stocks,
+				StockMarkets:      run.StockMarkets,
+				TickRules:         run.TickRules,
+				MarginMasters:     run.MarginMasters,
+				Regulations:       run.Regulations,
+				OperationStatuses: run.OperationStatuses,
+			},
+		})
+	}
+	return results, nil
+}
+
+func newStockMasterResult(stock *model.StockMaster) *StockMasterResult {
+	return &StockMasterResult{
 		Symbol:       stock.IssueCode,
 		Name:         stock.IssueName,
 		NameKana:     stock.IssueNameKana,
 		Market:       stock.MarketCode,
 		IndustryCode: stock.IndustryCode,
 		IndustryName: stock.IndustryName,
+		UpperLimit:   stock.UpperLimit,
+		LowerLimit:   stock.LowerLimit,
+		TradingUnit:  stock.TradingUnit,
 	}
-
-	return result, nil
 }
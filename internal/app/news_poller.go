@@ -0,0 +1,41 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// NewsPoller polls the news of the watched stocks at a fixed interval.
+type NewsPoller struct {
+	newsUseCase NewsUseCase
+	interval    time.Duration
+}
+
+// NewNewsPoller creates a poller that polls the news every interval.
+func NewNewsPoller(newsUseCase NewsUseCase, interval time.Duration) (*NewsPoller, error) {
+	if interval <= 0 {
+		return nil, errors.New("news poll interval must be positive")
+	}
+	return &NewsPoller{newsUseCase: newsUseCase, interval: interval}, nil
+}
+
+// Run polls the news immediately and then every interval until the context is canceled.
+// A failed poll is logged and retried at the next interval.
+func (p *NewsPoller) Run(ctx context.Context) {
+	runPeriodically(ctx, p.interval, p.poll)
+}
+
+func (p *NewsPoller) poll(ctx context.Context) {
+	summary, err := p.newsUseCase.PollNews(ctx)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		slog.Error("News poll failed", "error", err)
+		return
+	}
+	slog.Info("News poll completed", "headers", summary.Headers, "saved", summary.Saved, "bodies", summary.Bodies,
+		"header_failures", summary.HeaderFailures, "body_failures", summary.BodyFailures)
+}
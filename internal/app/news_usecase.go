@@ -0,0 +1,39 @@
+package app
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"time"
+)
+
+// NewsPollConfig configures which news PollNews fetches.
+type NewsPollConfig struct {
+	// Symbols are the stocks whose news headers are polled.
+	// When empty, the latest news headers are polled without a stock filter.
+	Symbols []string
+	// LookbackDays is the number of days before today to poll headers from. Defaults to 1.
+	LookbackDays int
+	// BodyFetchLimit is the maximum number of news bodies fetched per poll. Defaults to 50.
+	BodyFetchLimit int
+}
+
+// NewsPollSummary reports the outcome of a news poll.
+type NewsPollSummary struct {
+	Headers        int // number of headers received
+	Saved          int // number of news items that were not stored yet
+	Bodies         int // number of bodies fetched
+	HeaderFailures int // number of stocks whose headers could not be fetched
+	BodyFailures   int // number of bodies that could not be fetched
+}
+
+// NewsUseCase defines the interface for news related use cases.
+type NewsUseCase interface {
+	// ListNews returns the stored news, newest first, optionally filtered by a related stock and a start time.
+	ListNews(ctx context.Context, symbol string, since time.Time, limit int) ([]*model.News, error)
+	// GetNews returns a news item with its body, fetching the body when it has not been stored yet.
+	// ErrNotFound is returned when the news item is not stored.
+	GetNews(ctx context.Context, id string) (*model.News, error)
+	// PollNews fetches the news headers of the watched stocks, stores the new ones and fetches missing bodies.
+	// A stock or a body that cannot be fetched is counted in the summary and skipped.
+	PollNews(ctx context.Context) (*NewsPollSummary, error)
+}
@@ -0,0 +1,224 @@
+package app
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"stock-bot/internal/infrastructure/client"
+	"stock-bot/internal/infrastructure/client/dto/master/request"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+// newsHeaderPageSize is the number of headers requested per page.
+const newsHeaderPageSize = 100
+
+// newsUseCaseImpl implements the NewsUseCase interface.
+type newsUseCaseImpl struct {
+	masterClient client.MasterDataClient
+	newsRepo     repository.NewsRepository
+	session      *client.Session
+	pollConfig   NewsPollConfig
+}
+
+// NewNewsUseCaseImpl creates a new NewsUseCase.
+func NewNewsUseCaseImpl(masterClient client.MasterDataClient, newsRepo repository.NewsRepository, session *client.Session, pollConfig NewsPollConfig) NewsUseCase {
+	if pollConfig.LookbackDays <= 0 {
+		pollConfig.LookbackDays = 1
+	}
+	if pollConfig.BodyFetchLimit <= 0 {
+		pollConfig.BodyFetchLimit = 50
+	}
+	return &newsUseCaseImpl{
+		masterClient: masterClient,
+		newsRepo:     newsRepo,
+		session:      session,
+		pollConfig:   pollConfig,
+	}
+}
+
+// ListNews returns the stored news, newest first.
+func (uc *newsUseCaseImpl) ListNews(ctx context.Context, symbol string, since time.Time, limit int) ([]*model.News, error) {
+	news, err := uc.newsRepo.FindNews(ctx, repository.NewsQuery{Symbol: symbol, Since: since, Limit: limit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find news: %w", err)
+	}
+	return news, nil
+}
+
+// GetNews returns a news item with its body.
+// When the body cannot be fetched, the news item is returned without it.
+func (uc *newsUseCaseImpl) GetNews(ctx context.Context, id string) (*model.News, error) {
+	news, err := uc.newsRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find news: %w", err)
+	}
+	if news == nil {
+		return nil, ErrNotFound
+	}
+	if news.BodyFetchedAt == nil {
+		if err := uc.fetchBody(ctx, news); err != nil {
+			slog.Warn("failed to fetch news body", "news_id", id, "error", err)
+		}
+	}
+	return news, nil
+}
+
+// PollNews fetches the news headers of the watched stocks from LookbackDays before today,
+// stores the news items that are not stored yet (deduplicated by news ID) and fetches missing bodies.
+// A stock whose headers cannot be fetched and a body that cannot be fetched are logged, counted in the summary
+// and skipped so that they do not hold back the others; the poll fails only when no headers could be fetched at all.
+func (uc *newsUseCaseImpl) PollNews(ctx context.Context) (*NewsPollSummary, error) {
+	summary := &NewsPollSummary{}
+	fromDate := time.Now().In(marketLocation()).AddDate(0, 0, -uc.pollConfig.LookbackDays).Format("20060102")
+
+	symbols := uc.pollConfig.Symbols
+	if len(symbols) == 0 {
+		symbols = []string{""}
+	}
+	var lastErr error
+	for _, symbol := range symbols {
+		news, err := uc.fetchHeaders(ctx, symbol, fromDate)
+		if err != nil {
+			if ctx.Err() != nil {
+				return summary, ctx.Err()
+			}
+			slog.Warn("failed to fetch news headers", "symbol", symbol, "error", err)
+			summary.HeaderFailures++
+			lastErr = fmt.Errorf("failed to fetch news headers for %q: %w", symbol, err)
+			continue
+		}
+		summary.Headers += len(news)
+
+		saved, err := uc.newsRepo.SaveNews(ctx, news)
+		if err != nil {
+			return summary, fmt.Errorf("failed to save news: %w", err)
+		}
+		summary.Saved += saved
+	}
+	if summary.HeaderFailures == len(symbols) {
+		return summary, lastErr
+	}
+
+	withoutBody, err := uc.newsRepo.FindWithoutBody(ctx, uc.pollConfig.BodyFetchLimit)
+	if err != nil {
+		return summary, fmt.Errorf("failed to find news without body: %w", err)
+	}
+	for _, news := range withoutBody {
+		if err := uc.fetchBody(ctx, news); err != nil {
+			if ctx.Err() != nil {
+				return summary, ctx.Err()
+			}
+			slog.Warn("failed to fetch news body", "news_id", news.ID, "error", err)
+			summary.BodyFailures++
+			continue
+		}
+		summary.Bodies++
+	}
+	return summary, nil
+}
+
+// fetchHeaders fetches every page of news headers of the stock (all stocks when symbol is empty) since fromDate.
+func (uc *newsUseCaseImpl) fetchHeaders(ctx context.Context, symbol, fromDate string) ([]*model.News, error) {
+	var news []*model.News
+	seen := make(map[string]struct{})
+	for offset := 0; ; offset += newsHeaderPageSize {
+		res, err := uc.masterClient.GetNewsHeader(ctx, uc.session, request.ReqGetNewsHead{
+			Issue:    symbol,
+			FromDate: fromDate,
+			Offset:   strconv.Itoa(offset),
+			Limit:    strconv.Itoa(newsHeaderPageSize),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range res.CLMMfdsNewsHead {
+			if _, ok := seen[item.PID]; ok || item.PID == "" {
+				continue
+			}
+			seen[item.PID] = struct{}{}
+			news = append(news, &model.News{
+				ID:          item.PID,
+				PublishedAt: parseNewsTime(item.PDT, item.PTM),
+				Categories:  splitNewsList(item.PCGL),
+				Genres:      splitNewsList(item.PGNL),
+				Symbols:     splitNewsList(item.PISL),
+				Headline:    decodeNewsText(item.PHDL),
+			})
+		}
+
+		total, _ := strconv.Atoi(res.PRECMAX)
+		if len(res.CLMMfdsNewsHead) < newsHeaderPageSize || offset+len(res.CLMMfdsNewsHead) >= total {
+			return news, nil
+		}
+	}
+}
+
+// fetchBody fetches the body of the news item and stores it.
+// A news item without a body is stored with an empty body so that it is not fetched again.
+func (uc *newsUseCaseImpl) fetchBody(ctx context.Context, news *model.News) error {
+	res, err := uc.masterClient.GetNewsBody(ctx, uc.session, request.ReqGetNewsBody{NewsID: news.ID})
+	if err != nil {
+		return fmt.Errorf("failed to get news body for %s: %w", news.ID, err)
+	}
+	body := ""
+	for _, item := range res.CLMMfdsNewsBody {
+		if item.PID == news.ID || item.PID == "" {
+			body = decodeNewsText(item.PTX)
+			break
+		}
+	}
+
+	fetchedAt := time.Now()
+	if err := uc.newsRepo.SaveBody(ctx, news.ID, body, fetchedAt); err != nil {
+		return fmt.Errorf("failed to save news body for %s: %w", news.ID, err)
+	}
+	news.Body = body
+	news.BodyFetchedAt = &fetchedAt
+	return nil
+}
+
+// parseNewsTime parses the news date (YYYYMMDD) and time (HHMMSS) in the market timezone.
+func parseNewsTime(date, clock string) time.Time {
+	t, err := time.ParseInLocation("20060102150405", date+clock, marketLocation())
+	if err != nil {
+		t, _ = time.ParseInLocation("20060102", date, marketLocation())
+	}
+	return t
+}
+
+// splitNewsList splits a "|" separated list, dropping empty elements.
+func splitNewsList(s string) []string {
+	list := []string{}
+	for _, v := range strings.Split(s, "|") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// decodeNewsText decodes a base64 encoded headline or body.
+// The decoded text is used as is when it is valid UTF-8 and is otherwise decoded as Shift-JIS.
+// Text that is not valid base64 is returned unchanged.
+func decodeNewsText(s string) string {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return s
+	}
+	if utf8.Valid(b) {
+		return string(b)
+	}
+	decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(b)
+	if err != nil {
+		return string(b)
+	}
+	return string(decoded)
+}
@@ -3,10 +3,14 @@ package app
 
 import (
 	"context"
+	"errors"
 	"stock-bot/domain/model"
 	"stock-bot/internal/infrastructure/client"
 )
 
+// ErrInvalidOrder is returned when order parameters are rejected before reaching the broker.
+var ErrInvalidOrder = errors.New("invalid order")
+
 type OrderUseCase interface {
 	ExecuteOrder(ctx context.Context, session *client.Session, orderParams OrderParams) (*model.Order, error)
 }
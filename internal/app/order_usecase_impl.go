@@ -3,24 +3,36 @@ package app
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"stock-bot/domain/model"
 	"stock-bot/domain/repository"
 	"stock-bot/internal/infrastructure/client"
+	"time"
 	// "stock-bot/internal/infrastructure/client/dto/order/request" // Removed as request.ReqNewOrder is no longer directly used
 )
 
 // OrderUseCaseの実装
 type OrderUseCaseImpl struct {
-	orderClient client.OrderClient
-	orderRepo   repository.OrderRepository
+	orderClient  client.OrderClient
+	orderRepo    repository.OrderRepository
+	masterRepo   repository.MasterRepository
+	tickService  TickService
+	marginGuard  MarginEntryGuard      // nil の場合は新規信用建の可否を確認しない
+	sellReserver *SellQuantityReserver // nil の場合は売却可能数量を確認しない
 	// secondPassword string // Removed
 }
 
 // NewOrderUseCaseImpl はOrderUseCaseImplの新しいインスタンスを生成します
-func NewOrderUseCaseImpl(orderClient client.OrderClient, orderRepo repository.OrderRepository) OrderUseCase {
+// marginGuard は新規信用建の前に委託保証金率を確認するために使用する (nil の場合は確認しない)
+// sellReserver は現物の売り注文の前に売却可能数量を確認するために使用する (nil の場合は確認しない)
+func NewOrderUseCaseImpl(orderClient client.OrderClient, orderRepo repository.OrderRepository, masterRepo repository.MasterRepository, tickService TickService, marginGuard MarginEntryGuard, sellReserver *SellQuantityReserver) OrderUseCase {
 	return &OrderUseCaseImpl{
-		orderClient: orderClient,
-		orderRepo:   orderRepo,
+		orderClient:  orderClient,
+		orderRepo:    orderRepo,
+		masterRepo:   masterRepo,
+		tickService:  tickService,
+		marginGuard:  marginGuard,
+		sellReserver: sellReserver,
 		// secondPassword: secondPassword, // Removed
 	}
 }
@@ -36,7 +48,52 @@ func (uc *OrderUseCaseImpl) ExecuteOrder(ctx context.Context, session *client.Se
 	case model.TradeTypeSell:
 		baibaiKubun = "1" // 売
 	default:
-		return nil, fmt.Errorf("invalid trade type: %s", params.TradeType)
+		return nil, fmt.Errorf("%w: invalid trade type: %s", ErrInvalidOrder, params.TradeType)
+	}
+
+	// 委託保証金率が追証の水準に近い場合は新規信用建を行わない
+	if params.IsMargin && uc.marginGuard != nil {
+		if err := uc.marginGuard.CheckMarginEntry(); err != nil {
+			return nil, err
+		}
+	}
+
+	// 数量は銘柄の売買単位の整数倍でなければならない
+	if params.Quantity == 0 {
+		return nil, fmt.Errorf("%w: quantity must be positive", ErrInvalidOrder)
+	}
+	stock, err := uc.findStockMaster(ctx, params.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	if stock != nil {
+		if err := stock.ValidateQuantity(int(params.Quantity)); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidOrder, err)
+		}
+	}
+
+	orderPlaced := false // 証券会社が注文を受け付けたかどうか (売却可能数量の予約の扱いに使用する)
+	// 売り注文は売却可能数量 (他の売り注文で拘束されている数量を除く) までに抑え、
+	// 同時に発注される売り注文で売り越さないよう、発注が終わるまで数量を予約する
+	// 注文は IsMargin にかかわらず現物 (GenkinShinyouKubun "0") で発注するため、信用の指定でも確認を省略しない
+	// 売却可能数量を確認できない場合は、売り越しを避けるため発注しない (エージェントの発注も同じ)
+	if params.TradeType == model.TradeTypeSell && uc.sellReserver != nil {
+		tradingUnit := 0
+		if stock != nil {
+			tradingUnit = stock.TradingUnit
+		}
+		sellable, done, err := uc.sellReserver.Reserve(ctx, session, params.Symbol, int(params.Quantity), tradingUnit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check sellable quantity: %w", err)
+		}
+		if sellable == 0 {
+			return nil, fmt.Errorf("%w: %w: %s", ErrInvalidOrder, model.ErrNoSellableQuantity, params.Symbol)
+		}
+		defer func() { done(orderPlaced) }()
+		if uint64(sellable) < params.Quantity {
+			slog.Info("sell quantity capped to the sellable quantity", "symbol", params.Symbol, "quantity", params.Quantity, "sellable", sellable)
+			params.Quantity = uint64(sellable)
+		}
 	}
 
 	// OrderType のマッピング
@@ -47,10 +104,25 @@ func (uc *OrderUseCaseImpl) ExecuteOrder(ctx context.Context, session *client.Se
 		orderPrice = "0" // 成行
 		condition = "0"  // 指定なし
 	case model.OrderTypeLimit:
-		orderPrice = fmt.Sprintf("%.1f", params.Price) // 指値価格
-		condition = "0"                                // 指定なし
+		if params.Price <= 0 {
+			return nil, fmt.Errorf("%w: limit price must be positive: %v", ErrInvalidOrder, params.Price)
+		}
+		// 指値は呼値の単位に合わせる (買いは切り下げ、売りは切り上げ)
+		rounded, err := uc.tickService.RoundPrice(ctx, params.Symbol, time.Now(), params.Price, model.TickRoundingForSide(params.TradeType))
+		if err != nil {
+			return nil, fmt.Errorf("failed to round limit price to tick: %w", err)
+		}
+		// 値幅制限の範囲外の指値は証券会社に送らずに拒否する
+		if stock != nil {
+			if _, err := stock.ApplyPriceLimits(rounded, model.PriceLimitReject); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidOrder, err)
+			}
+		}
+		params.Price = rounded
+		orderPrice = model.FormatTickPrice(rounded) // 指値価格
+		condition = "0"                             // 指定なし
 	default:
-		return nil, fmt.Errorf("invalid order type: %s", params.OrderType)
+		return nil, fmt.Errorf("%w: invalid order type: %s", ErrInvalidOrder, params.OrderType)
 	}
 
 	req := client.NewOrderParams{ // Changed to client.NewOrderParams
@@ -79,6 +151,7 @@ func (uc *OrderUseCaseImpl) ExecuteOrder(ctx context.Context, session *client.Se
 	if res.ResultCode != "0" {
 		return nil, fmt.Errorf("order failed with result code %s: %s", res.ResultCode, res.ResultText)
 	}
+	orderPlaced = true
 
 	// 3. 結果をドメインモデルに変換
 	order := &model.Order{
@@ -100,4 +173,15 @@ func (uc *OrderUseCaseImpl) ExecuteOrder(ctx context.Context, session *client.Se
 	}
 
 	return order, nil
-}
\ No newline at end of file
+}
+
+// findStockMaster は銘柄マスタを取得する
+// 銘柄マスタがない場合は nil を返し、売買単位・値幅制限の確認は行わない
+func (uc *OrderUseCaseImpl) findStockMaster(ctx context.Context, symbol string) (*model.StockMaster, error) {
+	rawResult, err := uc.masterRepo.FindByIssueCode(ctx, symbol, "StockMaster")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stock master for %s: %w", symbol, err)
+	}
+	stock, _ := rawResult.(*model.StockMaster)
+	return stock, nil
+}
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+// runPeriodically calls run immediately and then every interval until the context is canceled.
+// run is responsible for logging its own failures; a failed run is simply retried at the next interval.
+func runPeriodically(ctx context.Context, interval time.Duration, run func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		run(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
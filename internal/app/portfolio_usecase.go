@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"stock-bot/internal/infrastructure/client"
+)
+
+// PortfolioTotals is the aggregate of all positions.
+type PortfolioTotals struct {
+	PositionCount int
+	MarketValue   float64 // gross exposure (long + short)
+	LongValue     float64
+	ShortValue    float64
+	NetExposure   float64 // long - short
+	CostBasis     float64
+	UnrealizedPL  float64
+}
+
+// PortfolioExposure is the exposure of a group of positions, such as a position type or an industry.
+type PortfolioExposure struct {
+	Code          string // position type or industry code ("" when the industry is unknown)
+	Name          string
+	PositionCount int
+	MarketValue   float64
+	Weight        float64 // share of the gross market value (0..1)
+	UnrealizedPL  float64
+}
+
+// PortfolioConcentration describes how concentrated the portfolio is by symbol.
+type PortfolioConcentration struct {
+	LargestSymbol string
+	LargestWeight float64 // share of the largest symbol (0..1)
+	Top5Weight    float64 // share of the 5 largest symbols (0..1)
+	HHI           float64 // Herfindahl-Hirschman index of the symbol weights (0..1)
+}
+
+// PortfolioResult is the result type for the GetPortfolio use case.
+type PortfolioResult struct {
+	Positions     []*Position
+	Totals        PortfolioTotals
+	ByType        []*PortfolioExposure // cash, margin long and margin short
+	ByIndustry    []*PortfolioExposure // largest first
+	Concentration PortfolioConcentration
+	// RealizedPL is the net P&L of the trades matched from the stored executions with the moving-average cost method.
+	RealizedPL      float64
+	RealizedPLToday float64
+	// ParseErrors lists the broker values that could not be parsed, prefixed with the symbol and position type.
+	// The totals treat them as 0, so they should be checked before trusting the numbers.
+	ParseErrors []string
+}
+
+// PortfolioUseCase defines the interface for portfolio analytics.
+type PortfolioUseCase interface {
+	GetPortfolio(ctx context.Context, session *client.Session) (*PortfolioResult, error)
+}
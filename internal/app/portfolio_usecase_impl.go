@@ -0,0 +1,174 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"stock-bot/internal/infrastructure/client"
+	"time"
+)
+
+// portfolioUseCaseImpl implements the PortfolioUseCase interface.
+type portfolioUseCaseImpl struct {
+	positionUseCase PositionUseCase
+	masterRepo      repository.MasterRepository
+	orderRepo       repository.OrderRepository
+}
+
+// NewPortfolioUseCaseImpl creates a new PortfolioUseCase.
+func NewPortfolioUseCaseImpl(positionUseCase PositionUseCase, masterRepo repository.MasterRepository, orderRepo repository.OrderRepository) PortfolioUseCase {
+	return &portfolioUseCaseImpl{
+		positionUseCase: positionUseCase,
+		masterRepo:      masterRepo,
+		orderRepo:       orderRepo,
+	}
+}
+
+var positionTypeNames = map[PositionType]string{
+	PositionTypeCash:        "現物",
+	PositionTypeMarginLong:  "信用買",
+	PositionTypeMarginShort: "信用売",
+}
+
+// GetPortfolio aggregates the broker's positions and the stored executions.
+func (uc *portfolioUseCaseImpl) GetPortfolio(ctx context.Context, session *client.Session) (*PortfolioResult, error) {
+	positions, err := uc.positionUseCase.ListPositions(ctx, session, "all")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PortfolioResult{Positions: positions}
+	byType := make(map[PositionType]*PortfolioExposure)
+	byIndustry := make(map[string]*PortfolioExposure)
+	bySymbol := make(map[string]float64)
+	for _, p := range positions {
+		for _, e := range p.ParseErrors {
+			result.ParseErrors = append(result.ParseErrors, fmt.Sprintf("%s (%s): %s", p.Symbol, p.PositionType, e))
+		}
+
+		value := p.Quantity * p.CurrentPrice
+		totals := &result.Totals
+		totals.PositionCount++
+		totals.MarketValue += value
+		totals.CostBasis += p.Quantity * p.AverageCost
+		totals.UnrealizedPL += p.UnrealizedPL
+		if p.PositionType == PositionTypeMarginShort {
+			totals.ShortValue += value
+		} else {
+			totals.LongValue += value
+		}
+		bySymbol[p.Symbol] += value
+
+		typeExposure, ok := byType[p.PositionType]
+		if !ok {
+			typeExposure = &PortfolioExposure{Code: string(p.PositionType), Name: positionTypeNames[p.PositionType]}
+			byType[p.PositionType] = typeExposure
+		}
+		typeExposure.add(p, value)
+
+		stock, err := uc.findStockMaster(ctx, p.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		var code, name string
+		if stock != nil {
+			code, name = stock.IndustryCode, stock.IndustryName
+		}
+		industryExposure, ok := byIndustry[code]
+		if !ok {
+			industryExposure = &PortfolioExposure{Code: code, Name: name}
+			byIndustry[code] = industryExposure
+		}
+		industryExposure.add(p, value)
+	}
+	result.Totals.NetExposure = result.Totals.LongValue - result.Totals.ShortValue
+
+	for _, positionType := range []PositionType{PositionTypeCash, PositionTypeMarginLong, PositionTypeMarginShort} {
+		if e, ok := byType[positionType]; ok {
+			result.ByType = append(result.ByType, e)
+		}
+	}
+	for _, e := range byIndustry {
+		result.ByIndustry = append(result.ByIndustry, e)
+	}
+	slices.SortFunc(result.ByIndustry, func(a, b *PortfolioExposure) int {
+		return cmp.Or(cmp.Compare(b.MarketValue, a.MarketValue), cmp.Compare(a.Code, b.Code))
+	})
+	for _, e := range slices.Concat(result.ByType, result.ByIndustry) {
+		e.Weight = weight(e.MarketValue, result.Totals.MarketValue)
+	}
+	result.Concentration = concentration(bySymbol, result.Totals.MarketValue)
+
+	orders, err := uc.orderRepo.FindExecutedOrders(ctx, time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find executed orders: %w", err)
+	}
+	trades, err := model.MatchTrades(orders, model.CostMethodAverage)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().In(marketLocation())
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	for _, t := range trades {
+		result.RealizedPL += t.NetProfitLoss
+		if !t.ExitTime.Before(today) {
+			result.RealizedPLToday += t.NetProfitLoss
+		}
+	}
+	return result, nil
+}
+
+func (uc *portfolioUseCaseImpl) findStockMaster(ctx context.Context, symbol string) (*model.StockMaster, error) {
+	entity, err := uc.masterRepo.FindByIssueCode(ctx, symbol, "StockMaster")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stock master of %s: %w", symbol, err)
+	}
+	stock, _ := entity.(*model.StockMaster)
+	return stock, nil
+}
+
+func (e *PortfolioExposure) add(p *Position, value float64) {
+	e.PositionCount++
+	e.MarketValue += value
+	e.UnrealizedPL += p.UnrealizedPL
+}
+
+func weight(value, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return value / total
+}
+
+// concentration computes the concentration metrics from the gross market value of each symbol.
+func concentration(bySymbol map[string]float64, total float64) PortfolioConcentration {
+	type symbolValue struct {
+		symbol string
+		value  float64
+	}
+	values := make([]symbolValue, 0, len(bySymbol))
+	for symbol, value := range bySymbol {
+		values = append(values, symbolValue{symbol, value})
+	}
+	slices.SortFunc(values, func(a, b symbolValue) int {
+		return cmp.Or(cmp.Compare(b.value, a.value), cmp.Compare(a.symbol, b.symbol))
+	})
+
+	var c PortfolioConcentration
+	if len(values) == 0 || total == 0 {
+		return c
+	}
+	c.LargestSymbol = values[0].symbol
+	c.LargestWeight = weight(values[0].value, total)
+	for i, v := range values {
+		w := weight(v.value, total)
+		if i < 5 {
+			c.Top5Weight += w
+		}
+		c.HHI += w * w
+	}
+	return c
+}
@@ -24,6 +24,8 @@ type Position struct {
 	UnrealizedPL     float64
 	UnrealizedPLRate float64
 	OpenedDate       string
+	// ParseErrors lists the broker values that could not be parsed. The affected fields are 0.
+	ParseErrors []string
 }
 
 // PositionUseCase defines the interface for position-related use cases.
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"stock-bot/internal/infrastructure/client"
 	"strconv"
+	"strings"
 )
 
 // positionUseCaseImpl implements the PositionUseCase interface.
@@ -31,22 +32,16 @@ func (uc *positionUseCaseImpl) ListPositions(ctx context.Context, session *clien
 		}
 
 		for _, p := range cashPositions.GenbutuKabuList {
-			// In a real implementation, we should handle parsing errors properly.
-			// For now, ignoring them to match the test's simplicity, but this is technical debt.
-			qty, _ := strconv.ParseFloat(p.UriOrderZanKabuSuryou, 64)
-			avgCost, _ := strconv.ParseFloat(p.UriOrderGaisanBokaTanka, 64)
-			curPrice, _ := strconv.ParseFloat(p.UriOrderHyoukaTanka, 64)
-			unrealizedPL, _ := strconv.ParseFloat(p.UriOrderGaisanHyoukaSoneki, 64)
-			unrealizedPLRate, _ := strconv.ParseFloat(p.UriOrderGaisanHyoukaSonekiRitu, 64)
-
+			var parser positionParser
 			positions = append(positions, &Position{
 				Symbol:           p.UriOrderIssueCode,
 				PositionType:     PositionTypeCash,
-				Quantity:         qty,
-				AverageCost:      avgCost,
-				CurrentPrice:     curPrice,
-				UnrealizedPL:     unrealizedPL,
-				UnrealizedPLRate: unrealizedPLRate,
+				Quantity:         parser.float("quantity", p.UriOrderZanKabuSuryou),
+				AverageCost:      parser.float("average_cost", p.UriOrderGaisanBokaTanka),
+				CurrentPrice:     parser.float("current_price", p.UriOrderHyoukaTanka),
+				UnrealizedPL:     parser.float("unrealized_pl", p.UriOrderGaisanHyoukaSoneki),
+				UnrealizedPLRate: parser.float("unrealized_pl_rate", p.UriOrderGaisanHyoukaSonekiRitu),
+				ParseErrors:      parser.errs,
 			})
 		}
 	}
@@ -71,24 +66,35 @@ func (uc *positionUseCaseImpl) ListPositions(ctx context.Context, session *clien
 				continue // Skip if position type is unknown
 			}
 
-			qty, _ := strconv.ParseFloat(p.OrderTategyokuSuryou, 64)
-			avgCost, _ := strconv.ParseFloat(p.OrderTategyokuTanka, 64)
-			curPrice, _ := strconv.ParseFloat(p.OrderHyoukaTanka, 64)
-			unrealizedPL, _ := strconv.ParseFloat(p.OrderGaisanHyoukaSoneki, 64)
-			unrealizedPLRate, _ := strconv.ParseFloat(p.OrderGaisanHyoukaSonekiRitu, 64)
-
+			var parser positionParser
 			positions = append(positions, &Position{
 				Symbol:           p.OrderIssueCode,
 				PositionType:     posType,
-				Quantity:         qty,
-				AverageCost:      avgCost,
-				CurrentPrice:     curPrice,
-				UnrealizedPL:     unrealizedPL,
-				UnrealizedPLRate: unrealizedPLRate,
+				Quantity:         parser.float("quantity", p.OrderTategyokuSuryou),
+				AverageCost:      parser.float("average_cost", p.OrderTategyokuTanka),
+				CurrentPrice:     parser.float("current_price", p.OrderHyoukaTanka),
+				UnrealizedPL:     parser.float("unrealized_pl", p.OrderGaisanHyoukaSoneki),
+				UnrealizedPLRate: parser.float("unrealized_pl_rate", p.OrderGaisanHyoukaSonekiRitu),
 				OpenedDate:       p.OrderTategyokuDay,
+				ParseErrors:      parser.errs,
 			})
 		}
 	}
 
 	return positions, nil
-}
\ No newline at end of file
+}
+
+// positionParser collects the values of a position that could not be parsed,
+// so that they are reported to the caller instead of silently becoming zeros.
+type positionParser struct {
+	errs []string
+}
+
+func (p *positionParser) float(name, s string) float64 {
+	v, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(s), ",", ""), 64)
+	if err != nil {
+		p.errs = append(p.errs, fmt.Sprintf("%s: invalid value %q", name, s))
+		return 0
+	}
+	return v
+}
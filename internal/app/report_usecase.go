@@ -0,0 +1,146 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+// ReportFormat is the file format of a stored daily report.
+type ReportFormat string
+
+const (
+	ReportFormatJSON     ReportFormat = "json"
+	ReportFormatMarkdown ReportFormat = "markdown"
+)
+
+// Report event types derived from the balance snapshots, in addition to the stored trading event types.
+const (
+	ReportEventMarginAlert     = "MARGIN_ALERT"     // the maintenance rate fell below the alert rate or a margin call occurred
+	ReportEventMarginRecovered = "MARGIN_RECOVERED" // the maintenance rate recovered
+)
+
+// ReportConfig configures the daily report.
+type ReportConfig struct {
+	Dir             string  // directory the report files are written to
+	MarginAlertRate float64 // maintenance rate (%) below which a margin alert is reported; 0 for margin calls only
+}
+
+// DailyReport is the end-of-day summary of what the bot did on a day (Japan time).
+// It is stored as JSON with these field names, next to a Markdown rendering.
+type DailyReport struct {
+	Date        string             `json:"date"` // YYYY-MM-DD
+	GeneratedAt time.Time          `json:"generated_at"`
+	Summary     DailyReportSummary `json:"summary"`
+	Signals     []*ReportSignal    `json:"signals"`
+	Orders      []*ReportOrder     `json:"orders"`
+	Rejections  []*ReportEvent     `json:"rejections"`
+	Fills       []*ReportFill      `json:"fills"`
+	ProfitLoss  ReportProfitLoss   `json:"profit_loss"`
+	Balance     *ReportBalance     `json:"balance"` // nil when no balance snapshot was taken on the day
+	RiskEvents  []*ReportEvent     `json:"risk_events"`
+	// Warnings lists the sections that could not be collected; the report is written without them.
+	Warnings []string `json:"warnings"`
+}
+
+// DailyReportSummary counts the items of a daily report.
+type DailyReportSummary struct {
+	SignalsConsumed int `json:"signals_consumed"`
+	OrdersPlaced    int `json:"orders_placed"`
+	OrdersRejected  int `json:"orders_rejected"`
+	Fills           int `json:"fills"`
+	RiskEvents      int `json:"risk_events"`
+}
+
+// ReportSignal is a signal consumed by the agent.
+type ReportSignal struct {
+	ID         uint      `json:"id"`
+	Symbol     string    `json:"symbol"`
+	Type       string    `json:"type"`
+	Source     string    `json:"source"`
+	ConsumedAt time.Time `json:"consumed_at"`
+	OrderIDs   []string  `json:"order_ids"` // orders placed for the signal on the day
+}
+
+// ReportOrder is an order placed on the day.
+type ReportOrder struct {
+	OrderID        string    `json:"order_id"`
+	PlacedAt       time.Time `json:"placed_at"`
+	Symbol         string    `json:"symbol"`
+	TradeType      string    `json:"trade_type"`
+	OrderType      string    `json:"order_type"`
+	IsMargin       bool      `json:"is_margin"`
+	Quantity       int       `json:"quantity"`
+	Price          float64   `json:"price"`
+	Status         string    `json:"status"`
+	FilledQuantity int       `json:"filled_quantity"`
+	SignalID       *uint     `json:"signal_id"`
+}
+
+// ReportFill is an execution on the day.
+type ReportFill struct {
+	OrderID    string    `json:"order_id"`
+	ExecutedAt time.Time `json:"executed_at"`
+	Symbol     string    `json:"symbol"`
+	TradeType  string    `json:"trade_type"`
+	Quantity   int       `json:"quantity"`
+	Price      float64   `json:"price"`
+	Commission float64   `json:"commission"`
+}
+
+// ReportEvent is an order rejection or a risk event.
+type ReportEvent struct {
+	OccurredAt time.Time `json:"occurred_at"`
+	Type       string    `json:"type"`
+	Symbol     string    `json:"symbol,omitempty"`
+	SignalID   *uint     `json:"signal_id,omitempty"`
+	Message    string    `json:"message"`
+}
+
+// ReportProfitLoss is the P&L of the day.
+// The realized P&L is that of the trades closed on the day (FIFO); the unrealized P&L is that of the
+// positions held when the report was generated. Past positions are not recorded, so the unrealized P&L is
+// only reported for the current day and is left out of the report of another day.
+type ReportProfitLoss struct {
+	Trades        int     `json:"trades"`
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	RealizedGross float64 `json:"realized_gross"`
+	Commission    float64 `json:"commission"`
+	Realized      float64 `json:"realized"`
+	Unrealized    float64 `json:"unrealized"`
+	Positions     int     `json:"positions"`
+	// UnrealizedAsOf is when the unrealized P&L and the positions were taken (nil when they were not collected).
+	UnrealizedAsOf *time.Time `json:"unrealized_as_of"`
+}
+
+// ReportBalance is the change of the balance between the first and the last snapshot of the day.
+type ReportBalance struct {
+	Snapshots                 int                `json:"snapshots"`
+	Open                      ReportBalancePoint `json:"open"`
+	Close                     ReportBalancePoint `json:"close"`
+	CashBuyingPowerChange     float64            `json:"cash_buying_power_change"`
+	DepositedMarginChange     float64            `json:"deposited_margin_change"`
+	ValuationProfitLossChange float64            `json:"valuation_profit_loss_change"`
+	// MinMaintenanceRate is the lowest maintenance rate while margin positions were held (nil without margin positions).
+	MinMaintenanceRate *float64 `json:"min_maintenance_rate"`
+}
+
+// ReportBalancePoint is a balance snapshot in a daily report.
+type ReportBalancePoint struct {
+	TakenAt             time.Time `json:"taken_at"`
+	CashBuyingPower     float64   `json:"cash_buying_power"`
+	MarginBuyingPower   float64   `json:"margin_buying_power"`
+	WithdrawableCash    float64   `json:"withdrawable_cash"`
+	DepositedMargin     float64   `json:"deposited_margin"`
+	ValuationProfitLoss float64   `json:"valuation_profit_loss"`
+	MaintenanceRate     float64   `json:"maintenance_rate"`
+}
+
+// ReportUseCase defines the interface for the daily reports.
+type ReportUseCase interface {
+	// GenerateDailyReport collects the activity of the day (YYYY-MM-DD, Japan time) and writes the report files,
+	// replacing the previous report of the day.
+	GenerateDailyReport(ctx context.Context, date string) (*DailyReport, error)
+	// GetDailyReport returns the stored report file of the day. It returns ErrNotFound if no report has been written.
+	GetDailyReport(ctx context.Context, date string, format ReportFormat) ([]byte, error)
+}
@@ -0,0 +1,444 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"stock-bot/internal/infrastructure/client"
+	"strings"
+	"time"
+)
+
+// reportUseCaseImpl implements the ReportUseCase interface.
+type reportUseCaseImpl struct {
+	signalRepo      repository.SignalRepository
+	orderRepo       repository.OrderRepository
+	eventRepo       repository.TradingEventRepository
+	balanceRepo     repository.BalanceRepository
+	tradeUseCase    TradeUseCase
+	positionUseCase PositionUseCase
+	session         *client.Session
+	config          ReportConfig
+}
+
+// NewReportUseCaseImpl creates a new ReportUseCase.
+func NewReportUseCaseImpl(signalRepo repository.SignalRepository, orderRepo repository.OrderRepository, eventRepo repository.TradingEventRepository, balanceRepo repository.BalanceRepository, tradeUseCase TradeUseCase, positionUseCase PositionUseCase, session *client.Session, config ReportConfig) ReportUseCase {
+	return &reportUseCaseImpl{
+		signalRepo:      signalRepo,
+		orderRepo:       orderRepo,
+		eventRepo:       eventRepo,
+		balanceRepo:     balanceRepo,
+		tradeUseCase:    tradeUseCase,
+		positionUseCase: positionUseCase,
+		session:         session,
+		config:          config,
+	}
+}
+
+// GenerateDailyReport collects the report from the stored records.
+// The signals, orders and events are required; the P&L and the balance are reported as warnings when they
+// cannot be collected, so that a broker outage does not lose the rest of the report.
+func (uc *reportUseCaseImpl) GenerateDailyReport(ctx context.Context, date string) (*DailyReport, error) {
+	start, err := time.ParseInLocation(time.DateOnly, date, marketLocation())
+	if err != nil {
+		return nil, fmt.Errorf("invalid report date %q: %w", date, err)
+	}
+	end := start.AddDate(0, 0, 1)
+
+	report := &DailyReport{
+		Date:        date,
+		GeneratedAt: time.Now(),
+		Signals:     []*ReportSignal{},
+		Orders:      []*ReportOrder{},
+		Rejections:  []*ReportEvent{},
+		Fills:       []*ReportFill{},
+		RiskEvents:  []*ReportEvent{},
+		Warnings:    []string{},
+	}
+
+	orders, err := uc.orderRepo.FindOrders(ctx, repository.OrderQuery{Since: start, Until: end})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find orders: %w", err)
+	}
+	ordersBySignal := make(map[uint][]string)
+	for _, o := range orders {
+		report.Orders = append(report.Orders, toReportOrder(o))
+		if o.SignalID != nil {
+			ordersBySignal[*o.SignalID] = append(ordersBySignal[*o.SignalID], o.OrderID)
+		}
+	}
+
+	signals, err := uc.signalRepo.FindConsumed(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find consumed signals: %w", err)
+	}
+	for _, s := range signals {
+		orderIDs := ordersBySignal[s.ID]
+		if orderIDs == nil {
+			orderIDs = []string{}
+		}
+		report.Signals = append(report.Signals, &ReportSignal{
+			ID:         s.ID,
+			Symbol:     s.Symbol,
+			Type:       string(s.SignalType),
+			Source:     string(s.Source),
+			ConsumedAt: *s.ConsumedAt,
+			OrderIDs:   orderIDs,
+		})
+	}
+
+	executed, err := uc.orderRepo.FindExecutedOrders(ctx, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find executed orders: %w", err)
+	}
+	for _, o := range executed {
+		for _, e := range o.Executions {
+			if e.ExecutionTime.Before(start) || !e.ExecutionTime.Before(end) {
+				continue
+			}
+			report.Fills = append(report.Fills, &ReportFill{
+				OrderID:    o.OrderID,
+				ExecutedAt: e.ExecutionTime,
+				Symbol:     o.Symbol,
+				TradeType:  string(o.TradeType),
+				Quantity:   e.ExecutionQuantity,
+				Price:      e.ExecutionPrice,
+				Commission: e.Commission,
+			})
+		}
+	}
+	slices.SortStableFunc(report.Fills, func(a, b *ReportFill) int { return a.ExecutedAt.Compare(b.ExecutedAt) })
+
+	events, err := uc.eventRepo.FindEvents(ctx, repository.TradingEventQuery{Since: start, Until: end})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find trading events: %w", err)
+	}
+	for _, e := range events {
+		event := &ReportEvent{OccurredAt: e.OccurredAt, Type: string(e.Type), Symbol: e.Symbol, SignalID: e.SignalID, Message: e.Message}
+		if e.Type == model.TradingEventOrderRejected {
+			report.Rejections = append(report.Rejections, event)
+		} else {
+			report.RiskEvents = append(report.RiskEvents, event)
+		}
+	}
+
+	if err := uc.collectProfitLoss(ctx, report); err != nil {
+		report.Warnings = append(report.Warnings, err.Error())
+	}
+	if err := uc.collectBalance(ctx, report, start, end); err != nil {
+		report.Warnings = append(report.Warnings, err.Error())
+	}
+	slices.SortStableFunc(report.RiskEvents, func(a, b *ReportEvent) int { return a.OccurredAt.Compare(b.OccurredAt) })
+
+	report.Summary = DailyReportSummary{
+		SignalsConsumed: len(report.Signals),
+		OrdersPlaced:    len(report.Orders),
+		OrdersRejected:  len(report.Rejections),
+		Fills:           len(report.Fills),
+		RiskEvents:      len(report.RiskEvents),
+	}
+
+	if err := uc.writeReport(report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// collectProfitLoss fills in the realized P&L of the day and, in the report of the current day,
+// the unrealized P&L of the current positions.
+func (uc *reportUseCaseImpl) collectProfitLoss(ctx context.Context, report *DailyReport) error {
+	days, err := uc.tradeUseCase.GetDailyProfitLoss(ctx, DailyProfitLossQuery{From: report.Date, To: report.Date})
+	if err != nil {
+		return fmt.Errorf("realized P&L: %w", err)
+	}
+	pl := &report.ProfitLoss
+	for _, d := range days {
+		pl.Trades += d.Trades
+		pl.Wins += d.Wins
+		pl.Losses += d.Losses
+		pl.RealizedGross += d.GrossProfitLoss
+		pl.Commission += d.Commission
+		pl.Realized += d.NetProfitLoss
+	}
+
+	// the current positions say nothing about the positions held at the end of another day
+	if report.Date != report.GeneratedAt.In(marketLocation()).Format(time.DateOnly) {
+		return errors.New("unrealized P&L: only available in the report of the current day")
+	}
+	positions, err := uc.positionUseCase.ListPositions(ctx, uc.session, "all")
+	if err != nil {
+		return fmt.Errorf("unrealized P&L: %w", err)
+	}
+	for _, p := range positions {
+		pl.Positions++
+		pl.Unrealized += p.UnrealizedPL
+	}
+	asOf := report.GeneratedAt
+	pl.UnrealizedAsOf = &asOf
+	return nil
+}
+
+// collectBalance fills in the balance change and the margin alerts from the snapshots of the day.
+func (uc *reportUseCaseImpl) collectBalance(ctx context.Context, report *DailyReport, start, end time.Time) error {
+	snapshots, err := uc.balanceRepo.FindSnapshots(ctx, repository.BalanceSnapshotQuery{Since: start, Until: end})
+	if err != nil {
+		return fmt.Errorf("balance: %w", err)
+	}
+	if len(snapshots) == 0 {
+		return nil
+	}
+	slices.Reverse(snapshots) // oldest first
+
+	first, last := snapshots[0], snapshots[len(snapshots)-1]
+	balance := &ReportBalance{
+		Snapshots:                 len(snapshots),
+		Open:                      toReportBalancePoint(first),
+		Close:                     toReportBalancePoint(last),
+		CashBuyingPowerChange:     last.CashBuyingPower - first.CashBuyingPower,
+		DepositedMarginChange:     last.DepositedMargin - first.DepositedMargin,
+		ValuationProfitLossChange: last.ValuationProfitLoss - first.ValuationProfitLoss,
+	}
+
+	alert := false
+	for _, s := range snapshots {
+		if s.HasMarginPositions() && (balance.MinMaintenanceRate == nil || s.MaintenanceRate < *balance.MinMaintenanceRate) {
+			rate := s.MaintenanceRate
+			balance.MinMaintenanceRate = &rate
+		}
+		below := s.BelowMaintenanceRate(uc.config.MarginAlertRate)
+		switch {
+		case below && !alert:
+			message := fmt.Sprintf("maintenance rate %.2f%% is below %.2f%%", s.MaintenanceRate, uc.config.MarginAlertRate)
+			if s.HasMarginCall {
+				message = "the account has a margin call"
+			}
+			report.RiskEvents = append(report.RiskEvents, &ReportEvent{OccurredAt: s.TakenAt, Type: ReportEventMarginAlert, Message: message})
+		case !below && alert:
+			report.RiskEvents = append(report.RiskEvents, &ReportEvent{OccurredAt: s.TakenAt, Type: ReportEventMarginRecovered, Message: fmt.Sprintf("maintenance rate recovered to %.2f%%", s.MaintenanceRate)})
+		}
+		alert = below
+	}
+	report.Balance = balance
+	return nil
+}
+
+// GetDailyReport reads the stored report file.
+func (uc *reportUseCaseImpl) GetDailyReport(ctx context.Context, date string, format ReportFormat) ([]byte, error) {
+	if _, err := time.Parse(time.DateOnly, date); err != nil {
+		return nil, fmt.Errorf("invalid report date %q: %w", date, err)
+	}
+	path, err := uc.reportPath(date, format)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("daily report of %s: %w", date, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to read daily report: %w", err)
+	}
+	return data, nil
+}
+
+func (uc *reportUseCaseImpl) reportPath(date string, format ReportFormat) (string, error) {
+	switch format {
+	case ReportFormatJSON:
+		return filepath.Join(uc.config.Dir, date+".json"), nil
+	case ReportFormatMarkdown:
+		return filepath.Join(uc.config.Dir, date+".md"), nil
+	default:
+		return "", fmt.Errorf("unknown report format: %s", format)
+	}
+}
+
+// writeReport writes the JSON and Markdown files. Each file is replaced atomically so that a reader
+// never sees a partially written report.
+func (uc *reportUseCaseImpl) writeReport(report *DailyReport) error {
+	if err := os.MkdirAll(uc.config.Dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create report directory: %w", err)
+	}
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode daily report: %w", err)
+	}
+	files := map[ReportFormat][]byte{
+		ReportFormatJSON:     append(data, '\n'),
+		ReportFormatMarkdown: []byte(renderDailyReportMarkdown(report)),
+	}
+	for format, content := range files {
+		path, err := uc.reportPath(report.Date, format)
+		if err != nil {
+			return err
+		}
+		if err := writeFileAtomic(path, content); err != nil {
+			return fmt.Errorf("failed to write daily report: %w", err)
+		}
+	}
+	return nil
+}
+
+func writeFileAtomic(path string, content []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op after the rename
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func toReportOrder(o *model.Order) *ReportOrder {
+	filled := 0
+	for _, e := range o.Executions {
+		filled += e.ExecutionQuantity
+	}
+	return &ReportOrder{
+		OrderID:        o.OrderID,
+		PlacedAt:       o.CreatedAt,
+		Symbol:         o.Symbol,
+		TradeType:      string(o.TradeType),
+		OrderType:      string(o.OrderType),
+		IsMargin:       o.IsMargin,
+		Quantity:       o.Quantity,
+		Price:          o.Price,
+		Status:         string(o.OrderStatus),
+		FilledQuantity: filled,
+		SignalID:       o.SignalID,
+	}
+}
+
+func toReportBalancePoint(s *model.BalanceSnapshot) ReportBalancePoint {
+	return ReportBalancePoint{
+		TakenAt:             s.TakenAt,
+		CashBuyingPower:     s.CashBuyingPower,
+		MarginBuyingPower:   s.MarginBuyingPower,
+		WithdrawableCash:    s.WithdrawableCash,
+		DepositedMargin:     s.DepositedMargin,
+		ValuationProfitLoss: s.ValuationProfitLoss,
+		MaintenanceRate:     s.MaintenanceRate,
+	}
+}
+
+// formatUnrealized formats the unrealized P&L for the Markdown report, "-" when it was not collected.
+func formatUnrealized(pl ReportProfitLoss) string {
+	if pl.UnrealizedAsOf == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f", pl.Unrealized)
+}
+
+// renderDailyReportMarkdown renders the report for reading. The JSON file is the complete record.
+func renderDailyReportMarkdown(r *DailyReport) string {
+	var b strings.Builder
+	clock := func(t time.Time) string { return t.In(marketLocation()).Format("15:04:05") }
+
+	fmt.Fprintf(&b, "# 日次レポート %s\n\n", r.Date)
+	fmt.Fprintf(&b, "作成日時: %s\n\n", r.GeneratedAt.In(marketLocation()).Format("2006-01-02 15:04:05"))
+
+	b.WriteString("## サマリー\n\n")
+	b.WriteString("| 項目 | 値 |\n|---|---:|\n")
+	fmt.Fprintf(&b, "| 処理したシグナル | %d |\n", r.Summary.SignalsConsumed)
+	fmt.Fprintf(&b, "| 発注 | %d |\n", r.Summary.OrdersPlaced)
+	fmt.Fprintf(&b, "| 発注拒否 | %d |\n", r.Summary.OrdersRejected)
+	fmt.Fprintf(&b, "| 約定 | %d |\n", r.Summary.Fills)
+	fmt.Fprintf(&b, "| 実現損益 (手数料控除後) | %.0f |\n", r.ProfitLoss.Realized)
+	fmt.Fprintf(&b, "| 評価損益 (作成時点) | %s |\n", formatUnrealized(r.ProfitLoss))
+	fmt.Fprintf(&b, "| リスクイベント | %d |\n", r.Summary.RiskEvents)
+
+	if len(r.Warnings) > 0 {
+		b.WriteString("\n## 警告\n\n以下の項目は取得できなかったため、レポートに含まれていません。\n\n")
+		for _, w := range r.Warnings {
+			fmt.Fprintf(&b, "- %s\n", w)
+		}
+	}
+
+	b.WriteString("\n## シグナル\n\n")
+	if len(r.Signals) == 0 {
+		b.WriteString("なし\n")
+	} else {
+		b.WriteString("| 時刻 | ID | 銘柄 | 売買 | 取り込み元 | 注文 |\n|---|---:|---|---|---|---|\n")
+		for _, s := range r.Signals {
+			fmt.Fprintf(&b, "| %s | %d | %s | %s | %s | %s |\n", clock(s.ConsumedAt), s.ID, s.Symbol, s.Type, s.Source, strings.Join(s.OrderIDs, ", "))
+		}
+	}
+
+	b.WriteString("\n## 注文\n\n")
+	if len(r.Orders) == 0 {
+		b.WriteString("なし\n")
+	} else {
+		b.WriteString("| 時刻 | 注文番号 | 銘柄 | 売買 | 種別 | 信用 | 数量 | 価格 | 約定数量 | 状態 |\n|---|---|---|---|---|---|---:|---:|---:|---|\n")
+		for _, o := range r.Orders {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %t | %d | %.1f | %d | %s |\n", clock(o.PlacedAt), o.OrderID, o.Symbol, o.TradeType, o.OrderType, o.IsMargin, o.Quantity, o.Price, o.FilledQuantity, o.Status)
+		}
+	}
+
+	b.WriteString("\n## 発注拒否\n\n")
+	writeReportEvents(&b, r.Rejections, clock)
+
+	b.WriteString("\n## 約定\n\n")
+	if len(r.Fills) == 0 {
+		b.WriteString("なし\n")
+	} else {
+		b.WriteString("| 時刻 | 注文番号 | 銘柄 | 売買 | 数量 | 価格 | 手数料 |\n|---|---|---|---|---:|---:|---:|\n")
+		for _, f := range r.Fills {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %d | %.1f | %.0f |\n", clock(f.ExecutedAt), f.OrderID, f.Symbol, f.TradeType, f.Quantity, f.Price, f.Commission)
+		}
+	}
+
+	pl := r.ProfitLoss
+	b.WriteString("\n## 損益\n\n")
+	b.WriteString("| 項目 | 値 |\n|---|---:|\n")
+	fmt.Fprintf(&b, "| 決済した取引 (勝ち / 負け) | %d (%d / %d) |\n", pl.Trades, pl.Wins, pl.Losses)
+	fmt.Fprintf(&b, "| 実現損益 (手数料控除前) | %.0f |\n", pl.RealizedGross)
+	fmt.Fprintf(&b, "| 手数料 | %.0f |\n", pl.Commission)
+	fmt.Fprintf(&b, "| 実現損益 (手数料控除後) | %.0f |\n", pl.Realized)
+	if pl.UnrealizedAsOf != nil {
+		fmt.Fprintf(&b, "| 評価損益 (%d 建玉, 作成時点) | %s |\n", pl.Positions, formatUnrealized(pl))
+	} else {
+		fmt.Fprintf(&b, "| 評価損益 (作成時点) | %s |\n", formatUnrealized(pl))
+	}
+
+	b.WriteString("\n## 残高\n\n")
+	if r.Balance == nil {
+		b.WriteString("この日の残高スナップショットはありません。\n")
+	} else {
+		bal := r.Balance
+		b.WriteString("| 項目 | 始 | 終 | 増減 |\n|---|---:|---:|---:|\n")
+		fmt.Fprintf(&b, "| 時刻 | %s | %s | |\n", clock(bal.Open.TakenAt), clock(bal.Close.TakenAt))
+		fmt.Fprintf(&b, "| 現物買付可能額 | %.0f | %.0f | %.0f |\n", bal.Open.CashBuyingPower, bal.Close.CashBuyingPower, bal.CashBuyingPowerChange)
+		fmt.Fprintf(&b, "| 受入保証金 | %.0f | %.0f | %.0f |\n", bal.Open.DepositedMargin, bal.Close.DepositedMargin, bal.DepositedMarginChange)
+		fmt.Fprintf(&b, "| 評価損益 | %.0f | %.0f | %.0f |\n", bal.Open.ValuationProfitLoss, bal.Close.ValuationProfitLoss, bal.ValuationProfitLossChange)
+		if bal.MinMaintenanceRate != nil {
+			fmt.Fprintf(&b, "\n委託保証金率の最低値: %.2f%%\n", *bal.MinMaintenanceRate)
+		}
+	}
+
+	b.WriteString("\n## リスクイベント\n\n")
+	writeReportEvents(&b, r.RiskEvents, clock)
+	return b.String()
+}
+
+func writeReportEvents(b *strings.Builder, events []*ReportEvent, clock func(time.Time) string) {
+	if len(events) == 0 {
+		b.WriteString("なし\n")
+		return
+	}
+	b.WriteString("| 時刻 | 種別 | 銘柄 | 内容 |\n|---|---|---|---|\n")
+	for _, e := range events {
+		fmt.Fprintf(b, "| %s | %s | %s | %s |\n", clock(e.OccurredAt), e.Type, e.Symbol, strings.ReplaceAll(e.Message, "|", "\\|"))
+	}
+}
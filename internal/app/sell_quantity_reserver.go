@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"stock-bot/internal/infrastructure/client"
+	"stock-bot/internal/infrastructure/client/dto/balance/request"
+	"sync"
+	"time"
+)
+
+// DefaultSellReservationHold is how long the reservation of a placed sell order is kept.
+// The broker's sellable quantity may not reflect an order right after it is accepted, so the
+// reservation covers that gap. The quantity is counted twice meanwhile, which only makes sells stricter.
+const DefaultSellReservationHold = 10 * time.Second
+
+// SellQuantityReserver caps sell quantities to the quantity the broker reports as sellable
+// (売付可能株数, 特定口座) and reserves them locally while the sell orders are being placed,
+// so that two exits of the same stock placed at the same moment cannot oversell.
+// A single reserver must be shared by every path that places sell orders.
+type SellQuantityReserver struct {
+	balanceClient client.BalanceClient
+	hold          time.Duration
+
+	mu           sync.Mutex
+	reservations map[string][]*sellReservation // by symbol
+}
+
+type sellReservation struct {
+	quantity  int
+	expiresAt time.Time // zero while the order is being placed
+}
+
+// NewSellQuantityReserver creates a reserver that keeps the reservations of placed orders for hold.
+func NewSellQuantityReserver(balanceClient client.BalanceClient, hold time.Duration) *SellQuantityReserver {
+	return &SellQuantityReserver{
+		balanceClient: balanceClient,
+		hold:          hold,
+		reservations:  make(map[string][]*sellReservation),
+	}
+}
+
+// Reserve returns the quantity of the stock that may be sold, up to quantity, and reserves it.
+// The quantity is rounded down to a multiple of tradingUnit (0: not rounded) so that no odd lot is sold.
+// done must be called once the order has been handled: with true when the order was placed,
+// with false when it was not (the reservation is released immediately).
+// When nothing can be sold it returns 0 and a no-op done.
+func (r *SellQuantityReserver) Reserve(ctx context.Context, session *client.Session, symbol string, quantity int, tradingUnit int) (int, func(placed bool), error) {
+	res, err := r.balanceClient.GetZanUriKanousuu(ctx, session, request.ReqZanUriKanousuu{IssueCode: symbol})
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get sellable quantity from client: %w", err)
+	}
+	if res.SResultCode != "0" {
+		return 0, nil, fmt.Errorf("client returned error: result_code=%s, text=%s", res.SResultCode, res.SResultText)
+	}
+	sellable := int(parseDetailInt64(res.SZanKabuSuryouUriKanouTokutei))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	reserved := 0
+	active := r.reservations[symbol][:0]
+	for _, reservation := range r.reservations[symbol] {
+		if !reservation.expiresAt.IsZero() && !reservation.expiresAt.After(now) {
+			continue
+		}
+		reserved += reservation.quantity
+		active = append(active, reservation)
+	}
+	r.reservations[symbol] = active
+
+	quantity = min(quantity, max(sellable-reserved, 0))
+	if tradingUnit > 0 {
+		quantity -= quantity % tradingUnit
+	}
+	if quantity <= 0 {
+		return 0, func(bool) {}, nil
+	}
+	reservation := &sellReservation{quantity: quantity}
+	r.reservations[symbol] = append(r.reservations[symbol], reservation)
+	return quantity, func(placed bool) { r.finish(symbol, reservation, placed) }, nil
+}
+
+// finish keeps the reservation of a placed order for the hold period and releases the others.
+func (r *SellQuantityReserver) finish(symbol string, reservation *sellReservation, placed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if placed && r.hold > 0 {
+		reservation.expiresAt = time.Now().Add(r.hold)
+		return
+	}
+	reservations := r.reservations[symbol]
+	for i, other := range reservations {
+		if other == reservation {
+			r.reservations[symbol] = append(reservations[:i], reservations[i+1:]...)
+			break
+		}
+	}
+	if len(r.reservations[symbol]) == 0 {
+		delete(r.reservations, symbol)
+	}
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"time"
+)
+
+// SignalInput holds a single signal submitted through the API.
+type SignalInput struct {
+	Symbol      string
+	SignalType  model.SignalType
+	LimitPrice  float64
+	StopPrice   float64
+	TargetPrice float64
+	Weight      float64
+	ValidUntil  *time.Time
+	Rationale   string
+}
+
+// SignalRejection describes why a submitted signal was not accepted.
+type SignalRejection struct {
+	Index  int
+	Symbol string
+	Reason string
+}
+
+// SignalIngestResult holds the outcome of a signal ingestion.
+type SignalIngestResult struct {
+	SignalIDs []uint
+	Rejected  []SignalRejection
+}
+
+// SignalNotifier is notified when new signals are ready to be consumed.
+// The trading agent implements this to process pushed signals without waiting for its next tick.
+type SignalNotifier interface {
+	NotifySignals()
+}
+
+// SignalUseCase defines the interface for signal related use cases.
+type SignalUseCase interface {
+	IngestSignals(ctx context.Context, generatedAt time.Time, inputs []SignalInput) (*SignalIngestResult, error)
+	ListSignals(ctx context.Context, symbol string, limit int) ([]*model.Signal, error)
+}
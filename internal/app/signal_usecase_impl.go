@@ -0,0 +1,132 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"time"
+)
+
+// signalUseCaseImpl implements the SignalUseCase interface.
+type signalUseCaseImpl struct {
+	signalRepo repository.SignalRepository
+	masterRepo repository.MasterRepository
+	notifier   SignalNotifier
+	location   *time.Location
+}
+
+// NewSignalUseCaseImpl creates a new SignalUseCase.
+// notifier may be nil, in which case the agent picks up the signals on its next tick.
+func NewSignalUseCaseImpl(signalRepo repository.SignalRepository, masterRepo repository.MasterRepository, notifier SignalNotifier) SignalUseCase {
+	return &signalUseCaseImpl{
+		signalRepo: signalRepo,
+		masterRepo: masterRepo,
+		notifier:   notifier,
+		location:   marketLocation(),
+	}
+}
+
+// IngestSignals validates the submitted signals against the stock master, stores the valid ones
+// in a single transaction and notifies the agent. Invalid signals are reported back instead of failing
+// the whole batch; a failure to store them stores none of them.
+func (uc *signalUseCaseImpl) IngestSignals(ctx context.Context, generatedAt time.Time, inputs []SignalInput) (*SignalIngestResult, error) {
+	receivedAt := time.Now()
+	if generatedAt.IsZero() {
+		generatedAt = receivedAt
+	}
+	tradingDay := receivedAt.In(uc.location).Format("20060102")
+
+	result := &SignalIngestResult{
+		SignalIDs: []uint{},
+		Rejected:  []SignalRejection{},
+	}
+	seen := make(map[string]struct{}, len(inputs))
+	var signals []*model.Signal
+
+	for i, input := range inputs {
+		reject := func(reason string) {
+			result.Rejected = append(result.Rejected, SignalRejection{Index: i, Symbol: input.Symbol, Reason: reason})
+		}
+
+		if input.SignalType != model.SignalTypeBuy && input.SignalType != model.SignalTypeSell {
+			reject(fmt.Sprintf("unsupported signal type %q", input.SignalType))
+			continue
+		}
+		if input.Weight < 0 || input.Weight > 1 {
+			reject(fmt.Sprintf("weight %v is outside 0 to 1", input.Weight))
+			continue
+		}
+		if input.ValidUntil != nil && receivedAt.After(*input.ValidUntil) {
+			reject("signal already expired")
+			continue
+		}
+
+		stock, err := uc.masterRepo.FindByIssueCode(ctx, input.Symbol, "StockMaster")
+		if err != nil {
+			return nil, fmt.Errorf("failed to find stock master for %s: %w", input.Symbol, err)
+		}
+		if stock == nil {
+			reject("unknown symbol")
+			continue
+		}
+
+		recordKey := model.SignalRecordKey(tradingDay, input.Symbol, input.SignalType)
+		if _, ok := seen[recordKey]; ok {
+			reject("duplicate signal in request")
+			continue
+		}
+		seen[recordKey] = struct{}{}
+
+		exists, err := uc.signalRepo.ExistsByRecordKey(ctx, recordKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check signal record key %s: %w", recordKey, err)
+		}
+		if exists {
+			reject("signal already received for this trading day")
+			continue
+		}
+
+		signal := &model.Signal{
+			Symbol:      input.Symbol,
+			SignalType:  input.SignalType,
+			GeneratedAt: generatedAt,
+			Rationale:   input.Rationale,
+			Price:       input.LimitPrice,
+			StopPrice:   input.StopPrice,
+			TargetPrice: input.TargetPrice,
+			Weight:      input.Weight,
+			ExpiresAt:   input.ValidUntil,
+			ReadAt:      receivedAt,
+			RecordKey:   recordKey,
+			Source:      model.SignalSourceHTTP,
+		}
+		signals = append(signals, signal)
+	}
+
+	if len(signals) > 0 {
+		if err := uc.signalRepo.SaveBatch(ctx, signals, nil); err != nil {
+			return nil, fmt.Errorf("failed to save signals: %w", err)
+		}
+	}
+	for _, signal := range signals {
+		result.SignalIDs = append(result.SignalIDs, signal.ID)
+	}
+
+	if len(result.SignalIDs) > 0 && uc.notifier != nil {
+		uc.notifier.NotifySignals()
+	}
+	slog.Info("Signals ingested", "accepted", len(result.SignalIDs), "rejected", len(result.Rejected))
+
+	return result, nil
+}
+
+// ListSignals returns the stored signals, newest first.
+func (uc *signalUseCaseImpl) ListSignals(ctx context.Context, symbol string, limit int) ([]*model.Signal, error) {
+	signals, err := uc.signalRepo.FindRecent(ctx, symbol, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list signals: %w", err)
+	}
+	return signals, nil
+}
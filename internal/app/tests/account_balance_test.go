@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"stock-bot/internal/app"
+	"stock-bot/internal/infrastructure/client/dto/balance/response"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validSummary() *response.ResZanKaiSummary {
+	return &response.ResZanKaiSummary{
+		ResultCode:             "0",
+		UpdateDate:             "202512261530",
+		GenbutuKabuKaituke:     "1234567",
+		SinyouSinkidate:        "2345678",
+		SinyouGenbiki:          "1000000",
+		HosyouKinritu:          "50.25",
+		Syukkin:                "100000",
+		NseityouTousiKanougaku: "",
+		Fusokugaku:             "0",
+		OisyouHasseiFlg:        "0",
+		TatekaekinHasseiFlg:    "1",
+	}
+}
+
+func TestParseAccountBalance_Success(t *testing.T) {
+	balance, err := app.ParseAccountBalance(validSummary())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1234567.0, balance.CashBuyingPower)
+	assert.Equal(t, 2345678.0, balance.MarginBuyingPower)
+	assert.Equal(t, 1000000.0, balance.MarginDeliveryCapacity)
+	assert.Equal(t, 50.25, balance.MaintenanceRate)
+	assert.Equal(t, 100000.0, balance.WithdrawableCash)
+	assert.Equal(t, 0.0, balance.NisaGrowthCapacity) // 口座がない場合は空
+	assert.False(t, balance.HasMarginCall)
+	assert.True(t, balance.HasAdvance)
+	assert.Equal(t, time.Date(2025, 12, 26, 6, 30, 0, 0, time.UTC), balance.UpdatedAt.UTC())
+}
+
+func TestParseAccountBalance_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(s *response.ResZanKaiSummary)
+	}{
+		{"結果コードがエラー", func(s *response.ResZanKaiSummary) { s.ResultCode = "991" }},
+		{"買付可能額が空", func(s *response.ResZanKaiSummary) { s.GenbutuKabuKaituke = "" }},
+		{"委託保証金率が不正", func(s *response.ResZanKaiSummary) { s.HosyouKinritu = "-" }},
+		{"任意の項目が不正", func(s *response.ResZanKaiSummary) { s.Fusokugaku = "abc" }},
+		{"フラグが不明", func(s *response.ResZanKaiSummary) { s.OisyouHasseiFlg = "2" }},
+		{"更新日時が不正", func(s *response.ResZanKaiSummary) { s.UpdateDate = "20251226" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := validSummary()
+			tt.modify(summary)
+
+			balance, err := app.ParseAccountBalance(summary)
+
+			assert.Error(t, err)
+			assert.Nil(t, balance)
+		})
+	}
+
+	_, err := app.ParseAccountBalance(nil)
+	assert.Error(t, err)
+}
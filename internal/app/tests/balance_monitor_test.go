@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"stock-bot/domain/model"
+	"stock-bot/internal/app"
+	"stock-bot/internal/infrastructure/client"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// BalanceUseCaseMock implements the app.BalanceUseCase interface for testing.
+type BalanceUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *BalanceUseCaseMock) GetBalance(ctx context.Context, session *client.Session) (*model.AccountBalance, error) {
+	args := m.Called(ctx, session)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.AccountBalance), args.Error(1)
+}
+func (m *BalanceUseCaseMock) GetBalanceHistory(ctx context.Context, session *client.Session, since time.Time, limit int) (*app.BalanceHistoryResult, error) {
+	args := m.Called(ctx, session, since, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*app.BalanceHistoryResult), args.Error(1)
+}
+func (m *BalanceUseCaseMock) SnapshotBalance(ctx context.Context, session *client.Session) (*model.BalanceSnapshot, error) {
+	args := m.Called(ctx, session)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.BalanceSnapshot), args.Error(1)
+}
+func (m *BalanceUseCaseMock) GetBuyingPower(ctx context.Context, session *client.Session, day int) (*app.BuyingPowerDetail, error) {
+	args := m.Called(ctx, session, day)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*app.BuyingPowerDetail), args.Error(1)
+}
+func (m *BalanceUseCaseMock) GetMarginCapacity(ctx context.Context, session *client.Session, day int) (*app.MarginCapacityDetail, error) {
+	args := m.Called(ctx, session, day)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*app.MarginCapacityDetail), args.Error(1)
+}
+
+func TestBalanceMonitor_CheckMarginEntry(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	balanceUseCaseMock := new(BalanceUseCaseMock)
+	monitor, err := app.NewBalanceMonitor(balanceUseCaseMock, session, time.Minute, 30)
+	assert.NoError(t, err)
+
+	// 初回のスナップショットまでは新規信用建を止めない
+	assert.NoError(t, monitor.CheckMarginEntry())
+
+	// 委託保証金率が警告の水準を下回ると止める
+	balanceUseCaseMock.On("SnapshotBalance", ctx, session).Return(&model.BalanceSnapshot{PositionValue: 3000000, MaintenanceRate: 28}, nil).Once()
+	_, err = monitor.Check(ctx)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, monitor.CheckMarginEntry(), app.ErrMarginEntryBlocked)
+
+	// スナップショットの取得に失敗した場合は直前の状態を維持する
+	balanceUseCaseMock.On("SnapshotBalance", ctx, session).Return(nil, errors.New("api error")).Once()
+	_, err = monitor.Check(ctx)
+	assert.Error(t, err)
+	assert.ErrorIs(t, monitor.CheckMarginEntry(), app.ErrMarginEntryBlocked)
+
+	// 回復すると再開する
+	balanceUseCaseMock.On("SnapshotBalance", ctx, session).Return(&model.BalanceSnapshot{PositionValue: 3000000, MaintenanceRate: 35}, nil).Once()
+	_, err = monitor.Check(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, monitor.CheckMarginEntry())
+
+	// 建玉がない場合の委託保証金率は監視しない
+	balanceUseCaseMock.On("SnapshotBalance", ctx, session).Return(&model.BalanceSnapshot{MaintenanceRate: 0}, nil).Once()
+	_, err = monitor.Check(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, monitor.CheckMarginEntry())
+
+	// 追証が発生している場合は止める
+	balanceUseCaseMock.On("SnapshotBalance", ctx, session).Return(&model.BalanceSnapshot{PositionValue: 3000000, MaintenanceRate: 45, HasMarginCall: true}, nil).Once()
+	_, err = monitor.Check(ctx)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, monitor.CheckMarginEntry(), app.ErrMarginEntryBlocked)
+
+	balanceUseCaseMock.AssertExpectations(t)
+}
+
+func TestNewBalanceMonitor_InvalidConfig(t *testing.T) {
+	_, err := app.NewBalanceMonitor(new(BalanceUseCaseMock), &client.Session{}, 0, 30)
+	assert.Error(t, err)
+
+	_, err = app.NewBalanceMonitor(new(BalanceUseCaseMock), &client.Session{}, time.Minute, -1)
+	assert.Error(t, err)
+}
@@ -3,11 +3,14 @@ package tests
 import (
 	"context"
 	"errors"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
 	"stock-bot/internal/app"
 	"stock-bot/internal/infrastructure/client"
 	"stock-bot/internal/infrastructure/client/dto/balance/request"
 	"stock-bot/internal/infrastructure/client/dto/balance/response"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -89,6 +92,23 @@ func (m *BalanceClientMock) GetZanUriKanousuu(ctx context.Context, session *clie
 	return args.Get(0).(*response.ResZanUriKanousuu), args.Error(1)
 }
 
+// BalanceRepositoryMock implements the repository.BalanceRepository interface for testing.
+type BalanceRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *BalanceRepositoryMock) SaveSnapshot(ctx context.Context, snapshot *model.BalanceSnapshot) error {
+	args := m.Called(ctx, snapshot)
+	return args.Error(0)
+}
+func (m *BalanceRepositoryMock) FindSnapshots(ctx context.Context, query repository.BalanceSnapshotQuery) ([]*model.BalanceSnapshot, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.BalanceSnapshot), args.Error(1)
+}
+
 func TestGetBalance_Success(t *testing.T) {
 	ctx := context.Background()
 	session := &client.Session{}
@@ -109,7 +129,7 @@ func TestGetBalance_Success(t *testing.T) {
 	balanceClientMock.On("GetZanKaiSummary", ctx, session).Return(apiResponse, nil).Once()
 
 	// Initialize Usecase
-	uc := app.NewBalanceUseCaseImpl(balanceClientMock)
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, nil)
 
 	// Execute
 	result, err := uc.GetBalance(ctx, session)
@@ -117,9 +137,9 @@ func TestGetBalance_Success(t *testing.T) {
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
-	assert.Equal(t, 1234567.0, result.AvailableCashForStock)
-	assert.Equal(t, 2345678.0, result.AvailableMarginForNewPosition)
-	assert.Equal(t, 50.25, result.MarginMaintenanceRate)
+	assert.Equal(t, 1234567.0, result.CashBuyingPower)
+	assert.Equal(t, 2345678.0, result.MarginBuyingPower)
+	assert.Equal(t, 50.25, result.MaintenanceRate)
 	assert.Equal(t, 100000.0, result.WithdrawableCash)
 	assert.True(t, result.HasMarginCall)
 
@@ -137,7 +157,7 @@ func TestGetBalance_ClientError(t *testing.T) {
 	balanceClientMock.On("GetZanKaiSummary", ctx, session).Return(nil, expectedErr).Once()
 
 	// Initialize Usecase
-	uc := app.NewBalanceUseCaseImpl(balanceClientMock)
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, nil)
 
 	// Execute
 	result, err := uc.GetBalance(ctx, session)
@@ -170,7 +190,7 @@ func TestGetBalance_ParseError(t *testing.T) {
 	balanceClientMock.On("GetZanKaiSummary", ctx, session).Return(apiResponse, nil).Once()
 
 	// Initialize Usecase
-	uc := app.NewBalanceUseCaseImpl(balanceClientMock)
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, nil)
 
 	// Execute
 	result, err := uc.GetBalance(ctx, session)
@@ -183,4 +203,245 @@ func TestGetBalance_ParseError(t *testing.T) {
 	balanceClientMock.AssertExpectations(t)
 }
 
+func TestGetBalanceHistory_Success(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	since := time.Date(2025, 12, 26, 9, 0, 0, 0, time.UTC)
+
+	balanceClientMock := new(BalanceClientMock)
+	balanceRepoMock := new(BalanceRepositoryMock)
+
+	balanceClientMock.On("GetZanKaiKanougakuSuii", ctx, session, request.ReqZanKaiKanougakuSuii{}).Return(&response.ResZanKaiKanougakuSuii{
+		SResultCode: "0",
+		AKanougakuSuiiList: []response.ResKanougakuSuii{
+			{SHituke: "20251225", SGenbutuKaitukeKanougaku: "1000000", SItakuHosyoukinRitu: "45.50", SOisyouYoryoku: "300000"},
+			{SHituke: "20251226", SGenbutuKaitukeKanougaku: "900000", SItakuHosyoukinRitu: "-", SFusokugaku: "0"},
+		},
+	}, nil).Once()
+	snapshots := []*model.BalanceSnapshot{{TakenAt: since.Add(time.Hour), MaintenanceRate: 40}}
+	balanceRepoMock.On("FindSnapshots", ctx, repository.BalanceSnapshotQuery{Since: since, Limit: 10}).Return(snapshots, nil).Once()
+
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, balanceRepoMock)
+	result, err := uc.GetBalanceHistory(ctx, session, since, 10)
+
+	assert.NoError(t, err)
+	if assert.Len(t, result.Daily, 2) {
+		// newest first
+		assert.Equal(t, "20251226", result.Daily[0].Date)
+		assert.Equal(t, 900000.0, result.Daily[0].CashBuyingPower)
+		assert.Equal(t, 0.0, result.Daily[0].MaintenanceRate)
+		assert.Equal(t, 45.5, result.Daily[1].MaintenanceRate)
+		assert.Equal(t, 300000.0, result.Daily[1].MarginCallSurplus)
+	}
+	assert.Equal(t, snapshots, result.Snapshots)
+	balanceClientMock.AssertExpectations(t)
+	balanceRepoMock.AssertExpectations(t)
+}
+
+func TestGetBalanceHistory_ResultCodeError(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	balanceClientMock := new(BalanceClientMock)
+	balanceRepoMock := new(BalanceRepositoryMock)
+	balanceClientMock.On("GetZanKaiKanougakuSuii", ctx, session, mock.Anything).Return(&response.ResZanKaiKanougakuSuii{SResultCode: "991", SResultText: "error"}, nil).Once()
+
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, balanceRepoMock)
+	result, err := uc.GetBalanceHistory(ctx, session, time.Time{}, 100)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	balanceRepoMock.AssertNotCalled(t, "FindSnapshots", mock.Anything, mock.Anything)
+}
+
+func TestSnapshotBalance_Success(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	balanceClientMock := new(BalanceClientMock)
+	balanceRepoMock := new(BalanceRepositoryMock)
+
+	balanceClientMock.On("GetZanKaiSummary", ctx, session).Return(&response.ResZanKaiSummary{
+		ResultCode:         "0",
+		GenbutuKabuKaituke: "500000",
+		SinyouSinkidate:    "800000",
+		HosyouKinritu:      "40.00",
+		Syukkin:            "200000",
+		OisyouHasseiFlg:    "0",
+	}, nil).Once()
+	balanceClientMock.On("GetZanRealHosyoukinRitu", ctx, session, request.ReqZanRealHosyoukinRitu{}).Return(&response.ResZanRealHosyoukinRitu{
+		SResultCode:             "0",
+		SUkeireHosyoukin:        "1000000",
+		STateKabuDaikin:         "3000000",
+		SHyoukaSonEki:           "-150000",
+		SItakuHosyoukinRitu:     "28.33",
+		SOisyouYoryoku:          "250000",
+		SOisyouHituyouHosyoukin: "600000",
+	}, nil).Once()
+	balanceRepoMock.On("SaveSnapshot", ctx, mock.AnythingOfType("*model.BalanceSnapshot")).Return(nil).Once()
+
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, balanceRepoMock)
+	snapshot, err := uc.SnapshotBalance(ctx, session)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 500000.0, snapshot.CashBuyingPower)
+	assert.Equal(t, 800000.0, snapshot.MarginBuyingPower)
+	assert.Equal(t, 200000.0, snapshot.WithdrawableCash)
+	assert.Equal(t, 3000000.0, snapshot.PositionValue)
+	assert.Equal(t, -150000.0, snapshot.ValuationProfitLoss)
+	// the real-time rate is stored instead of the summary's rate
+	assert.Equal(t, 28.33, snapshot.MaintenanceRate)
+	assert.False(t, snapshot.HasMarginCall)
+	assert.False(t, snapshot.TakenAt.IsZero())
+	balanceClientMock.AssertExpectations(t)
+	balanceRepoMock.AssertExpectations(t)
+}
+
+func TestSnapshotBalance_MalformedRate(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	balanceClientMock := new(BalanceClientMock)
+	balanceRepoMock := new(BalanceRepositoryMock)
+
+	balanceClientMock.On("GetZanKaiSummary", ctx, session).Return(&response.ResZanKaiSummary{
+		ResultCode:         "0",
+		GenbutuKabuKaituke: "500000",
+		SinyouSinkidate:    "800000",
+		HosyouKinritu:      "40.00",
+		Syukkin:            "200000",
+		OisyouHasseiFlg:    "0",
+	}, nil).Once()
+	balanceClientMock.On("GetZanRealHosyoukinRitu", ctx, session, request.ReqZanRealHosyoukinRitu{}).Return(&response.ResZanRealHosyoukinRitu{
+		SResultCode:         "0",
+		STateKabuDaikin:     "3000000",
+		SItakuHosyoukinRitu: "**.**",
+	}, nil).Once()
+
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, balanceRepoMock)
+	snapshot, err := uc.SnapshotBalance(ctx, session)
+
+	// a malformed rate is an error, not a 0% rate
+	assert.ErrorContains(t, err, "sItakuHosyoukinRitu")
+	assert.Nil(t, snapshot)
+	balanceRepoMock.AssertNotCalled(t, "SaveSnapshot", mock.Anything, mock.Anything)
+}
+
 // go test -v ./internal/app/tests/balance_usecase_impl_test.go
+
+func TestGetBuyingPower_Success(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	balanceClientMock := new(BalanceClientMock)
+	balanceClientMock.On("GetZanKaiGenbutuKaitukeSyousai", ctx, session, 2).Return(&response.ResZanKaiGenbutuKaitukeSyousai{
+		ResultCode:              "0",
+		Hituke:                  "20251230",
+		GenbutuKaitukeKanougaku: "850000",
+		AzukariKin:              "1000000",
+		HattyuZyutoukin:         "150000",
+		HosyoukinYoryoku:        "-",
+	}, nil).Once()
+
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, new(BalanceRepositoryMock))
+	result, err := uc.GetBuyingPower(ctx, session, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Day)
+	assert.Equal(t, "20251230", result.Date)
+	assert.Equal(t, 850000.0, result.CashBuyingPower)
+	assert.Equal(t, 1000000.0, result.DepositBalance)
+	assert.Equal(t, 150000.0, result.OrderReserved)
+	assert.Equal(t, 0.0, result.MarginSurplus)
+	balanceClientMock.AssertExpectations(t)
+}
+
+func TestGetBuyingPower_InvalidDay(t *testing.T) {
+	balanceClientMock := new(BalanceClientMock)
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, new(BalanceRepositoryMock))
+
+	_, err := uc.GetBuyingPower(context.Background(), &client.Session{}, app.MaxBuyingPowerDay+1)
+
+	assert.Error(t, err)
+	balanceClientMock.AssertNotCalled(t, "GetZanKaiGenbutuKaitukeSyousai", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetBuyingPower_MalformedValue(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	balanceClientMock := new(BalanceClientMock)
+	balanceClientMock.On("GetZanKaiGenbutuKaitukeSyousai", ctx, session, 0).Return(&response.ResZanKaiGenbutuKaitukeSyousai{
+		ResultCode:              "0",
+		Hituke:                  "20251230",
+		GenbutuKaitukeKanougaku: "85O000",
+	}, nil).Once()
+
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, new(BalanceRepositoryMock))
+	result, err := uc.GetBuyingPower(ctx, session, 0)
+
+	// a malformed buying power is an error, not 0 yen
+	assert.ErrorContains(t, err, "sGenbutuKaitukeKanougaku")
+	assert.Nil(t, result)
+}
+
+func TestGetMarginCapacity_Success(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	balanceClientMock := new(BalanceClientMock)
+	balanceClientMock.On("GetZanKaiSinyouSinkidateSyousai", ctx, session, 0).Return(&response.ResZanKaiSinyouSinkidateSyousai{
+		SResultCode:               "0",
+		SHituke:                   "20251226",
+		SSinyouSinkidateKanougaku: "2500000",
+		SUkeireHosyoukin:          "1200000",
+		SHosyoukinTyousyuRitu:     "33.00",
+		SMikessaiTateDaikin:       "3000000",
+		SItakuHosyoukinRitu:       "40.00",
+	}, nil).Once()
+
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, new(BalanceRepositoryMock))
+	result, err := uc.GetMarginCapacity(ctx, session, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "20251226", result.Date)
+	assert.Equal(t, 2500000.0, result.MarginBuyingPower)
+	assert.Equal(t, 1200000.0, result.DepositedMargin)
+	assert.Equal(t, 33.0, result.CollectionRate)
+	assert.Equal(t, 3000000.0, result.PositionValue)
+	assert.Equal(t, 40.0, result.MaintenanceRate)
+	balanceClientMock.AssertExpectations(t)
+}
+
+func TestGetMarginCapacity_MalformedValue(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	balanceClientMock := new(BalanceClientMock)
+	balanceClientMock.On("GetZanKaiSinyouSinkidateSyousai", ctx, session, 0).Return(&response.ResZanKaiSinyouSinkidateSyousai{
+		SResultCode:               "0",
+		SHituke:                   "20251226",
+		SSinyouSinkidateKanougaku: "2,500,000",
+		SItakuHosyoukinRitu:       "abc",
+	}, nil).Once()
+
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, new(BalanceRepositoryMock))
+	result, err := uc.GetMarginCapacity(ctx, session, 0)
+
+	assert.ErrorContains(t, err, "sItakuHosyoukinRitu")
+	assert.Nil(t, result)
+}
+
+func TestGetMarginCapacity_ResultCodeError(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	balanceClientMock := new(BalanceClientMock)
+	balanceClientMock.On("GetZanKaiSinyouSinkidateSyousai", ctx, session, 1).Return(&response.ResZanKaiSinyouSinkidateSyousai{SResultCode: "991", SResultText: "error"}, nil).Once()
+
+	uc := app.NewBalanceUseCaseImpl(balanceClientMock, new(BalanceRepositoryMock))
+	result, err := uc.GetMarginCapacity(ctx, session, 1)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"stock-bot/internal/app"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDailyReportScheduler_NextRun(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	scheduler, err := app.NewDailyReportScheduler(nil, "15:45")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{
+			name: "作成時刻前は当日に作成する",
+			now:  time.Date(2025, 12, 22, 15, 0, 0, 0, jst), // 月曜日
+			want: time.Date(2025, 12, 22, 15, 45, 0, 0, jst),
+		},
+		{
+			name: "作成時刻を過ぎていれば翌平日に作成する",
+			now:  time.Date(2025, 12, 26, 15, 45, 0, 0, jst), // 金曜日
+			want: time.Date(2025, 12, 29, 15, 45, 0, 0, jst),
+		},
+		{
+			name: "市場のタイムゾーンで判定する",
+			now:  time.Date(2025, 12, 22, 6, 0, 0, 0, time.UTC), // 月曜日 15:00 JST
+			want: time.Date(2025, 12, 22, 15, 45, 0, 0, jst),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scheduler.NextRun(tt.now)
+			assert.True(t, tt.want.Equal(got), "want %v, got %v", tt.want, got)
+		})
+	}
+
+	_, err = app.NewDailyReportScheduler(nil, "25:00")
+	assert.Error(t, err)
+}
@@ -0,0 +1,185 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"stock-bot/domain/model"
+	"stock-bot/internal/app"
+	"stock-bot/internal/app/mocks"
+	"stock-bot/internal/infrastructure/client"
+	"stock-bot/internal/infrastructure/client/dto/master/request"
+	"stock-bot/internal/infrastructure/client/dto/master/response"
+	priceresponse "stock-bot/internal/infrastructure/client/dto/price/response"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// FundamentalRepositoryMock implements the repository.FundamentalRepository interface for testing.
+type FundamentalRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *FundamentalRepositoryMock) UpsertFundamentals(ctx context.Context, fundamentals []*model.StockFundamental) error {
+	args := m.Called(ctx, fundamentals)
+	return args.Error(0)
+}
+func (m *FundamentalRepositoryMock) FindFundamental(ctx context.Context, issueCode string, snapshotDate string) (*model.StockFundamental, error) {
+	args := m.Called(ctx, issueCode, snapshotDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.StockFundamental), args.Error(1)
+}
+func (m *FundamentalRepositoryMock) FindLatestFundamental(ctx context.Context, issueCode string) (*model.StockFundamental, error) {
+	args := m.Called(ctx, issueCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.StockFundamental), args.Error(1)
+}
+
+func priceInfo(price string) *priceresponse.ResGetPriceInfo {
+	return &priceresponse.ResGetPriceInfo{
+		CLMMfdsMarketPrice: []priceresponse.ResMarketPriceInfoItem{{Values: map[string]string{"CurrentPrice": price}}},
+	}
+}
+
+func todayInMarket() string {
+	location, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		location = time.FixedZone("JST", 9*60*60)
+	}
+	return time.Now().In(location).Format("20060102")
+}
+
+func TestFundamentalsUseCaseImpl_GetFundamentals(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	today := todayInMarket()
+
+	t.Run("正常系: 当日のスナップショットがない場合は取得して保存し、現在値から指標を求める", func(t *testing.T) {
+		mockMaster := new(MasterDataClientMock)
+		mockPrice := new(mocks.PriceInfoClient)
+		mockRepo := new(FundamentalRepositoryMock)
+		uc := app.NewFundamentalsUseCaseImpl(mockMaster, mockPrice, mockRepo)
+
+		mockRepo.On("FindFundamental", ctx, "7203", today).Return(nil, nil).Once()
+		mockMaster.On("GetIssueDetail", ctx, session, request.ReqGetIssueDetail{TargetIssueCodes: "7203"}).Return(&response.ResGetIssueDetail{
+			CLMMfdsIssueDetail: []response.ResIssueDetailListItem{{
+				IssueCode: "7203",
+				PBPSB:     "2500",
+				PEPSF:     "250",
+				PRPER:     "12",  // 取得時点の株価 3000
+				PSPBR:     "1.2", // 3000 / 2500
+				PSYIE:     "2.5", // 一株配当 75
+				PCLOE:     "2026/03/30",
+				PIDVE:     "2025/09/29",
+				PYHPR:     "3500",
+				PYHPD:     "2025/07/01",
+				PYLPR:     "-",
+			}},
+		}, nil).Once()
+		mockRepo.On("UpsertFundamentals", ctx, mock.MatchedBy(func(f []*model.StockFundamental) bool {
+			return len(f) == 1 && f[0].IssueCode == "7203" && f[0].SnapshotDate == today && f[0].DividendPerShare == 75
+		})).Return(nil).Once()
+		mockPrice.On("GetPriceInfo", ctx, session, mock.Anything).Return(priceInfo("2000"), nil).Once()
+
+		result, err := uc.GetFundamentals(ctx, session, "7203")
+		assert.NoError(t, err)
+		assert.Equal(t, "7203", result.Symbol)
+		assert.Equal(t, 2500.0, result.BPS)
+		assert.Equal(t, 75.0, result.DividendPerShare)
+		assert.Equal(t, "20260330", result.ExDividendDate)
+		assert.Equal(t, "20250929", result.InterimExDividendDate)
+		assert.Equal(t, "20250701", result.YearHighDate)
+		assert.Equal(t, 0.0, result.YearLow)
+		if assert.NotNil(t, result.Price) {
+			assert.Equal(t, 2000.0, *result.Price)
+		}
+		if assert.NotNil(t, result.PER) {
+			assert.Equal(t, 8.0, *result.PER)
+		}
+		if assert.NotNil(t, result.PBR) {
+			assert.Equal(t, 0.8, *result.PBR)
+		}
+		if assert.NotNil(t, result.DividendYield) {
+			assert.Equal(t, 3.75, *result.DividendYield)
+		}
+		mockMaster.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 当日のスナップショットがある場合は API を呼ばない。EPS が負の場合は PER を求めない", func(t *testing.T) {
+		mockMaster := new(MasterDataClientMock)
+		mockPrice := new(mocks.PriceInfoClient)
+		mockRepo := new(FundamentalRepositoryMock)
+		uc := app.NewFundamentalsUseCaseImpl(mockMaster, mockPrice, mockRepo)
+
+		mockRepo.On("FindFundamental", ctx, "9984", today).Return(&model.StockFundamental{IssueCode: "9984", SnapshotDate: today, EPS: -10, BPS: 1000}, nil).Once()
+		mockPrice.On("GetPriceInfo", ctx, session, mock.Anything).Return(priceInfo("1500"), nil).Once()
+
+		result, err := uc.GetFundamentals(ctx, session, "9984")
+		assert.NoError(t, err)
+		assert.Nil(t, result.PER)
+		assert.Nil(t, result.DividendYield)
+		if assert.NotNil(t, result.PBR) {
+			assert.Equal(t, 1.5, *result.PBR)
+		}
+		mockMaster.AssertNotCalled(t, "GetIssueDetail", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: 取得に失敗した場合は最新のスナップショットを使い、現在値が取得できない場合は指標を省略する", func(t *testing.T) {
+		mockMaster := new(MasterDataClientMock)
+		mockPrice := new(mocks.PriceInfoClient)
+		mockRepo := new(FundamentalRepositoryMock)
+		uc := app.NewFundamentalsUseCaseImpl(mockMaster, mockPrice, mockRepo)
+
+		mockRepo.On("FindFundamental", ctx, "7203", today).Return(nil, nil).Once()
+		mockMaster.On("GetIssueDetail", ctx, session, mock.Anything).Return(nil, errors.New("api error")).Once()
+		mockRepo.On("FindLatestFundamental", ctx, "7203").Return(&model.StockFundamental{IssueCode: "7203", SnapshotDate: "20251201", EPS: 250}, nil).Once()
+		mockPrice.On("GetPriceInfo", ctx, session, mock.Anything).Return(nil, errors.New("price error")).Once()
+
+		result, err := uc.GetFundamentals(ctx, session, "7203")
+		assert.NoError(t, err)
+		assert.Equal(t, "20251201", result.SnapshotDate)
+		assert.Nil(t, result.Price)
+		assert.Nil(t, result.PER)
+	})
+
+	t.Run("異常系: 投資指標がない場合は ErrNotFound を返す", func(t *testing.T) {
+		mockMaster := new(MasterDataClientMock)
+		mockPrice := new(mocks.PriceInfoClient)
+		mockRepo := new(FundamentalRepositoryMock)
+		uc := app.NewFundamentalsUseCaseImpl(mockMaster, mockPrice, mockRepo)
+
+		mockRepo.On("FindFundamental", ctx, "0000", today).Return(nil, nil).Once()
+		mockMaster.On("GetIssueDetail", ctx, session, mock.Anything).Return(&response.ResGetIssueDetail{}, nil).Once()
+		mockRepo.On("FindLatestFundamental", ctx, "0000").Return(nil, nil).Once()
+
+		_, err := uc.GetFundamentals(ctx, session, "0000")
+		assert.ErrorIs(t, err, app.ErrNotFound)
+	})
+}
+
+func TestFundamentalsUseCaseImpl_SnapshotFundamentals(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	mockMaster := new(MasterDataClientMock)
+	mockRepo := new(FundamentalRepositoryMock)
+	uc := app.NewFundamentalsUseCaseImpl(mockMaster, new(mocks.PriceInfoClient), mockRepo)
+
+	mockMaster.On("GetIssueDetail", ctx, session, request.ReqGetIssueDetail{TargetIssueCodes: "7203,6758"}).Return(&response.ResGetIssueDetail{
+		CLMMfdsIssueDetail: []response.ResIssueDetailListItem{{IssueCode: "7203", PEPSF: "250"}, {IssueCode: "6758", PEPSF: "300"}},
+	}, nil).Once()
+	mockRepo.On("UpsertFundamentals", ctx, mock.MatchedBy(func(f []*model.StockFundamental) bool { return len(f) == 2 })).Return(nil).Once()
+
+	stored, err := uc.SnapshotFundamentals(ctx, session, []string{"7203", "6758"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, stored)
+	mockMaster.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"stock-bot/internal/app"
+	"stock-bot/internal/infrastructure/client"
+	"stock-bot/internal/infrastructure/client/dto/master/request"
+	"stock-bot/internal/infrastructure/client/dto/master/response"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MarginInfoRepositoryMock implements the repository.MarginInfoRepository interface for testing.
+type MarginInfoRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *MarginInfoRepositoryMock) UpsertSecuritiesFinanceBalances(ctx context.Context, balances []*model.SecuritiesFinanceBalance) error {
+	args := m.Called(ctx, balances)
+	return args.Error(0)
+}
+func (m *MarginInfoRepositoryMock) UpsertCreditBalances(ctx context.Context, balances []*model.CreditBalance) error {
+	args := m.Called(ctx, balances)
+	return args.Error(0)
+}
+func (m *MarginInfoRepositoryMock) UpsertMarginPremiums(ctx context.Context, premiums []*model.MarginPremiumRecord) error {
+	args := m.Called(ctx, premiums)
+	return args.Error(0)
+}
+func (m *MarginInfoRepositoryMock) FindSecuritiesFinanceBalances(ctx context.Context, query repository.MarginInfoQuery) ([]*model.SecuritiesFinanceBalance, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.SecuritiesFinanceBalance), args.Error(1)
+}
+func (m *MarginInfoRepositoryMock) FindCreditBalances(ctx context.Context, query repository.MarginInfoQuery) ([]*model.CreditBalance, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.CreditBalance), args.Error(1)
+}
+func (m *MarginInfoRepositoryMock) FindMarginPremiums(ctx context.Context, query repository.MarginInfoQuery) ([]*model.MarginPremiumRecord, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.MarginPremiumRecord), args.Error(1)
+}
+func (m *MarginInfoRepositoryMock) FindLatestMarginPremium(ctx context.Context, issueCode string) (*model.MarginPremiumRecord, error) {
+	args := m.Called(ctx, issueCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.MarginPremiumRecord), args.Error(1)
+}
+
+func TestMarginUseCaseImpl_GetMarginInfo(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	t.Run("正常系: 3種類の時系列を同じ条件で取得する", func(t *testing.T) {
+		mockMaster := new(MasterDataClientMock)
+		mockRepo := new(MarginInfoRepositoryMock)
+		uc := app.NewMarginUseCaseImpl(mockMaster, mockRepo, session, nil)
+
+		query := repository.MarginInfoQuery{IssueCode: "7203", Since: "20251201", Limit: 30}
+		mockRepo.On("FindSecuritiesFinanceBalances", ctx, query).Return([]*model.SecuritiesFinanceBalance{{IssueCode: "7203", RecordDate: "20251226", LoanRatio: 3.5}}, nil).Once()
+		mockRepo.On("FindCreditBalances", ctx, query).Return([]*model.CreditBalance{{IssueCode: "7203", RecordDate: "20251219", Ratio: 4.2}}, nil).Once()
+		mockRepo.On("FindMarginPremiums", ctx, query).Return([]*model.MarginPremiumRecord{{IssueCode: "7203", RecordDate: "20251226", Premium: 0.05}}, nil).Once()
+
+		result, err := uc.GetMarginInfo(ctx, "7203", "20251201", 30)
+		assert.NoError(t, err)
+		assert.Equal(t, "7203", result.Symbol)
+		assert.Len(t, result.SecuritiesFinance, 1)
+		assert.Len(t, result.Credit, 1)
+		assert.Len(t, result.Premiums, 1)
+		assert.Equal(t, 0.05, result.Premiums[0].Premium)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: リポジトリのエラーを返す", func(t *testing.T) {
+		mockMaster := new(MasterDataClientMock)
+		mockRepo := new(MarginInfoRepositoryMock)
+		uc := app.NewMarginUseCaseImpl(mockMaster, mockRepo, session, nil)
+
+		mockRepo.On("FindSecuritiesFinanceBalances", ctx, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		result, err := uc.GetMarginInfo(ctx, "7203", "", 0)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestMarginUseCaseImpl_CollectMarginInfo(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	today := todayInMarket()
+
+	t.Run("正常系: 証金残・信用残・逆日歩を保存し、日付のない行と逆日歩のない銘柄、解析できない逆日歩は除く", func(t *testing.T) {
+		mockMaster := new(MasterDataClientMock)
+		mockRepo := new(MarginInfoRepositoryMock)
+		uc := app.NewMarginUseCaseImpl(mockMaster, mockRepo, session, []string{"7203", "6758"})
+
+		mockMaster.On("GetMarginInfo", ctx, session, request.ReqGetMarginInfo{TargetIssueCodes: "7203,6758"}).Return(&response.ResGetMarginInfo{
+			CLMMfdsSyoukinZan: []response.ResMarginInfoListItem{
+				{IssueCode: "7203", PSFD: "2025/12/26", PSFKS: "1", PSFF6: "120000", PSFS6: "40000", PSFN6: "80000", PSFR6: "3.00"},
+				{IssueCode: "6758", PSFD: ""},
+			},
+		}, nil).Once()
+		mockMaster.On("GetCreditInfo", ctx, session, request.ReqGetCreditInfo{TargetIssueCodes: "7203,6758"}).Return(&response.ResGetCreditInfo{
+			CLMMfdsShinyouZan: []response.ResCreditInfoListItem{
+				{IssueCode: "7203", PMBD: "2025/12/19", PMBBQ: "500000", PMBSQ: "100000", PMBRQ: "5.00"},
+				{IssueCode: "6758", PMBD: "2025/12/19", PMBBQ: "300000", PMBSQ: "300000", PMBRQ: "1.00"},
+			},
+		}, nil).Once()
+		mockMaster.On("GetMarginPremiumInfo", ctx, session, request.ReqGetMarginPremiumInfo{TargetIssueCodes: "7203,6758"}).Return(&response.ResGetMarginPremiumInfo{
+			CLMMfdsHibuInfo: []response.ResMarginPremiumInfoListItem{
+				{IssueCode: "7203", PBWRQ: "0.10"},
+				{IssueCode: "6758", PBWRQ: ""},
+				{IssueCode: "9984", PBWRQ: "-"},
+				// 解析できない逆日歩は 0 として保存せずに除く
+				{IssueCode: "8306", PBWRQ: "0.1x"},
+			},
+		}, nil).Once()
+		mockRepo.On("UpsertSecuritiesFinanceBalances", ctx, mock.MatchedBy(func(b []*model.SecuritiesFinanceBalance) bool {
+			return len(b) == 1 && b[0].IssueCode == "7203" && b[0].RecordDate == "20251226" && b[0].Preliminary && b[0].LoanBalance == 120000 && b[0].LoanRatio == 3
+		})).Return(nil).Once()
+		mockRepo.On("UpsertCreditBalances", ctx, mock.MatchedBy(func(b []*model.CreditBalance) bool {
+			return len(b) == 2 && b[0].RecordDate == "20251219" && b[0].LongBalance == 500000 && b[0].Ratio == 5
+		})).Return(nil).Once()
+		mockRepo.On("UpsertMarginPremiums", ctx, mock.MatchedBy(func(p []*model.MarginPremiumRecord) bool {
+			return len(p) == 1 && p[0].IssueCode == "7203" && p[0].RecordDate == today && p[0].Premium == 0.1
+		})).Return(nil).Once()
+
+		summary, err := uc.CollectMarginInfo(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &app.MarginCollectSummary{SecuritiesFinance: 1, Credit: 2, Premiums: 1, MalformedPremiums: 1}, summary)
+		mockMaster.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: API のエラーを返す", func(t *testing.T) {
+		mockMaster := new(MasterDataClientMock)
+		mockRepo := new(MarginInfoRepositoryMock)
+		uc := app.NewMarginUseCaseImpl(mockMaster, mockRepo, session, []string{"7203"})
+
+		mockMaster.On("GetMarginInfo", ctx, session, mock.Anything).Return(nil, errors.New("api error")).Once()
+
+		_, err := uc.CollectMarginInfo(ctx)
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "UpsertSecuritiesFinanceBalances", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"stock-bot/internal/app"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMasterSyncScheduler_NextRun(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	scheduler, err := app.NewMasterSyncScheduler(nil, nil, "08:00")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{
+			name: "営業日の同期時刻前は当日に同期する",
+			now:  time.Date(2025, 12, 22, 7, 30, 0, 0, jst), // 月曜日
+			want: time.Date(2025, 12, 22, 8, 0, 0, 0, jst),
+		},
+		{
+			name: "同期時刻を過ぎていれば翌営業日に同期する",
+			now:  time.Date(2025, 12, 22, 8, 0, 0, 0, jst),
+			want: time.Date(2025, 12, 23, 8, 0, 0, 0, jst),
+		},
+		{
+			name: "週末は同期しない",
+			now:  time.Date(2025, 12, 26, 9, 0, 0, 0, jst), // 金曜日
+			want: time.Date(2025, 12, 29, 8, 0, 0, 0, jst),
+		},
+		{
+			name: "市場のタイムゾーンで判定する",
+			now:  time.Date(2025, 12, 21, 22, 30, 0, 0, time.UTC), // 月曜日 07:30 JST
+			want: time.Date(2025, 12, 22, 8, 0, 0, 0, jst),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scheduler.NextRun(tt.now)
+			assert.True(t, tt.want.Equal(got), "want %v, got %v", tt.want, got)
+		})
+	}
+}
+
+func TestMasterSyncScheduler_NextRunSkipsHolidays(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	scheduler, err := app.NewMasterSyncScheduler(nil, nil, "08:00")
+	assert.NoError(t, err)
+
+	// 12/31 (水) から 1/2 (金) までは休業日
+	scheduler.UpdateCalendar(&app.MasterSyncSummary{
+		CurrentDay:       "20251230",
+		NextBusinessDays: []string{"20260105", "20260106"},
+	})
+
+	got := scheduler.NextRun(time.Date(2025, 12, 30, 9, 0, 0, 0, jst))
+	want := time.Date(2026, 1, 5, 8, 0, 0, 0, jst)
+	assert.True(t, want.Equal(got), "want %v, got %v", want, got)
+
+	// 日付情報の範囲外の平日は営業日とみなす
+	got = scheduler.NextRun(time.Date(2026, 1, 6, 9, 0, 0, 0, jst))
+	want = time.Date(2026, 1, 7, 8, 0, 0, 0, jst)
+	assert.True(t, want.Equal(got), "want %v, got %v", want, got)
+}
+
+func TestNewMasterSyncScheduler_InvalidTime(t *testing.T) {
+	_, err := app.NewMasterSyncScheduler(nil, nil, "8am")
+	assert.Error(t, err)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
 	"stock-bot/internal/app"
 	"stock-bot/internal/infrastructure/client"
 	"stock-bot/internal/infrastructure/client/dto/master/request"
 	"stock-bot/internal/infrastructure/client/dto/master/response"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -27,6 +29,10 @@ func (m *MasterDataClientMock) DownloadMasterData(ctx context.Context, session *
 	}
 	return args.Get(0).(*response.ResDownloadMaster), args.Error(1)
 }
+func (m *MasterDataClientMock) StreamMasterData(ctx context.Context, session *client.Session, req request.ReqDownloadMaster, handle client.MasterRecordHandler) error {
+	args := m.Called(ctx, session, req, handle)
+	return args.Error(0)
+}
 func (m *MasterDataClientMock) GetMasterDataQuery(ctx context.Context, session *client.Session, req request.ReqGetMasterData) (*response.ResGetMasterData, error) {
 	args := m.Called(ctx, session, req)
 	if args.Get(0) == nil {
@@ -36,11 +42,17 @@ func (m *MasterDataClientMock) GetMasterDataQuery(ctx context.Context, session *
 }
 func (m *MasterDataClientMock) GetNewsHeader(ctx context.Context, session *client.Session, req request.ReqGetNewsHead) (*response.ResGetNewsHeader, error) {
 	args := m.Called(ctx, session, req)
-	return nil, args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ResGetNewsHeader), args.Error(1)
 }
 func (m *MasterDataClientMock) GetNewsBody(ctx context.Context, session *client.Session, req request.ReqGetNewsBody) (*response.ResGetNewsBody, error) {
 	args := m.Called(ctx, session, req)
-	return nil, args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ResGetNewsBody), args.Error(1)
 }
 func (m *MasterDataClientMock) GetIssueDetail(ctx context.Context, session *client.Session, req request.ReqGetIssueDetail) (*response.ResGetIssueDetail, error) {
 	args := m.Called(ctx, session, req)
@@ -51,15 +63,24 @@ func (m *MasterDataClientMock) GetIssueDetail(ctx context.Context, session *clie
 }
 func (m *MasterDataClientMock) GetMarginInfo(ctx context.Context, session *client.Session, req request.ReqGetMarginInfo) (*response.ResGetMarginInfo, error) {
 	args := m.Called(ctx, session, req)
-	return nil, args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ResGetMarginInfo), args.Error(1)
 }
 func (m *MasterDataClientMock) GetCreditInfo(ctx context.Context, session *client.Session, req request.ReqGetCreditInfo) (*response.ResGetCreditInfo, error) {
 	args := m.Called(ctx, session, req)
-	return nil, args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ResGetCreditInfo), args.Error(1)
 }
 func (m *MasterDataClientMock) GetMarginPremiumInfo(ctx context.Context, session *client.Session, req request.ReqGetMarginPremiumInfo) (*response.ResGetMarginPremiumInfo, error) {
 	args := m.Called(ctx, session, req)
-	return nil, args.Error(1)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*response.ResGetMarginPremiumInfo), args.Error(1)
 }
 
 // MasterRepositoryMock implements the repository.MasterRepository interface for testing.
@@ -90,6 +111,79 @@ func (m *MasterRepositoryMock) UpsertTickRules(ctx context.Context, tickRules []
 	args := m.Called(ctx, tickRules)
 	return args.Error(0)
 }
+func (m *MasterRepositoryMock) FindTickRule(ctx context.Context, tickUnitNumber string) (*model.TickRule, error) {
+	args := m.Called(ctx, tickUnitNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.TickRule), args.Error(1)
+}
+func (m *MasterRepositoryMock) FindStockMasters(ctx context.Context, query repository.StockMasterQuery) ([]*model.StockMaster, int64, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*model.StockMaster), args.Get(1).(int64), args.Error(2)
+}
+func (m *MasterRepositoryMock) CountStocksByIndustry(ctx context.Context) ([]*repository.IndustryCount, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.IndustryCount), args.Error(1)
+}
+func (m *MasterRepositoryMock) SyncStockMasters(ctx context.Context, stocks []*model.StockMaster, deleteMissing bool, syncRunID uint) (model.MasterSyncCounts, error) {
+	args := m.Called(ctx, stocks, deleteMissing, syncRunID)
+	return args.Get(0).(model.MasterSyncCounts), args.Error(1)
+}
+func (m *MasterRepositoryMock) SyncStockMarketMasters(ctx context.Context, markets []*model.StockMarketMaster) (model.MasterSyncCounts, error) {
+	args := m.Called(ctx, markets)
+	return args.Get(0).(model.MasterSyncCounts), args.Error(1)
+}
+func (m *MasterRepositoryMock) SyncTickRules(ctx context.Context, tickRules []*model.TickRule) (model.MasterSyncCounts, error) {
+	args := m.Called(ctx, tickRules)
+	return args.Get(0).(model.MasterSyncCounts), args.Error(1)
+}
+func (m *MasterRepositoryMock) SyncMarginMasters(ctx context.Context, margins []*model.MarginMaster) (model.MasterSyncCounts, error) {
+	args := m.Called(ctx, margins)
+	return args.Get(0).(model.MasterSyncCounts), args.Error(1)
+}
+func (m *MasterRepositoryMock) SyncStockIssueRegulations(ctx context.Context, regulations []*model.StockIssueRegulation) (model.MasterSyncCounts, error) {
+	args := m.Called(ctx, regulations)
+	return args.Get(0).(model.MasterSyncCounts), args.Error(1)
+}
+func (m *MasterRepositoryMock) SyncOperationStatuses(ctx context.Context, statuses []*model.OperationStatus) (model.MasterSyncCounts, error) {
+	args := m.Called(ctx, statuses)
+	return args.Get(0).(model.MasterSyncCounts), args.Error(1)
+}
+func (m *MasterRepositoryMock) CreateMasterSyncRun(ctx context.Context, run *model.MasterSyncRun) error {
+	args := m.Called(ctx, run)
+	return args.Error(0)
+}
+func (m *MasterRepositoryMock) UpdateMasterSyncRun(ctx context.Context, run *model.MasterSyncRun) error {
+	args := m.Called(ctx, run)
+	return args.Error(0)
+}
+func (m *MasterRepositoryMock) FindMasterSyncRuns(ctx context.Context, limit int) ([]*model.MasterSyncRun, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.MasterSyncRun), args.Error(1)
+}
+
+// expectMasterSyncRun は同期の実行履歴の作成と更新を期待し、作成時に ID を設定する
+// 返り値には更新時に記録された実行履歴が格納される
+func expectMasterSyncRun(m *MasterRepositoryMock, id uint) *model.MasterSyncRun {
+	recorded := &model.MasterSyncRun{}
+	m.On("CreateMasterSyncRun", mock.Anything, mock.AnythingOfType("*model.MasterSyncRun")).Run(func(args mock.Arguments) {
+		args.Get(1).(*model.MasterSyncRun).ID = id
+	}).Return(nil).Once()
+	m.On("UpdateMasterSyncRun", mock.Anything, mock.AnythingOfType("*model.MasterSyncRun")).Run(func(args mock.Arguments) {
+		*recorded = *args.Get(1).(*model.MasterSyncRun)
+	}).Return(nil).Once()
+	return recorded
+}
 
 func TestGetStock_Success(t *testing.T) {
 	ctx := context.Background()
@@ -105,11 +199,13 @@ func TestGetStock_Success(t *testing.T) {
 		MarketCode:   "東証プライム",
 		IndustryCode: "3600",
 		IndustryName: "輸送用機器",
+		UpperLimit:   3500,
+		LowerLimit:   2500,
 	}
 
 	masterRepoMock.On("FindByIssueCode", ctx, symbol, "StockMaster").Return(mockStockMaster, nil).Once()
 
-	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock)
+	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock, app.MasterSyncConfig{})
 
 	// --- Execute ---
 	result, err := uc.GetStock(ctx, symbol)
@@ -123,6 +219,8 @@ func TestGetStock_Success(t *testing.T) {
 	assert.Equal(t, "東証プライム", result.Market)
 	assert.Equal(t, "3600", result.IndustryCode)
 	assert.Equal(t, "輸送用機器", result.IndustryName)
+	assert.Equal(t, 3500.0, result.UpperLimit)
+	assert.Equal(t, 2500.0, result.LowerLimit)
 
 	masterRepoMock.AssertExpectations(t)
 }
@@ -135,7 +233,7 @@ func TestGetStock_NotFound(t *testing.T) {
 
 	masterRepoMock.On("FindByIssueCode", ctx, symbol, "StockMaster").Return(nil, nil).Once()
 
-	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock)
+	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock, app.MasterSyncConfig{})
 
 	// --- Execute ---
 	result, err := uc.GetStock(ctx, symbol)
@@ -157,7 +255,7 @@ func TestGetStock_RepoError(t *testing.T) {
 
 	masterRepoMock.On("FindByIssueCode", ctx, symbol, "StockMaster").Return(nil, expectedErr).Once()
 
-	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock)
+	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock, app.MasterSyncConfig{})
 
 	// --- Execute ---
 	result, err := uc.GetStock(ctx, symbol)
@@ -170,6 +268,84 @@ func TestGetStock_RepoError(t *testing.T) {
 	masterRepoMock.AssertExpectations(t)
 }
 
+func TestListStocks_Success(t *testing.T) {
+	ctx := context.Background()
+	masterClientMock := new(MasterDataClientMock)
+	masterRepoMock := new(MasterRepositoryMock)
+
+	query := repository.StockMasterQuery{
+		MarketCode:  "00",
+		Keyword:     "トヨタ",
+		TradingUnit: 100,
+		Offset:      0,
+		Limit:       2,
+	}
+	stocks := []*model.StockMaster{
+		{IssueCode: "7203", IssueName: "トヨタ自動車", MarketCode: "00", TradingUnit: 100},
+		{IssueCode: "8015", IssueName: "豊田通商", MarketCode: "00", TradingUnit: 100},
+	}
+	masterRepoMock.On("FindStockMasters", ctx, query).Return(stocks, int64(5), nil).Once()
+
+	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock, app.MasterSyncConfig{})
+
+	result, err := uc.ListStocks(ctx, app.StockSearchParams{
+		Market:      "00",
+		Keyword:     "トヨタ",
+		TradingUnit: 100,
+		Offset:      0,
+		Limit:       2,
+	})
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, int64(5), result.Total)
+		assert.Equal(t, 2, result.Limit)
+		if assert.Len(t, result.Stocks, 2) {
+			assert.Equal(t, "7203", result.Stocks[0].Symbol)
+			assert.Equal(t, 100, result.Stocks[0].TradingUnit)
+			assert.Equal(t, "8015", result.Stocks[1].Symbol)
+		}
+	}
+	masterRepoMock.AssertExpectations(t)
+}
+
+func TestListStocks_RepoError(t *testing.T) {
+	ctx := context.Background()
+	masterRepoMock := new(MasterRepositoryMock)
+
+	masterRepoMock.On("FindStockMasters", ctx, mock.Anything).Return(nil, int64(0), errors.New("db error")).Once()
+
+	uc := app.NewMasterUseCaseImpl(new(MasterDataClientMock), masterRepoMock, app.MasterSyncConfig{})
+
+	result, err := uc.ListStocks(ctx, app.StockSearchParams{Limit: 100})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	masterRepoMock.AssertExpectations(t)
+}
+
+func TestListIndustries_Success(t *testing.T) {
+	ctx := context.Background()
+	masterRepoMock := new(MasterRepositoryMock)
+
+	masterRepoMock.On("CountStocksByIndustry", ctx).Return([]*repository.IndustryCount{
+		{IndustryCode: "3600", IndustryName: "輸送用機器", Count: 90},
+		{IndustryCode: "3650", IndustryName: "電気機器", Count: 230},
+	}, nil).Once()
+
+	uc := app.NewMasterUseCaseImpl(new(MasterDataClientMock), masterRepoMock, app.MasterSyncConfig{})
+
+	industries, err := uc.ListIndustries(ctx)
+
+	assert.NoError(t, err)
+	if assert.Len(t, industries, 2) {
+		assert.Equal(t, "3600", industries[0].Code)
+		assert.Equal(t, "輸送用機器", industries[0].Name)
+		assert.Equal(t, int64(90), industries[0].Count)
+	}
+	masterRepoMock.AssertExpectations(t)
+}
+
 func TestDownloadAndStoreMasterData_Success(t *testing.T) {
 	ctx := context.Background()
 	session := &client.Session{}
@@ -230,23 +406,33 @@ func TestDownloadAndStoreMasterData_Success(t *testing.T) {
 	}
 
 	// --- Mock Setup ---
-	masterClientMock.On("DownloadMasterData", ctx, session, request.ReqDownloadMaster{}).Return(dummyMasterData, nil).Once()
+	masterClientMock.On("StreamMasterData", ctx, session, request.ReqDownloadMaster{}, mock.Anything).Run(streamMasterRecords(dummyMasterData)).Return(nil).Once()
 
+	recordedRun := expectMasterSyncRun(masterRepoMock, 7)
 	var capturedStocks []*model.StockMaster
-	masterRepoMock.On("UpsertStockMasters", ctx, mock.AnythingOfType("[]*model.StockMaster")).Run(func(args mock.Arguments) {
+	masterRepoMock.On("SyncStockMasters", ctx, mock.AnythingOfType("[]*model.StockMaster"), false, uint(7)).Run(func(args mock.Arguments) {
 		capturedStocks = args.Get(1).([]*model.StockMaster)
-	}).Return(nil).Once()
+	}).Return(model.MasterSyncCounts{Inserted: 2}, nil).Once()
 
-	masterRepoMock.On("UpsertTickRules", ctx, mock.AnythingOfType("[]*model.TickRule")).Return(nil).Once()
+	masterRepoMock.On("SyncTickRules", ctx, mock.AnythingOfType("[]*model.TickRule")).Return(model.MasterSyncCounts{Unchanged: 1}, nil).Once()
 
 	// --- Execute ---
-	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock)
-	err := uc.DownloadAndStoreMasterData(ctx, session)
+	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock, app.MasterSyncConfig{
+		Scope:          app.MasterSyncWatched,
+		WatchedSymbols: []string{"7203", "9984"},
+	})
+	summary, err := uc.DownloadAndStoreMasterData(ctx, session, model.MasterSyncTriggerManual)
 
 	// --- Assert ---
 	assert.NoError(t, err)
 	masterClientMock.AssertExpectations(t)
 	masterRepoMock.AssertExpectations(t)
+	if assert.NotNil(t, summary) {
+		assert.Equal(t, uint(7), summary.RunID)
+		assert.Equal(t, app.MasterSyncWatched, summary.Scope)
+		assert.Equal(t, 2, summary.Stocks.Inserted)
+		assert.Equal(t, 1, summary.TickRules.Unchanged)
+	}
 
 	// Assert captured data
 	assert.Len(t, capturedStocks, 2)
@@ -263,4 +449,241 @@ func TestDownloadAndStoreMasterData_Success(t *testing.T) {
 	assert.Equal(t, "ソフトバンクグループ", capturedStocks[1].IssueName)
 	assert.Equal(t, 100, capturedStocks[1].TradingUnit)
 	assert.Equal(t, 8000.0, capturedStocks[1].UpperLimit)
+
+	// Assert the recorded sync run
+	assert.Equal(t, model.MasterSyncTriggerManual, recordedRun.Trigger)
+	assert.Equal(t, model.MasterSyncSucceeded, recordedRun.Status)
+	assert.NotNil(t, recordedRun.FinishedAt)
+	assert.Equal(t, 2, recordedRun.Stocks.Inserted)
+	assert.Equal(t, 1, recordedRun.TickRules.Unchanged)
+}
+
+func TestDownloadAndStoreMasterData_WatchedScopeStoresOnlyWatchedStocks(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	masterClientMock := new(MasterDataClientMock)
+	masterRepoMock := new(MasterRepositoryMock)
+
+	masterClientMock.On("StreamMasterData", ctx, session, request.ReqDownloadMaster{}, mock.Anything).Run(streamMasterRecords(&response.ResDownloadMaster{
+		StockMaster: []response.ResStockMaster{
+			{IssueCode: "7203", IssueName: "トヨタ自動車", TradingUnit: "100"},
+			{IssueCode: "9984", IssueName: "ソフトバンクグループ", TradingUnit: "100"},
+		},
+		StockMarketMaster: []response.ResStockMarketMaster{
+			{IssueCode: "7203", ListingMarket: "00", UpperLimit: "3500", LowerLimit: "2500"},
+			{IssueCode: "9984", ListingMarket: "00", UpperLimit: "9000", LowerLimit: "7000"},
+		},
+		FutureMaster: []response.ResFutureMaster{{}},
+	})).Return(nil).Once()
+
+	expectMasterSyncRun(masterRepoMock, 1)
+	var capturedStocks []*model.StockMaster
+	masterRepoMock.On("SyncStockMasters", ctx, mock.Anything, false, uint(1)).Run(func(args mock.Arguments) {
+		capturedStocks = args.Get(1).([]*model.StockMaster)
+	}).Return(model.MasterSyncCounts{Inserted: 1}, nil).Once()
+	masterRepoMock.On("SyncTickRules", ctx, mock.Anything).Return(model.MasterSyncCounts{}, nil).Once()
+
+	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock, app.MasterSyncConfig{
+		Scope:          app.MasterSyncWatched,
+		WatchedSymbols: []string{"7203"},
+	})
+	_, err := uc.DownloadAndStoreMasterData(ctx, session, model.MasterSyncTriggerStartup)
+
+	assert.NoError(t, err)
+	if assert.Len(t, capturedStocks, 1) {
+		assert.Equal(t, "7203", capturedStocks[0].IssueCode)
+		assert.Equal(t, 3500.0, capturedStocks[0].UpperLimit)
+	}
+	// 監視銘柄のみの同期では、市場マスタ・保証金マスタ・規制・運用ステータスは反映しない
+	masterRepoMock.AssertNotCalled(t, "SyncStockMarketMasters", mock.Anything, mock.Anything)
+	masterRepoMock.AssertNotCalled(t, "SyncMarginMasters", mock.Anything, mock.Anything)
+	masterRepoMock.AssertExpectations(t)
+}
+
+func TestDownloadAndStoreMasterData_FullScope(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	masterClientMock := new(MasterDataClientMock)
+	masterRepoMock := new(MasterRepositoryMock)
+
+	masterClientMock.On("StreamMasterData", ctx, session, request.ReqDownloadMaster{}, mock.Anything).Run(streamMasterRecords(&response.ResDownloadMaster{
+		StockMaster: []response.ResStockMaster{
+			{IssueCode: "7203", IssueName: "トヨタ自動車", PreferredMarket: "00", TradingUnit: "100"},
+			{IssueCode: "9984", IssueName: "ソフトバンクグループ", PreferredMarket: "00", TradingUnit: "100"},
+		},
+		StockMarketMaster: []response.ResStockMarketMaster{
+			{IssueCode: "7203", ListingMarket: "02", UpperLimit: "9000", LowerLimit: "2000"},
+			{IssueCode: "7203", ListingMarket: "00", UpperLimit: "3500", LowerLimit: "2500", MarketTradingUnit: "100", PreviousClose: "3000"},
+		},
+		MarginMaster: []response.ResMarginMaster{
+			{IssueCode: "7203", ListingMarket: "00", ChangeDate: "20251201", CollateralRate: "30", CashMarginRate: "30"},
+		},
+		StockIssueRegulation: []response.ResStockIssueRegulation{
+			{IssueCode: "9984", ListingMarket: "00", InstitutionalMarginNewSell: "1", PreAdjustmentFlag: "1"},
+		},
+		OperationStatusStock: []response.ResOperationStatus{
+			{CLMID: "CLMUnyouStatusKabu", OperationCategory: "01", OperationUnit: "0101", OperationStatus: "001"},
+			{CLMID: "CLMUnyouStatusKabu", OperationCategory: "01", OperationUnit: "0102", OperationStatus: "001", DeleteFlag: "1"},
+		},
+		DateInfo: []response.ResDateInfo{
+			{DayKey: "002", NextBusinessDay1: "20251226"},
+			{DayKey: "001", CurrentDay: "20251224", NextBusinessDay1: "20251225", NextBusinessDay2: "20251226"},
+		},
+	})).Return(nil).Once()
+
+	expectMasterSyncRun(masterRepoMock, 3)
+	var capturedStocks []*model.StockMaster
+	var capturedMarkets []*model.StockMarketMaster
+	var capturedRegulations []*model.StockIssueRegulation
+	var capturedStatuses []*model.OperationStatus
+	masterRepoMock.On("SyncStockMasters", ctx, mock.Anything, true, uint(3)).Run(func(args mock.Arguments) {
+		capturedStocks = args.Get(1).([]*model.StockMaster)
+	}).Return(model.MasterSyncCounts{Updated: 1, Unchanged: 1, Deleted: 3}, nil).Once()
+	masterRepoMock.On("SyncTickRules", ctx, mock.Anything).Return(model.MasterSyncCounts{}, nil).Once()
+	masterRepoMock.On("SyncStockMarketMasters", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		capturedMarkets = args.Get(1).([]*model.StockMarketMaster)
+	}).Return(model.MasterSyncCounts{Inserted: 2}, nil).Once()
+	masterRepoMock.On("SyncMarginMasters", ctx, mock.Anything).Return(model.MasterSyncCounts{Inserted: 1}, nil).Once()
+	masterRepoMock.On("SyncStockIssueRegulations", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		capturedRegulations = args.Get(1).([]*model.StockIssueRegulation)
+	}).Return(model.MasterSyncCounts{Inserted: 1}, nil).Once()
+	masterRepoMock.On("SyncOperationStatuses", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		capturedStatuses = args.Get(1).([]*model.OperationStatus)
+	}).Return(model.MasterSyncCounts{Inserted: 1}, nil).Once()
+
+	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock, app.MasterSyncConfig{
+		Scope:          app.MasterSyncFull,
+		WatchedSymbols: []string{"7203"}, // 全銘柄の同期では使用しない
+	})
+	summary, err := uc.DownloadAndStoreMasterData(ctx, session, model.MasterSyncTriggerScheduled)
+
+	assert.NoError(t, err)
+	masterRepoMock.AssertExpectations(t)
+	if assert.NotNil(t, summary) {
+		assert.Equal(t, app.MasterSyncFull, summary.Scope)
+		assert.Equal(t, 3, summary.Stocks.Deleted)
+		assert.Equal(t, 2, summary.StockMarkets.Inserted)
+		assert.Equal(t, 1, summary.OperationStatuses.Inserted)
+		assert.Equal(t, "20251224", summary.CurrentDay)
+		assert.Equal(t, []string{"20251225", "20251226"}, summary.NextBusinessDays)
+	}
+
+	if assert.Len(t, capturedStocks, 2) {
+		// 値幅制限は優先市場の上場情報から取得する
+		assert.Equal(t, 3500.0, capturedStocks[0].UpperLimit)
+		assert.Equal(t, 2500.0, capturedStocks[0].LowerLimit)
+	}
+	if assert.Len(t, capturedMarkets, 2) {
+		assert.Equal(t, "00", capturedMarkets[1].ListingMarket)
+		assert.Equal(t, 3000.0, capturedMarkets[1].PreviousClose)
+		assert.Equal(t, 100, capturedMarkets[1].TradingUnit)
+	}
+	if assert.Len(t, capturedRegulations, 1) {
+		assert.Equal(t, model.RegulationProhibited, capturedRegulations[0].MarginNewSell)
+		assert.True(t, capturedRegulations[0].PreAdjustment)
+	}
+	// 削除フラグの立った運用ステータスは反映しない
+	if assert.Len(t, capturedStatuses, 1) {
+		assert.Equal(t, "0101", capturedStatuses[0].OperationUnit)
+	}
+}
+
+func TestDownloadAndStoreMasterData_RecordsFailedRun(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	masterClientMock := new(MasterDataClientMock)
+	masterRepoMock := new(MasterRepositoryMock)
+
+	masterClientMock.On("StreamMasterData", ctx, session, request.ReqDownloadMaster{}, mock.Anything).Run(streamMasterRecords(&response.ResDownloadMaster{
+		StockMaster: []response.ResStockMaster{{IssueCode: "7203", TradingUnit: "100"}},
+	})).Return(nil).Once()
+
+	recordedRun := expectMasterSyncRun(masterRepoMock, 5)
+	masterRepoMock.On("SyncStockMasters", ctx, mock.Anything, false, uint(5)).Return(model.MasterSyncCounts{Updated: 1}, nil).Once()
+	masterRepoMock.On("SyncTickRules", ctx, mock.Anything).Return(model.MasterSyncCounts{}, errors.New("db down")).Once()
+
+	uc := app.NewMasterUseCaseImpl(masterClientMock, masterRepoMock, app.MasterSyncConfig{})
+	summary, err := uc.DownloadAndStoreMasterData(ctx, session, model.MasterSyncTriggerScheduled)
+
+	assert.Error(t, err)
+	assert.Nil(t, summary)
+	masterRepoMock.AssertExpectations(t)
+	// 失敗した場合も、失敗するまでに反映した件数とエラー内容を記録する
+	assert.Equal(t, model.MasterSyncFailed, recordedRun.Status)
+	assert.Contains(t, recordedRun.Error, "db down")
+	assert.Equal(t, 1, recordedRun.Stocks.Updated)
+	assert.NotNil(t, recordedRun.FinishedAt)
+}
+
+// streamMasterRecords feeds the records of the master data to the handler passed to StreamMasterData one by one.
+func streamMasterRecords(res *response.ResDownloadMaster) func(mock.Arguments) {
+	return func(args mock.Arguments) {
+		handle := args.Get(3).(client.MasterRecordHandler)
+		feed := func(clmid string, record any) {
+			_ = handle(clmid, record)
+		}
+		feed("CLMSystemStatus", res.SystemStatus)
+		for _, r := range res.DateInfo {
+			feed("CLMDateZyouhou", r)
+		}
+		for _, r := range res.TickRule {
+			feed("CLMYobine", r)
+		}
+		for _, r := range res.OperationStatus {
+			feed("CLMUnyouStatus", r)
+		}
+		for _, r := range res.OperationStatusStock {
+			feed("CLMUnyouStatusKabu", r)
+		}
+		for _, r := range res.OperationStatusDerivative {
+			feed("CLMUnyouStatusHasei", r)
+		}
+		for _, r := range res.StockMaster {
+			feed("CLMIssueMstKabu", r)
+		}
+		for _, r := range res.StockMarketMaster {
+			feed("CLMIssueSizyouMstKabu", r)
+		}
+		for _, r := range res.StockIssueRegulation {
+			feed("CLMIssueSizyouKiseiKabu", r)
+		}
+		for _, r := range res.FutureMaster {
+			feed("CLMIssueMstSak", r)
+		}
+		for _, r := range res.MarginMaster {
+			feed("CLMHosyoukinMst", r)
+		}
+	}
+}
+
+func TestListSyncRuns(t *testing.T) {
+	ctx := context.Background()
+	masterRepoMock := new(MasterRepositoryMock)
+
+	finishedAt := time.Date(2025, 12, 24, 8, 1, 0, 0, time.UTC)
+	masterRepoMock.On("FindMasterSyncRuns", ctx, 20).Return([]*model.MasterSyncRun{
+		{
+			ID:         2,
+			Scope:      "full",
+			Trigger:    model.MasterSyncTriggerScheduled,
+			Status:     model.MasterSyncSucceeded,
+			StartedAt:  time.Date(2025, 12, 24, 8, 0, 0, 0, time.UTC),
+			FinishedAt: &finishedAt,
+			Stocks:     model.MasterSyncCounts{Updated: 4, Unchanged: 3800},
+		},
+		{ID: 1, Scope: "full", Trigger: model.MasterSyncTriggerStartup, Status: model.MasterSyncFailed, Error: "boom"},
+	}, nil).Once()
+
+	uc := app.NewMasterUseCaseImpl(new(MasterDataClientMock), masterRepoMock, app.MasterSyncConfig{})
+	runs, err := uc.ListSyncRuns(ctx, 20)
+
+	assert.NoError(t, err)
+	if assert.Len(t, runs, 2) {
+		assert.Equal(t, uint(2), runs[0].ID)
+		assert.Equal(t, uint(2), runs[0].Summary.RunID)
+		assert.Equal(t, app.MasterSyncFull, runs[0].Summary.Scope)
+		assert.Equal(t, 4, runs[0].Summary.Stocks.Updated)
+		assert.Equal(t, &finishedAt, runs[0].FinishedAt)
+		assert.Equal(t, "boom", runs[1].Error)
+	}
 }
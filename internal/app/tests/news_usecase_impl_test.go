@@ -0,0 +1,272 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"stock-bot/internal/app"
+	"stock-bot/internal/infrastructure/client"
+	"stock-bot/internal/infrastructure/client/dto/master/request"
+	"stock-bot/internal/infrastructure/client/dto/master/response"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// NewsRepositoryMock implements the repository.NewsRepository interface for testing.
+type NewsRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *NewsRepositoryMock) SaveNews(ctx context.Context, news []*model.News) (int, error) {
+	args := m.Called(ctx, news)
+	return args.Int(0), args.Error(1)
+}
+func (m *NewsRepositoryMock) FindByID(ctx context.Context, id string) (*model.News, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.News), args.Error(1)
+}
+func (m *NewsRepositoryMock) FindNews(ctx context.Context, query repository.NewsQuery) ([]*model.News, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.News), args.Error(1)
+}
+func (m *NewsRepositoryMock) FindWithoutBody(ctx context.Context, limit int) ([]*model.News, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.News), args.Error(1)
+}
+func (m *NewsRepositoryMock) SaveBody(ctx context.Context, id string, body string, fetchedAt time.Time) error {
+	args := m.Called(ctx, id, body, fetchedAt)
+	return args.Error(0)
+}
+
+func encodeNewsText(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+func TestNewsUseCaseImpl_PollNews(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	t.Run("正常系: 監視銘柄ごとにヘッダーを取得し、重複を除いて保存し、本文を取得する", func(t *testing.T) {
+		mockClient := new(MasterDataClientMock)
+		mockRepo := new(NewsRepositoryMock)
+		uc := app.NewNewsUseCaseImpl(mockClient, mockRepo, session, app.NewsPollConfig{Symbols: []string{"7203", "6758"}})
+
+		shiftJISHeadline, err := japanese.ShiftJIS.NewEncoder().String("業績予想の修正")
+		assert.NoError(t, err)
+
+		mockClient.On("GetNewsHeader", ctx, session, mock.MatchedBy(func(req request.ReqGetNewsHead) bool {
+			return req.Issue == "7203" && req.Offset == "0"
+		})).Return(&response.ResGetNewsHeader{
+			PRECMAX: "2",
+			CLMMfdsNewsHead: []response.ResNewsHeadListItem{
+				{PID: "N1", PDT: "20251225", PTM: "153000", PCGL: "TDNET|IR", PGNL: "決算", PISL: "7203", PHDL: encodeNewsText("決算短信")},
+				{PID: "N2", PDT: "20251225", PTM: "160000", PCGL: "TDNET", PISL: "7203|6758", PHDL: base64.StdEncoding.EncodeToString([]byte(shiftJISHeadline))},
+			},
+		}, nil).Once()
+		mockClient.On("GetNewsHeader", ctx, session, mock.MatchedBy(func(req request.ReqGetNewsHead) bool {
+			return req.Issue == "6758"
+		})).Return(&response.ResGetNewsHeader{
+			PRECMAX: "1",
+			CLMMfdsNewsHead: []response.ResNewsHeadListItem{
+				{PID: "N2", PDT: "20251225", PTM: "160000", PCGL: "TDNET", PISL: "7203|6758", PHDL: base64.StdEncoding.EncodeToString([]byte(shiftJISHeadline))},
+			},
+		}, nil).Once()
+
+		mockRepo.On("SaveNews", ctx, mock.MatchedBy(func(news []*model.News) bool {
+			if len(news) != 2 {
+				return false
+			}
+			jst := time.FixedZone("JST", 9*60*60)
+			return news[0].ID == "N1" &&
+				news[0].PublishedAt.Equal(time.Date(2025, 12, 25, 15, 30, 0, 0, jst)) &&
+				assert.ObjectsAreEqual([]string{"TDNET", "IR"}, news[0].Categories) &&
+				assert.ObjectsAreEqual([]string{"7203"}, news[0].Symbols) &&
+				news[0].Headline == "決算短信" &&
+				news[1].Headline == "業績予想の修正" &&
+				assert.ObjectsAreEqual([]string{}, news[1].Genres)
+		})).Return(2, nil).Once()
+		// 保存済みのニュースは保存件数に含まれない
+		mockRepo.On("SaveNews", ctx, mock.MatchedBy(func(news []*model.News) bool {
+			return len(news) == 1 && news[0].ID == "N2"
+		})).Return(0, nil).Once()
+
+		mockRepo.On("FindWithoutBody", ctx, 50).Return([]*model.News{{ID: "N1"}}, nil).Once()
+		mockClient.On("GetNewsBody", ctx, session, request.ReqGetNewsBody{NewsID: "N1"}).Return(&response.ResGetNewsBody{
+			CLMMfdsNewsBody: []response.ResNewsBodyListItem{{PID: "N1", PTX: encodeNewsText("本文です")}},
+		}, nil).Once()
+		mockRepo.On("SaveBody", ctx, "N1", "本文です", mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+		summary, err := uc.PollNews(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &app.NewsPollSummary{Headers: 3, Saved: 2, Bodies: 1}, summary)
+		mockClient.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 件数が上限に達した場合は次のページを取得する", func(t *testing.T) {
+		mockClient := new(MasterDataClientMock)
+		mockRepo := new(NewsRepositoryMock)
+		uc := app.NewNewsUseCaseImpl(mockClient, mockRepo, session, app.NewsPollConfig{Symbols: []string{"7203"}})
+
+		firstPage := make([]response.ResNewsHeadListItem, 100)
+		for i := range firstPage {
+			firstPage[i] = response.ResNewsHeadListItem{PID: fmt.Sprintf("P%03d", i), PDT: "20251225", PTM: "090000", PISL: "7203"}
+		}
+		mockClient.On("GetNewsHeader", ctx, session, mock.MatchedBy(func(req request.ReqGetNewsHead) bool {
+			return req.Offset == "0" && req.Limit == "100"
+		})).Return(&response.ResGetNewsHeader{PRECMAX: "101", CLMMfdsNewsHead: firstPage}, nil).Once()
+		mockClient.On("GetNewsHeader", ctx, session, mock.MatchedBy(func(req request.ReqGetNewsHead) bool {
+			return req.Offset == "100"
+		})).Return(&response.ResGetNewsHeader{PRECMAX: "101", CLMMfdsNewsHead: []response.ResNewsHeadListItem{
+			{PID: "LAST", PDT: "20251225", PTM: "090000", PISL: "7203"},
+		}}, nil).Once()
+		mockRepo.On("SaveNews", ctx, mock.MatchedBy(func(news []*model.News) bool { return len(news) == 101 })).Return(101, nil).Once()
+		mockRepo.On("FindWithoutBody", ctx, 50).Return([]*model.News{}, nil).Once()
+
+		summary, err := uc.PollNews(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 101, summary.Saved)
+		mockClient.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: ヘッダーの取得に失敗した場合はエラーを返す", func(t *testing.T) {
+		mockClient := new(MasterDataClientMock)
+		mockRepo := new(NewsRepositoryMock)
+		uc := app.NewNewsUseCaseImpl(mockClient, mockRepo, session, app.NewsPollConfig{Symbols: []string{"7203"}})
+
+		mockClient.On("GetNewsHeader", ctx, session, mock.Anything).Return(nil, errors.New("api error")).Once()
+
+		_, err := uc.PollNews(ctx)
+		assert.ErrorContains(t, err, "api error")
+		mockRepo.AssertNotCalled(t, "SaveNews", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 一部の銘柄のヘッダーや本文の取得に失敗しても、件数を記録して残りを処理する", func(t *testing.T) {
+		mockClient := new(MasterDataClientMock)
+		mockRepo := new(NewsRepositoryMock)
+		uc := app.NewNewsUseCaseImpl(mockClient, mockRepo, session, app.NewsPollConfig{Symbols: []string{"7203", "6758"}})
+
+		mockClient.On("GetNewsHeader", ctx, session, mock.MatchedBy(func(req request.ReqGetNewsHead) bool {
+			return req.Issue == "7203"
+		})).Return(nil, errors.New("api error")).Once()
+		mockClient.On("GetNewsHeader", ctx, session, mock.MatchedBy(func(req request.ReqGetNewsHead) bool {
+			return req.Issue == "6758"
+		})).Return(&response.ResGetNewsHeader{
+			PRECMAX: "1",
+			CLMMfdsNewsHead: []response.ResNewsHeadListItem{
+				{PID: "N3", PDT: "20251225", PTM: "160000", PISL: "6758"},
+			},
+		}, nil).Once()
+		mockRepo.On("SaveNews", ctx, mock.MatchedBy(func(news []*model.News) bool {
+			return len(news) == 1 && news[0].ID == "N3"
+		})).Return(1, nil).Once()
+
+		mockRepo.On("FindWithoutBody", ctx, 50).Return([]*model.News{{ID: "BAD"}, {ID: "N3"}}, nil).Once()
+		mockClient.On("GetNewsBody", ctx, session, request.ReqGetNewsBody{NewsID: "BAD"}).Return(nil, errors.New("invalid news id")).Once()
+		mockClient.On("GetNewsBody", ctx, session, request.ReqGetNewsBody{NewsID: "N3"}).Return(&response.ResGetNewsBody{
+			CLMMfdsNewsBody: []response.ResNewsBodyListItem{{PID: "N3", PTX: encodeNewsText("本文です")}},
+		}, nil).Once()
+		mockRepo.On("SaveBody", ctx, "N3", "本文です", mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+		summary, err := uc.PollNews(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &app.NewsPollSummary{Headers: 1, Saved: 1, Bodies: 1, HeaderFailures: 1, BodyFailures: 1}, summary)
+		mockClient.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestNewsUseCaseImpl_GetNews(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	t.Run("正常系: 本文が未取得の場合は取得して保存する", func(t *testing.T) {
+		mockClient := new(MasterDataClientMock)
+		mockRepo := new(NewsRepositoryMock)
+		uc := app.NewNewsUseCaseImpl(mockClient, mockRepo, session, app.NewsPollConfig{})
+
+		mockRepo.On("FindByID", ctx, "N1").Return(&model.News{ID: "N1", Headline: "決算短信"}, nil).Once()
+		mockClient.On("GetNewsBody", ctx, session, request.ReqGetNewsBody{NewsID: "N1"}).Return(&response.ResGetNewsBody{
+			CLMMfdsNewsBody: []response.ResNewsBodyListItem{{PID: "N1", PTX: encodeNewsText("本文です")}},
+		}, nil).Once()
+		mockRepo.On("SaveBody", ctx, "N1", "本文です", mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+		news, err := uc.GetNews(ctx, "N1")
+		assert.NoError(t, err)
+		assert.Equal(t, "本文です", news.Body)
+		assert.NotNil(t, news.BodyFetchedAt)
+		mockClient.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 本文が取得済みの場合は API を呼ばない", func(t *testing.T) {
+		mockClient := new(MasterDataClientMock)
+		mockRepo := new(NewsRepositoryMock)
+		uc := app.NewNewsUseCaseImpl(mockClient, mockRepo, session, app.NewsPollConfig{})
+
+		fetchedAt := time.Now()
+		mockRepo.On("FindByID", ctx, "N1").Return(&model.News{ID: "N1", Body: "本文です", BodyFetchedAt: &fetchedAt}, nil).Once()
+
+		news, err := uc.GetNews(ctx, "N1")
+		assert.NoError(t, err)
+		assert.Equal(t, "本文です", news.Body)
+		mockClient.AssertNotCalled(t, "GetNewsBody", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: 本文の取得に失敗した場合は本文なしで返す", func(t *testing.T) {
+		mockClient := new(MasterDataClientMock)
+		mockRepo := new(NewsRepositoryMock)
+		uc := app.NewNewsUseCaseImpl(mockClient, mockRepo, session, app.NewsPollConfig{})
+
+		mockRepo.On("FindByID", ctx, "N1").Return(&model.News{ID: "N1"}, nil).Once()
+		mockClient.On("GetNewsBody", ctx, session, mock.Anything).Return(nil, errors.New("api error")).Once()
+
+		news, err := uc.GetNews(ctx, "N1")
+		assert.NoError(t, err)
+		assert.Nil(t, news.BodyFetchedAt)
+		mockRepo.AssertNotCalled(t, "SaveBody", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 見つからない場合は ErrNotFound を返す", func(t *testing.T) {
+		mockClient := new(MasterDataClientMock)
+		mockRepo := new(NewsRepositoryMock)
+		uc := app.NewNewsUseCaseImpl(mockClient, mockRepo, session, app.NewsPollConfig{})
+
+		mockRepo.On("FindByID", ctx, "missing").Return(nil, nil).Once()
+
+		_, err := uc.GetNews(ctx, "missing")
+		assert.ErrorIs(t, err, app.ErrNotFound)
+	})
+}
+
+func TestNewsUseCaseImpl_ListNews(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(NewsRepositoryMock)
+	uc := app.NewNewsUseCaseImpl(new(MasterDataClientMock), mockRepo, &client.Session{}, app.NewsPollConfig{})
+
+	since := time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)
+	expected := []*model.News{{ID: "N2"}, {ID: "N1"}}
+	mockRepo.On("FindNews", ctx, repository.NewsQuery{Symbol: "7203", Since: since, Limit: 50}).Return(expected, nil).Once()
+
+	news, err := uc.ListNews(ctx, "7203", since, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, news)
+	mockRepo.AssertExpectations(t)
+}
@@ -2,13 +2,16 @@ package tests
 
 import (
 	"context"
+	"fmt"
 	"errors"
 	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
 	"stock-bot/internal/app"
 	"stock-bot/internal/infrastructure/client"
 	"stock-bot/internal/infrastructure/client/dto/order/request"
 	"stock-bot/internal/infrastructure/client/dto/order/response"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -94,6 +97,53 @@ func (m *OrderRepositoryMock) FindByStatus(ctx context.Context, status model.Ord
 	return args.Get(0).([]*model.Order), args.Error(1)
 }
 
+func (m *OrderRepositoryMock) FindOrders(ctx context.Context, query repository.OrderQuery) ([]*model.Order, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Order), args.Error(1)
+}
+
+func (m *OrderRepositoryMock) SaveExecutions(ctx context.Context, executions []*model.Execution) error {
+	args := m.Called(ctx, executions)
+	return args.Error(0)
+}
+
+func (m *OrderRepositoryMock) UpdateStatus(ctx context.Context, order *model.Order, status model.OrderStatus) error {
+	args := m.Called(ctx, order, status)
+	return args.Error(0)
+}
+
+func (m *OrderRepositoryMock) FindStatusTransitions(ctx context.Context, orderID string) ([]*model.OrderStatusTransition, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.OrderStatusTransition), args.Error(1)
+}
+
+func (m *OrderRepositoryMock) SaveExecution(ctx context.Context, order *model.Order, execution *model.Execution) error {
+	args := m.Called(ctx, order, execution)
+	return args.Error(0)
+}
+
+func (m *OrderRepositoryMock) FindExecutions(ctx context.Context, orderID string) ([]*model.Execution, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Execution), args.Error(1)
+}
+
+func (m *OrderRepositoryMock) FindExecutedOrders(ctx context.Context, since time.Time) ([]*model.Order, error) {
+	args := m.Called(ctx, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Order), args.Error(1)
+}
+
 // OrderUsecaseの実装をテスト
 func TestExecuteOrder_Success(t *testing.T) {
 	ctx := context.Background()
@@ -114,7 +164,9 @@ func TestExecuteOrder_Success(t *testing.T) {
 	orderRepositoryMock.On("Save", ctx, mock.AnythingOfType("*model.Order")).Return(nil).Once()
 
 	// Usecaseの初期化
-	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IssueCode: "7203", TradingUnit: 100}, nil)
+	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock, masterRepoMock, app.NewTickServiceImpl(masterRepoMock), nil, nil)
 
 	// 実行
 	orderParams := app.OrderParams{
@@ -156,7 +208,9 @@ func TestExecuteOrder_ClientError(t *testing.T) {
 	orderClientMock.On("NewOrder", ctx, session, mock.AnythingOfType("client.NewOrderParams")).Return(nil, expectedErr).Once()
 
 	// Usecaseの初期化
-	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IssueCode: "7203", TradingUnit: 100}, nil)
+	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock, masterRepoMock, app.NewTickServiceImpl(masterRepoMock), nil, nil)
 
 	// 実行
 	orderParams := app.OrderParams{
@@ -197,7 +251,9 @@ func TestExecuteOrder_RepositoryError(t *testing.T) {
 	orderRepositoryMock.On("Save", ctx, mock.AnythingOfType("*model.Order")).Return(expectedErr).Once()
 
 	// Usecaseの初期化
-	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IssueCode: "7203", TradingUnit: 100}, nil)
+	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock, masterRepoMock, app.NewTickServiceImpl(masterRepoMock), nil, nil)
 
 	// 実行
 	orderParams := app.OrderParams{
@@ -218,4 +274,281 @@ func TestExecuteOrder_RepositoryError(t *testing.T) {
 	orderRepositoryMock.AssertExpectations(t)
 }
 
+func TestExecuteOrder_LimitPriceRoundedToTick(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tradeType     model.TradeType
+		price         float64
+		expectedPrice float64
+		expectedParam string
+	}{
+		{name: "買いは切り下げ", tradeType: model.TradeTypeBuy, price: 2503.7, expectedPrice: 2503.5, expectedParam: "2503.5"},
+		{name: "売りは切り上げ", tradeType: model.TradeTypeSell, price: 2503.7, expectedPrice: 2504, expectedParam: "2504"},
+		{name: "呼値に合っている価格はそのまま", tradeType: model.TradeTypeBuy, price: 999.9, expectedPrice: 999.9, expectedParam: "999.9"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			session := &client.Session{}
+			orderClientMock := new(OrderClientMock)
+			orderRepositoryMock := new(OrderRepositoryMock)
+			masterRepoMock := new(MasterRepositoryMock)
+
+			masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IssueCode: "7203", TickUnitNumber: "102"}, nil)
+			masterRepoMock.On("FindTickRule", ctx, "102").Return(topix500TickRule(), nil)
+			orderClientMock.On("NewOrder", ctx, session, mock.MatchedBy(func(p client.NewOrderParams) bool {
+				return p.OrderPrice == tc.expectedParam
+			})).Return(&response.ResNewOrder{ResultCode: "0", OrderNumber: "limit-1"}, nil).Once()
+			orderRepositoryMock.On("Save", ctx, mock.AnythingOfType("*model.Order")).Return(nil).Once()
+
+			uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock, masterRepoMock, app.NewTickServiceImpl(masterRepoMock), nil, nil)
+
+			result, err := uc.ExecuteOrder(ctx, session, app.OrderParams{
+				Symbol:    "7203",
+				TradeType: tc.tradeType,
+				OrderType: model.OrderTypeLimit,
+				Quantity:  100,
+				Price:     tc.price,
+			})
+
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.expectedPrice, result.Price)
+			}
+			orderClientMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExecuteOrder_LimitPriceMustBePositive(t *testing.T) {
+	ctx := context.Background()
+	orderClientMock := new(OrderClientMock)
+	orderRepositoryMock := new(OrderRepositoryMock)
+
+	masterRepoMock := new(MasterRepositoryMock)
+	masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IssueCode: "7203", TradingUnit: 100}, nil)
+	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock, masterRepoMock, app.NewTickServiceImpl(masterRepoMock), nil, nil)
+
+	result, err := uc.ExecuteOrder(ctx, &client.Session{}, app.OrderParams{
+		Symbol:    "7203",
+		TradeType: model.TradeTypeBuy,
+		OrderType: model.OrderTypeLimit,
+		Quantity:  100,
+		Price:     0,
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	orderClientMock.AssertNotCalled(t, "NewOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExecuteOrder_LimitPriceOutsidePriceLimitsRejected(t *testing.T) {
+	ctx := context.Background()
+	orderClientMock := new(OrderClientMock)
+	orderRepositoryMock := new(OrderRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+
+	// 値幅制限 700〜1300円 (呼値テーブルは標準にフォールバック)
+	masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IssueCode: "7203", UpperLimit: 1300, LowerLimit: 700}, nil)
+
+	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock, masterRepoMock, app.NewTickServiceImpl(masterRepoMock), nil, nil)
+
+	result, err := uc.ExecuteOrder(ctx, &client.Session{}, app.OrderParams{
+		Symbol:    "7203",
+		TradeType: model.TradeTypeBuy,
+		OrderType: model.OrderTypeLimit,
+		Quantity:  100,
+		Price:     1400,
+	})
+
+	assert.ErrorIs(t, err, app.ErrInvalidOrder)
+	assert.ErrorIs(t, err, model.ErrPriceOutsideLimits)
+	assert.Nil(t, result)
+	orderClientMock.AssertNotCalled(t, "NewOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExecuteOrder_OddLotQuantityRejected(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stock    interface{}
+		quantity uint64
+		wantErr  error
+	}{
+		{name: "売買単位の整数倍でない", stock: &model.StockMaster{IssueCode: "7203", TradingUnit: 100}, quantity: 150, wantErr: model.ErrOddLotQuantity},
+		{name: "数量がゼロ", stock: &model.StockMaster{IssueCode: "7203", TradingUnit: 100}, quantity: 0, wantErr: app.ErrInvalidOrder},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			orderClientMock := new(OrderClientMock)
+			orderRepositoryMock := new(OrderRepositoryMock)
+			masterRepoMock := new(MasterRepositoryMock)
+			masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(tc.stock, nil)
+
+			uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock, masterRepoMock, app.NewTickServiceImpl(masterRepoMock), nil, nil)
+
+			result, err := uc.ExecuteOrder(ctx, &client.Session{}, app.OrderParams{
+				Symbol:    "7203",
+				TradeType: model.TradeTypeBuy,
+				OrderType: model.OrderTypeMarket,
+				Quantity:  tc.quantity,
+			})
+
+			assert.ErrorIs(t, err, app.ErrInvalidOrder)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Nil(t, result)
+			orderClientMock.AssertNotCalled(t, "NewOrder", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestExecuteOrder_WithoutStockMasterSkipsTradingUnitCheck(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	orderClientMock := new(OrderClientMock)
+	orderRepositoryMock := new(OrderRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+
+	masterRepoMock.On("FindByIssueCode", ctx, "9999", "StockMaster").Return(nil, nil)
+	orderClientMock.On("NewOrder", ctx, session, mock.AnythingOfType("client.NewOrderParams")).Return(&response.ResNewOrder{ResultCode: "0", OrderNumber: "no-master-1"}, nil).Once()
+	orderRepositoryMock.On("Save", ctx, mock.AnythingOfType("*model.Order")).Return(nil).Once()
+
+	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock, masterRepoMock, app.NewTickServiceImpl(masterRepoMock), nil, nil)
+
+	result, err := uc.ExecuteOrder(ctx, session, app.OrderParams{
+		Symbol:    "9999",
+		TradeType: model.TradeTypeBuy,
+		OrderType: model.OrderTypeMarket,
+		Quantity:  150,
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, 150, result.Quantity)
+	}
+	orderClientMock.AssertExpectations(t)
+}
+
 // go test -v ./internal/app/tests/order_usecase_impl_test.go
+
+// MarginEntryGuardMock blocks new margin entries with the given error.
+type MarginEntryGuardMock struct {
+	err error
+}
+
+func (g *MarginEntryGuardMock) CheckMarginEntry() error {
+	return g.err
+}
+
+func TestExecuteOrder_MarginEntryBlocked(t *testing.T) {
+	ctx := context.Background()
+	orderClientMock := new(OrderClientMock)
+	orderRepositoryMock := new(OrderRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	guard := &MarginEntryGuardMock{err: fmt.Errorf("%w: maintenance rate 25.00%% is below 30.00%%", app.ErrMarginEntryBlocked)}
+	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock, masterRepoMock, app.NewTickServiceImpl(masterRepoMock), guard, nil)
+
+	result, err := uc.ExecuteOrder(ctx, &client.Session{}, app.OrderParams{
+		Symbol:    "7203",
+		TradeType: model.TradeTypeBuy,
+		OrderType: model.OrderTypeMarket,
+		Quantity:  100,
+		IsMargin:  true,
+	})
+
+	assert.ErrorIs(t, err, app.ErrMarginEntryBlocked)
+	assert.Nil(t, result)
+	orderClientMock.AssertNotCalled(t, "NewOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExecuteOrder_SellQuantityCappedToSellable(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	orderClientMock := new(OrderClientMock)
+	orderRepositoryMock := new(OrderRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IssueCode: "7203", TradingUnit: 100}, nil)
+	balanceClientMock := new(BalanceClientMock)
+	// 他の売り注文で 100 株が拘束されている
+	balanceClientMock.On("GetZanUriKanousuu", ctx, session, mock.Anything).Return(sellable("200"), nil)
+	reserver := app.NewSellQuantityReserver(balanceClientMock, time.Minute)
+	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock, masterRepoMock, app.NewTickServiceImpl(masterRepoMock), nil, reserver)
+
+	orderClientMock.On("NewOrder", ctx, session, mock.MatchedBy(func(p client.NewOrderParams) bool {
+		return p.OrderSuryou == "200"
+	})).Return(&response.ResNewOrder{ResultCode: "0", OrderNumber: "1"}, nil).Once()
+	orderRepositoryMock.On("Save", ctx, mock.AnythingOfType("*model.Order")).Return(nil).Once()
+
+	result, err := uc.ExecuteOrder(ctx, session, app.OrderParams{
+		Symbol:    "7203",
+		TradeType: model.TradeTypeSell,
+		OrderType: model.OrderTypeMarket,
+		Quantity:  300,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 200, result.Quantity)
+
+	// 発注した数量は予約されたままのため、直後の売り注文は売り越さない
+	result, err = uc.ExecuteOrder(ctx, session, app.OrderParams{
+		Symbol:    "7203",
+		TradeType: model.TradeTypeSell,
+		OrderType: model.OrderTypeMarket,
+		Quantity:  100,
+	})
+	assert.ErrorIs(t, err, app.ErrInvalidOrder)
+	assert.ErrorIs(t, err, model.ErrNoSellableQuantity)
+	assert.Nil(t, result)
+	orderClientMock.AssertExpectations(t)
+}
+
+func TestExecuteOrder_MarginSellIsCappedToSellable(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	orderClientMock := new(OrderClientMock)
+	orderRepositoryMock := new(OrderRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IssueCode: "7203", TradingUnit: 100}, nil)
+	balanceClientMock := new(BalanceClientMock)
+	balanceClientMock.On("GetZanUriKanousuu", ctx, session, mock.Anything).Return(sellable("100"), nil)
+	reserver := app.NewSellQuantityReserver(balanceClientMock, time.Minute)
+	uc := app.NewOrderUseCaseImpl(orderClientMock, orderRepositoryMock, masterRepoMock, app.NewTickServiceImpl(masterRepoMock), nil, reserver)
+
+	// 注文は現物で発注されるため、信用の指定でも売却可能数量までに抑える
+	orderClientMock.On("NewOrder", ctx, session, mock.MatchedBy(func(p client.NewOrderParams) bool {
+		return p.OrderSuryou == "100" && p.GenkinShinyouKubun == "0"
+	})).Return(&response.ResNewOrder{ResultCode: "0", OrderNumber: "1"}, nil).Once()
+	orderRepositoryMock.On("Save", ctx, mock.AnythingOfType("*model.Order")).Return(nil).Once()
+
+	result, err := uc.ExecuteOrder(ctx, session, app.OrderParams{
+		Symbol:    "7203",
+		TradeType: model.TradeTypeSell,
+		OrderType: model.OrderTypeMarket,
+		Quantity:  300,
+		IsMargin:  true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 100, result.Quantity)
+	orderClientMock.AssertExpectations(t)
+}
+
+func TestExecuteOrder_SellableLookupFailureRejectsSell(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	orderClientMock := new(OrderClientMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IssueCode: "7203", TradingUnit: 100}, nil)
+	balanceClientMock := new(BalanceClientMock)
+	balanceClientMock.On("GetZanUriKanousuu", ctx, session, mock.Anything).Return(nil, errors.New("api error"))
+	reserver := app.NewSellQuantityReserver(balanceClientMock, time.Minute)
+	uc := app.NewOrderUseCaseImpl(orderClientMock, new(OrderRepositoryMock), masterRepoMock, app.NewTickServiceImpl(masterRepoMock), nil, reserver)
+
+	result, err := uc.ExecuteOrder(ctx, session, app.OrderParams{
+		Symbol:    "7203",
+		TradeType: model.TradeTypeSell,
+		OrderType: model.OrderTypeMarket,
+		Quantity:  100,
+	})
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	orderClientMock.AssertNotCalled(t, "NewOrder", mock.Anything, mock.Anything, mock.Anything)
+}
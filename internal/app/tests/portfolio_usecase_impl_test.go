@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"stock-bot/domain/model"
+	"stock-bot/internal/app"
+	"stock-bot/internal/infrastructure/client"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// PositionUseCaseMock implements the app.PositionUseCase interface for testing.
+type PositionUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *PositionUseCaseMock) ListPositions(ctx context.Context, session *client.Session, filterType string) ([]*app.Position, error) {
+	args := m.Called(ctx, session, filterType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*app.Position), args.Error(1)
+}
+
+func TestPortfolioUseCaseImpl_GetPortfolio(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	t.Run("正常系: 合計・種別と業種ごとの評価額・集中度・実現損益を集計する", func(t *testing.T) {
+		positionMock := new(PositionUseCaseMock)
+		masterMock := new(MasterRepositoryMock)
+		orderMock := new(OrderRepositoryMock)
+		uc := app.NewPortfolioUseCaseImpl(positionMock, masterMock, orderMock)
+
+		positionMock.On("ListPositions", ctx, session, "all").Return([]*app.Position{
+			{Symbol: "7203", PositionType: app.PositionTypeCash, Quantity: 100, AverageCost: 2800, CurrentPrice: 3000, UnrealizedPL: 20000},
+			{Symbol: "7267", PositionType: app.PositionTypeMarginLong, Quantity: 100, AverageCost: 1500, CurrentPrice: 1000, UnrealizedPL: -50000},
+			{Symbol: "6758", PositionType: app.PositionTypeMarginShort, Quantity: 100, AverageCost: 13000, CurrentPrice: 0, UnrealizedPL: 0, ParseErrors: []string{`current_price: invalid value "-"`}},
+		}, nil).Once()
+		masterMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IndustryCode: "3700", IndustryName: "輸送用機器"}, nil)
+		masterMock.On("FindByIssueCode", ctx, "7267", "StockMaster").Return(&model.StockMaster{IndustryCode: "3700", IndustryName: "輸送用機器"}, nil)
+		masterMock.On("FindByIssueCode", ctx, "6758", "StockMaster").Return(nil, nil)
+
+		// 買 100株@1000 (手数料 100) → 買 100株@1200 → 売 100株@1300 (手数料 100)
+		// 移動平均単価 1100、建ての手数料は 200株に按分されるので 100株分の 50 を計上し、
+		// 実現損益は 100 * (1300 - 1100) - 50 - 100 = 19850
+		base := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+		orderMock.On("FindExecutedOrders", ctx, time.Time{}).Return([]*model.Order{
+			{Symbol: "9984", TradeType: model.TradeTypeBuy, Executions: []model.Execution{
+				{ExecutionTime: base, ExecutionPrice: 1000, ExecutionQuantity: 100, Commission: 100},
+				{ExecutionTime: base.Add(time.Hour), ExecutionPrice: 1200, ExecutionQuantity: 100},
+			}},
+			{Symbol: "9984", TradeType: model.TradeTypeSell, Executions: []model.Execution{
+				{ExecutionTime: base.Add(2 * time.Hour), ExecutionPrice: 1300, ExecutionQuantity: 100, Commission: 100},
+			}},
+			// 記録のない現物の売りは取得価格がないので計上しない
+			{Symbol: "8306", TradeType: model.TradeTypeSell, Executions: []model.Execution{
+				{ExecutionTime: base.Add(3 * time.Hour), ExecutionPrice: 1500, ExecutionQuantity: 100},
+			}},
+		}, nil).Once()
+
+		result, err := uc.GetPortfolio(ctx, session)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 3, result.Totals.PositionCount)
+		assert.Equal(t, 400000.0, result.Totals.MarketValue)
+		assert.Equal(t, 400000.0, result.Totals.LongValue)
+		assert.Equal(t, 0.0, result.Totals.ShortValue)
+		assert.Equal(t, 1730000.0, result.Totals.CostBasis)
+		assert.Equal(t, -30000.0, result.Totals.UnrealizedPL)
+
+		if assert.Len(t, result.ByType, 3) {
+			assert.Equal(t, "CASH", result.ByType[0].Code)
+			assert.Equal(t, 0.75, result.ByType[0].Weight)
+			assert.Equal(t, "MARGIN_LONG", result.ByType[1].Code)
+		}
+		if assert.Len(t, result.ByIndustry, 2) {
+			assert.Equal(t, "3700", result.ByIndustry[0].Code)
+			assert.Equal(t, 2, result.ByIndustry[0].PositionCount)
+			assert.Equal(t, 1.0, result.ByIndustry[0].Weight)
+			assert.Equal(t, "", result.ByIndustry[1].Code) // マスタにない銘柄
+		}
+
+		assert.Equal(t, "7203", result.Concentration.LargestSymbol)
+		assert.Equal(t, 0.75, result.Concentration.LargestWeight)
+		assert.Equal(t, 1.0, result.Concentration.Top5Weight)
+		assert.InDelta(t, 0.625, result.Concentration.HHI, 1e-9)
+
+		assert.Equal(t, 19850.0, result.RealizedPL)
+		assert.Equal(t, 0.0, result.RealizedPLToday)
+		assert.Equal(t, []string{`6758 (MARGIN_SHORT): current_price: invalid value "-"`}, result.ParseErrors)
+	})
+
+	t.Run("正常系: 信用売建の返済と途転を計上する", func(t *testing.T) {
+		positionMock := new(PositionUseCaseMock)
+		orderMock := new(OrderRepositoryMock)
+		uc := app.NewPortfolioUseCaseImpl(positionMock, new(MasterRepositoryMock), orderMock)
+
+		positionMock.On("ListPositions", ctx, session, "all").Return([]*app.Position{}, nil).Once()
+		// 売建 100株@2000 → 買 300株@1900 (100株を返済して 200株の買建) → 売 200株@1950
+		// (2000 - 1900) * 100 + (1950 - 1900) * 200 = 20000
+		now := time.Now()
+		orderMock.On("FindExecutedOrders", ctx, time.Time{}).Return([]*model.Order{
+			{Symbol: "7203", TradeType: model.TradeTypeSell, IsMargin: true, Executions: []model.Execution{
+				{ExecutionTime: now.Add(-2 * time.Second), ExecutionPrice: 2000, ExecutionQuantity: 100},
+			}},
+			{Symbol: "7203", TradeType: model.TradeTypeBuy, IsMargin: true, Executions: []model.Execution{
+				{ExecutionTime: now.Add(-time.Second), ExecutionPrice: 1900, ExecutionQuantity: 300},
+			}},
+			{Symbol: "7203", TradeType: model.TradeTypeSell, IsMargin: true, Executions: []model.Execution{
+				{ExecutionTime: now, ExecutionPrice: 1950, ExecutionQuantity: 200},
+			}},
+		}, nil).Once()
+
+		result, err := uc.GetPortfolio(ctx, session)
+		assert.NoError(t, err)
+		assert.Equal(t, 20000.0, result.RealizedPL)
+		assert.Equal(t, 0, result.Totals.PositionCount)
+		assert.Empty(t, result.ParseErrors)
+	})
+
+	t.Run("異常系: ポジション取得のエラーを返す", func(t *testing.T) {
+		positionMock := new(PositionUseCaseMock)
+		orderMock := new(OrderRepositoryMock)
+		uc := app.NewPortfolioUseCaseImpl(positionMock, new(MasterRepositoryMock), orderMock)
+
+		positionMock.On("ListPositions", ctx, session, "all").Return(nil, errors.New("api error")).Once()
+
+		_, err := uc.GetPortfolio(ctx, session)
+		assert.Error(t, err)
+		orderMock.AssertNotCalled(t, "FindExecutedOrders", mock.Anything, mock.Anything)
+	})
+}
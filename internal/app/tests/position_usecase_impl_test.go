@@ -207,4 +207,35 @@ func TestListPositions_ClientError(t *testing.T) {
 	balanceClientMock.AssertExpectations(t)
 }
 
+func TestListPositions_ParseErrors(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	balanceClientMock := new(PositionUseCaseBalanceClientMock)
+
+	balanceClientMock.On("GetGenbutuKabuList", ctx, session).Return(&response.ResGenbutuKabuList{
+		ResultCode: "0",
+		GenbutuKabuList: []response.ResGenbutuKabu{
+			{
+				UriOrderIssueCode:              "7203",
+				UriOrderZanKabuSuryou:          "1,000",
+				UriOrderGaisanBokaTanka:        "7000",
+				UriOrderHyoukaTanka:            "-",
+				UriOrderGaisanHyoukaSoneki:     "",
+				UriOrderGaisanHyoukaSonekiRitu: "0.00",
+			},
+		},
+	}, nil).Once()
+
+	uc := app.NewPositionUseCaseImpl(balanceClientMock)
+	result, err := uc.ListPositions(ctx, session, "cash")
+
+	assert.NoError(t, err)
+	if assert.Len(t, result, 1) {
+		// 桁区切りは解析でき、解析できない値は 0 として報告される
+		assert.Equal(t, 1000.0, result[0].Quantity)
+		assert.Equal(t, 0.0, result[0].CurrentPrice)
+		assert.Equal(t, []string{`current_price: invalid value "-"`, `unrealized_pl: invalid value ""`}, result[0].ParseErrors)
+	}
+}
+
 // go test -v ./internal/app/tests/position_usecase_impl_test.go
@@ -0,0 +1,249 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"stock-bot/internal/app"
+	"stock-bot/internal/infrastructure/client"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TradingEventRepositoryMock implements the repository.TradingEventRepository interface for testing.
+type TradingEventRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *TradingEventRepositoryMock) Save(ctx context.Context, event *model.TradingEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *TradingEventRepositoryMock) FindEvents(ctx context.Context, query repository.TradingEventQuery) ([]*model.TradingEvent, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.TradingEvent), args.Error(1)
+}
+
+// TradeUseCaseMock implements the app.TradeUseCase interface for testing.
+type TradeUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *TradeUseCaseMock) SyncExecutions(ctx context.Context) (*app.ExecutionSyncSummary, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*app.ExecutionSyncSummary), args.Error(1)
+}
+
+func (m *TradeUseCaseMock) ListTrades(ctx context.Context, query app.TradeQuery) ([]*model.Trade, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Trade), args.Error(1)
+}
+
+func (m *TradeUseCaseMock) GetDailyProfitLoss(ctx context.Context, query app.DailyProfitLossQuery) ([]*app.DailyProfitLoss, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*app.DailyProfitLoss), args.Error(1)
+}
+
+type reportTestMocks struct {
+	signalRepo   *SignalRepositoryMock
+	orderRepo    *OrderRepositoryMock
+	eventRepo    *TradingEventRepositoryMock
+	balanceRepo  *BalanceRepositoryMock
+	tradeUseCase *TradeUseCaseMock
+	positionUC   *PositionUseCaseMock
+}
+
+func newReportTestUseCase(dir string, session *client.Session) (app.ReportUseCase, *reportTestMocks) {
+	m := &reportTestMocks{
+		signalRepo:   new(SignalRepositoryMock),
+		orderRepo:    new(OrderRepositoryMock),
+		eventRepo:    new(TradingEventRepositoryMock),
+		balanceRepo:  new(BalanceRepositoryMock),
+		tradeUseCase: new(TradeUseCaseMock),
+		positionUC:   new(PositionUseCaseMock),
+	}
+	uc := app.NewReportUseCaseImpl(m.signalRepo, m.orderRepo, m.eventRepo, m.balanceRepo, m.tradeUseCase, m.positionUC, session, app.ReportConfig{Dir: dir, MarginAlertRate: 30})
+	return uc, m
+}
+
+func TestReportUseCaseImpl_GenerateDailyReport(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+	jst := time.FixedZone("JST", 9*60*60)
+	// 評価損益は当日のレポートにのみ含まれるため、当日のレポートを作成する
+	now := time.Now().In(jst)
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, jst)
+	end := start.AddDate(0, 0, 1)
+	today := start.Format(time.DateOnly)
+
+	t.Run("正常系: 1日の活動を集計し、JSON と Markdown を書き出す", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "reports")
+		uc, m := newReportTestUseCase(dir, session)
+
+		signalID := uint(1)
+		consumedAt := start.Add(9 * time.Hour)
+		m.signalRepo.On("FindConsumed", ctx, mock.MatchedBy(start.Equal), mock.MatchedBy(end.Equal)).Return([]*model.Signal{
+			{ID: 1, Symbol: "7203", SignalType: model.SignalTypeBuy, Source: model.SignalSourceFile, ConsumedAt: &consumedAt},
+			{ID: 2, Symbol: "6758", SignalType: model.SignalTypeBuy, Source: model.SignalSourceHTTP, ConsumedAt: &consumedAt},
+		}, nil).Once()
+		m.orderRepo.On("FindOrders", ctx, mock.MatchedBy(func(q repository.OrderQuery) bool {
+			return q.Since.Equal(start) && q.Until.Equal(end)
+		})).Return([]*model.Order{
+			{OrderID: "1001", Symbol: "7203", TradeType: model.TradeTypeBuy, OrderType: model.OrderTypeMarket, Quantity: 100, OrderStatus: model.OrderStatusFilled, SignalID: &signalID,
+				Executions: []model.Execution{{ExecutionQuantity: 100}}},
+		}, nil).Once()
+		m.orderRepo.On("FindExecutedOrders", ctx, mock.MatchedBy(start.Equal)).Return([]*model.Order{
+			{OrderID: "1001", Symbol: "7203", TradeType: model.TradeTypeBuy, Executions: []model.Execution{
+				{ExecutionTime: start.Add(9*time.Hour + time.Minute), ExecutionPrice: 3000, ExecutionQuantity: 100, Commission: 55},
+				{ExecutionTime: end.Add(time.Hour), ExecutionPrice: 3100, ExecutionQuantity: 100}, // 翌日の約定
+			}},
+		}, nil).Once()
+		m.eventRepo.On("FindEvents", ctx, mock.MatchedBy(func(q repository.TradingEventQuery) bool {
+			return q.Since.Equal(start) && q.Until.Equal(end)
+		})).Return([]*model.TradingEvent{
+			{OccurredAt: start.Add(9 * time.Hour), Type: model.TradingEventOrderRejected, Symbol: "6758", Message: "insufficient buying power"},
+			{OccurredAt: start.Add(10 * time.Hour), Type: model.TradingEventRiskBlocked, Symbol: "9984", Message: "fresh news within the news block window"},
+		}, nil).Once()
+		m.tradeUseCase.On("GetDailyProfitLoss", ctx, app.DailyProfitLossQuery{From: today, To: today}).Return([]*app.DailyProfitLoss{
+			{Date: today, Trades: 2, Wins: 1, Losses: 1, GrossProfitLoss: 5000, Commission: 200, NetProfitLoss: 4800},
+		}, nil).Once()
+		m.positionUC.On("ListPositions", ctx, session, "all").Return([]*app.Position{
+			{Symbol: "7203", UnrealizedPL: 10000},
+			{Symbol: "8306", UnrealizedPL: -3000},
+		}, nil).Once()
+		// 新しい順に返る。保証金率が 30% を下回ってから回復する
+		m.balanceRepo.On("FindSnapshots", ctx, mock.MatchedBy(func(q repository.BalanceSnapshotQuery) bool {
+			return q.Since.Equal(start) && q.Until.Equal(end)
+		})).Return([]*model.BalanceSnapshot{
+			{TakenAt: start.Add(15 * time.Hour), CashBuyingPower: 700000, DepositedMargin: 1050000, PositionValue: 1000000, MaintenanceRate: 35},
+			{TakenAt: start.Add(11 * time.Hour), CashBuyingPower: 600000, DepositedMargin: 1000000, PositionValue: 1000000, MaintenanceRate: 25},
+			{TakenAt: start.Add(9 * time.Hour), CashBuyingPower: 1000000, DepositedMargin: 1000000, PositionValue: 1000000, MaintenanceRate: 40},
+		}, nil).Once()
+
+		report, err := uc.GenerateDailyReport(ctx, today)
+		assert.NoError(t, err)
+
+		assert.Equal(t, app.DailyReportSummary{SignalsConsumed: 2, OrdersPlaced: 1, OrdersRejected: 1, Fills: 1, RiskEvents: 3}, report.Summary)
+		assert.Equal(t, []string{"1001"}, report.Signals[0].OrderIDs)
+		assert.Equal(t, []string{}, report.Signals[1].OrderIDs)
+		assert.Equal(t, 100, report.Orders[0].FilledQuantity)
+		assert.Equal(t, 55.0, report.Fills[0].Commission)
+		assert.Equal(t, "6758", report.Rejections[0].Symbol)
+		assert.Equal(t, app.ReportProfitLoss{Trades: 2, Wins: 1, Losses: 1, RealizedGross: 5000, Commission: 200, Realized: 4800, Unrealized: 7000, Positions: 2,
+			UnrealizedAsOf: &report.GeneratedAt}, report.ProfitLoss)
+		if assert.NotNil(t, report.Balance) {
+			assert.Equal(t, 3, report.Balance.Snapshots)
+			assert.Equal(t, -300000.0, report.Balance.CashBuyingPowerChange)
+			assert.Equal(t, 50000.0, report.Balance.DepositedMarginChange)
+			assert.Equal(t, 25.0, *report.Balance.MinMaintenanceRate)
+		}
+		if assert.Len(t, report.RiskEvents, 3) {
+			// 日時の順に並ぶ
+			assert.Equal(t, string(model.TradingEventRiskBlocked), report.RiskEvents[0].Type)
+			assert.Equal(t, app.ReportEventMarginAlert, report.RiskEvents[1].Type)
+			assert.Equal(t, app.ReportEventMarginRecovered, report.RiskEvents[2].Type)
+		}
+		assert.Empty(t, report.Warnings)
+
+		data, err := uc.GetDailyReport(ctx, today, app.ReportFormatJSON)
+		assert.NoError(t, err)
+		var stored app.DailyReport
+		assert.NoError(t, json.Unmarshal(data, &stored))
+		assert.Equal(t, report.Summary, stored.Summary)
+
+		markdown, err := uc.GetDailyReport(ctx, today, app.ReportFormatMarkdown)
+		assert.NoError(t, err)
+		assert.Contains(t, string(markdown), "# 日次レポート "+today)
+		assert.Contains(t, string(markdown), "insufficient buying power")
+
+		entries, err := os.ReadDir(dir)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2) // 一時ファイルは残らない
+	})
+
+	t.Run("正常系: 損益と残高を取得できない場合は警告としてレポートを作成する", func(t *testing.T) {
+		uc, m := newReportTestUseCase(t.TempDir(), session)
+
+		m.signalRepo.On("FindConsumed", ctx, mock.Anything, mock.Anything).Return([]*model.Signal{}, nil).Once()
+		m.orderRepo.On("FindOrders", ctx, mock.Anything).Return([]*model.Order{}, nil).Once()
+		m.orderRepo.On("FindExecutedOrders", ctx, mock.Anything).Return([]*model.Order{}, nil).Once()
+		m.eventRepo.On("FindEvents", ctx, mock.Anything).Return([]*model.TradingEvent{}, nil).Once()
+		m.tradeUseCase.On("GetDailyProfitLoss", ctx, mock.Anything).Return([]*app.DailyProfitLoss{}, nil).Once()
+		m.positionUC.On("ListPositions", ctx, session, "all").Return(nil, errors.New("api error")).Once()
+		m.balanceRepo.On("FindSnapshots", ctx, mock.Anything).Return([]*model.BalanceSnapshot{}, nil).Once()
+
+		report, err := uc.GenerateDailyReport(ctx, today)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"unrealized P&L: api error"}, report.Warnings)
+		assert.Nil(t, report.Balance)
+	})
+
+	t.Run("正常系: 過去の日のレポートには現在の建玉の評価損益を含めない", func(t *testing.T) {
+		uc, m := newReportTestUseCase(t.TempDir(), session)
+
+		m.signalRepo.On("FindConsumed", ctx, mock.Anything, mock.Anything).Return([]*model.Signal{}, nil).Once()
+		m.orderRepo.On("FindOrders", ctx, mock.Anything).Return([]*model.Order{}, nil).Once()
+		m.orderRepo.On("FindExecutedOrders", ctx, mock.Anything).Return([]*model.Order{}, nil).Once()
+		m.eventRepo.On("FindEvents", ctx, mock.Anything).Return([]*model.TradingEvent{}, nil).Once()
+		m.tradeUseCase.On("GetDailyProfitLoss", ctx, app.DailyProfitLossQuery{From: "2025-12-01", To: "2025-12-01"}).Return([]*app.DailyProfitLoss{
+			{Date: "2025-12-01", Trades: 1, Wins: 1, GrossProfitLoss: 1000, NetProfitLoss: 900},
+		}, nil).Once()
+		m.balanceRepo.On("FindSnapshots", ctx, mock.Anything).Return([]*model.BalanceSnapshot{}, nil).Once()
+
+		report, err := uc.GenerateDailyReport(ctx, "2025-12-01")
+		assert.NoError(t, err)
+		assert.Equal(t, 900.0, report.ProfitLoss.Realized)
+		assert.Nil(t, report.ProfitLoss.UnrealizedAsOf)
+		assert.Equal(t, []string{"unrealized P&L: only available in the report of the current day"}, report.Warnings)
+		m.positionUC.AssertNotCalled(t, "ListPositions", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 不正な日付はエラーを返す", func(t *testing.T) {
+		uc, _ := newReportTestUseCase(t.TempDir(), session)
+
+		report, err := uc.GenerateDailyReport(ctx, "20251201")
+		assert.Error(t, err)
+		assert.Nil(t, report)
+	})
+}
+
+func TestReportUseCaseImpl_GetDailyReport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("異常系: 作成されていないレポートは ErrNotFound を返す", func(t *testing.T) {
+		uc, _ := newReportTestUseCase(t.TempDir(), &client.Session{})
+
+		data, err := uc.GetDailyReport(ctx, "2025-12-01", app.ReportFormatJSON)
+		assert.ErrorIs(t, err, app.ErrNotFound)
+		assert.Nil(t, data)
+	})
+
+	t.Run("異常系: 日付でないパスは読み込まない", func(t *testing.T) {
+		uc, _ := newReportTestUseCase(t.TempDir(), &client.Session{})
+
+		data, err := uc.GetDailyReport(ctx, "../secret", app.ReportFormatJSON)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, app.ErrNotFound)
+		assert.Nil(t, data)
+	})
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"stock-bot/internal/app"
+	"stock-bot/internal/infrastructure/client"
+	"stock-bot/internal/infrastructure/client/dto/balance/request"
+	"stock-bot/internal/infrastructure/client/dto/balance/response"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func sellable(quantity string) *response.ResZanUriKanousuu {
+	return &response.ResZanUriKanousuu{SResultCode: "0", SZanKabuSuryouUriKanouTokutei: quantity, SZanKabuSuryouUriKanouIppan: "0"}
+}
+
+func TestSellQuantityReserver_Reserve(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	t.Run("正常系: 売却可能数量までに抑え、売買単位未満を切り捨てる", func(t *testing.T) {
+		balanceClientMock := new(BalanceClientMock)
+		balanceClientMock.On("GetZanUriKanousuu", ctx, session, request.ReqZanUriKanousuu{IssueCode: "7203"}).Return(sellable("250"), nil)
+		reserver := app.NewSellQuantityReserver(balanceClientMock, time.Minute)
+
+		quantity, done, err := reserver.Reserve(ctx, session, "7203", 300, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, 200, quantity)
+		done(false)
+	})
+
+	t.Run("正常系: 発注中の予約は差し引かれ、発注に失敗した予約はすぐに解放される", func(t *testing.T) {
+		balanceClientMock := new(BalanceClientMock)
+		balanceClientMock.On("GetZanUriKanousuu", ctx, session, mock.Anything).Return(sellable("100"), nil)
+		reserver := app.NewSellQuantityReserver(balanceClientMock, time.Minute)
+
+		first, done, err := reserver.Reserve(ctx, session, "7203", 100, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 100, first)
+
+		// 同時に発注される売り注文は売り越さない
+		second, _, err := reserver.Reserve(ctx, session, "7203", 100, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, second)
+
+		// 他の銘柄には影響しない
+		other, otherDone, err := reserver.Reserve(ctx, session, "6758", 100, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 100, other)
+		otherDone(false)
+
+		done(false)
+		third, thirdDone, err := reserver.Reserve(ctx, session, "7203", 100, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 100, third)
+		thirdDone(false)
+	})
+
+	t.Run("正常系: 発注できた予約は保持期間の間は差し引かれる", func(t *testing.T) {
+		balanceClientMock := new(BalanceClientMock)
+		balanceClientMock.On("GetZanUriKanousuu", ctx, session, mock.Anything).Return(sellable("100"), nil)
+		reserver := app.NewSellQuantityReserver(balanceClientMock, 50*time.Millisecond)
+
+		_, done, err := reserver.Reserve(ctx, session, "7203", 60, 0)
+		assert.NoError(t, err)
+		done(true)
+
+		quantity, done, err := reserver.Reserve(ctx, session, "7203", 100, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 40, quantity)
+		done(false)
+
+		time.Sleep(60 * time.Millisecond)
+		quantity, done, err = reserver.Reserve(ctx, session, "7203", 100, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 100, quantity)
+		done(false)
+	})
+
+	t.Run("異常系: 売却可能数量を取得できない場合はエラーを返す", func(t *testing.T) {
+		balanceClientMock := new(BalanceClientMock)
+		balanceClientMock.On("GetZanUriKanousuu", ctx, session, mock.Anything).Return(nil, errors.New("api error")).Once()
+		reserver := app.NewSellQuantityReserver(balanceClientMock, time.Minute)
+
+		_, _, err := reserver.Reserve(ctx, session, "7203", 100, 0)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,230 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"stock-bot/domain/model"
+	"stock-bot/internal/app"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// SignalRepositoryMock implements the repository.SignalRepository interface for testing.
+type SignalRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *SignalRepositoryMock) Save(ctx context.Context, signal *model.Signal) error {
+	args := m.Called(ctx, signal)
+	return args.Error(0)
+}
+func (m *SignalRepositoryMock) SaveBatch(ctx context.Context, signals []*model.Signal, file *model.SignalFile) error {
+	args := m.Called(ctx, signals, file)
+	return args.Error(0)
+}
+func (m *SignalRepositoryMock) FindByID(ctx context.Context, id uint) (*model.Signal, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Signal), args.Error(1)
+}
+func (m *SignalRepositoryMock) FindBySymbol(ctx context.Context, symbol string) ([]*model.Signal, error) {
+	args := m.Called(ctx, symbol)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Signal), args.Error(1)
+}
+func (m *SignalRepositoryMock) FindByFileHash(ctx context.Context, fileHash string) ([]*model.Signal, error) {
+	args := m.Called(ctx, fileHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Signal), args.Error(1)
+}
+func (m *SignalRepositoryMock) IsFileProcessed(ctx context.Context, fileHash string) (bool, error) {
+	args := m.Called(ctx, fileHash)
+	return args.Bool(0), args.Error(1)
+}
+func (m *SignalRepositoryMock) ExistsByRecordKey(ctx context.Context, recordKey string) (bool, error) {
+	args := m.Called(ctx, recordKey)
+	return args.Bool(0), args.Error(1)
+}
+func (m *SignalRepositoryMock) FindRecent(ctx context.Context, symbol string, limit int) ([]*model.Signal, error) {
+	args := m.Called(ctx, symbol, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Signal), args.Error(1)
+}
+func (m *SignalRepositoryMock) FindUnconsumed(ctx context.Context) ([]*model.Signal, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Signal), args.Error(1)
+}
+func (m *SignalRepositoryMock) MarkConsumed(ctx context.Context, id uint, consumedAt time.Time) error {
+	args := m.Called(ctx, id, consumedAt)
+	return args.Error(0)
+}
+
+func (m *SignalRepositoryMock) FindConsumed(ctx context.Context, since, until time.Time) ([]*model.Signal, error) {
+	args := m.Called(ctx, since, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Signal), args.Error(1)
+}
+
+// SignalNotifierMock records how many times the agent was notified.
+type SignalNotifierMock struct {
+	notified int
+}
+
+func (n *SignalNotifierMock) NotifySignals() {
+	n.notified++
+}
+
+func TestIngestSignals_AcceptsValidAndRejectsInvalid(t *testing.T) {
+	ctx := context.Background()
+	signalRepoMock := new(SignalRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	notifier := &SignalNotifierMock{}
+
+	masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IssueCode: "7203"}, nil)
+	masterRepoMock.On("FindByIssueCode", ctx, "9999", "StockMaster").Return(nil, nil)
+	signalRepoMock.On("ExistsByRecordKey", ctx, mock.AnythingOfType("string")).Return(false, nil)
+	signalRepoMock.On("SaveBatch", ctx, mock.AnythingOfType("[]*model.Signal"), (*model.SignalFile)(nil)).Run(func(args mock.Arguments) {
+		args.Get(1).([]*model.Signal)[0].ID = 42
+	}).Return(nil).Once()
+
+	expired := time.Now().Add(-time.Minute)
+	inputs := []app.SignalInput{
+		{Symbol: "7203", SignalType: model.SignalTypeBuy, LimitPrice: 2500, Weight: 0.5},
+		{Symbol: "9999", SignalType: model.SignalTypeBuy},
+		{Symbol: "7203", SignalType: model.SignalTypeBuy},
+		{Symbol: "7203", SignalType: model.SignalTypeSell, ValidUntil: &expired},
+		{Symbol: "7203", SignalType: model.SignalTypeSell, Weight: 50},
+	}
+
+	uc := app.NewSignalUseCaseImpl(signalRepoMock, masterRepoMock, notifier)
+
+	// --- Execute ---
+	result, err := uc.IngestSignals(ctx, time.Time{}, inputs)
+
+	// --- Assert ---
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{42}, result.SignalIDs)
+	assert.Len(t, result.Rejected, 4)
+	assert.Equal(t, app.SignalRejection{Index: 1, Symbol: "9999", Reason: "unknown symbol"}, result.Rejected[0])
+	assert.Equal(t, 2, result.Rejected[1].Index)
+	assert.Equal(t, "duplicate signal in request", result.Rejected[1].Reason)
+	assert.Equal(t, 3, result.Rejected[2].Index)
+	assert.Equal(t, "signal already expired", result.Rejected[2].Reason)
+	assert.Equal(t, app.SignalRejection{Index: 4, Symbol: "7203", Reason: "weight 50 is outside 0 to 1"}, result.Rejected[3])
+	assert.Equal(t, 1, notifier.notified)
+
+	batch := signalRepoMock.Calls[len(signalRepoMock.Calls)-1].Arguments.Get(1).([]*model.Signal)
+	assert.Len(t, batch, 1)
+	saved := batch[0]
+	assert.Equal(t, model.SignalSourceHTTP, saved.Source)
+	assert.Nil(t, saved.ConsumedAt)
+	assert.Equal(t, 2500.0, saved.Price)
+	assert.Contains(t, saved.RecordKey, ":7203:BUY")
+
+	signalRepoMock.AssertExpectations(t)
+	masterRepoMock.AssertExpectations(t)
+}
+
+func TestIngestSignals_RejectsSignalAlreadyReceivedToday(t *testing.T) {
+	ctx := context.Background()
+	signalRepoMock := new(SignalRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	notifier := &SignalNotifierMock{}
+
+	masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(&model.StockMaster{IssueCode: "7203"}, nil)
+	signalRepoMock.On("ExistsByRecordKey", ctx, mock.AnythingOfType("string")).Return(true, nil)
+
+	uc := app.NewSignalUseCaseImpl(signalRepoMock, masterRepoMock, notifier)
+
+	// --- Execute ---
+	result, err := uc.IngestSignals(ctx, time.Time{}, []app.SignalInput{{Symbol: "7203", SignalType: model.SignalTypeBuy}})
+
+	// --- Assert ---
+	assert.NoError(t, err)
+	assert.Empty(t, result.SignalIDs)
+	assert.Len(t, result.Rejected, 1)
+	assert.Equal(t, 0, notifier.notified)
+	signalRepoMock.AssertNotCalled(t, "SaveBatch", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestIngestSignals_RepoError(t *testing.T) {
+	ctx := context.Background()
+	signalRepoMock := new(SignalRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	expectedErr := errors.New("repository error")
+
+	masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(nil, expectedErr)
+
+	uc := app.NewSignalUseCaseImpl(signalRepoMock, masterRepoMock, nil)
+
+	// --- Execute ---
+	result, err := uc.IngestSignals(ctx, time.Time{}, []app.SignalInput{{Symbol: "7203", SignalType: model.SignalTypeBuy}})
+
+	// --- Assert ---
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), expectedErr.Error())
+}
+
+func TestIngestSignals_SaveErrorStoresNoSignal(t *testing.T) {
+	ctx := context.Background()
+	signalRepoMock := new(SignalRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	notifier := &SignalNotifierMock{}
+
+	masterRepoMock.On("FindByIssueCode", ctx, mock.AnythingOfType("string"), "StockMaster").Return(&model.StockMaster{}, nil)
+	signalRepoMock.On("ExistsByRecordKey", ctx, mock.AnythingOfType("string")).Return(false, nil)
+	// 一括保存に失敗した場合は、どのシグナルも保存されない
+	signalRepoMock.On("SaveBatch", ctx, mock.MatchedBy(func(signals []*model.Signal) bool { return len(signals) == 2 }), (*model.SignalFile)(nil)).
+		Return(errors.New("db down")).Once()
+
+	uc := app.NewSignalUseCaseImpl(signalRepoMock, masterRepoMock, notifier)
+
+	// --- Execute ---
+	result, err := uc.IngestSignals(ctx, time.Time{}, []app.SignalInput{
+		{Symbol: "7203", SignalType: model.SignalTypeBuy},
+		{Symbol: "6758", SignalType: model.SignalTypeBuy},
+	})
+
+	// --- Assert ---
+	assert.ErrorContains(t, err, "db down")
+	assert.Nil(t, result)
+	assert.Equal(t, 0, notifier.notified)
+	signalRepoMock.AssertExpectations(t)
+	signalRepoMock.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestListSignals(t *testing.T) {
+	ctx := context.Background()
+	signalRepoMock := new(SignalRepositoryMock)
+	masterRepoMock := new(MasterRepositoryMock)
+	stored := []*model.Signal{{ID: 2, Symbol: "7203"}, {ID: 1, Symbol: "7203"}}
+
+	signalRepoMock.On("FindRecent", ctx, "7203", 100).Return(stored, nil).Once()
+
+	uc := app.NewSignalUseCaseImpl(signalRepoMock, masterRepoMock, nil)
+
+	// --- Execute ---
+	signals, err := uc.ListSignals(ctx, "7203", 100)
+
+	// --- Assert ---
+	assert.NoError(t, err)
+	assert.Equal(t, stored, signals)
+	signalRepoMock.AssertExpectations(t)
+}
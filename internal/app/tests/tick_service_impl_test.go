@@ -0,0 +1,212 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"stock-bot/domain/model"
+	"stock-bot/internal/app"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// topix500TickRule は東証のTOPIX500構成銘柄の呼値テーブル (2014年7月以降)
+func topix500TickRule() *model.TickRule {
+	return &model.TickRule{
+		TickUnitNumber: "102",
+		ApplicableDate: "20140722",
+		TickLevels: []model.TickLevel{
+			{LowerPrice: 0, UpperPrice: 1000, TickValue: 0.1},
+			{LowerPrice: 1000, UpperPrice: 3000, TickValue: 0.5},
+			{LowerPrice: 3000, UpperPrice: 10000, TickValue: 1},
+			{LowerPrice: 10000, UpperPrice: 30000, TickValue: 5},
+			{LowerPrice: 30000, UpperPrice: 100000, TickValue: 10},
+			{LowerPrice: 100000, UpperPrice: 300000, TickValue: 50},
+			{LowerPrice: 300000, UpperPrice: 1000000, TickValue: 100},
+			{LowerPrice: 1000000, UpperPrice: 3000000, TickValue: 500},
+			{LowerPrice: 3000000, UpperPrice: 10000000, TickValue: 1000},
+			{LowerPrice: 10000000, UpperPrice: 30000000, TickValue: 5000},
+			{LowerPrice: 30000000, UpperPrice: 999999999, TickValue: 10000},
+		},
+	}
+}
+
+// standardTickRule は東証の通常銘柄 (TOPIX500構成銘柄以外) の呼値テーブル
+func standardTickRule() *model.TickRule {
+	rule := model.StandardTSETickRule()
+	rule.TickUnitNumber = "101"
+	rule.ApplicableDate = "20140722"
+	return rule
+}
+
+func TestTickRule_RoundStandardTable(t *testing.T) {
+	rule := standardTickRule()
+	testCases := []struct {
+		price    float64
+		rounding model.TickRounding
+		expected float64
+	}{
+		{1234.4, model.TickRoundDown, 1234},
+		{1234.4, model.TickRoundUp, 1235},
+		{1234.5, model.TickRoundNearest, 1234},
+		{1234.6, model.TickRoundNearest, 1235},
+		{3000, model.TickRoundUp, 3000},
+		{3001, model.TickRoundDown, 3000},
+		{3001, model.TickRoundUp, 3005},
+		{4998, model.TickRoundNearest, 5000},
+		{29995, model.TickRoundDown, 29990},
+		{30001, model.TickRoundUp, 30050},
+		{49999, model.TickRoundUp, 50000},
+		{50001, model.TickRoundUp, 50100},
+		{300250, model.TickRoundNearest, 300000},
+		{4999999, model.TickRoundUp, 5000000},
+	}
+	for _, tc := range testCases {
+		rounded, err := rule.Round(tc.price, tc.rounding)
+		if assert.NoError(t, err) {
+			assert.Equal(t, tc.expected, rounded, "price=%v rounding=%s", tc.price, tc.rounding)
+			assert.True(t, rule.IsValidPrice(rounded), "rounded price %v must be a valid tick", rounded)
+		}
+	}
+}
+
+func TestTickRule_RoundTopix500Table(t *testing.T) {
+	rule := topix500TickRule()
+	testCases := []struct {
+		price    float64
+		rounding model.TickRounding
+		expected float64
+	}{
+		{999.93, model.TickRoundDown, 999.9},
+		{999.93, model.TickRoundUp, 1000},
+		{1000.3, model.TickRoundDown, 1000},
+		{1000.3, model.TickRoundUp, 1000.5},
+		{2999.7, model.TickRoundNearest, 2999.5},
+		{2999.8, model.TickRoundNearest, 3000},
+		{3000.4, model.TickRoundUp, 3001},
+		{10002, model.TickRoundDown, 10000},
+		{10002, model.TickRoundUp, 10005},
+		{100020, model.TickRoundNearest, 100000},
+		{0.25, model.TickRoundNearest, 0.2},
+	}
+	for _, tc := range testCases {
+		rounded, err := rule.Round(tc.price, tc.rounding)
+		if assert.NoError(t, err) {
+			assert.Equal(t, tc.expected, rounded, "price=%v rounding=%s", tc.price, tc.rounding)
+			assert.True(t, rule.IsValidPrice(rounded), "rounded price %v must be a valid tick", rounded)
+		}
+	}
+
+	assert.Equal(t, "999.9", model.FormatTickPrice(999.9000000000001))
+	assert.Equal(t, "3001", model.FormatTickPrice(3001))
+}
+
+func TestTickRule_RoundRejectsInvalidPrice(t *testing.T) {
+	rule := topix500TickRule()
+	_, err := rule.Round(0, model.TickRoundUp)
+	assert.Error(t, err)
+	_, err = rule.Round(0.05, model.TickRoundDown)
+	assert.Error(t, err)
+}
+
+func TestTickRule_Step(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rule      *model.TickRule
+		reference float64
+		ticks     int
+		expected  float64
+	}{
+		{"通常: 段階の境界を上に越える", standardTickRule(), 2999, 2, 3005},
+		{"通常: 段階の境界を下に越える", standardTickRule(), 3005, -2, 2999},
+		{"通常: 呼値に合わない基準価格から上", standardTickRule(), 3002, 1, 3005},
+		{"通常: 呼値に合わない基準価格から下", standardTickRule(), 3002, -1, 3000},
+		{"TOPIX500: 1000円の境界", topix500TickRule(), 999.9, 2, 1000.5},
+		{"TOPIX500: 3000円から下", topix500TickRule(), 3000, -1, 2999.5},
+		{"TOPIX500: 3000円から上", topix500TickRule(), 3000, 1, 3001},
+		{"0ティックは最も近い呼値", topix500TickRule(), 3000.6, 0, 3001},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := tc.rule.Step(tc.reference, tc.ticks)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.expected, price)
+			}
+		})
+	}
+
+	_, err := topix500TickRule().Step(0.2, -3)
+	assert.Error(t, err)
+}
+
+func TestTickService_ResolveTickRule(t *testing.T) {
+	ctx := context.Background()
+	jst := time.FixedZone("JST", 9*60*60)
+	updatedAt := time.Date(2025, 12, 19, 7, 0, 0, 0, jst)
+	stock := &model.StockMaster{
+		MasterBase:         model.MasterBase{UpdatedAt: updatedAt},
+		IssueCode:          "7203",
+		TickUnitNumber:     "101",
+		NextTickUnitNumber: "102",
+	}
+
+	t.Run("当日は当日の呼値の単位番号を使う", func(t *testing.T) {
+		masterRepoMock := new(MasterRepositoryMock)
+		masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(stock, nil)
+		masterRepoMock.On("FindTickRule", ctx, "101").Return(standardTickRule(), nil).Once()
+
+		rule, err := app.NewTickServiceImpl(masterRepoMock).ResolveTickRule(ctx, "7203", updatedAt.Add(2*time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, "101", rule.TickUnitNumber)
+		masterRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("マスタ更新日より後の日付は翌営業日の呼値の単位番号を使う", func(t *testing.T) {
+		masterRepoMock := new(MasterRepositoryMock)
+		masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(stock, nil)
+		masterRepoMock.On("FindTickRule", ctx, "102").Return(topix500TickRule(), nil).Once()
+
+		svc := app.NewTickServiceImpl(masterRepoMock)
+		rule, err := svc.ResolveTickRule(ctx, "7203", updatedAt.AddDate(0, 0, 3))
+		assert.NoError(t, err)
+		assert.Equal(t, "102", rule.TickUnitNumber)
+		masterRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("マスタがない銘柄は通常銘柄の呼値テーブルを使う", func(t *testing.T) {
+		masterRepoMock := new(MasterRepositoryMock)
+		masterRepoMock.On("FindByIssueCode", ctx, "9999", "StockMaster").Return(nil, nil)
+
+		svc := app.NewTickServiceImpl(masterRepoMock)
+		price, err := svc.RoundPrice(ctx, "9999", updatedAt, 3001, model.TickRoundUp)
+		assert.NoError(t, err)
+		assert.Equal(t, 3005.0, price)
+		masterRepoMock.AssertNotCalled(t, "FindTickRule", ctx, "9999")
+	})
+
+	t.Run("適用日前の呼値テーブルは使わない", func(t *testing.T) {
+		future := topix500TickRule()
+		future.ApplicableDate = "20260101"
+		masterRepoMock := new(MasterRepositoryMock)
+		masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(stock, nil)
+		masterRepoMock.On("FindTickRule", ctx, "101").Return(future, nil).Twice()
+
+		svc := app.NewTickServiceImpl(masterRepoMock)
+		price, err := svc.StepPrice(ctx, "7203", updatedAt, 2999, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 3000.0, price)
+		price, err = svc.StepPrice(ctx, "7203", updatedAt, 2999.9, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 3000.0, price)
+	})
+
+	t.Run("リポジトリのエラーを返す", func(t *testing.T) {
+		masterRepoMock := new(MasterRepositoryMock)
+		expectedErr := errors.New("repository error")
+		masterRepoMock.On("FindByIssueCode", ctx, "7203", "StockMaster").Return(nil, expectedErr)
+
+		_, err := app.NewTickServiceImpl(masterRepoMock).ResolveTickRule(ctx, "7203", updatedAt)
+		assert.ErrorIs(t, err, expectedErr)
+	})
+}
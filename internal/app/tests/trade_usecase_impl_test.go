@@ -0,0 +1,611 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"stock-bot/internal/app"
+	"stock-bot/internal/infrastructure/client"
+	"stock-bot/internal/infrastructure/client/dto/order/request"
+	"stock-bot/internal/infrastructure/client/dto/order/response"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// PositionRepositoryMock implements the repository.PositionRepository interface for testing.
+type PositionRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *PositionRepositoryMock) Save(ctx context.Context, position *model.Position) error {
+	args := m.Called(ctx, position)
+	return args.Error(0)
+}
+
+func (m *PositionRepositoryMock) FindBySymbol(ctx context.Context, symbol string) (*model.Position, error) {
+	args := m.Called(ctx, symbol)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Position), args.Error(1)
+}
+
+func (m *PositionRepositoryMock) FindAll(ctx context.Context) ([]*model.Position, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Position), args.Error(1)
+}
+
+func (m *PositionRepositoryMock) ReplaceAll(ctx context.Context, positions []*model.Position) error {
+	args := m.Called(ctx, positions)
+	return args.Error(0)
+}
+
+func (m *PositionRepositoryMock) FindLots(ctx context.Context, query repository.PositionLotQuery) ([]*model.PositionLot, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.PositionLot), args.Error(1)
+}
+
+func TestTradeUseCaseImpl_SyncExecutions(t *testing.T) {
+	ctx := context.Background()
+	session := &client.Session{}
+
+	t.Run("正常系: 約定のある注文の約定を保存し、ボット以外の注文も保存する", func(t *testing.T) {
+		clientMock := new(OrderClientMock)
+		repoMock := new(OrderRepositoryMock)
+		positionRepoMock := new(PositionRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(clientMock, repoMock, positionRepoMock, session)
+
+		clientMock.On("GetOrderList", ctx, session, request.ReqOrderList{}).Return(&response.ResOrderList{
+			ResultCode: "0",
+			OrderList: []response.ResOrder{
+				{OrderOrderNumber: "1001", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "200"},
+				{OrderOrderNumber: "1002", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "0"}, // 未約定
+				{OrderOrderNumber: "1003", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "100"},
+			},
+		}, nil).Once()
+		clientMock.On("GetOrderListDetail", ctx, session, request.ReqOrderListDetail{OrderNumber: "1001", EigyouDay: "20251201"}).Return(&response.ResOrderListDetail{
+			ResultCode:    "0",
+			OrderNumber:   "1001",
+			EigyouDay:     "20251201",
+			BaiBaiTesuryo: "200",
+			Shouhizei:     "20",
+			YakuzyouSikkouList: []response.ResYakuzyouSikkou{
+				{YakuzyouSuryou: "150", YakuzyouPrice: "1,000", YakuzyouDate: "20251201093000"},
+				{YakuzyouSuryou: "0", YakuzyouPrice: "0", YakuzyouDate: "20251201150000"}, // 失効
+				{YakuzyouSuryou: "50", YakuzyouPrice: "1001", YakuzyouDate: "20251201093100"},
+			},
+		}, nil).Once()
+		clientMock.On("GetOrderListDetail", ctx, session, request.ReqOrderListDetail{OrderNumber: "1003", EigyouDay: "20251201"}).Return(&response.ResOrderListDetail{
+			ResultCode:            "0",
+			OrderNumber:           "1003",
+			EigyouDay:             "20251201",
+			IssueCode:             "7203",
+			OrderBaibaiKubun:      "3",
+			GenkinSinyouKubun:     "2",
+			SinyouZyoutoekiKazeiC: "3",
+			OrderOrderSuryou:      "100",
+			YakuzyouSuryou:        "100",
+			YakuzyouSikkouList: []response.ResYakuzyouSikkou{
+				{YakuzyouSuryou: "100", YakuzyouPrice: "3000", YakuzyouDate: "20251201100000"},
+			},
+		}, nil).Once()
+
+		existing := &model.Order{OrderID: "1001", Quantity: 200, OrderStatus: model.OrderStatusNew}
+		repoMock.On("FindByID", ctx, "1001").Return(existing, nil).Once()
+		repoMock.On("FindByID", ctx, "1003").Return(nil, nil).Once()
+		var imported *model.Order
+		repoMock.On("Save", ctx, mock.AnythingOfType("*model.Order")).Run(func(args mock.Arguments) {
+			imported = args.Get(1).(*model.Order)
+		}).Return(nil).Once()
+		saved := make(map[string][]*model.Execution)
+		repoMock.On("SaveExecution", ctx, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			order, execution := args.Get(1).(*model.Order), args.Get(2).(*model.Execution)
+			order.Executions = append(order.Executions, *execution)
+			saved[order.OrderID] = append(saved[order.OrderID], execution)
+		}).Return(nil).Times(3)
+		// 注文は約定の数量から状態を更新する (ボット以外の注文も新規の状態で保存してから更新する)
+		var updated []string
+		repoMock.On("UpdateStatus", ctx, mock.Anything, model.OrderStatusFilled).Run(func(args mock.Arguments) {
+			order := args.Get(1).(*model.Order)
+			assert.Equal(t, model.OrderStatusNew, order.OrderStatus)
+			order.OrderStatus = model.OrderStatusFilled
+			updated = append(updated, order.OrderID)
+		}).Return(nil).Twice()
+		repoMock.On("FindByStatus", ctx, mock.Anything).Return([]*model.Order{}, nil)
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return(tradeTestOrders(), nil).Once()
+		positionRepoMock.On("ReplaceAll", ctx, mock.Anything).Return(nil).Once()
+
+		summary, err := uc.SyncExecutions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &app.ExecutionSyncSummary{Orders: 2, Imported: 1, Executions: 3, Lots: 1}, summary)
+
+		if assert.Len(t, saved["1001"], 2) {
+			jst := time.FixedZone("JST", 9*60*60)
+			assert.Equal(t, "20251201-1001-1", saved["1001"][0].ExecutionID)
+			assert.Equal(t, "1001", saved["1001"][0].OrderID)
+			assert.True(t, saved["1001"][0].ExecutionTime.Equal(time.Date(2025, 12, 1, 9, 30, 0, 0, jst)))
+			assert.Equal(t, 1000.0, saved["1001"][0].ExecutionPrice)
+			assert.Equal(t, 165.0, saved["1001"][0].Commission) // (200 + 20) * 150 / 200
+			assert.Equal(t, "20251201-1001-3", saved["1001"][1].ExecutionID)
+			assert.Equal(t, 55.0, saved["1001"][1].Commission)
+		}
+		assert.Len(t, saved["1003"], 1)
+		assert.Equal(t, []string{"1001", "1003"}, updated)
+		if assert.NotNil(t, imported) {
+			assert.Equal(t, "1003", imported.OrderID)
+			assert.Equal(t, "7203", imported.Symbol)
+			assert.Equal(t, model.TradeTypeBuy, imported.TradeType)
+			assert.True(t, imported.IsMargin)
+			assert.Equal(t, model.AccountTypeGeneral, imported.AccountType)
+			assert.Equal(t, model.OrderStatusFilled, imported.OrderStatus)
+		}
+		clientMock.AssertExpectations(t)
+		repoMock.AssertExpectations(t)
+		positionRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 約定から口座区分ごとにロットを作成し、ポジション台帳を置き換える", func(t *testing.T) {
+		clientMock := new(OrderClientMock)
+		repoMock := new(OrderRepositoryMock)
+		positionRepoMock := new(PositionRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(clientMock, repoMock, positionRepoMock, session)
+
+		jst := time.FixedZone("JST", 9*60*60)
+		orders := append(tradeTestOrders(),
+			&model.Order{OrderID: "6", Symbol: "9984", TradeType: model.TradeTypeBuy, AccountType: model.AccountTypeNISA, Executions: []model.Execution{
+				{ExecutionID: "6", ExecutionTime: time.Date(2025, 12, 4, 9, 0, 0, 0, jst), ExecutionPrice: 1100, ExecutionQuantity: 100},
+			}},
+			&model.Order{OrderID: "7", Symbol: "8306", TradeType: model.TradeTypeSell, IsMargin: true, Executions: []model.Execution{
+				{ExecutionID: "7", ExecutionTime: time.Date(2025, 12, 4, 10, 0, 0, 0, jst), ExecutionPrice: 1500, ExecutionQuantity: 300, Commission: 90},
+			}},
+			&model.Order{OrderID: "8", Symbol: "8306", TradeType: model.TradeTypeBuy, IsMargin: true, Executions: []model.Execution{
+				{ExecutionID: "8", ExecutionTime: time.Date(2025, 12, 5, 10, 0, 0, 0, jst), ExecutionPrice: 1400, ExecutionQuantity: 100},
+			}},
+		)
+		clientMock.On("GetOrderList", ctx, session, request.ReqOrderList{}).Return(&response.ResOrderList{ResultCode: "0"}, nil).Once()
+		repoMock.On("FindByStatus", ctx, mock.Anything).Return([]*model.Order{}, nil)
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return(orders, nil).Once()
+		var positions []*model.Position
+		positionRepoMock.On("ReplaceAll", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			positions = args.Get(1).([]*model.Position)
+		}).Return(nil).Once()
+
+		summary, err := uc.SyncExecutions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &app.ExecutionSyncSummary{Lots: 3}, summary)
+
+		if assert.Len(t, positions, 3) {
+			// 信用の売建 300株のうち 100株を買い戻した残り
+			short := positions[0]
+			assert.Equal(t, "8306", short.Symbol)
+			assert.True(t, short.IsMargin)
+			assert.Equal(t, model.PositionTypeShort, short.PositionType)
+			assert.Equal(t, 200, short.Quantity)
+			if assert.Len(t, short.Lots, 1) {
+				assert.Equal(t, 300, short.Lots[0].OpenQuantity)
+				assert.Equal(t, 60.0, short.Lots[0].Commission) // 90 * 200 / 300
+				assert.Equal(t, 300060.0, short.Lots[0].Cost())
+			}
+
+			// NISA口座の買付は特定口座の保有と相殺しない
+			nisa := positions[1]
+			assert.Equal(t, model.AccountTypeNISA, nisa.AccountType)
+			assert.Equal(t, 100, nisa.Quantity)
+			assert.Equal(t, "6", nisa.Lots[0].ExecutionID)
+
+			// 特定口座では先入先出で 12/1 9:00 の 100株を売却し、10:00 の 100株が残る
+			specific := positions[2]
+			assert.Equal(t, "9984", specific.Symbol)
+			assert.Equal(t, model.AccountTypeSpecific, specific.AccountType)
+			assert.Equal(t, 1200.0, specific.AveragePrice)
+			if assert.Len(t, specific.Lots, 1) {
+				assert.Equal(t, "2", specific.Lots[0].OrderID)
+				assert.True(t, specific.Lots[0].OpenedAt.Equal(time.Date(2025, 12, 1, 10, 0, 0, 0, jst)))
+			}
+		}
+		positionRepoMock.AssertExpectations(t)
+	})
+
+	t.Run("異常系: ポジション台帳を保存できない場合はエラーを返す", func(t *testing.T) {
+		clientMock := new(OrderClientMock)
+		repoMock := new(OrderRepositoryMock)
+		positionRepoMock := new(PositionRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(clientMock, repoMock, positionRepoMock, session)
+
+		clientMock.On("GetOrderList", ctx, session, request.ReqOrderList{}).Return(&response.ResOrderList{ResultCode: "0"}, nil).Once()
+		repoMock.On("FindByStatus", ctx, mock.Anything).Return([]*model.Order{}, nil)
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return(tradeTestOrders(), nil).Once()
+		positionRepoMock.On("ReplaceAll", ctx, mock.Anything).Return(errors.New("db error")).Once()
+
+		summary, err := uc.SyncExecutions(ctx)
+		assert.Error(t, err)
+		assert.Nil(t, summary)
+	})
+
+	t.Run("正常系: 状態の更新が他の更新と競合した場合は次回の同期に回す", func(t *testing.T) {
+		clientMock := new(OrderClientMock)
+		repoMock := new(OrderRepositoryMock)
+		positionRepoMock := new(PositionRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(clientMock, repoMock, positionRepoMock, session)
+
+		clientMock.On("GetOrderList", ctx, session, request.ReqOrderList{}).Return(&response.ResOrderList{
+			ResultCode: "0",
+			OrderList:  []response.ResOrder{{OrderOrderNumber: "1001", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "100"}},
+		}, nil).Once()
+		clientMock.On("GetOrderListDetail", ctx, session, request.ReqOrderListDetail{OrderNumber: "1001", EigyouDay: "20251201"}).Return(&response.ResOrderListDetail{
+			ResultCode:  "0",
+			OrderNumber: "1001",
+			EigyouDay:   "20251201",
+			YakuzyouSikkouList: []response.ResYakuzyouSikkou{
+				{YakuzyouSuryou: "100", YakuzyouPrice: "1000", YakuzyouDate: "20251201093000"},
+			},
+		}, nil).Once()
+		existing := &model.Order{OrderID: "1001", Quantity: 200, OrderStatus: model.OrderStatusNew}
+		repoMock.On("FindByID", ctx, "1001").Return(existing, nil).Once()
+		repoMock.On("SaveExecution", ctx, existing, mock.Anything).Run(func(args mock.Arguments) {
+			existing.Executions = append(existing.Executions, *args.Get(2).(*model.Execution))
+		}).Return(nil).Once()
+		repoMock.On("UpdateStatus", ctx, existing, model.OrderStatusPartiallyFilled).Return(fmt.Errorf("order 1001 version 0: %w", repository.ErrOrderConflict)).Once()
+		repoMock.On("FindByStatus", ctx, mock.Anything).Return([]*model.Order{}, nil)
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return([]*model.Order{}, nil).Once()
+		positionRepoMock.On("ReplaceAll", ctx, mock.Anything).Return(nil).Once()
+
+		summary, err := uc.SyncExecutions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &app.ExecutionSyncSummary{Orders: 1, Executions: 1}, summary)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 取消済みの注文に遅れて届いた約定は保存し、状態は変えない", func(t *testing.T) {
+		clientMock := new(OrderClientMock)
+		repoMock := new(OrderRepositoryMock)
+		positionRepoMock := new(PositionRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(clientMock, repoMock, positionRepoMock, session)
+
+		clientMock.On("GetOrderList", ctx, session, request.ReqOrderList{}).Return(&response.ResOrderList{
+			ResultCode: "0",
+			OrderList:  []response.ResOrder{{OrderOrderNumber: "1001", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "100"}},
+		}, nil).Once()
+		clientMock.On("GetOrderListDetail", ctx, session, request.ReqOrderListDetail{OrderNumber: "1001", EigyouDay: "20251201"}).Return(&response.ResOrderListDetail{
+			ResultCode:  "0",
+			OrderNumber: "1001",
+			EigyouDay:   "20251201",
+			YakuzyouSikkouList: []response.ResYakuzyouSikkou{
+				{YakuzyouSuryou: "100", YakuzyouPrice: "1000", YakuzyouDate: "20251201093000"},
+			},
+		}, nil).Once()
+		canceled := &model.Order{OrderID: "1001", Quantity: 200, OrderStatus: model.OrderStatusCanceled}
+		repoMock.On("FindByID", ctx, "1001").Return(canceled, nil).Once()
+		repoMock.On("SaveExecution", ctx, canceled, mock.Anything).Return(nil).Once()
+		repoMock.On("FindByStatus", ctx, mock.Anything).Return([]*model.Order{}, nil)
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return([]*model.Order{}, nil).Once()
+		positionRepoMock.On("ReplaceAll", ctx, mock.Anything).Return(nil).Once()
+
+		summary, err := uc.SyncExecutions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &app.ExecutionSyncSummary{Orders: 1, Executions: 1}, summary)
+		repoMock.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: 約定や注文の数量・単価が不正な注文は保存せずに数え、次の注文を同期する", func(t *testing.T) {
+		clientMock := new(OrderClientMock)
+		repoMock := new(OrderRepositoryMock)
+		positionRepoMock := new(PositionRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(clientMock, repoMock, positionRepoMock, session)
+
+		clientMock.On("GetOrderList", ctx, session, request.ReqOrderList{}).Return(&response.ResOrderList{
+			ResultCode: "0",
+			OrderList: []response.ResOrder{
+				{OrderOrderNumber: "1001", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "100"},
+				{OrderOrderNumber: "1002", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "100"},
+				{OrderOrderNumber: "1003", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "100"},
+				{OrderOrderNumber: "1004", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "100"},
+			},
+		}, nil).Once()
+		// ボット以外の注文の注文数量が不正な場合は、数量 0 の注文として保存しない
+		clientMock.On("GetOrderListDetail", ctx, session, request.ReqOrderListDetail{OrderNumber: "1004", EigyouDay: "20251201"}).Return(&response.ResOrderListDetail{
+			ResultCode:       "0",
+			OrderNumber:      "1004",
+			EigyouDay:        "20251201",
+			IssueCode:        "7203",
+			OrderBaibaiKubun: "3",
+			OrderOrderSuryou: "1OO",
+			OrderOrderPrice:  "1000",
+			YakuzyouSikkouList: []response.ResYakuzyouSikkou{
+				{YakuzyouSuryou: "100", YakuzyouPrice: "1000", YakuzyouDate: "20251201093000"},
+			},
+		}, nil).Once()
+		repoMock.On("FindByID", ctx, "1004").Return(nil, nil).Once()
+		for orderNumber, item := range map[string]response.ResYakuzyouSikkou{
+			"1001": {YakuzyouSuryou: "1OO", YakuzyouPrice: "1000", YakuzyouDate: "20251201093000"},
+			"1002": {YakuzyouSuryou: "100", YakuzyouPrice: "abc", YakuzyouDate: "20251201093000"},
+			"1003": {YakuzyouSuryou: "100", YakuzyouPrice: "1000", YakuzyouDate: "20251201093000"},
+		} {
+			clientMock.On("GetOrderListDetail", ctx, session, request.ReqOrderListDetail{OrderNumber: orderNumber, EigyouDay: "20251201"}).Return(&response.ResOrderListDetail{
+				ResultCode:         "0",
+				OrderNumber:        orderNumber,
+				EigyouDay:          "20251201",
+				YakuzyouSikkouList: []response.ResYakuzyouSikkou{item},
+			}, nil).Once()
+		}
+		existing := &model.Order{OrderID: "1003", Quantity: 100, OrderStatus: model.OrderStatusFilled}
+		repoMock.On("FindByID", ctx, "1003").Return(existing, nil).Once()
+		repoMock.On("SaveExecution", ctx, existing, mock.Anything).Return(nil).Once()
+		repoMock.On("FindByStatus", ctx, mock.Anything).Return([]*model.Order{}, nil)
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return([]*model.Order{}, nil).Once()
+		positionRepoMock.On("ReplaceAll", ctx, mock.Anything).Return(nil).Once()
+
+		summary, err := uc.SyncExecutions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &app.ExecutionSyncSummary{Orders: 1, Executions: 1, Skipped: 3}, summary)
+		repoMock.AssertNotCalled(t, "Save", ctx, mock.Anything)
+		repoMock.AssertNotCalled(t, "FindByID", ctx, "1001")
+		repoMock.AssertNotCalled(t, "FindByID", ctx, "1002")
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 証券会社で取消・失効・受付エラーになった注文を約定の有無にかかわらず完了にする", func(t *testing.T) {
+		clientMock := new(OrderClientMock)
+		repoMock := new(OrderRepositoryMock)
+		positionRepoMock := new(PositionRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(clientMock, repoMock, positionRepoMock, session)
+
+		clientMock.On("GetOrderList", ctx, session, request.ReqOrderList{}).Return(&response.ResOrderList{
+			ResultCode: "0",
+			OrderList: []response.ResOrder{
+				{OrderOrderNumber: "2001", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "0", OrderStatusCode: "7"},   // 取消完了
+				{OrderOrderNumber: "2002", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "0", OrderStatusCode: "12"},  // 全部失効
+				{OrderOrderNumber: "2003", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "0", OrderStatusCode: "2"},   // 受付エラー
+				{OrderOrderNumber: "2004", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "100", OrderStatusCode: "7"}, // 一部約定後に取消
+				{OrderOrderNumber: "2005", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "0", OrderStatusCode: "7"},   // ボット以外の注文
+				{OrderOrderNumber: "2006", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "0", OrderStatusCode: "1"},   // 未約定
+			},
+		}, nil).Once()
+		clientMock.On("GetOrderListDetail", ctx, session, request.ReqOrderListDetail{OrderNumber: "2004", EigyouDay: "20251201"}).Return(&response.ResOrderListDetail{
+			ResultCode:  "0",
+			OrderNumber: "2004",
+			EigyouDay:   "20251201",
+			YakuzyouSikkouList: []response.ResYakuzyouSikkou{
+				{YakuzyouSuryou: "100", YakuzyouPrice: "1000", YakuzyouDate: "20251201093000"},
+			},
+		}, nil).Once()
+
+		orders := map[string]*model.Order{
+			"2001": {OrderID: "2001", Quantity: 100, OrderStatus: model.OrderStatusNew},
+			"2002": {OrderID: "2002", Quantity: 100, OrderStatus: model.OrderStatusNew},
+			"2003": {OrderID: "2003", Quantity: 100, OrderStatus: model.OrderStatusNew},
+			"2004": {OrderID: "2004", Quantity: 300, OrderStatus: model.OrderStatusNew},
+		}
+		for id, order := range orders {
+			repoMock.On("FindByID", ctx, id).Return(order, nil).Once()
+		}
+		repoMock.On("FindByID", ctx, "2005").Return(nil, nil).Once()
+		repoMock.On("SaveExecution", ctx, orders["2004"], mock.Anything).Run(func(args mock.Arguments) {
+			order := args.Get(1).(*model.Order)
+			order.Executions = append(order.Executions, *args.Get(2).(*model.Execution))
+		}).Return(nil).Once()
+		// 実際のリポジトリと同じく、許可されている遷移だけを反映する
+		var transitions []string
+		repoMock.On("UpdateStatus", ctx, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			order, status := args.Get(1).(*model.Order), args.Get(2).(model.OrderStatus)
+			assert.NoError(t, order.OrderStatus.ValidateTransition(status))
+			transitions = append(transitions, fmt.Sprintf("%s:%s->%s", order.OrderID, order.OrderStatus, status))
+			order.OrderStatus = status
+		}).Return(nil)
+		repoMock.On("FindByStatus", ctx, mock.Anything).Return([]*model.Order{}, nil)
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return([]*model.Order{}, nil).Once()
+		positionRepoMock.On("ReplaceAll", ctx, mock.Anything).Return(nil).Once()
+
+		summary, err := uc.SyncExecutions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &app.ExecutionSyncSummary{Orders: 1, Executions: 1, Closed: 4}, summary)
+		assert.Equal(t, []string{
+			"2001:NEW->CANCELED",
+			"2002:NEW->EXPIRED",
+			"2003:NEW->REJECTED",
+			"2004:NEW->PARTIALLY_FILLED",
+			"2004:PARTIALLY_FILLED->CANCELED",
+		}, transitions)
+		repoMock.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+		repoMock.AssertNotCalled(t, "FindByID", ctx, "2006")
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 一部約定の注文が受付エラーになった場合は状態を変えない", func(t *testing.T) {
+		clientMock := new(OrderClientMock)
+		repoMock := new(OrderRepositoryMock)
+		positionRepoMock := new(PositionRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(clientMock, repoMock, positionRepoMock, session)
+
+		clientMock.On("GetOrderList", ctx, session, request.ReqOrderList{}).Return(&response.ResOrderList{
+			ResultCode: "0",
+			OrderList:  []response.ResOrder{{OrderOrderNumber: "2001", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "0", OrderStatusCode: "2"}},
+		}, nil).Once()
+		partial := &model.Order{OrderID: "2001", Quantity: 200, OrderStatus: model.OrderStatusPartiallyFilled}
+		repoMock.On("FindByID", ctx, "2001").Return(partial, nil).Once()
+		repoMock.On("UpdateStatus", ctx, partial, model.OrderStatusRejected).Return(partial.OrderStatus.ValidateTransition(model.OrderStatusRejected)).Once()
+		repoMock.On("FindByStatus", ctx, mock.Anything).Return([]*model.Order{}, nil)
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return([]*model.Order{}, nil).Once()
+		positionRepoMock.On("ReplaceAll", ctx, mock.Anything).Return(nil).Once()
+
+		summary, err := uc.SyncExecutions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &app.ExecutionSyncSummary{}, summary)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 注文一覧にない前日以前の当日限りの注文を失効にする", func(t *testing.T) {
+		clientMock := new(OrderClientMock)
+		repoMock := new(OrderRepositoryMock)
+		positionRepoMock := new(PositionRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(clientMock, repoMock, positionRepoMock, session)
+
+		clientMock.On("GetOrderList", ctx, session, request.ReqOrderList{}).Return(&response.ResOrderList{
+			ResultCode: "0",
+			OrderList:  []response.ResOrder{{OrderOrderNumber: "3002", OrderSikkouDay: "20251201", OrderYakuzyouSuryo: "0", OrderStatusCode: "1"}},
+		}, nil).Once()
+		stale := &model.Order{OrderID: "3001", Quantity: 100, TimeInForce: model.TimeInForceDay, OrderStatus: model.OrderStatusNew}
+		stale.CreatedAt = time.Now().AddDate(0, 0, -2)
+		listed := &model.Order{OrderID: "3002", Quantity: 100, TimeInForce: model.TimeInForceDay, OrderStatus: model.OrderStatusNew}
+		listed.CreatedAt = time.Now().AddDate(0, 0, -2) // 前日に発注した翌営業日の注文など、まだ一覧にある注文は失効にしない
+		fresh := &model.Order{OrderID: "3003", Quantity: 100, TimeInForce: model.TimeInForceDay, OrderStatus: model.OrderStatusPartiallyFilled}
+		fresh.CreatedAt = time.Now()
+		repoMock.On("FindByStatus", ctx, model.OrderStatusNew).Return([]*model.Order{stale, listed}, nil).Once()
+		repoMock.On("FindByStatus", ctx, model.OrderStatusPartiallyFilled).Return([]*model.Order{fresh}, nil).Once()
+		repoMock.On("UpdateStatus", ctx, stale, model.OrderStatusExpired).Return(nil).Once()
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return([]*model.Order{}, nil).Once()
+		positionRepoMock.On("ReplaceAll", ctx, mock.Anything).Return(nil).Once()
+
+		summary, err := uc.SyncExecutions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &app.ExecutionSyncSummary{Closed: 1}, summary)
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 注文一覧のエラーを返す", func(t *testing.T) {
+		clientMock := new(OrderClientMock)
+		uc := app.NewTradeUseCaseImpl(clientMock, new(OrderRepositoryMock), new(PositionRepositoryMock), session)
+
+		clientMock.On("GetOrderList", ctx, session, request.ReqOrderList{}).Return(&response.ResOrderList{ResultCode: "990", ResultText: "system error"}, nil).Once()
+
+		summary, err := uc.SyncExecutions(ctx)
+		assert.Error(t, err)
+		assert.Nil(t, summary)
+	})
+}
+
+// tradeTestOrders は 12/1 に 100株@1000 (手数料 100) と 100株@1200 を買い、
+// 12/2 (日本時間) に 100株@1300 (手数料 100) を売り、12/3 に 7203 を 100株@2000 で買って 100株@1900 で売った注文
+func tradeTestOrders() []*model.Order {
+	jst := time.FixedZone("JST", 9*60*60)
+	return []*model.Order{
+		{OrderID: "1", Symbol: "9984", TradeType: model.TradeTypeBuy, Executions: []model.Execution{
+			{ExecutionID: "1", ExecutionTime: time.Date(2025, 12, 1, 9, 0, 0, 0, jst), ExecutionPrice: 1000, ExecutionQuantity: 100, Commission: 100},
+		}},
+		{OrderID: "2", Symbol: "9984", TradeType: model.TradeTypeBuy, Executions: []model.Execution{
+			{ExecutionID: "2", ExecutionTime: time.Date(2025, 12, 1, 10, 0, 0, 0, jst), ExecutionPrice: 1200, ExecutionQuantity: 100},
+		}},
+		{OrderID: "3", Symbol: "9984", TradeType: model.TradeTypeSell, Executions: []model.Execution{
+			{ExecutionID: "3", ExecutionTime: time.Date(2025, 12, 2, 8, 0, 0, 0, jst), ExecutionPrice: 1300, ExecutionQuantity: 100, Commission: 100},
+		}},
+		{OrderID: "4", Symbol: "7203", TradeType: model.TradeTypeBuy, Executions: []model.Execution{
+			{ExecutionID: "4", ExecutionTime: time.Date(2025, 12, 3, 9, 0, 0, 0, jst), ExecutionPrice: 2000, ExecutionQuantity: 100},
+		}},
+		{OrderID: "5", Symbol: "7203", TradeType: model.TradeTypeSell, Executions: []model.Execution{
+			{ExecutionID: "5", ExecutionTime: time.Date(2025, 12, 3, 14, 0, 0, 0, jst), ExecutionPrice: 1900, ExecutionQuantity: 100},
+		}},
+	}
+}
+
+func TestTradeUseCaseImpl_ListTrades(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 先入先出法では最初に建てた注文から決済する", func(t *testing.T) {
+		repoMock := new(OrderRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(new(OrderClientMock), repoMock, new(PositionRepositoryMock), &client.Session{})
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return(tradeTestOrders(), nil).Once()
+
+		trades, err := uc.ListTrades(ctx, app.TradeQuery{Symbol: "9984"})
+		assert.NoError(t, err)
+		if assert.Len(t, trades, 1) {
+			assert.Equal(t, model.TradeSideLong, trades[0].Side)
+			assert.Equal(t, "1", trades[0].EntryOrderID)
+			assert.Equal(t, "3", trades[0].ExitOrderID)
+			assert.Equal(t, 1000.0, trades[0].EntryPrice)
+			assert.Equal(t, 30000.0, trades[0].GrossProfitLoss)
+			assert.Equal(t, 200.0, trades[0].Commission)
+			assert.Equal(t, 29800.0, trades[0].NetProfitLoss)
+		}
+	})
+
+	t.Run("正常系: 移動平均法では平均単価で決済し、建ての手数料を数量で按分する", func(t *testing.T) {
+		repoMock := new(OrderRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(new(OrderClientMock), repoMock, new(PositionRepositoryMock), &client.Session{})
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return(tradeTestOrders(), nil).Once()
+
+		trades, err := uc.ListTrades(ctx, app.TradeQuery{Symbol: "9984", Method: model.CostMethodAverage})
+		assert.NoError(t, err)
+		if assert.Len(t, trades, 1) {
+			assert.Equal(t, 1100.0, trades[0].EntryPrice)
+			assert.Equal(t, 20000.0, trades[0].GrossProfitLoss)
+			assert.Equal(t, 150.0, trades[0].Commission)
+			assert.Equal(t, 19850.0, trades[0].NetProfitLoss)
+		}
+	})
+
+	t.Run("正常系: 決済日時で絞り込み、新しい順に件数を制限して返す", func(t *testing.T) {
+		repoMock := new(OrderRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(new(OrderClientMock), repoMock, new(PositionRepositoryMock), &client.Session{})
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return(tradeTestOrders(), nil).Twice()
+
+		trades, err := uc.ListTrades(ctx, app.TradeQuery{Limit: 1})
+		assert.NoError(t, err)
+		if assert.Len(t, trades, 1) {
+			assert.Equal(t, "7203", trades[0].Symbol)
+		}
+
+		trades, err = uc.ListTrades(ctx, app.TradeQuery{Until: time.Date(2025, 12, 3, 0, 0, 0, 0, time.UTC)})
+		assert.NoError(t, err)
+		if assert.Len(t, trades, 1) {
+			assert.Equal(t, "9984", trades[0].Symbol)
+		}
+	})
+
+	t.Run("異常系: 未知の計算方法はエラーを返す", func(t *testing.T) {
+		repoMock := new(OrderRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(new(OrderClientMock), repoMock, new(PositionRepositoryMock), &client.Session{})
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return(tradeTestOrders(), nil).Once()
+
+		trades, err := uc.ListTrades(ctx, app.TradeQuery{Method: "LIFO"})
+		assert.Error(t, err)
+		assert.Nil(t, trades)
+	})
+}
+
+func TestTradeUseCaseImpl_GetDailyProfitLoss(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 日本時間の決済日ごとに集計する", func(t *testing.T) {
+		repoMock := new(OrderRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(new(OrderClientMock), repoMock, new(PositionRepositoryMock), &client.Session{})
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return(tradeTestOrders(), nil).Once()
+
+		days, err := uc.GetDailyProfitLoss(ctx, app.DailyProfitLossQuery{})
+		assert.NoError(t, err)
+		assert.Equal(t, []*app.DailyProfitLoss{
+			{Date: "2025-12-03", Trades: 1, Losses: 1, GrossProfitLoss: -10000, NetProfitLoss: -10000},
+			{Date: "2025-12-02", Trades: 1, Wins: 1, GrossProfitLoss: 30000, Commission: 200, NetProfitLoss: 29800},
+		}, days)
+	})
+
+	t.Run("正常系: 期間の終了日を含めて絞り込む", func(t *testing.T) {
+		repoMock := new(OrderRepositoryMock)
+		uc := app.NewTradeUseCaseImpl(new(OrderClientMock), repoMock, new(PositionRepositoryMock), &client.Session{})
+		repoMock.On("FindExecutedOrders", ctx, time.Time{}).Return(tradeTestOrders(), nil).Once()
+
+		days, err := uc.GetDailyProfitLoss(ctx, app.DailyProfitLossQuery{From: "2025-12-01", To: "2025-12-02"})
+		assert.NoError(t, err)
+		if assert.Len(t, days, 1) {
+			assert.Equal(t, "2025-12-02", days[0].Date)
+		}
+	})
+
+	t.Run("異常系: 不正な日付はエラーを返す", func(t *testing.T) {
+		uc := app.NewTradeUseCaseImpl(new(OrderClientMock), new(OrderRepositoryMock), new(PositionRepositoryMock), &client.Session{})
+
+		days, err := uc.GetDailyProfitLoss(ctx, app.DailyProfitLossQuery{From: "20251201"})
+		assert.Error(t, err)
+		assert.Nil(t, days)
+	})
+}
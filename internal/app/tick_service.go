@@ -0,0 +1,17 @@
+package app
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"time"
+)
+
+// TickService resolves the tick table that applies to a symbol and rounds prices to valid ticks.
+type TickService interface {
+	// ResolveTickRule returns the tick table that applies to the symbol on the given date.
+	ResolveTickRule(ctx context.Context, symbol string, date time.Time) (*model.TickRule, error)
+	// RoundPrice rounds the price to a valid tick for the symbol on the given date.
+	RoundPrice(ctx context.Context, symbol string, date time.Time, price float64, rounding model.TickRounding) (float64, error)
+	// StepPrice returns the price n ticks away from the reference price (n > 0 up, n < 0 down).
+	StepPrice(ctx context.Context, symbol string, date time.Time, reference float64, ticks int) (float64, error)
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"time"
+)
+
+// tickServiceImpl implements the TickService interface.
+type tickServiceImpl struct {
+	masterRepo repository.MasterRepository
+	location   *time.Location
+}
+
+// NewTickServiceImpl creates a new TickService.
+func NewTickServiceImpl(masterRepo repository.MasterRepository) TickService {
+	return &tickServiceImpl{
+		masterRepo: masterRepo,
+		location:   marketLocation(),
+	}
+}
+
+// ResolveTickRule returns the tick table that applies to the symbol on the given date.
+//
+// The stock master carries the tick unit number for the day it was downloaded and the one for
+// the next business day. The next one is used for dates after the master was last updated.
+// When the symbol or its tick table is unknown, or the table is not yet in effect on the date,
+// the standard TSE table is returned. Its ticks are multiples of the TOPIX500 ticks, so prices
+// rounded with it are valid for every TSE stock.
+func (s *tickServiceImpl) ResolveTickRule(ctx context.Context, symbol string, date time.Time) (*model.TickRule, error) {
+	rawResult, err := s.masterRepo.FindByIssueCode(ctx, symbol, "StockMaster")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stock master for %s: %w", symbol, err)
+	}
+	stock, _ := rawResult.(*model.StockMaster)
+	if stock == nil || stock.TickUnitNumber == "" {
+		slog.Warn("Tick unit number not found, using standard TSE tick table", "symbol", symbol)
+		return model.StandardTSETickRule(), nil
+	}
+
+	day := date.In(s.location).Format("20060102")
+	tickUnitNumber := stock.TickUnitNumber
+	if stock.NextTickUnitNumber != "" && !stock.UpdatedAt.IsZero() && day > stock.UpdatedAt.In(s.location).Format("20060102") {
+		tickUnitNumber = stock.NextTickUnitNumber
+	}
+
+	tickRule, err := s.masterRepo.FindTickRule(ctx, tickUnitNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tick rule %s for %s: %w", tickUnitNumber, symbol, err)
+	}
+	if tickRule == nil || len(tickRule.TickLevels) == 0 {
+		slog.Warn("Tick rule not found, using standard TSE tick table", "symbol", symbol, "tick_unit_number", tickUnitNumber)
+		return model.StandardTSETickRule(), nil
+	}
+	if tickRule.ApplicableDate != "" && day < tickRule.ApplicableDate {
+		slog.Warn("Tick rule is not yet in effect, using standard TSE tick table", "symbol", symbol, "tick_unit_number", tickUnitNumber, "applicable_date", tickRule.ApplicableDate)
+		return model.StandardTSETickRule(), nil
+	}
+	return tickRule, nil
+}
+
+// RoundPrice rounds the price to a valid tick for the symbol on the given date.
+func (s *tickServiceImpl) RoundPrice(ctx context.Context, symbol string, date time.Time, price float64, rounding model.TickRounding) (float64, error) {
+	tickRule, err := s.ResolveTickRule(ctx, symbol, date)
+	if err != nil {
+		return 0, err
+	}
+	rounded, err := tickRule.Round(price, rounding)
+	if err != nil {
+		return 0, fmt.Errorf("failed to round price %v for %s: %w", price, symbol, err)
+	}
+	return rounded, nil
+}
+
+// StepPrice returns the price n ticks away from the reference price (n > 0 up, n < 0 down).
+func (s *tickServiceImpl) StepPrice(ctx context.Context, symbol string, date time.Time, reference float64, ticks int) (float64, error) {
+	tickRule, err := s.ResolveTickRule(ctx, symbol, date)
+	if err != nil {
+		return 0, err
+	}
+	price, err := tickRule.Step(reference, ticks)
+	if err != nil {
+		return 0, fmt.Errorf("failed to step %d ticks from %v for %s: %w", ticks, reference, symbol, err)
+	}
+	return price, nil
+}
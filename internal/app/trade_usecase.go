@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"time"
+)
+
+// TradeQuery filters the round-trip trades by the exit time.
+type TradeQuery struct {
+	Symbol string           // empty for all symbols
+	Method model.CostMethod // defaults to FIFO
+	Since  time.Time        // zero for no lower bound
+	Until  time.Time        // exclusive; zero for no upper bound
+	Limit  int              // 0 for no limit
+}
+
+// DailyProfitLossQuery filters the daily P&L by the exit date (Japan time).
+type DailyProfitLossQuery struct {
+	Method model.CostMethod // defaults to FIFO
+	From   string           // YYYY-MM-DD; empty for no lower bound
+	To     string           // YYYY-MM-DD, inclusive; empty for no upper bound
+}
+
+// DailyProfitLoss is the realized P&L of the trades closed on a day (Japan time).
+type DailyProfitLoss struct {
+	Date            string // YYYY-MM-DD
+	Trades          int
+	Wins            int
+	Losses          int
+	GrossProfitLoss float64
+	Commission      float64
+	NetProfitLoss   float64
+}
+
+// ExecutionSyncSummary reports the outcome of an execution sync.
+type ExecutionSyncSummary struct {
+	Orders     int // number of orders with executions
+	Imported   int // number of orders that were placed outside the bot and stored
+	Executions int // number of executions saved
+	Lots       int // number of open lots in the rebuilt position ledger
+	Closed     int // number of orders closed as canceled, expired or rejected (including stale day orders)
+	Skipped    int // number of orders with executions that were not stored because they could not be converted
+}
+
+// TradeUseCase defines the interface for the trade ledger.
+type TradeUseCase interface {
+	// SyncExecutions fetches today's orders from the broker, stores their executions, closes the canceled, expired and rejected orders
+	// and rebuilds the position ledger.
+	SyncExecutions(ctx context.Context) (*ExecutionSyncSummary, error)
+	// ListTrades matches the stored executions into round-trip trades, newest exit first.
+	ListTrades(ctx context.Context, query TradeQuery) ([]*model.Trade, error)
+	// GetDailyProfitLoss aggregates the trades by the exit date, newest first.
+	GetDailyProfitLoss(ctx context.Context, query DailyProfitLossQuery) ([]*DailyProfitLoss, error)
+}
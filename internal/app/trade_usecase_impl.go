@@ -0,0 +1,414 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"stock-bot/internal/infrastructure/client"
+	"stock-bot/internal/infrastructure/client/dto/order/request"
+	"stock-bot/internal/infrastructure/client/dto/order/response"
+	"time"
+)
+
+// tradeUseCaseImpl implements the TradeUseCase interface.
+type tradeUseCaseImpl struct {
+	orderClient  client.OrderClient
+	orderRepo    repository.OrderRepository
+	positionRepo repository.PositionRepository
+	session      *client.Session
+}
+
+// NewTradeUseCaseImpl creates a new TradeUseCase.
+func NewTradeUseCaseImpl(orderClient client.OrderClient, orderRepo repository.OrderRepository, positionRepo repository.PositionRepository, session *client.Session) TradeUseCase {
+	return &tradeUseCaseImpl{
+		orderClient:  orderClient,
+		orderRepo:    orderRepo,
+		positionRepo: positionRepo,
+		session:      session,
+	}
+}
+
+// SyncExecutions fetches the order list, and the details of the orders with executions, and stores the executions.
+// Orders placed outside the bot (e.g. on the broker's website) are stored as well, so that the ledger is complete.
+// An order whose details cannot be converted is logged and skipped.
+// Stored orders that the broker reports as canceled, expired or rejected are closed, whether or not they have executions,
+// and open day orders from previous days that are no longer listed are expired.
+// The position ledger is then rebuilt from all stored executions.
+func (uc *tradeUseCaseImpl) SyncExecutions(ctx context.Context) (*ExecutionSyncSummary, error) {
+	list, err := uc.orderClient.GetOrderList(ctx, uc.session, request.ReqOrderList{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order list: %w", err)
+	}
+	if list.ResultCode != "0" {
+		return nil, fmt.Errorf("order list client returned error: code=%s text=%s", list.ResultCode, list.ResultText)
+	}
+
+	summary := &ExecutionSyncSummary{}
+	listed := make(map[string]struct{}, len(list.OrderList))
+	for _, item := range list.OrderList {
+		listed[item.OrderOrderNumber] = struct{}{}
+		var order *model.Order
+		if parseDetailFloat(item.OrderYakuzyouSuryo) > 0 {
+			order, err = uc.syncOrderExecutions(ctx, item, summary)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		status, ok := closedOrderStatus(item.OrderStatusCode)
+		if !ok {
+			continue
+		}
+		if order == nil {
+			order, err = uc.orderRepo.FindByID(ctx, item.OrderOrderNumber)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find order %s: %w", item.OrderOrderNumber, err)
+			}
+			if order == nil {
+				continue // orders placed outside the bot are imported only when they have executions
+			}
+		}
+		closed, err := uc.updateStatus(ctx, order, status)
+		if err != nil {
+			return nil, err
+		}
+		if closed {
+			summary.Closed++
+		}
+	}
+
+	expired, err := uc.expireStaleOrders(ctx, listed)
+	if err != nil {
+		return nil, err
+	}
+	summary.Closed += expired
+
+	lots, err := uc.rebuildPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	summary.Lots = lots
+	return summary, nil
+}
+
+// syncOrderExecutions stores the executions of an order in the order list and moves the order to the status of its executions.
+// It returns the stored order, or nil when the details cannot be converted.
+func (uc *tradeUseCaseImpl) syncOrderExecutions(ctx context.Context, item response.ResOrder, summary *ExecutionSyncSummary) (*model.Order, error) {
+	detail, err := uc.orderClient.GetOrderListDetail(ctx, uc.session, request.ReqOrderListDetail{
+		OrderNumber: item.OrderOrderNumber,
+		EigyouDay:   item.OrderSikkouDay,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order detail of %s: %w", item.OrderOrderNumber, err)
+	}
+	if detail.ResultCode != "0" {
+		return nil, fmt.Errorf("order detail client returned error: order=%s code=%s text=%s", item.OrderOrderNumber, detail.ResultCode, detail.ResultText)
+	}
+	executions, err := toExecutions(detail)
+	if err != nil {
+		slog.Warn("Skipping executions that cannot be converted", "order_id", detail.OrderNumber, "error", err)
+		summary.Skipped++
+		return nil, nil
+	}
+	if len(executions) == 0 {
+		return nil, nil
+	}
+
+	order, err := uc.orderRepo.FindByID(ctx, detail.OrderNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find order %s: %w", detail.OrderNumber, err)
+	}
+	if order == nil {
+		order, err = toImportedOrder(detail)
+		if err != nil {
+			slog.Warn("Skipping an order placed outside the bot", "order_id", detail.OrderNumber, "error", err)
+			summary.Skipped++
+			return nil, nil
+		}
+		if err := uc.orderRepo.Save(ctx, order); err != nil {
+			return nil, fmt.Errorf("failed to save order %s: %w", detail.OrderNumber, err)
+		}
+		summary.Imported++
+	}
+	for _, execution := range executions {
+		if err := uc.orderRepo.SaveExecution(ctx, order, execution); err != nil {
+			return nil, fmt.Errorf("failed to save executions of %s: %w", detail.OrderNumber, err)
+		}
+	}
+	if _, err := uc.updateStatus(ctx, order, order.FillStatus()); err != nil {
+		return nil, err
+	}
+	summary.Orders++
+	summary.Executions += len(executions)
+	return order, nil
+}
+
+// expireStaleOrders expires the open day orders placed before today that are no longer in the broker's order list.
+// The order list only covers the orders of the current business day, so a day order that was never filled
+// or canceled would otherwise stay open forever. It returns the number of expired orders.
+func (uc *tradeUseCaseImpl) expireStaleOrders(ctx context.Context, listed map[string]struct{}) (int, error) {
+	now := time.Now().In(marketLocation())
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var expired int
+	for _, status := range []model.OrderStatus{model.OrderStatusNew, model.OrderStatusPartiallyFilled} {
+		orders, err := uc.orderRepo.FindByStatus(ctx, status)
+		if err != nil {
+			return 0, fmt.Errorf("failed to find %s orders: %w", status, err)
+		}
+		for _, order := range orders {
+			if _, ok := listed[order.OrderID]; ok || !order.CreatedAt.Before(today) {
+				continue
+			}
+			if order.TimeInForce != "" && order.TimeInForce != model.TimeInForceDay {
+				continue
+			}
+			moved, err := uc.updateStatus(ctx, order, model.OrderStatusExpired)
+			if err != nil {
+				return 0, err
+			}
+			if moved {
+				slog.Info("Expired a day order missing from the order list", "order_id", order.OrderID, "placed_at", order.CreatedAt)
+				expired++
+			}
+		}
+	}
+	return expired, nil
+}
+
+// updateStatus moves an open order to status, and reports whether it was moved.
+// An order updated concurrently (e.g. by another sync), or already moved past that status, is left as it is.
+func (uc *tradeUseCaseImpl) updateStatus(ctx context.Context, order *model.Order, status model.OrderStatus) (bool, error) {
+	if order.OrderStatus.IsTerminal() || status == order.OrderStatus {
+		return false, nil
+	}
+	if err := uc.orderRepo.UpdateStatus(ctx, order, status); err != nil {
+		if errors.Is(err, repository.ErrOrderConflict) || errors.Is(err, model.ErrInvalidOrderTransition) {
+			slog.Warn("Skipping order status update", "order_id", order.OrderID, "status", status, "error", err)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to update status of %s: %w", order.OrderID, err)
+	}
+	return true, nil
+}
+
+// closedOrderStatus converts the status code of the order list (状態コード) of an order that will not be filled any further.
+// Orders that are working or filled are not closed by the code, their status follows the executions.
+func closedOrderStatus(code string) (model.OrderStatus, bool) {
+	switch code {
+	case "7": // 取消完了
+		return model.OrderStatusCanceled, true
+	case "11", "12", "19": // 一部失効, 全部失効, 繰越失効
+		return model.OrderStatusExpired, true
+	case "2", "14": // 受付エラー, 無効
+		return model.OrderStatusRejected, true
+	default:
+		return "", false
+	}
+}
+
+// rebuildPositions replaces the position ledger with the lots left open by the stored executions (FIFO),
+// and returns the number of open lots.
+func (uc *tradeUseCaseImpl) rebuildPositions(ctx context.Context) (int, error) {
+	orders, err := uc.orderRepo.FindExecutedOrders(ctx, time.Time{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to find executed orders: %w", err)
+	}
+	positions := model.BuildPositions(orders)
+	if err := uc.positionRepo.ReplaceAll(ctx, positions); err != nil {
+		return 0, fmt.Errorf("failed to replace positions: %w", err)
+	}
+	var lots int
+	for _, p := range positions {
+		lots += len(p.Lots)
+	}
+	return lots, nil
+}
+
+// ListTrades matches all stored executions, so that positions opened before Since keep their cost basis.
+func (uc *tradeUseCaseImpl) ListTrades(ctx context.Context, query TradeQuery) ([]*model.Trade, error) {
+	if query.Method == "" {
+		query.Method = model.CostMethodFIFO
+	}
+	orders, err := uc.orderRepo.FindExecutedOrders(ctx, time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find executed orders: %w", err)
+	}
+	trades, err := model.MatchTrades(orders, query.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	trades = slices.DeleteFunc(trades, func(t *model.Trade) bool {
+		return (query.Symbol != "" && t.Symbol != query.Symbol) ||
+			(!query.Since.IsZero() && t.ExitTime.Before(query.Since)) ||
+			(!query.Until.IsZero() && !t.ExitTime.Before(query.Until))
+	})
+	slices.Reverse(trades)
+	if query.Limit > 0 && len(trades) > query.Limit {
+		trades = trades[:query.Limit]
+	}
+	return trades, nil
+}
+
+// GetDailyProfitLoss aggregates the trades by the exit date in Japan time.
+func (uc *tradeUseCaseImpl) GetDailyProfitLoss(ctx context.Context, query DailyProfitLossQuery) ([]*DailyProfitLoss, error) {
+	tradeQuery := TradeQuery{Method: query.Method}
+	if query.From != "" {
+		from, err := time.ParseInLocation(time.DateOnly, query.From, marketLocation())
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date %q: %w", query.From, err)
+		}
+		tradeQuery.Since = from
+	}
+	if query.To != "" {
+		to, err := time.ParseInLocation(time.DateOnly, query.To, marketLocation())
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date %q: %w", query.To, err)
+		}
+		tradeQuery.Until = to.AddDate(0, 0, 1)
+	}
+	trades, err := uc.ListTrades(ctx, tradeQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	var days []*DailyProfitLoss
+	byDate := make(map[string]*DailyProfitLoss)
+	for _, t := range trades {
+		date := t.ExitTime.In(marketLocation()).Format(time.DateOnly)
+		day, ok := byDate[date]
+		if !ok {
+			day = &DailyProfitLoss{Date: date}
+			byDate[date] = day
+			days = append(days, day) // trades are newest first, so are the days
+		}
+		day.Trades++
+		switch {
+		case t.NetProfitLoss > 0:
+			day.Wins++
+		case t.NetProfitLoss < 0:
+			day.Losses++
+		}
+		day.GrossProfitLoss += t.GrossProfitLoss
+		day.Commission += t.Commission
+		day.NetProfitLoss += t.NetProfitLoss
+	}
+	return days, nil
+}
+
+// toExecutions converts the executions of an order detail (約定失効リスト).
+// The broker does not number executions, so the ID is made of the business day, the order number and the position in the list.
+// The commission and tax are reported per order and are allocated to the executions by quantity.
+// A malformed quantity, price or commission is an error, so that a fill is never stored as 0 shares or 0 yen.
+func toExecutions(detail *response.ResOrderListDetail) ([]*model.Execution, error) {
+	p := summaryParser{}
+	var executions []*model.Execution
+	var total int
+	for i, item := range detail.YakuzyouSikkouList {
+		quantity := int(p.detail("sYakuzyouSuryou", item.YakuzyouSuryou))
+		if p.err != nil {
+			return nil, p.err
+		}
+		if quantity <= 0 {
+			continue // 失効
+		}
+		price := p.detail("sYakuzyouPrice", item.YakuzyouPrice)
+		if p.err != nil {
+			return nil, p.err
+		}
+		if price <= 0 {
+			return nil, fmt.Errorf("execution %d has no price: %q", i+1, item.YakuzyouPrice)
+		}
+		executedAt, err := time.ParseInLocation("20060102150405", item.YakuzyouDate, marketLocation())
+		if err != nil {
+			return nil, fmt.Errorf("invalid execution date %q: %w", item.YakuzyouDate, err)
+		}
+		executions = append(executions, &model.Execution{
+			OrderID:           detail.OrderNumber,
+			ExecutionID:       fmt.Sprintf("%s-%s-%d", detail.EigyouDay, detail.OrderNumber, i+1),
+			ExecutionTime:     executedAt,
+			ExecutionPrice:    price,
+			ExecutionQuantity: quantity,
+		})
+		total += quantity
+	}
+
+	commission := p.detail("sBaiBaiTesuryo", detail.BaiBaiTesuryo) + p.detail("sShouhizei", detail.Shouhizei)
+	if p.err != nil {
+		return nil, p.err
+	}
+	for _, e := range executions {
+		e.Commission = commission * float64(e.ExecutionQuantity) / float64(total)
+	}
+	return executions, nil
+}
+
+// toImportedOrder converts an order placed outside the bot.
+// The order is stored as NEW and moves to the status of its executions like the orders of the bot.
+// Delivery orders (現引・現渡) are not trades and are not imported.
+// A malformed or missing quantity and a malformed price are errors, so that an order is never stored as 0 shares.
+func toImportedOrder(detail *response.ResOrderListDetail) (*model.Order, error) {
+	var tradeType model.TradeType
+	switch detail.OrderBaibaiKubun {
+	case "1":
+		tradeType = model.TradeTypeSell
+	case "3":
+		tradeType = model.TradeTypeBuy
+	default:
+		return nil, fmt.Errorf("unsupported trade type: %q", detail.OrderBaibaiKubun)
+	}
+	orderType := model.OrderTypeMarket
+	if detail.OrderOrderPriceKubun == "2" {
+		orderType = model.OrderTypeLimit
+	}
+	isMargin := detail.GenkinSinyouKubun != "0"
+	accountCode := detail.GenbutuZyoutoekiKazeiC
+	if isMargin {
+		accountCode = detail.SinyouZyoutoekiKazeiC
+	}
+	accountType, err := toAccountType(accountCode)
+	if err != nil {
+		return nil, err
+	}
+	p := summaryParser{}
+	quantity := int(p.detail("sOrderOrderSuryou", detail.OrderOrderSuryou))
+	price := p.detail("sOrderOrderPrice", detail.OrderOrderPrice) // 0 for market orders
+	if p.err != nil {
+		return nil, p.err
+	}
+	if quantity <= 0 {
+		return nil, fmt.Errorf("order has no quantity: %q", detail.OrderOrderSuryou)
+	}
+	return &model.Order{
+		OrderID:     detail.OrderNumber,
+		Symbol:      detail.IssueCode,
+		TradeType:   tradeType,
+		OrderType:   orderType,
+		Quantity:    quantity,
+		Price:       price,
+		TimeInForce: model.TimeInForceDay,
+		OrderStatus: model.OrderStatusNew, // the status follows the executions once they are saved
+		IsMargin:    isMargin,
+		AccountType: accountType,
+	}, nil
+}
+
+// toAccountType converts an account code (譲渡益課税区分).
+// A corporate account is taxed like a general account. An empty code is the bot's default, the specific account.
+func toAccountType(code string) (model.AccountType, error) {
+	switch code {
+	case "", "1":
+		return model.AccountTypeSpecific, nil
+	case "3", "9":
+		return model.AccountTypeGeneral, nil
+	case "5", "6":
+		return model.AccountTypeNISA, nil
+	default:
+		return "", fmt.Errorf("unsupported account type: %q", code)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -29,6 +30,14 @@ type Config struct {
 	DBName            string `env:"DB_NAME"`            // データベース名
 	LogLevel          string `env:"LOG_LEVEL"`          // ログレベル (debug, info, warn, error など)
 	HTTPPort          int    `env:"HTTP_PORT"`          // HTTPサーバーポート番号
+	MasterSyncScope   string `env:"MASTER_SYNC_SCOPE"`  // マスタデータの同期範囲 (watched: 監視銘柄のみ, full: 全銘柄)
+	MasterSyncTime    string `env:"MASTER_SYNC_TIME"`   // 営業日ごとにマスタデータを同期する時刻 (HH:MM, 日本時間, off で無効)
+	NewsPollInterval  time.Duration `env:"NEWS_POLL_INTERVAL"` // 監視銘柄のニュースを取得する間隔 (例: 5m, off の場合は 0 で無効)
+	BalanceSnapshotInterval time.Duration `env:"BALANCE_SNAPSHOT_INTERVAL"` // 残高と委託保証金率を記録する間隔 (例: 5m, off の場合は 0 で無効)
+	MarginAlertRate         float64       `env:"MARGIN_ALERT_RATE"`         // 委託保証金率 (%) がこの値を下回ったら警告し、新規信用建を停止する
+	ExecutionPollInterval   time.Duration `env:"EXECUTION_POLL_INTERVAL"`   // 注文の約定を取得して保存する間隔 (例: 1m, off の場合は 0 で無効)
+	ReportDir               string        `env:"REPORT_DIR"`                // 日次レポート (Markdown と JSON) の出力先ディレクトリ
+	ReportTime              string        `env:"REPORT_TIME"`               // 平日ごとに日次レポートを作成する時刻 (HH:MM, 日本時間, off で無効)
 	WatchedStocks     []string
 }
 
@@ -71,6 +80,38 @@ func LoadConfig(envPath string) (*Config, error) {
 	dbPort := GetInt("DB_PORT", 5432)
 	httpPort := GetInt("HTTP_PORT", 8080)
 	logLevel := GetString("LOG_LEVEL", "info")
+	masterSyncScope := GetString("MASTER_SYNC_SCOPE", "watched")
+	if masterSyncScope != "watched" && masterSyncScope != "full" {
+		return nil, fmt.Errorf("invalid MASTER_SYNC_SCOPE %q: must be watched or full", masterSyncScope)
+	}
+	masterSyncTime := GetString("MASTER_SYNC_TIME", "08:00")
+	var newsPollInterval time.Duration
+	if v := GetString("NEWS_POLL_INTERVAL", "5m"); v != "off" {
+		newsPollInterval, err = time.ParseDuration(v)
+		if err != nil || newsPollInterval <= 0 {
+			return nil, fmt.Errorf("invalid NEWS_POLL_INTERVAL %q: must be a positive duration or off", v)
+		}
+	}
+
+	var balanceSnapshotInterval time.Duration
+	if v := GetString("BALANCE_SNAPSHOT_INTERVAL", "5m"); v != "off" {
+		balanceSnapshotInterval, err = time.ParseDuration(v)
+		if err != nil || balanceSnapshotInterval <= 0 {
+			return nil, fmt.Errorf("invalid BALANCE_SNAPSHOT_INTERVAL %q: must be a positive duration or off", v)
+		}
+	}
+	marginAlertRate, err := strconv.ParseFloat(GetString("MARGIN_ALERT_RATE", "30"), 64)
+	if err != nil || marginAlertRate < 0 {
+		return nil, fmt.Errorf("invalid MARGIN_ALERT_RATE %q: must be a non-negative number", os.Getenv("MARGIN_ALERT_RATE"))
+	}
+
+	var executionPollInterval time.Duration
+	if v := GetString("EXECUTION_POLL_INTERVAL", "1m"); v != "off" {
+		executionPollInterval, err = time.ParseDuration(v)
+		if err != nil || executionPollInterval <= 0 {
+			return nil, fmt.Errorf("invalid EXECUTION_POLL_INTERVAL %q: must be a positive duration or off", v)
+		}
+	}
 
 	// 監視銘柄リストの読み込み
 	watchedStocks, err := loadWatchedStocks("watched_stocks.csv")
@@ -94,6 +135,14 @@ func LoadConfig(envPath string) (*Config, error) {
 		DBName:            GetString("DB_NAME", ""),     // デフォルト値は空文字列
 		LogLevel:          logLevel,
 		HTTPPort:          httpPort,
+		MasterSyncScope:   masterSyncScope,
+		MasterSyncTime:    masterSyncTime,
+		NewsPollInterval:  newsPollInterval,
+		BalanceSnapshotInterval: balanceSnapshotInterval,
+		MarginAlertRate:         marginAlertRate,
+		ExecutionPollInterval:   executionPollInterval,
+		ReportDir:               GetString("REPORT_DIR", "reports"),
+		ReportTime:              GetString("REPORT_TIME", "15:45"),
 		WatchedStocks:     watchedStocks,
 	}, nil
 }
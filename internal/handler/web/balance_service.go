@@ -6,6 +6,9 @@ import (
 	"stock-bot/gen/balance" // This is the Goa-generated service interface and types
 	"stock-bot/internal/app" // This is our app-level use case interface and types
 	"stock-bot/internal/infrastructure/client"
+	"time"
+
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BalanceService implements the balance.Service interface.
@@ -35,15 +38,133 @@ func (s *BalanceService) Get(ctx context.Context) (res *balance.StockbotBalance,
 		return nil, err
 	}
 
-	// Map app.BalanceResult to balance.StockbotBalance (Goa generated type)
+	// Map model.AccountBalance to balance.StockbotBalance (Goa generated type)
 	res = &balance.StockbotBalance{
-		AvailableCashForStock:         balanceResult.AvailableCashForStock,
-		AvailableMarginForNewPosition: balanceResult.AvailableMarginForNewPosition,
-		MarginMaintenanceRate:         balanceResult.MarginMaintenanceRate,
+		AvailableCashForStock:         balanceResult.CashBuyingPower,
+		AvailableMarginForNewPosition: balanceResult.MarginBuyingPower,
+		MarginMaintenanceRate:         balanceResult.MaintenanceRate,
 		WithdrawableCash:              balanceResult.WithdrawableCash,
 		HasMarginCall:                 balanceResult.HasMarginCall,
+		MarginDeliveryCapacity:        balanceResult.MarginDeliveryCapacity,
+		NisaGrowthCapacity:            balanceResult.NisaGrowthCapacity,
+		Shortfall:                     balanceResult.Shortfall,
+		HasAdvance:                    balanceResult.HasAdvance,
+	}
+	if !balanceResult.UpdatedAt.IsZero() {
+		updatedAt := balanceResult.UpdatedAt.Format(time.RFC3339)
+		res.UpdatedAt = &updatedAt
 	}
 
 	s.logger.Info("GetBalance successful", slog.Any("result", res))
 	return res, nil
 }
+
+// History implements the history action.
+func (s *BalanceService) History(ctx context.Context, p *balance.HistoryPayload) (res *balance.StockbotBalanceHistory, err error) {
+	var since time.Time
+	if p.Since != nil {
+		since, err = time.Parse(time.RFC3339, *p.Since)
+		if err != nil {
+			return nil, goa.InvalidFormatError("since", *p.Since, goa.FormatDateTime, err)
+		}
+	}
+	s.logger.Info("GetBalanceHistory called", slog.Time("since", since), slog.Int("limit", p.Limit))
+
+	history, err := s.balanceUseCase.GetBalanceHistory(ctx, s.session, since, p.Limit)
+	if err != nil {
+		s.logger.Error("Failed to get balance history from use case", slog.Any("error", err))
+		return nil, err
+	}
+
+	res = &balance.StockbotBalanceHistory{
+		Daily:     make([]*balance.BalanceDayResult, len(history.Daily)),
+		Snapshots: make([]*balance.BalanceSnapshotResult, len(history.Snapshots)),
+	}
+	for i, d := range history.Daily {
+		res.Daily[i] = &balance.BalanceDayResult{
+			Date:              d.Date,
+			CashBuyingPower:   d.CashBuyingPower,
+			MarginBuyingPower: d.MarginBuyingPower,
+			WithdrawableCash:  d.WithdrawableCash,
+			DepositedMargin:   d.DepositedMargin,
+			RequiredMargin:    d.RequiredMargin,
+			MaintenanceRate:   d.MaintenanceRate,
+			MarginCallSurplus: d.MarginCallSurplus,
+			Shortfall:         d.Shortfall,
+		}
+	}
+	for i, snapshot := range history.Snapshots {
+		res.Snapshots[i] = &balance.BalanceSnapshotResult{
+			TakenAt:             snapshot.TakenAt.Format(time.RFC3339),
+			CashBuyingPower:     snapshot.CashBuyingPower,
+			MarginBuyingPower:   snapshot.MarginBuyingPower,
+			WithdrawableCash:    snapshot.WithdrawableCash,
+			DepositedMargin:     snapshot.DepositedMargin,
+			PositionValue:       snapshot.PositionValue,
+			ValuationProfitLoss: snapshot.ValuationProfitLoss,
+			MaintenanceRate:     snapshot.MaintenanceRate,
+			MarginCallSurplus:   snapshot.MarginCallSurplus,
+			HasMarginCall:       snapshot.HasMarginCall,
+		}
+	}
+	return res, nil
+}
+
+// BuyingPower implements the buying_power action.
+func (s *BalanceService) BuyingPower(ctx context.Context, p *balance.BuyingPowerPayload) (res *balance.StockbotBuyingPower, err error) {
+	s.logger.Info("GetBuyingPower called", slog.Int("day", p.Day))
+
+	detail, err := s.balanceUseCase.GetBuyingPower(ctx, s.session, p.Day)
+	if err != nil {
+		s.logger.Error("Failed to get buying power from use case", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &balance.StockbotBuyingPower{
+		Day:                detail.Day,
+		Date:               detail.Date,
+		CashBuyingPower:    detail.CashBuyingPower,
+		MarginBuyingPower:  detail.MarginBuyingPower,
+		DepositBalance:     detail.DepositBalance,
+		OrderReserved:      detail.OrderReserved,
+		DayTradeRestricted: detail.DayTradeRestricted,
+		OtherRestricted:    detail.OtherRestricted,
+		CashMargin:         detail.CashMargin,
+		RequiredCashMargin: detail.RequiredCashMargin,
+		CollateralValue:    detail.CollateralValue,
+		PositionProfitLoss: detail.PositionProfitLoss,
+		DepositedMargin:    detail.DepositedMargin,
+		RequiredMargin:     detail.RequiredMargin,
+		MarginSurplus:      detail.MarginSurplus,
+	}, nil
+}
+
+// MarginCapacity implements the margin_capacity action.
+func (s *BalanceService) MarginCapacity(ctx context.Context, p *balance.MarginCapacityPayload) (res *balance.StockbotMarginCapacity, err error) {
+	s.logger.Info("GetMarginCapacity called", slog.Int("day", p.Day))
+
+	detail, err := s.balanceUseCase.GetMarginCapacity(ctx, s.session, p.Day)
+	if err != nil {
+		s.logger.Error("Failed to get margin capacity from use case", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &balance.StockbotMarginCapacity{
+		Day:                detail.Day,
+		Date:               detail.Date,
+		MarginBuyingPower:  detail.MarginBuyingPower,
+		DepositedMargin:    detail.DepositedMargin,
+		RequiredMargin:     detail.RequiredMargin,
+		MarginSurplus:      detail.MarginSurplus,
+		CollectionRate:     detail.CollectionRate,
+		DepositBalance:     detail.DepositBalance,
+		OrderReserved:      detail.OrderReserved,
+		CashMargin:         detail.CashMargin,
+		CollateralValue:    detail.CollateralValue,
+		PositionValue:      detail.PositionValue,
+		OrderPositionValue: detail.OrderPositionValue,
+		PositionProfitLoss: detail.PositionProfitLoss,
+		MaintenanceRate:    detail.MaintenanceRate,
+		TodayProfitLoss:    detail.TodayProfitLoss,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package web
+
+import (
+	"context"
+	"log/slog"
+	"stock-bot/gen/margin"
+	"stock-bot/internal/app"
+)
+
+// MarginService implements the margin.Service interface.
+type MarginService struct {
+	marginUseCase app.MarginUseCase
+	logger        *slog.Logger
+}
+
+// NewMarginService creates a new MarginService.
+func NewMarginService(marginUseCase app.MarginUseCase, logger *slog.Logger) *MarginService {
+	return &MarginService{
+		marginUseCase: marginUseCase,
+		logger:        logger,
+	}
+}
+
+// Get implements the get action.
+func (s *MarginService) Get(ctx context.Context, p *margin.GetPayload) (res *margin.StockbotMarginInfo, err error) {
+	since := ""
+	if p.Since != nil {
+		since = *p.Since
+	}
+	s.logger.Info("GetMarginInfo called", slog.String("symbol", p.Symbol), slog.String("since", since), slog.Int("limit", p.Limit))
+
+	info, err := s.marginUseCase.GetMarginInfo(ctx, p.Symbol, since, p.Limit)
+	if err != nil {
+		s.logger.Error("Failed to get margin info from use case", slog.String("symbol", p.Symbol), slog.Any("error", err))
+		return nil, err
+	}
+
+	res = &margin.StockbotMarginInfo{
+		Symbol:            info.Symbol,
+		SecuritiesFinance: make([]*margin.SecuritiesFinanceBalanceResult, 0, len(info.SecuritiesFinance)),
+		Credit:            make([]*margin.CreditBalanceResult, 0, len(info.Credit)),
+		Premiums:          make([]*margin.MarginPremiumResult, 0, len(info.Premiums)),
+	}
+	for _, b := range info.SecuritiesFinance {
+		res.SecuritiesFinance = append(res.SecuritiesFinance, &margin.SecuritiesFinanceBalanceResult{
+			Date:             b.RecordDate,
+			Preliminary:      b.Preliminary,
+			LoanBalance:      b.LoanBalance,
+			LoanChange:       b.LoanChange,
+			StockLoanBalance: b.StockLoanBalance,
+			StockLoanChange:  b.StockLoanChange,
+			NetBalance:       b.NetBalance,
+			NetChange:        b.NetChange,
+			LoanRatio:        b.LoanRatio,
+			TurnoverDays:     b.TurnoverDays,
+		})
+	}
+	for _, b := range info.Credit {
+		res.Credit = append(res.Credit, &margin.CreditBalanceResult{
+			Date:         b.RecordDate,
+			LongBalance:  b.LongBalance,
+			LongChange:   b.LongChange,
+			ShortBalance: b.ShortBalance,
+			ShortChange:  b.ShortChange,
+			Ratio:        b.Ratio,
+		})
+	}
+	for _, p := range info.Premiums {
+		res.Premiums = append(res.Premiums, &margin.MarginPremiumResult{
+			Date:    p.RecordDate,
+			Premium: p.Premium,
+		})
+	}
+	return res, nil
+}
+
+// Collect implements the collect action.
+func (s *MarginService) Collect(ctx context.Context) (res *margin.StockbotMarginCollect, err error) {
+	s.logger.Info("Collect margin info triggered.")
+
+	summary, err := s.marginUseCase.CollectMarginInfo(ctx)
+	if err != nil {
+		s.logger.Error("Failed to collect margin info", slog.Any("error", err))
+		return nil, err
+	}
+	return &margin.StockbotMarginCollect{
+		SecuritiesFinance: summary.SecuritiesFinance,
+		Credit:            summary.Credit,
+		Premiums:          summary.Premiums,
+	}, nil
+}
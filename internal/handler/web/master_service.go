@@ -4,25 +4,29 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"stock-bot/domain/model"
 	"stock-bot/gen/master"
 	"stock-bot/internal/app"
 	"stock-bot/internal/infrastructure/client"
+	"time"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // MasterService implements the master.Service interface.
 type MasterService struct {
-	masterUseCase app.MasterUseCase
-	logger        *slog.Logger
+	masterUseCase       app.MasterUseCase
+	fundamentalsUseCase app.FundamentalsUseCase
+	logger              *slog.Logger
     session       *client.Session
 }
 
 // NewMasterService creates a new MasterService.
-func NewMasterService(masterUseCase app.MasterUseCase, logger *slog.Logger, session *client.Session) *MasterService {
+func NewMasterService(masterUseCase app.MasterUseCase, fundamentalsUseCase app.FundamentalsUseCase, logger *slog.Logger, session *client.Session) *MasterService {
 	return &MasterService{
-		masterUseCase: masterUseCase,
-		logger:        logger,
+		masterUseCase:       masterUseCase,
+		fundamentalsUseCase: fundamentalsUseCase,
+		logger:              logger,
         session:       session,
 	}
 }
@@ -43,29 +47,207 @@ func (s *MasterService) GetStock(ctx context.Context, p *master.GetStockPayload)
 		return nil, err
 	}
 
-	// Map app.StockMasterResult to master.StockbotStockMaster
-	res = &master.StockbotStockMaster{
-		Symbol:       appStockMaster.Symbol,
-		Name:         appStockMaster.Name,
-		NameKana:     &appStockMaster.NameKana,
-		Market:       appStockMaster.Market,
-		IndustryCode: &appStockMaster.IndustryCode,
-		IndustryName: &appStockMaster.IndustryName,
-	}
+	res = toStockbotStockMaster(appStockMaster)
 
 	s.logger.Info("GetStock successful", slog.String("symbol", res.Symbol))
 	return res, nil
 }
 
+// GetFundamentals implements the get_fundamentals action.
+func (s *MasterService) GetFundamentals(ctx context.Context, p *master.GetFundamentalsPayload) (res *master.StockbotStockFundamentals, err error) {
+	s.logger.Info("GetFundamentals called", slog.String("symbol", p.Symbol))
+
+	fundamentals, err := s.fundamentalsUseCase.GetFundamentals(ctx, s.session, p.Symbol)
+	if err != nil {
+		if errors.Is(err, app.ErrNotFound) {
+			return nil, master.MakeNotFound(err)
+		}
+		s.logger.Error("Failed to get fundamentals from use case", slog.String("symbol", p.Symbol), slog.Any("error", err))
+		return nil, err
+	}
+	return toStockbotStockFundamentals(fundamentals), nil
+}
+
+// toStockbotStockFundamentals maps app.FundamentalsResult to master.StockbotStockFundamentals.
+// Values that are not reported (0 or empty) are omitted.
+func toStockbotStockFundamentals(f *app.FundamentalsResult) *master.StockbotStockFundamentals {
+	optionalFloat := func(v float64) *float64 {
+		if v == 0 {
+			return nil
+		}
+		return &v
+	}
+	optionalString := func(v string) *string {
+		if v == "" {
+			return nil
+		}
+		return &v
+	}
+	return &master.StockbotStockFundamentals{
+		Symbol:                f.Symbol,
+		SnapshotDate:          f.SnapshotDate,
+		FetchedAt:             f.FetchedAt.Format(time.RFC3339),
+		Bps:                   optionalFloat(f.BPS),
+		Eps:                   optionalFloat(f.EPS),
+		Roe:                   optionalFloat(f.ROE),
+		DividendPerShare:      optionalFloat(f.DividendPerShare),
+		ReportedPer:           optionalFloat(f.ReportedPER),
+		ReportedPbr:           optionalFloat(f.ReportedPBR),
+		ReportedDividendYield: optionalFloat(f.ReportedDividendYield),
+		EarningsYield:         optionalFloat(f.EarningsYield),
+		ExDividendDate:        optionalString(f.ExDividendDate),
+		InterimExDividendDate: optionalString(f.InterimExDividendDate),
+		LastExRightsDate:      optionalString(f.LastExRightsDate),
+		YearHigh:              optionalFloat(f.YearHigh),
+		YearHighDate:          optionalString(f.YearHighDate),
+		YearLow:               optionalFloat(f.YearLow),
+		YearLowDate:           optionalString(f.YearLowDate),
+		Price:                 f.Price,
+		Per:                   f.PER,
+		Pbr:                   f.PBR,
+		DividendYield:         f.DividendYield,
+	}
+}
+
+// ListStocks implements the list_stocks action.
+func (s *MasterService) ListStocks(ctx context.Context, p *master.ListStocksPayload) (res *master.StockbotStockMasterPage, err error) {
+	params := app.StockSearchParams{
+		Offset: p.Offset,
+		Limit:  p.Limit,
+	}
+	if p.Market != nil {
+		params.Market = *p.Market
+	}
+	if p.IndustryCode != nil {
+		params.IndustryCode = *p.IndustryCode
+	}
+	if p.Q != nil {
+		params.Keyword = *p.Q
+	}
+	if p.TradingUnit != nil {
+		params.TradingUnit = *p.TradingUnit
+	}
+
+	page, err := s.masterUseCase.ListStocks(ctx, params)
+	if err != nil {
+		s.logger.Error("Failed to search stock masters from use case", slog.Any("error", err))
+		return nil, err
+	}
+
+	res = &master.StockbotStockMasterPage{
+		Stocks: make([]*master.StockbotStockMaster, 0, len(page.Stocks)),
+		Total:  page.Total,
+		Offset: page.Offset,
+		Limit:  page.Limit,
+	}
+	for _, stock := range page.Stocks {
+		res.Stocks = append(res.Stocks, toStockbotStockMaster(stock))
+	}
+	return res, nil
+}
+
+// ListIndustries implements the list_industries action.
+func (s *MasterService) ListIndustries(ctx context.Context) (res *master.StockbotIndustryCollection, err error) {
+	industries, err := s.masterUseCase.ListIndustries(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list industries from use case", slog.Any("error", err))
+		return nil, err
+	}
+
+	res = &master.StockbotIndustryCollection{
+		Industries: make([]*master.IndustryResult, 0, len(industries)),
+	}
+	for _, industry := range industries {
+		res.Industries = append(res.Industries, &master.IndustryResult{
+			IndustryCode: industry.Code,
+			IndustryName: industry.Name,
+			Count:        industry.Count,
+		})
+	}
+	return res, nil
+}
+
+// toStockbotStockMaster maps app.StockMasterResult to master.StockbotStockMaster.
+func toStockbotStockMaster(stock *app.StockMasterResult) *master.StockbotStockMaster {
+	res := &master.StockbotStockMaster{
+		Symbol:       stock.Symbol,
+		Name:         stock.Name,
+		NameKana:     &stock.NameKana,
+		Market:       stock.Market,
+		IndustryCode: &stock.IndustryCode,
+		IndustryName: &stock.IndustryName,
+	}
+	if stock.UpperLimit > 0 && stock.LowerLimit > 0 {
+		res.UpperLimit = &stock.UpperLimit
+		res.LowerLimit = &stock.LowerLimit
+	}
+	if stock.TradingUnit > 0 {
+		res.TradingUnit = &stock.TradingUnit
+	}
+	return res
+}
+
 // Update implements the update action.
-func (s *MasterService) Update(ctx context.Context) (err error) {
+func (s *MasterService) Update(ctx context.Context) (res *master.StockbotMasterSyncSummary, err error) {
 	s.logger.Info("Update master data triggered.")
 
-	if err := s.masterUseCase.DownloadAndStoreMasterData(ctx, s.session); err != nil {
+	summary, err := s.masterUseCase.DownloadAndStoreMasterData(ctx, s.session, model.MasterSyncTriggerManual)
+	if err != nil {
 		s.logger.Error("Failed to download and store master data", slog.Any("error", err))
-		return err
+		return nil, err
 	}
 
 	s.logger.Info("Master data update completed successfully.")
-	return nil
+	return toStockbotMasterSyncSummary(summary), nil
+}
+
+// toStockbotMasterSyncSummary maps app.MasterSyncSummary to master.StockbotMasterSyncSummary.
+func toStockbotMasterSyncSummary(summary *app.MasterSyncSummary) *master.StockbotMasterSyncSummary {
+	counts := func(c model.MasterSyncCounts) *master.MasterSyncCounts {
+		return &master.MasterSyncCounts{
+			Inserted:  c.Inserted,
+			Updated:   c.Updated,
+			Unchanged: c.Unchanged,
+			Deleted:   c.Deleted,
+		}
+	}
+	return &master.StockbotMasterSyncSummary{
+		RunID:             summary.RunID,
+		Scope:             string(summary.Scope),
+		Stocks:            counts(summary.Stocks),
+		StockMarkets:      counts(summary.StockMarkets),
+		TickRules:         counts(summary.TickRules),
+		MarginMasters:     counts(summary.MarginMasters),
+		Regulations:       counts(summary.Regulations),
+		OperationStatuses: counts(summary.OperationStatuses),
+	}
+}
+
+// ListSyncRuns implements the list_sync_runs action.
+func (s *MasterService) ListSyncRuns(ctx context.Context, p *master.ListSyncRunsPayload) (res *master.StockbotMasterSyncRunCollection, err error) {
+	runs, err := s.masterUseCase.ListSyncRuns(ctx, p.Limit)
+	if err != nil {
+		s.logger.Error("Failed to list master sync runs from use case", slog.Any("error", err))
+		return nil, err
+	}
+
+	res = &master.StockbotMasterSyncRunCollection{Runs: make([]*master.MasterSyncRun, 0, len(runs))}
+	for _, run := range runs {
+		r := &master.MasterSyncRun{
+			ID:        run.ID,
+			Trigger:   string(run.Trigger),
+			Status:    string(run.Status),
+			StartedAt: run.StartedAt.Format(time.RFC3339),
+			Summary:   toStockbotMasterSyncSummary(&run.Summary),
+		}
+		if run.FinishedAt != nil {
+			finishedAt := run.FinishedAt.Format(time.RFC3339)
+			r.FinishedAt = &finishedAt
+		}
+		if run.Error != "" {
+			r.Error = &run.Error
+		}
+		res.Runs = append(res.Runs, r)
+	}
+	return res, nil
 }
@@ -0,0 +1,96 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"stock-bot/domain/model"
+	"stock-bot/gen/news"
+	"stock-bot/internal/app"
+	"time"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// NewsService implements the news.Service interface.
+type NewsService struct {
+	newsUseCase app.NewsUseCase
+	logger      *slog.Logger
+}
+
+// NewNewsService creates a new NewsService.
+func NewNewsService(newsUseCase app.NewsUseCase, logger *slog.Logger) *NewsService {
+	return &NewsService{
+		newsUseCase: newsUseCase,
+		logger:      logger,
+	}
+}
+
+// List implements the list action.
+func (s *NewsService) List(ctx context.Context, p *news.ListPayload) (res *news.StockbotNewsCollection, err error) {
+	symbol := ""
+	if p.Symbol != nil {
+		symbol = *p.Symbol
+	}
+	var since time.Time
+	if p.Since != nil {
+		since, err = time.Parse(time.RFC3339, *p.Since)
+		if err != nil {
+			return nil, goa.InvalidFormatError("since", *p.Since, goa.FormatDateTime, err)
+		}
+	}
+	s.logger.Info("ListNews called", slog.String("symbol", symbol), slog.Time("since", since), slog.Int("limit", p.Limit))
+
+	items, err := s.newsUseCase.ListNews(ctx, symbol, since, p.Limit)
+	if err != nil {
+		s.logger.Error("Failed to list news from use case", slog.Any("error", err))
+		return nil, err
+	}
+
+	results := make([]*news.NewsResult, len(items))
+	for i, n := range items {
+		results[i] = toNewsResult(n, false)
+	}
+	return &news.StockbotNewsCollection{News: results}, nil
+}
+
+// Get implements the get action.
+func (s *NewsService) Get(ctx context.Context, p *news.GetPayload) (res *news.NewsResult, err error) {
+	s.logger.Info("GetNews called", slog.String("id", p.ID))
+
+	n, err := s.newsUseCase.GetNews(ctx, p.ID)
+	if err != nil {
+		if errors.Is(err, app.ErrNotFound) {
+			return nil, news.MakeNotFound(err)
+		}
+		s.logger.Error("Failed to get news from use case", slog.String("id", p.ID), slog.Any("error", err))
+		return nil, err
+	}
+	return toNewsResult(n, true), nil
+}
+
+// toNewsResult maps model.News to news.NewsResult.
+// The body is included only when withBody is true and the body has been fetched.
+func toNewsResult(n *model.News, withBody bool) *news.NewsResult {
+	result := &news.NewsResult{
+		ID:          n.ID,
+		PublishedAt: n.PublishedAt.Format(time.RFC3339),
+		Categories:  nonNilStrings(n.Categories),
+		Genres:      nonNilStrings(n.Genres),
+		Symbols:     nonNilStrings(n.Symbols),
+		Headline:    n.Headline,
+	}
+	if withBody && n.BodyFetchedAt != nil {
+		body := n.Body
+		result.Body = &body
+	}
+	return result
+}
+
+// nonNilStrings returns an empty slice for nil so that the list is encoded as [].
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -2,6 +2,7 @@ package web
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"stock-bot/domain/model"
 	ordersvr "stock-bot/gen/order"
@@ -42,6 +43,14 @@ func (s *OrderService) Create(ctx context.Context, p *ordersvr.CreatePayload) (r
 	// UseCaseを呼び出す際にsessionを渡す
 	createdOrder, err := s.usecase.ExecuteOrder(ctx, s.session, orderParams)
 	if err != nil {
+		if errors.Is(err, app.ErrInvalidOrder) {
+			s.logger.Warn("Order rejected", "error", err)
+			return nil, ordersvr.MakeInvalidOrder(err)
+		}
+		if errors.Is(err, app.ErrMarginEntryBlocked) {
+			s.logger.Warn("Margin order blocked", "error", err)
+			return nil, ordersvr.MakeMarginEntryBlocked(err)
+		}
 		s.logger.Error("Failed to execute order", "error", err)
 		return nil, err // Goaが適切なエラーレスポンスに変換してくれる
 	}
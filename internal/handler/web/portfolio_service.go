@@ -0,0 +1,97 @@
+package web
+
+import (
+	"context"
+	"log/slog"
+	"stock-bot/gen/portfolio"
+	"stock-bot/internal/app"
+	"stock-bot/internal/infrastructure/client"
+)
+
+// PortfolioService implements the portfolio.Service interface.
+type PortfolioService struct {
+	portfolioUseCase app.PortfolioUseCase
+	logger           *slog.Logger
+	session          *client.Session
+}
+
+// NewPortfolioService creates a new PortfolioService.
+func NewPortfolioService(portfolioUseCase app.PortfolioUseCase, logger *slog.Logger, session *client.Session) *PortfolioService {
+	return &PortfolioService{
+		portfolioUseCase: portfolioUseCase,
+		logger:           logger,
+		session:          session,
+	}
+}
+
+// Get implements the get action.
+func (s *PortfolioService) Get(ctx context.Context) (res *portfolio.StockbotPortfolio, err error) {
+	s.logger.Info("GetPortfolio called")
+
+	result, err := s.portfolioUseCase.GetPortfolio(ctx, s.session)
+	if err != nil {
+		s.logger.Error("Failed to get portfolio from use case", slog.Any("error", err))
+		return nil, err
+	}
+	if len(result.ParseErrors) > 0 {
+		s.logger.Warn("Portfolio has values that could not be parsed", slog.Any("parse_errors", result.ParseErrors))
+	}
+
+	res = &portfolio.StockbotPortfolio{
+		Positions: make([]*portfolio.PositionResult, len(result.Positions)),
+		Totals: &portfolio.PortfolioTotalsResult{
+			PositionCount: result.Totals.PositionCount,
+			MarketValue:   result.Totals.MarketValue,
+			LongValue:     result.Totals.LongValue,
+			ShortValue:    result.Totals.ShortValue,
+			NetExposure:   result.Totals.NetExposure,
+			CostBasis:     result.Totals.CostBasis,
+			UnrealizedPl:  result.Totals.UnrealizedPL,
+		},
+		ByType:     toPortfolioExposures(result.ByType),
+		ByIndustry: toPortfolioExposures(result.ByIndustry),
+		Concentration: &portfolio.PortfolioConcentrationResult{
+			LargestSymbol: result.Concentration.LargestSymbol,
+			LargestWeight: result.Concentration.LargestWeight,
+			Top5Weight:    result.Concentration.Top5Weight,
+			Hhi:           result.Concentration.HHI,
+		},
+		RealizedPl:      result.RealizedPL,
+		RealizedPlToday: result.RealizedPLToday,
+		ParseErrors:     result.ParseErrors,
+	}
+	if res.ParseErrors == nil {
+		res.ParseErrors = []string{}
+	}
+	for i, p := range result.Positions {
+		res.Positions[i] = &portfolio.PositionResult{
+			Symbol:           p.Symbol,
+			PositionType:     string(p.PositionType),
+			Quantity:         p.Quantity,
+			AverageCost:      p.AverageCost,
+			CurrentPrice:     &p.CurrentPrice,
+			UnrealizedPl:     &p.UnrealizedPL,
+			UnrealizedPlRate: &p.UnrealizedPLRate,
+			ParseErrors:      p.ParseErrors,
+		}
+		if p.OpenedDate != "" {
+			res.Positions[i].OpenedDate = &p.OpenedDate
+		}
+	}
+	return res, nil
+}
+
+func toPortfolioExposures(exposures []*app.PortfolioExposure) []*portfolio.PortfolioExposureResult {
+	res := make([]*portfolio.PortfolioExposureResult, len(exposures))
+	for i, e := range exposures {
+		res[i] = &portfolio.PortfolioExposureResult{
+			Code:          e.Code,
+			Name:          e.Name,
+			PositionCount: e.PositionCount,
+			MarketValue:   e.MarketValue,
+			Weight:        e.Weight,
+			UnrealizedPl:  e.UnrealizedPL,
+		}
+	}
+	return res
+}
@@ -81,6 +81,8 @@ func (s *PositionService) List(ctx context.Context, p *position.ListPayload) (re
 
 			OpenedDate:        openedDate,
 
+			ParseErrors:       ap.ParseErrors,
+
 		}
 
 	}
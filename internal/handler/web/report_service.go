@@ -0,0 +1,73 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"stock-bot/gen/report"
+	"stock-bot/internal/app"
+	"time"
+)
+
+// ReportService implements the report.Service interface.
+type ReportService struct {
+	reportUseCase app.ReportUseCase
+	logger        *slog.Logger
+}
+
+// NewReportService creates a new ReportService.
+func NewReportService(reportUseCase app.ReportUseCase, logger *slog.Logger) *ReportService {
+	return &ReportService{
+		reportUseCase: reportUseCase,
+		logger:        logger,
+	}
+}
+
+var reportContentTypes = map[app.ReportFormat]string{
+	app.ReportFormatJSON:     "application/json",
+	app.ReportFormatMarkdown: "text/markdown; charset=utf-8",
+}
+
+// Daily implements the daily action.
+func (s *ReportService) Daily(ctx context.Context, p *report.DailyPayload) (res *report.DailyResult, body io.ReadCloser, err error) {
+	s.logger.Info("GetDailyReport called", slog.String("date", p.Date), slog.String("format", p.Format))
+
+	format := app.ReportFormat(p.Format)
+	data, err := s.reportUseCase.GetDailyReport(ctx, p.Date, format)
+	if err != nil {
+		if errors.Is(err, app.ErrNotFound) {
+			return nil, nil, report.MakeNotFound(err)
+		}
+		s.logger.Error("Failed to get daily report from use case", slog.String("date", p.Date), slog.Any("error", err))
+		return nil, nil, err
+	}
+	return &report.DailyResult{ContentType: reportContentTypes[format]}, io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// Generate implements the generate action.
+func (s *ReportService) Generate(ctx context.Context, p *report.GeneratePayload) (res *report.StockbotDailyReportSummary, err error) {
+	s.logger.Info("GenerateDailyReport called", slog.String("date", p.Date))
+
+	r, err := s.reportUseCase.GenerateDailyReport(ctx, p.Date)
+	if err != nil {
+		s.logger.Error("Failed to generate daily report from use case", slog.String("date", p.Date), slog.Any("error", err))
+		return nil, err
+	}
+	res = &report.StockbotDailyReportSummary{
+		Date:               r.Date,
+		GeneratedAt:        r.GeneratedAt.Format(time.RFC3339),
+		SignalsConsumed:    r.Summary.SignalsConsumed,
+		OrdersPlaced:       r.Summary.OrdersPlaced,
+		OrdersRejected:     r.Summary.OrdersRejected,
+		Fills:              r.Summary.Fills,
+		RiskEvents:         r.Summary.RiskEvents,
+		RealizedProfitLoss: r.ProfitLoss.Realized,
+		Warnings:           r.Warnings,
+	}
+	if r.ProfitLoss.UnrealizedAsOf != nil {
+		res.UnrealizedProfitLoss = &r.ProfitLoss.Unrealized
+	}
+	return res, nil
+}
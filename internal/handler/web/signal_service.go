@@ -0,0 +1,155 @@
+package web
+
+import (
+	"context"
+	"log/slog"
+	"stock-bot/domain/model"
+	"stock-bot/gen/signal"
+	"stock-bot/internal/app"
+	"time"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// SignalService implements the signal.Service interface.
+type SignalService struct {
+	signalUseCase app.SignalUseCase
+	logger        *slog.Logger
+}
+
+// NewSignalService creates a new SignalService.
+func NewSignalService(signalUseCase app.SignalUseCase, logger *slog.Logger) *SignalService {
+	return &SignalService{
+		signalUseCase: signalUseCase,
+		logger:        logger,
+	}
+}
+
+// Create implements the create action.
+func (s *SignalService) Create(ctx context.Context, p *signal.CreatePayload) (res *signal.StockbotSignalIngest, err error) {
+	s.logger.Info("CreateSignals called", slog.Int("count", len(p.Signals)))
+
+	var generatedAt time.Time
+	if p.GeneratedAt != nil {
+		generatedAt, err = time.Parse(time.RFC3339, *p.GeneratedAt)
+		if err != nil {
+			return nil, goa.InvalidFormatError("generated_at", *p.GeneratedAt, goa.FormatDateTime, err)
+		}
+	}
+
+	// Map signal.SignalInput to app.SignalInput
+	inputs := make([]app.SignalInput, len(p.Signals))
+	for i, in := range p.Signals {
+		input := app.SignalInput{
+			Symbol:     in.Symbol,
+			SignalType: model.SignalType(in.Side),
+		}
+		if in.LimitPrice != nil {
+			input.LimitPrice = *in.LimitPrice
+		}
+		if in.StopPrice != nil {
+			input.StopPrice = *in.StopPrice
+		}
+		if in.TargetPrice != nil {
+			input.TargetPrice = *in.TargetPrice
+		}
+		if in.Weight != nil {
+			input.Weight = *in.Weight
+		}
+		if in.Rationale != nil {
+			input.Rationale = *in.Rationale
+		}
+		if in.ValidUntil != nil {
+			validUntil, err := time.Parse(time.RFC3339, *in.ValidUntil)
+			if err != nil {
+				return nil, goa.InvalidFormatError("valid_until", *in.ValidUntil, goa.FormatDateTime, err)
+			}
+			input.ValidUntil = &validUntil
+		}
+		inputs[i] = input
+	}
+
+	result, err := s.signalUseCase.IngestSignals(ctx, generatedAt, inputs)
+	if err != nil {
+		s.logger.Error("Failed to ingest signals from use case", slog.Any("error", err))
+		return nil, err
+	}
+
+	rejected := make([]*signal.SignalRejection, len(result.Rejected))
+	for i, r := range result.Rejected {
+		rejected[i] = &signal.SignalRejection{
+			Index:  r.Index,
+			Symbol: r.Symbol,
+			Reason: r.Reason,
+		}
+	}
+
+	res = &signal.StockbotSignalIngest{
+		Accepted:  len(result.SignalIDs),
+		SignalIds: result.SignalIDs,
+		Rejected:  rejected,
+	}
+
+	s.logger.Info("CreateSignals successful", slog.Int("accepted", res.Accepted), slog.Int("rejected", len(rejected)))
+	return res, nil
+}
+
+// List implements the list action.
+func (s *SignalService) List(ctx context.Context, p *signal.ListPayload) (res *signal.StockbotSignalCollection, err error) {
+	symbol := ""
+	if p.Symbol != nil {
+		symbol = *p.Symbol
+	}
+	s.logger.Info("ListSignals called", slog.String("symbol", symbol), slog.Int("limit", p.Limit))
+
+	signals, err := s.signalUseCase.ListSignals(ctx, symbol, p.Limit)
+	if err != nil {
+		s.logger.Error("Failed to list signals from use case", slog.Any("error", err))
+		return nil, err
+	}
+
+	results := make([]*signal.SignalResult, len(signals))
+	for i, sig := range signals {
+		results[i] = toSignalResult(sig)
+	}
+
+	return &signal.StockbotSignalCollection{Signals: results}, nil
+}
+
+// toSignalResult maps model.Signal to signal.SignalResult.
+func toSignalResult(sig *model.Signal) *signal.SignalResult {
+	result := &signal.SignalResult{
+		ID:          sig.ID,
+		Symbol:      sig.Symbol,
+		Side:        string(sig.SignalType),
+		GeneratedAt: sig.GeneratedAt.Format(time.RFC3339),
+		Source:      string(sig.Source),
+	}
+	if sig.Rationale != "" {
+		result.Rationale = &sig.Rationale
+	}
+	if sig.Price > 0 {
+		result.LimitPrice = &sig.Price
+	}
+	if sig.StopPrice > 0 {
+		result.StopPrice = &sig.StopPrice
+	}
+	if sig.TargetPrice > 0 {
+		result.TargetPrice = &sig.TargetPrice
+	}
+	if sig.Weight > 0 {
+		result.Weight = &sig.Weight
+	}
+	if sig.ExpiresAt != nil {
+		validUntil := sig.ExpiresAt.Format(time.RFC3339)
+		result.ValidUntil = &validUntil
+	}
+	if sig.SourceFile != "" {
+		result.SourceFile = &sig.SourceFile
+	}
+	if sig.ConsumedAt != nil {
+		consumedAt := sig.ConsumedAt.Format(time.RFC3339)
+		result.ConsumedAt = &consumedAt
+	}
+	return result
+}
@@ -0,0 +1,124 @@
+package web
+
+import (
+	"context"
+	"log/slog"
+	"stock-bot/domain/model"
+	"stock-bot/gen/trade"
+	"stock-bot/internal/app"
+	"time"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// TradeService implements the trade.Service interface.
+type TradeService struct {
+	tradeUseCase app.TradeUseCase
+	logger       *slog.Logger
+}
+
+// NewTradeService creates a new TradeService.
+func NewTradeService(tradeUseCase app.TradeUseCase, logger *slog.Logger) *TradeService {
+	return &TradeService{
+		tradeUseCase: tradeUseCase,
+		logger:       logger,
+	}
+}
+
+// List implements the list action.
+func (s *TradeService) List(ctx context.Context, p *trade.ListPayload) (res *trade.StockbotTradeCollection, err error) {
+	query := app.TradeQuery{Method: model.CostMethod(p.Method), Limit: p.Limit}
+	if p.Symbol != nil {
+		query.Symbol = *p.Symbol
+	}
+	if p.Since != nil {
+		query.Since, err = time.Parse(time.RFC3339, *p.Since)
+		if err != nil {
+			return nil, goa.InvalidFormatError("since", *p.Since, goa.FormatDateTime, err)
+		}
+	}
+	if p.Until != nil {
+		query.Until, err = time.Parse(time.RFC3339, *p.Until)
+		if err != nil {
+			return nil, goa.InvalidFormatError("until", *p.Until, goa.FormatDateTime, err)
+		}
+	}
+	s.logger.Info("ListTrades called", slog.String("symbol", query.Symbol), slog.String("method", p.Method), slog.Time("since", query.Since), slog.Time("until", query.Until), slog.Int("limit", p.Limit))
+
+	trades, err := s.tradeUseCase.ListTrades(ctx, query)
+	if err != nil {
+		s.logger.Error("Failed to list trades from use case", slog.Any("error", err))
+		return nil, err
+	}
+
+	results := make([]*trade.TradeResult, len(trades))
+	for i, t := range trades {
+		results[i] = &trade.TradeResult{
+			Symbol:          t.Symbol,
+			IsMargin:        t.IsMargin,
+			Side:            string(t.Side),
+			Quantity:        t.Quantity,
+			EntryOrderID:    t.EntryOrderID,
+			ExitOrderID:     t.ExitOrderID,
+			EntryTime:       t.EntryTime.Format(time.RFC3339),
+			ExitTime:        t.ExitTime.Format(time.RFC3339),
+			EntryPrice:      t.EntryPrice,
+			ExitPrice:       t.ExitPrice,
+			Commission:      t.Commission,
+			GrossProfitLoss: t.GrossProfitLoss,
+			NetProfitLoss:   t.NetProfitLoss,
+		}
+	}
+	return &trade.StockbotTradeCollection{Trades: results}, nil
+}
+
+// DailyPnl implements the daily_pnl action.
+func (s *TradeService) DailyPnl(ctx context.Context, p *trade.DailyPnlPayload) (res *trade.StockbotDailyPnlCollection, err error) {
+	query := app.DailyProfitLossQuery{Method: model.CostMethod(p.Method)}
+	if p.From != nil {
+		query.From = *p.From
+	}
+	if p.To != nil {
+		query.To = *p.To
+	}
+	s.logger.Info("GetDailyProfitLoss called", slog.String("method", p.Method), slog.String("from", query.From), slog.String("to", query.To))
+
+	days, err := s.tradeUseCase.GetDailyProfitLoss(ctx, query)
+	if err != nil {
+		s.logger.Error("Failed to get daily P&L from use case", slog.Any("error", err))
+		return nil, err
+	}
+
+	results := make([]*trade.DailyProfitLossResult, len(days))
+	for i, d := range days {
+		results[i] = &trade.DailyProfitLossResult{
+			Date:            d.Date,
+			Trades:          d.Trades,
+			Wins:            d.Wins,
+			Losses:          d.Losses,
+			GrossProfitLoss: d.GrossProfitLoss,
+			Commission:      d.Commission,
+			NetProfitLoss:   d.NetProfitLoss,
+		}
+	}
+	return &trade.StockbotDailyPnlCollection{Days: results}, nil
+}
+
+// Sync implements the sync action.
+func (s *TradeService) Sync(ctx context.Context) (res *trade.StockbotExecutionSync, err error) {
+	s.logger.Info("SyncExecutions called")
+
+	summary, err := s.tradeUseCase.SyncExecutions(ctx)
+	if err != nil {
+		s.logger.Error("Failed to sync executions from use case", slog.Any("error", err))
+		return nil, err
+	}
+	return &trade.StockbotExecutionSync{
+		Orders:     summary.Orders,
+		Imported:   summary.Imported,
+		Executions: summary.Executions,
+		Lots:       summary.Lots,
+		Closed:     summary.Closed,
+		Skipped:    summary.Skipped,
+	}, nil
+}
@@ -11,6 +11,8 @@ import (
 type MasterDataClient interface {
 	// DownloadMasterData は、各種マスタ情報をリアルタイム配信でダウンロード
 	DownloadMasterData(ctx context.Context, session *Session, req request.ReqDownloadMaster) (*response.ResDownloadMaster, error)
+	// StreamMasterData は、各種マスタ情報をリアルタイム配信でダウンロードし、1件ずつ handle に渡す
+	StreamMasterData(ctx context.Context, session *Session, req request.ReqDownloadMaster, handle MasterRecordHandler) error
 	// GetMasterDataQuery は、指定したマスタ情報を取得（複数指定、項目指定可能）
 	GetMasterDataQuery(ctx context.Context, session *Session, req request.ReqGetMasterData) (*response.ResGetMasterData, error)
 	// GetNewsHeader は、指定した条件のニュースヘッダーを取得
@@ -0,0 +1,137 @@
+// internal/infrastructure/client/master_data_stream.go
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+
+	"stock-bot/internal/infrastructure/client/dto/master/response"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// clmidDownloadComplete は、マスタ情報の配信完了を表す sCLMID
+const clmidDownloadComplete = "CLMEventDownloadComplete"
+
+// ErrMasterDownloadIncomplete は、配信完了の通知 (CLMEventDownloadComplete) を受信する前にストリームが終了した場合に返される
+var ErrMasterDownloadIncomplete = errors.New("download master data stream finished without complete signal")
+
+// MasterRecordHandler は、マスタ情報を1件ずつ受け取るコールバック
+// record は sCLMID に対応する response パッケージの型の値 (例: CLMIssueMstKabu なら response.ResStockMaster)
+// エラーを返すとストリームの読み取りを中断し、そのエラーを返す
+type MasterRecordHandler func(clmid string, record any) error
+
+// masterRecordDecoders は、sCLMID ごとにマスタ情報を型付きの値に変換する関数
+var masterRecordDecoders = map[string]func(item map[string]interface{}) (any, error){
+	"CLMSystemStatus":          decodeMasterRecord[response.ResSystemStatus],
+	"CLMDateZyouhou":           decodeMasterRecord[response.ResDateInfo],
+	"CLMYobine":                decodeMasterRecord[response.ResTickRule],
+	"CLMUnyouStatus":           decodeMasterRecord[response.ResOperationStatus],
+	"CLMUnyouStatusKabu":       decodeMasterRecord[response.ResOperationStatus],
+	"CLMUnyouStatusHasei":      decodeMasterRecord[response.ResOperationStatus],
+	"CLMIssueMstKabu":          decodeMasterRecord[response.ResStockMaster],
+	"CLMIssueSizyouMstKabu":    decodeMasterRecord[response.ResStockMarketMaster],
+	"CLMIssueSizyouKiseiKabu":  decodeMasterRecord[response.ResStockIssueRegulation],
+	"CLMIssueMstSak":           decodeMasterRecord[response.ResFutureMaster],
+	"CLMIssueMstOp":            decodeMasterRecord[response.ResOptionMaster],
+	"CLMIssueSizyouKiseiHasei": decodeMasterRecord[response.ResFutureOptionRegulation],
+	"CLMDaiyouKakeme":          decodeMasterRecord[response.ResMarginRate],
+	"CLMHosyoukinMst":          decodeMasterRecord[response.ResMarginMaster],
+	"CLMOrderErrReason":        decodeMasterRecord[response.ResErrorReason],
+}
+
+func decodeMasterRecord[T any](item map[string]interface{}) (any, error) {
+	var record T
+	if err := convertMapToStruct(item, &record, ""); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// DecodeMasterDataStream は、Shift-JIS で配信されるマスタ情報のストリームを読み取り、1件ずつ handle に渡す
+//
+// ストリームは JSON オブジェクトが連続したもので、json.Decoder で1オブジェクトずつ読み取るため、
+// メモリに保持するのは読み取り中のオブジェクト1件分のみ。
+// 配信完了の通知 (CLMEventDownloadComplete) を受信した時点で読み取りを終了する。
+// 型に変換できないマスタ情報と未知の sCLMID は、ログに出力して読み飛ばす。
+func DecodeMasterDataStream(r io.Reader, handle MasterRecordHandler) error {
+	decoder := json.NewDecoder(transform.NewReader(r, japanese.ShiftJIS.NewDecoder()))
+	for {
+		var item map[string]interface{}
+		if err := decoder.Decode(&item); err != nil {
+			if errors.Is(err, io.EOF) {
+				return ErrMasterDownloadIncomplete
+			}
+			return errors.Wrap(err, "failed to decode master data stream")
+		}
+
+		clmid, _ := item["sCLMID"].(string)
+		if clmid == "" {
+			slog.Warn("sCLMID not found in master data item")
+			continue
+		}
+		if clmid == clmidDownloadComplete {
+			slog.Info("CLMEventDownloadComplete received, download finished.")
+			return nil
+		}
+
+		decode, ok := masterRecordDecoders[clmid]
+		if !ok {
+			slog.Warn("Unknown master data type", slog.String("sCLMID", clmid))
+			continue
+		}
+		record, err := decode(item)
+		if err != nil {
+			slog.Error("failed to map master data", slog.String("sCLMID", clmid), slog.Any("error", err))
+			continue
+		}
+		if err := handle(clmid, record); err != nil {
+			return err
+		}
+	}
+}
+
+// collectMasterRecords は、受け取ったマスタ情報を res に追加する MasterRecordHandler を返す
+func collectMasterRecords(res *response.ResDownloadMaster) MasterRecordHandler {
+	return func(clmid string, record any) error {
+		switch r := record.(type) {
+		case response.ResSystemStatus:
+			res.SystemStatus = r
+		case response.ResDateInfo:
+			res.DateInfo = append(res.DateInfo, r)
+		case response.ResTickRule:
+			res.TickRule = append(res.TickRule, r)
+		case response.ResOperationStatus:
+			switch clmid {
+			case "CLMUnyouStatusKabu":
+				res.OperationStatusStock = append(res.OperationStatusStock, r)
+			case "CLMUnyouStatusHasei":
+				res.OperationStatusDerivative = append(res.OperationStatusDerivative, r)
+			default:
+				res.OperationStatus = append(res.OperationStatus, r)
+			}
+		case response.ResStockMaster:
+			res.StockMaster = append(res.StockMaster, r)
+		case response.ResStockMarketMaster:
+			res.StockMarketMaster = append(res.StockMarketMaster, r)
+		case response.ResStockIssueRegulation:
+			res.StockIssueRegulation = append(res.StockIssueRegulation, r)
+		case response.ResFutureMaster:
+			res.FutureMaster = append(res.FutureMaster, r)
+		case response.ResOptionMaster:
+			res.OptionMaster = append(res.OptionMaster, r)
+		case response.ResFutureOptionRegulation:
+			res.FutureOptionRegulation = append(res.FutureOptionRegulation, r)
+		case response.ResMarginRate:
+			res.MarginRate = append(res.MarginRate, r)
+		case response.ResMarginMaster:
+			res.MarginMaster = append(res.MarginMaster, r)
+		case response.ResErrorReason:
+			res.ErrorReason = append(res.ErrorReason, r)
+		}
+		return nil
+	}
+}
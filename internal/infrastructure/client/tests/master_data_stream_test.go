@@ -0,0 +1,171 @@
+// internal/infrastructure/client/tests/master_data_stream_test.go
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"stock-bot/internal/config"
+	"stock-bot/internal/infrastructure/client"
+	"stock-bot/internal/infrastructure/client/dto/master/request"
+	"stock-bot/internal/infrastructure/client/dto/master/response"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// masterStreamJSON は配信されるマスタ情報の例
+// 文字列中の括弧やネストしたオブジェクト、マルチバイト文字を含む
+const masterStreamJSON = `{"sCLMID":"CLMSystemStatus","sSystemStatusKey":"001","sSystemStatus":"1"}
+{"sCLMID":"CLMIssueMstKabu","sIssueCode":"7203","sIssueName":"トヨタ自動車{株}","sIssueNameRyaku":"トヨタ","sBaibaiTani":"100"}` +
+	`{"sCLMID":"CLMIssueMstKabu","sIssueCode":"9984","sIssueName":"ソフトバンクグループ","sExtra":{"nested":"}{"}}` + "\r\n" +
+	`{"sCLMID":"CLMUnknown","sValue":"x"}` +
+	`{"sCLMID":"CLMUnyouStatusKabu","sUnyouUnit":"0101"}` +
+	`{"sCLMID":"CLMEventDownloadComplete"}` +
+	`{"sCLMID":"CLMIssueMstKabu","sIssueCode":"0000"}`
+
+// chunkedReader は data を指定した位置で分割して返す
+type chunkedReader struct {
+	chunks [][]byte
+}
+
+func (r *chunkedReader) Read(p []byte) (int, error) {
+	for len(r.chunks) > 0 && len(r.chunks[0]) == 0 {
+		r.chunks = r.chunks[1:]
+	}
+	if len(r.chunks) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, r.chunks[0])
+	r.chunks[0] = r.chunks[0][n:]
+	return n, nil
+}
+
+func encodeShiftJIS(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := japanese.ShiftJIS.NewEncoder().Bytes([]byte(s))
+	require.NoError(t, err)
+	return b
+}
+
+type streamedRecord struct {
+	clmid  string
+	record any
+}
+
+func decodeAll(r io.Reader) ([]streamedRecord, error) {
+	var records []streamedRecord
+	err := client.DecodeMasterDataStream(r, func(clmid string, record any) error {
+		records = append(records, streamedRecord{clmid, record})
+		return nil
+	})
+	return records, err
+}
+
+func assertStreamedRecords(t *testing.T, records []streamedRecord) {
+	t.Helper()
+	require.Len(t, records, 4)
+
+	assert.Equal(t, "CLMSystemStatus", records[0].clmid)
+	assert.Equal(t, "1", records[0].record.(response.ResSystemStatus).SystemStatus)
+
+	toyota, ok := records[1].record.(response.ResStockMaster)
+	require.True(t, ok)
+	assert.Equal(t, "7203", toyota.IssueCode)
+	assert.Equal(t, "トヨタ自動車{株}", toyota.IssueName)
+	assert.Equal(t, "100", toyota.TradingUnit)
+
+	softbank, ok := records[2].record.(response.ResStockMaster)
+	require.True(t, ok)
+	assert.Equal(t, "ソフトバンクグループ", softbank.IssueName)
+
+	// 未知の sCLMID は読み飛ばされる
+	assert.Equal(t, "CLMUnyouStatusKabu", records[3].clmid)
+	assert.IsType(t, response.ResOperationStatus{}, records[3].record)
+}
+
+func TestDecodeMasterDataStream_SplitAtEveryOffset(t *testing.T) {
+	data := encodeShiftJIS(t, masterStreamJSON)
+
+	for offset := 0; offset <= len(data); offset++ {
+		first := append([]byte(nil), data[:offset]...)
+		second := append([]byte(nil), data[offset:]...)
+		records, err := decodeAll(&chunkedReader{chunks: [][]byte{first, second}})
+		if !assert.NoError(t, err, "offset %d", offset) {
+			continue
+		}
+		assertStreamedRecords(t, records)
+		if t.Failed() {
+			t.Fatalf("failed when split at offset %d", offset)
+		}
+	}
+}
+
+func TestDecodeMasterDataStream_OneByteAtATime(t *testing.T) {
+	data := encodeShiftJIS(t, masterStreamJSON)
+
+	records, err := decodeAll(iotest.OneByteReader(bytes.NewReader(data)))
+	require.NoError(t, err)
+	assertStreamedRecords(t, records)
+}
+
+func TestDecodeMasterDataStream_WithoutCompleteSignal(t *testing.T) {
+	data := encodeShiftJIS(t, `{"sCLMID":"CLMIssueMstKabu","sIssueCode":"7203"}`)
+
+	records, err := decodeAll(bytes.NewReader(data))
+	assert.ErrorIs(t, err, client.ErrMasterDownloadIncomplete)
+	assert.Len(t, records, 1)
+}
+
+func TestDecodeMasterDataStream_TruncatedRecord(t *testing.T) {
+	data := encodeShiftJIS(t, `{"sCLMID":"CLMIssueMstKabu","sIssueCode":"7203"}{"sCLMID":"CLMIssueMst`)
+
+	_, err := decodeAll(bytes.NewReader(data))
+	assert.Error(t, err)
+}
+
+func TestDecodeMasterDataStream_HandlerErrorStopsReading(t *testing.T) {
+	data := encodeShiftJIS(t, masterStreamJSON)
+	stop := errors.New("stop")
+
+	calls := 0
+	err := client.DecodeMasterDataStream(bytes.NewReader(data), func(clmid string, record any) error {
+		calls++
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, calls)
+}
+
+func TestMasterDataClientImpl_DownloadMasterDataFromStream(t *testing.T) {
+	data := encodeShiftJIS(t, masterStreamJSON)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// マスタ情報を少しずつ配信する
+		flusher := w.(http.Flusher)
+		for start := 0; start < len(data); start += 7 {
+			w.Write(data[start:min(start+7, len(data))])
+			flusher.Flush()
+		}
+	}))
+	defer server.Close()
+
+	c := client.NewTachibanaClient(&config.Config{TachibanaBaseURL: server.URL})
+	session := client.NewSession()
+	session.MasterURL = server.URL
+
+	res, err := c.DownloadMasterData(context.Background(), session, request.ReqDownloadMaster{})
+	require.NoError(t, err)
+	assert.Equal(t, "1", res.SystemStatus.SystemStatus)
+	if assert.Len(t, res.StockMaster, 2) {
+		assert.Equal(t, "7203", res.StockMaster[0].IssueCode)
+		assert.Equal(t, "9984", res.StockMaster[1].IssueCode)
+	}
+	assert.Len(t, res.OperationStatusStock, 1)
+	assert.Empty(t, res.OperationStatus)
+}
@@ -0,0 +1,44 @@
+// internal/infrastructure/repository/balance_repository_impl.go
+package repository
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+
+	"github.com/cockroachdb/errors"
+	"gorm.io/gorm"
+)
+
+type balanceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBalanceRepository(db *gorm.DB) repository.BalanceRepository {
+	return &balanceRepositoryImpl{db: db}
+}
+
+func (r *balanceRepositoryImpl) SaveSnapshot(ctx context.Context, snapshot *model.BalanceSnapshot) error {
+	if err := r.db.WithContext(ctx).Create(snapshot).Error; err != nil {
+		return errors.Wrap(err, "failed to save balance snapshot")
+	}
+	return nil
+}
+
+func (r *balanceRepositoryImpl) FindSnapshots(ctx context.Context, query repository.BalanceSnapshotQuery) ([]*model.BalanceSnapshot, error) {
+	db := r.db.WithContext(ctx)
+	if !query.Since.IsZero() {
+		db = db.Where("taken_at >= ?", query.Since)
+	}
+	if !query.Until.IsZero() {
+		db = db.Where("taken_at < ?", query.Until)
+	}
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
+	}
+	var snapshots []*model.BalanceSnapshot
+	if err := db.Order("taken_at DESC").Find(&snapshots).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find balance snapshots")
+	}
+	return snapshots, nil
+}
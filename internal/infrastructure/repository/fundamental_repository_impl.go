@@ -0,0 +1,62 @@
+// internal/infrastructure/repository/fundamental_repository_impl.go
+package repository
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+
+	"github.com/cockroachdb/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type fundamentalRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFundamentalRepository(db *gorm.DB) repository.FundamentalRepository {
+	return &fundamentalRepositoryImpl{db: db}
+}
+
+func (r *fundamentalRepositoryImpl) UpsertFundamentals(ctx context.Context, fundamentals []*model.StockFundamental) error {
+	if len(fundamentals) == 0 {
+		return nil
+	}
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "issue_code"}, {Name: "snapshot_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"bps", "eps", "roe", "reported_per", "reported_pbr", "earnings_yield", "reported_dividend_yield",
+			"dividend_per_share", "ex_dividend_date", "interim_ex_dividend_date", "last_ex_rights_date",
+			"year_high", "year_high_date", "year_low", "year_low_date", "fetched_at", "updated_at",
+		}),
+	}).Create(fundamentals)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to upsert stock fundamentals")
+	}
+	return nil
+}
+
+func (r *fundamentalRepositoryImpl) FindFundamental(ctx context.Context, issueCode string, snapshotDate string) (*model.StockFundamental, error) {
+	var fundamental model.StockFundamental
+	result := r.db.WithContext(ctx).Where("issue_code = ? AND snapshot_date = ?", issueCode, snapshotDate).First(&fundamental)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(result.Error, "failed to find stock fundamental")
+	}
+	return &fundamental, nil
+}
+
+func (r *fundamentalRepositoryImpl) FindLatestFundamental(ctx context.Context, issueCode string) (*model.StockFundamental, error) {
+	var fundamental model.StockFundamental
+	result := r.db.WithContext(ctx).Where("issue_code = ?", issueCode).Order("snapshot_date DESC").First(&fundamental)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(result.Error, "failed to find latest stock fundamental")
+	}
+	return &fundamental, nil
+}
@@ -0,0 +1,114 @@
+// internal/infrastructure/repository/margin_info_repository_impl.go
+package repository
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+
+	"github.com/cockroachdb/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type marginInfoRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewMarginInfoRepository(db *gorm.DB) repository.MarginInfoRepository {
+	return &marginInfoRepositoryImpl{db: db}
+}
+
+// upsertByIssueCodeAndDate は銘柄・日付が同じ行を updateColumns で上書きして保存する
+func upsertByIssueCodeAndDate[T any](ctx context.Context, db *gorm.DB, rows []*T, updateColumns []string) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "issue_code"}, {Name: "record_date"}},
+		DoUpdates: clause.AssignmentColumns(append(updateColumns, "fetched_at", "updated_at")),
+	}).CreateInBatches(rows, 500).Error
+}
+
+// findByMarginInfoQuery は条件に一致する行を日付の新しい順に取得する
+func findByMarginInfoQuery[T any](ctx context.Context, db *gorm.DB, query repository.MarginInfoQuery) ([]*T, error) {
+	tx := db.WithContext(ctx)
+	if query.IssueCode != "" {
+		tx = tx.Where("issue_code = ?", query.IssueCode)
+	}
+	if query.Since != "" {
+		tx = tx.Where("record_date >= ?", query.Since)
+	}
+	if query.Limit > 0 {
+		tx = tx.Limit(query.Limit)
+	}
+	var rows []*T
+	err := tx.Order("record_date DESC, issue_code").Find(&rows).Error
+	return rows, err
+}
+
+func (r *marginInfoRepositoryImpl) UpsertSecuritiesFinanceBalances(ctx context.Context, balances []*model.SecuritiesFinanceBalance) error {
+	err := upsertByIssueCodeAndDate(ctx, r.db, balances, []string{
+		"preliminary", "loan_balance", "loan_change", "loan_new", "loan_repaid",
+		"stock_loan_balance", "stock_loan_change", "stock_loan_new", "stock_loan_repaid",
+		"net_balance", "net_change", "loan_ratio", "turnover_days",
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to upsert securities finance balances")
+	}
+	return nil
+}
+
+func (r *marginInfoRepositoryImpl) UpsertCreditBalances(ctx context.Context, balances []*model.CreditBalance) error {
+	err := upsertByIssueCodeAndDate(ctx, r.db, balances, []string{
+		"long_balance", "long_change", "short_balance", "short_change", "ratio",
+		"standard_long_balance", "standard_short_balance", "negotiable_long_balance", "negotiable_short_balance",
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to upsert credit balances")
+	}
+	return nil
+}
+
+func (r *marginInfoRepositoryImpl) UpsertMarginPremiums(ctx context.Context, premiums []*model.MarginPremiumRecord) error {
+	if err := upsertByIssueCodeAndDate(ctx, r.db, premiums, []string{"premium"}); err != nil {
+		return errors.Wrap(err, "failed to upsert margin premiums")
+	}
+	return nil
+}
+
+func (r *marginInfoRepositoryImpl) FindSecuritiesFinanceBalances(ctx context.Context, query repository.MarginInfoQuery) ([]*model.SecuritiesFinanceBalance, error) {
+	balances, err := findByMarginInfoQuery[model.SecuritiesFinanceBalance](ctx, r.db, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find securities finance balances")
+	}
+	return balances, nil
+}
+
+func (r *marginInfoRepositoryImpl) FindCreditBalances(ctx context.Context, query repository.MarginInfoQuery) ([]*model.CreditBalance, error) {
+	balances, err := findByMarginInfoQuery[model.CreditBalance](ctx, r.db, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find credit balances")
+	}
+	return balances, nil
+}
+
+func (r *marginInfoRepositoryImpl) FindMarginPremiums(ctx context.Context, query repository.MarginInfoQuery) ([]*model.MarginPremiumRecord, error) {
+	premiums, err := findByMarginInfoQuery[model.MarginPremiumRecord](ctx, r.db, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find margin premiums")
+	}
+	return premiums, nil
+}
+
+func (r *marginInfoRepositoryImpl) FindLatestMarginPremium(ctx context.Context, issueCode string) (*model.MarginPremiumRecord, error) {
+	var premium model.MarginPremiumRecord
+	result := r.db.WithContext(ctx).Where("issue_code = ?", issueCode).Order("record_date DESC").First(&premium)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(result.Error, "failed to find latest margin premium")
+	}
+	return &premium, nil
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"stock-bot/domain/model"
 	"stock-bot/domain/repository"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -46,7 +48,8 @@ func (r *masterRepositoryImpl) FindByIssueCode(ctx context.Context, issueCode st
 	case "StockMaster":
 		//entity = &model.StockMaster{} // ポインタにする必要があるので修正
 		var stockMaster model.StockMaster
-		result := r.db.WithContext(ctx).Where("issue_code = ?", issueCode).First(&stockMaster)
+		// DeletedAt は gorm.DeletedAt ではないため、論理削除 (上場廃止) された銘柄は明示的に除外する
+		result := r.db.WithContext(ctx).Where("issue_code = ? AND deleted_at IS NULL", issueCode).First(&stockMaster)
 		if result.Error != nil {
 			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 				return nil, nil // NotFoundの場合はnilを返す
@@ -72,6 +75,8 @@ func (r *masterRepositoryImpl) FindByIssueCode(ctx context.Context, issueCode st
 
 // dbStockMaster is a DTO for database operations, excluding relational fields.
 type dbStockMaster struct {
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
 	IssueCode               string
 	IssueName               string
 	IssueNameShort          string
@@ -84,6 +89,8 @@ type dbStockMaster struct {
 	ListedSharesOutstanding int64
 	UpperLimit              float64
 	LowerLimit              float64
+	TickUnitNumber          string
+	NextTickUnitNumber      string
 }
 
 // TableName explicitly sets the table name for the DTO to match the domain model's table.
@@ -92,6 +99,13 @@ func (dbStockMaster) TableName() string {
 }
 
 func (r *masterRepositoryImpl) UpsertStockMasters(ctx context.Context, stocks []*model.StockMaster) error {
+	return upsertStockMasters(r.db.WithContext(ctx), stocks)
+}
+
+// stockMasterUpsertBatchSize は一度に Upsert する銘柄数 (PostgreSQLのプレースホルダ数の上限を超えないようにする)
+const stockMasterUpsertBatchSize = 1000
+
+func upsertStockMasters(db *gorm.DB, stocks []*model.StockMaster) error {
 	if len(stocks) == 0 {
 		return nil
 	}
@@ -113,6 +127,8 @@ func (r *masterRepositoryImpl) UpsertStockMasters(ctx context.Context, stocks []
 			ListedSharesOutstanding: s.ListedSharesOutstanding,
 			UpperLimit:              s.UpperLimit,
 			LowerLimit:              s.LowerLimit,
+			TickUnitNumber:          s.TickUnitNumber,
+			NextTickUnitNumber:      s.NextTickUnitNumber,
 		})
 	}
 
@@ -129,14 +145,17 @@ func (r *masterRepositoryImpl) UpsertStockMasters(ctx context.Context, stocks []
 		"listed_shares_outstanding",
 		"upper_limit",
 		"lower_limit",
+		"tick_unit_number",
+		"next_tick_unit_number",
 		"updated_at", // Explicitly update timestamp
+		"deleted_at", // Restore stocks that were soft-deleted and listed again
 	}
 
 	// Use the DTO slice for the Create operation. GORM will not see the relational field.
-	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+	result := db.Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "issue_code"}},
 		DoUpdates: clause.AssignmentColumns(updateColumns),
-	}).Create(&dbStocks)
+	}).CreateInBatches(&dbStocks, stockMasterUpsertBatchSize)
 
 	if result.Error != nil {
 		return errors.Wrap(result.Error, "failed to upsert stock masters")
@@ -186,4 +205,74 @@ func (r *masterRepositoryImpl) UpsertTickRules(ctx context.Context, tickRules []
 	})
 }
 
+func (r *masterRepositoryImpl) FindTickRule(ctx context.Context, tickUnitNumber string) (*model.TickRule, error) {
+	var tickRule model.TickRule
+	result := r.db.WithContext(ctx).
+		Preload("TickLevels", func(db *gorm.DB) *gorm.DB { return db.Order("upper_price") }).
+		Where("tick_unit_number = ?", tickUnitNumber).
+		First(&tickRule)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil // NotFoundの場合はnilを返す
+		}
+		return nil, errors.Wrapf(result.Error, "failed to find tick rule: %s", tickUnitNumber)
+	}
+	return &tickRule, nil
+}
+
+func (r *masterRepositoryImpl) FindStockMasters(ctx context.Context, query repository.StockMasterQuery) ([]*model.StockMaster, int64, error) {
+	var total int64
+	if err := r.stockMasterScope(ctx, query).Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count stock masters")
+	}
+
+	var stocks []*model.StockMaster
+	db := r.stockMasterScope(ctx, query).Order("issue_code").Offset(query.Offset)
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
+	}
+	if err := db.Find(&stocks).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to find stock masters")
+	}
+	return stocks, total, nil
+}
+
+// stockMasterScope は検索条件を適用したクエリを返す。削除済みの銘柄は対象外とする
+func (r *masterRepositoryImpl) stockMasterScope(ctx context.Context, query repository.StockMasterQuery) *gorm.DB {
+	db := r.db.WithContext(ctx).Model(&model.StockMaster{}).Where("deleted_at IS NULL")
+	if query.MarketCode != "" {
+		db = db.Where("market_code = ?", query.MarketCode)
+	}
+	if query.IndustryCode != "" {
+		db = db.Where("industry_code = ?", query.IndustryCode)
+	}
+	if query.Keyword != "" {
+		pattern := "%" + escapeLikePattern(query.Keyword) + "%"
+		db = db.Where("issue_name LIKE ? OR issue_name_short LIKE ? OR issue_name_kana LIKE ?", pattern, pattern, pattern)
+	}
+	if query.TradingUnit > 0 {
+		db = db.Where("trading_unit = ?", query.TradingUnit)
+	}
+	return db
+}
+
+func (r *masterRepositoryImpl) CountStocksByIndustry(ctx context.Context) ([]*repository.IndustryCount, error) {
+	var counts []*repository.IndustryCount
+	result := r.db.WithContext(ctx).Model(&model.StockMaster{}).
+		Select("industry_code, MAX(industry_name) AS industry_name, COUNT(*) AS count").
+		Where("deleted_at IS NULL AND industry_code <> ''").
+		Group("industry_code").
+		Order("industry_code").
+		Scan(&counts)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "failed to count stocks by industry")
+	}
+	return counts, nil
+}
+
+// escapeLikePattern は LIKE 検索で特別な意味を持つ文字をエスケープする
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 // 他のメソッドも同様に実装
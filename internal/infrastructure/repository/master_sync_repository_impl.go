@@ -0,0 +1,290 @@
+// internal/infrastructure/repository/master_sync_repository_impl.go
+package repository
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"stock-bot/domain/model"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// masterSyncBatchSize は差分同期で一度に Upsert する行数
+const masterSyncBatchSize = 500
+
+// masterSyncSpec は差分同期するマスタテーブルの定義
+type masterSyncSpec[T any] struct {
+	name          string                             // エラーメッセージに使用する名前
+	keyColumns    []string                           // 主キーのカラム
+	updateColumns []string                           // 内容が変わった場合に更新するカラム (updated_at, deleted_at は自動で追加する)
+	key           func(*T) string                    // 行を一意に識別するキー
+	base          func(*T) *model.MasterBase         // 共通フィールド
+	upsert        func(tx *gorm.DB, rows []*T) error // 独自の Upsert が必要な場合に指定する
+	deleteMissing bool                               // 含まれない行を論理削除するかどうか
+	// touchUnchanged が true の場合、内容が変わらなかった行も updated_at を更新する (主キーが1カラムの場合のみ)
+	touchUnchanged bool
+	// history が指定されている場合、既存の行から変わった項目の履歴を保存する
+	history   func(old, row *T) []*model.StockMasterHistory
+	syncRunID uint // 履歴に記録するマスタデータ同期の ID
+}
+
+// syncMasterRecords は既存の行と比較して、追加・変更された行だけを Upsert する
+// deleteMissing が指定されている場合、rows に含まれない行を論理削除する
+// 論理削除済みの行が再び rows に含まれた場合は復活させる
+func syncMasterRecords[T any](ctx context.Context, db *gorm.DB, spec masterSyncSpec[T], rows []*T) (model.MasterSyncCounts, error) {
+	var counts model.MasterSyncCounts
+
+	var existing []*T
+	if err := db.WithContext(ctx).Find(&existing).Error; err != nil {
+		return counts, errors.Wrapf(err, "failed to load existing %s", spec.name)
+	}
+	existingByKey := make(map[string]*T, len(existing))
+	for _, e := range existing {
+		existingByKey[spec.key(e)] = e
+	}
+
+	var changed []*T
+	var unchangedKeys []string
+	var histories []*model.StockMasterHistory
+	seen := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		k := spec.key(row)
+		if _, dup := seen[k]; dup {
+			continue // 同じキーの行が重複している場合は最初の行を採用する
+		}
+		seen[k] = struct{}{}
+
+		old, ok := existingByKey[k]
+		switch {
+		case !ok, spec.base(old).DeletedAt != nil:
+			counts.Inserted++
+		case sameMasterContent(old, row, spec.base):
+			counts.Unchanged++
+			unchangedKeys = append(unchangedKeys, k)
+			continue
+		default:
+			counts.Updated++
+		}
+		if ok && spec.history != nil {
+			histories = append(histories, spec.history(old, row)...)
+		}
+		changed = append(changed, row)
+	}
+
+	var missing []*T
+	if spec.deleteMissing {
+		for k, old := range existingByKey {
+			if _, ok := seen[k]; !ok && spec.base(old).DeletedAt == nil {
+				missing = append(missing, old)
+			}
+		}
+	}
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(changed); start += masterSyncBatchSize {
+			batch := changed[start:min(start+masterSyncBatchSize, len(changed))]
+			if spec.upsert != nil {
+				if err := spec.upsert(tx, batch); err != nil {
+					return err
+				}
+				continue
+			}
+			for _, row := range batch {
+				spec.base(row).DeletedAt = nil
+			}
+			columns := make([]clause.Column, 0, len(spec.keyColumns))
+			for _, c := range spec.keyColumns {
+				columns = append(columns, clause.Column{Name: c})
+			}
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   columns,
+				DoUpdates: clause.AssignmentColumns(append(append([]string{}, spec.updateColumns...), "updated_at", "deleted_at")),
+			}).Create(&batch).Error; err != nil {
+				return errors.Wrapf(err, "failed to upsert %s", spec.name)
+			}
+		}
+
+		now := time.Now()
+		if len(histories) > 0 {
+			for _, h := range histories {
+				h.SyncRunID = spec.syncRunID
+				h.ChangedAt = now
+			}
+			if err := tx.CreateInBatches(histories, masterSyncBatchSize).Error; err != nil {
+				return errors.Wrapf(err, "failed to save %s history", spec.name)
+			}
+		}
+		if spec.touchUnchanged && len(spec.keyColumns) == 1 {
+			for start := 0; start < len(unchangedKeys); start += masterSyncBatchSize {
+				keys := unchangedKeys[start:min(start+masterSyncBatchSize, len(unchangedKeys))]
+				if err := tx.Model(new(T)).Where(spec.keyColumns[0]+" IN ?", keys).Update("updated_at", now).Error; err != nil {
+					return errors.Wrapf(err, "failed to touch unchanged %s", spec.name)
+				}
+			}
+		}
+		for _, old := range missing {
+			if err := tx.Model(old).Update("deleted_at", now).Error; err != nil {
+				return errors.Wrapf(err, "failed to soft-delete %s", spec.name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return model.MasterSyncCounts{}, err
+	}
+
+	counts.Deleted = len(missing)
+	return counts, nil
+}
+
+// sameMasterContent は共通フィールドを除いた内容が同じかどうかを返す
+func sameMasterContent[T any](a, b *T, base func(*T) *model.MasterBase) bool {
+	ac, bc := *a, *b
+	*base(&ac) = model.MasterBase{}
+	*base(&bc) = model.MasterBase{}
+	return reflect.DeepEqual(ac, bc)
+}
+
+func (r *masterRepositoryImpl) SyncStockMasters(ctx context.Context, stocks []*model.StockMaster, deleteMissing bool, syncRunID uint) (model.MasterSyncCounts, error) {
+	return syncMasterRecords(ctx, r.db, masterSyncSpec[model.StockMaster]{
+		name:          "stock masters",
+		keyColumns:    []string{"issue_code"},
+		key:           func(s *model.StockMaster) string { return s.IssueCode },
+		base:          func(s *model.StockMaster) *model.MasterBase { return &s.MasterBase },
+		upsert:        upsertStockMasters,
+		deleteMissing: deleteMissing,
+		// 呼値の単位番号 (翌営業日) の適用判定に updated_at を使用するため、同期した日時を記録する
+		touchUnchanged: true,
+		history:        model.StockMasterChanges,
+		syncRunID:      syncRunID,
+	}, stocks)
+}
+
+func (r *masterRepositoryImpl) SyncStockMarketMasters(ctx context.Context, markets []*model.StockMarketMaster) (model.MasterSyncCounts, error) {
+	return syncMasterRecords(ctx, r.db, masterSyncSpec[model.StockMarketMaster]{
+		name:       "stock market masters",
+		keyColumns: []string{"issue_code", "listing_market"},
+		updateColumns: []string{
+			"previous_close", "upper_limit", "lower_limit", "trading_unit",
+			"tick_unit_number", "next_tick_unit_number", "margin_eligibility", "delisting_date",
+		},
+		key:           func(m *model.StockMarketMaster) string { return m.IssueCode + "/" + m.ListingMarket },
+		base:          func(m *model.StockMarketMaster) *model.MasterBase { return &m.MasterBase },
+		deleteMissing: true,
+	}, markets)
+}
+
+func (r *masterRepositoryImpl) SyncMarginMasters(ctx context.Context, margins []*model.MarginMaster) (model.MasterSyncCounts, error) {
+	return syncMasterRecords(ctx, r.db, masterSyncSpec[model.MarginMaster]{
+		name:          "margin masters",
+		keyColumns:    []string{"issue_code", "listing_market"},
+		updateColumns: []string{"change_date", "collateral_rate", "cash_margin_rate"},
+		key:           func(m *model.MarginMaster) string { return m.IssueCode + "/" + m.ListingMarket },
+		base:          func(m *model.MarginMaster) *model.MasterBase { return &m.MasterBase },
+		deleteMissing: true,
+	}, margins)
+}
+
+func (r *masterRepositoryImpl) SyncStockIssueRegulations(ctx context.Context, regulations []*model.StockIssueRegulation) (model.MasterSyncCounts, error) {
+	return syncMasterRecords(ctx, r.db, masterSyncSpec[model.StockIssueRegulation]{
+		name:       "stock issue regulations",
+		keyColumns: []string{"issue_code", "listing_market"},
+		updateColumns: []string{
+			"trading_halt", "cash_buy", "cash_sell", "margin_new_buy", "margin_new_sell",
+			"general_margin_new_buy", "general_margin_new_sell", "pre_adjustment", "same_day_settlement",
+			"concentrated_margin_category",
+		},
+		key:           func(m *model.StockIssueRegulation) string { return m.IssueCode + "/" + m.ListingMarket },
+		base:          func(m *model.StockIssueRegulation) *model.MasterBase { return &m.MasterBase },
+		deleteMissing: true,
+	}, regulations)
+}
+
+func (r *masterRepositoryImpl) SyncOperationStatuses(ctx context.Context, statuses []*model.OperationStatus) (model.MasterSyncCounts, error) {
+	return syncMasterRecords(ctx, r.db, masterSyncSpec[model.OperationStatus]{
+		name:          "operation statuses",
+		keyColumns:    []string{"kind", "operation_category", "operation_unit", "target_business"},
+		updateColumns: []string{"business_day_flag", "status", "business_status", "event_name", "estimated_time"},
+		key: func(m *model.OperationStatus) string {
+			return m.Kind + "/" + m.OperationCategory + "/" + m.OperationUnit + "/" + m.TargetBusiness
+		},
+		base:          func(m *model.OperationStatus) *model.MasterBase { return &m.MasterBase },
+		deleteMissing: true,
+	}, statuses)
+}
+
+func (r *masterRepositoryImpl) SyncTickRules(ctx context.Context, tickRules []*model.TickRule) (model.MasterSyncCounts, error) {
+	var counts model.MasterSyncCounts
+
+	var existing []*model.TickRule
+	if err := r.db.WithContext(ctx).Preload("TickLevels").Find(&existing).Error; err != nil {
+		return counts, errors.Wrap(err, "failed to load existing tick rules")
+	}
+	existingByUnit := make(map[string]*model.TickRule, len(existing))
+	for _, e := range existing {
+		existingByUnit[e.TickUnitNumber] = e
+	}
+
+	var changed []*model.TickRule
+	for _, rule := range tickRules {
+		old, ok := existingByUnit[rule.TickUnitNumber]
+		switch {
+		case !ok:
+			counts.Inserted++
+		case sameTickRule(old, rule):
+			counts.Unchanged++
+			continue
+		default:
+			counts.Updated++
+		}
+		changed = append(changed, rule)
+	}
+
+	if err := r.UpsertTickRules(ctx, changed); err != nil {
+		return model.MasterSyncCounts{}, err
+	}
+	return counts, nil
+}
+
+// sameTickRule は適用日と呼値の段階が同じかどうかを返す
+func sameTickRule(a, b *model.TickRule) bool {
+	if a.ApplicableDate != b.ApplicableDate || len(a.TickLevels) != len(b.TickLevels) {
+		return false
+	}
+	type level struct{ lower, upper, tick float64 }
+	levels := func(rule *model.TickRule) []level {
+		ls := make([]level, 0, len(rule.TickLevels))
+		for _, l := range rule.TickLevels {
+			ls = append(ls, level{l.LowerPrice, l.UpperPrice, l.TickValue})
+		}
+		sort.Slice(ls, func(i, j int) bool { return ls[i].upper < ls[j].upper })
+		return ls
+	}
+	return reflect.DeepEqual(levels(a), levels(b))
+}
+
+func (r *masterRepositoryImpl) CreateMasterSyncRun(ctx context.Context, run *model.MasterSyncRun) error {
+	if err := r.db.WithContext(ctx).Create(run).Error; err != nil {
+		return errors.Wrap(err, "failed to create master sync run")
+	}
+	return nil
+}
+
+func (r *masterRepositoryImpl) UpdateMasterSyncRun(ctx context.Context, run *model.MasterSyncRun) error {
+	if err := r.db.WithContext(ctx).Save(run).Error; err != nil {
+		return errors.Wrap(err, "failed to update master sync run")
+	}
+	return nil
+}
+
+func (r *masterRepositoryImpl) FindMasterSyncRuns(ctx context.Context, limit int) ([]*model.MasterSyncRun, error) {
+	var runs []*model.MasterSyncRun
+	if err := r.db.WithContext(ctx).Order("started_at DESC, id DESC").Limit(limit).Find(&runs).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find master sync runs")
+	}
+	return runs, nil
+}
@@ -0,0 +1,89 @@
+// internal/infrastructure/repository/news_repository_impl.go
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type newsRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewNewsRepository(db *gorm.DB) repository.NewsRepository {
+	return &newsRepositoryImpl{db: db}
+}
+
+func (r *newsRepositoryImpl) SaveNews(ctx context.Context, news []*model.News) (int, error) {
+	if len(news) == 0 {
+		return 0, nil
+	}
+	// 保存済みのニュースIDは上書きしない (取得済みの本文を残すため)
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(news, 500)
+	if result.Error != nil {
+		return 0, errors.Wrap(result.Error, "failed to save news")
+	}
+	return int(result.RowsAffected), nil
+}
+
+func (r *newsRepositoryImpl) FindByID(ctx context.Context, id string) (*model.News, error) {
+	var news model.News
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&news)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(result.Error, "failed to find news by id")
+	}
+	return &news, nil
+}
+
+func (r *newsRepositoryImpl) FindNews(ctx context.Context, query repository.NewsQuery) ([]*model.News, error) {
+	db := r.db.WithContext(ctx)
+	if query.Symbol != "" {
+		symbols, err := json.Marshal([]string{query.Symbol})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal news symbol")
+		}
+		db = db.Where("symbols @> ?::jsonb", string(symbols))
+	}
+	if !query.Since.IsZero() {
+		db = db.Where("published_at >= ?", query.Since)
+	}
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
+	}
+
+	var news []*model.News
+	if err := db.Order("published_at DESC, id DESC").Find(&news).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find news")
+	}
+	return news, nil
+}
+
+func (r *newsRepositoryImpl) FindWithoutBody(ctx context.Context, limit int) ([]*model.News, error) {
+	var news []*model.News
+	result := r.db.WithContext(ctx).Where("body_fetched_at IS NULL").Order("published_at DESC, id DESC").Limit(limit).Find(&news)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "failed to find news without body")
+	}
+	return news, nil
+}
+
+func (r *newsRepositoryImpl) SaveBody(ctx context.Context, id string, body string, fetchedAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&model.News{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"body":            body,
+		"body_fetched_at": fetchedAt,
+	})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to save news body")
+	}
+	return nil
+}
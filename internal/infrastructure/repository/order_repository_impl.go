@@ -4,11 +4,14 @@ package repository
 
 import (
 	"context"
+	"slices"
 	"stock-bot/domain/model"
 	"stock-bot/domain/repository"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type orderRepositoryImpl struct {
@@ -20,16 +23,21 @@ func NewOrderRepository(db *gorm.DB) repository.OrderRepository {
 }
 
 func (r *orderRepositoryImpl) Save(ctx context.Context, order *model.Order) error {
-	result := r.db.WithContext(ctx).Create(order)
-	if result.Error != nil {
-		return errors.Wrap(result.Error, "failed to save order")
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(order).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.OrderStatusTransition{OrderID: order.OrderID, ToStatus: order.OrderStatus, Version: order.Version}).Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to save order")
 	}
 	return nil
 }
 
 func (r *orderRepositoryImpl) FindByID(ctx context.Context, orderID string) (*model.Order, error) {
 	var order model.Order
-	result := r.db.WithContext(ctx).Where("order_id = ?", orderID).First(&order)
+	result := preloadExecutions(r.db.WithContext(ctx)).Where("order_id = ?", orderID).First(&order)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -41,9 +49,142 @@ func (r *orderRepositoryImpl) FindByID(ctx context.Context, orderID string) (*mo
 
 func (r *orderRepositoryImpl) FindByStatus(ctx context.Context, status model.OrderStatus) ([]*model.Order, error) {
 	var orders []*model.Order
-	result := r.db.WithContext(ctx).Where("order_status = ?", status).Find(&orders)
+	result := preloadExecutions(r.db.WithContext(ctx)).Where("order_status = ?", status).Order("id").Find(&orders)
 	if result.Error != nil {
 		return nil, errors.Wrap(result.Error, "failed to find orders by status")
 	}
 	return orders, nil
 }
+
+func (r *orderRepositoryImpl) FindOrders(ctx context.Context, query repository.OrderQuery) ([]*model.Order, error) {
+	db := preloadExecutions(r.db.WithContext(ctx))
+	if !query.Since.IsZero() {
+		db = db.Where("created_at >= ?", query.Since)
+	}
+	if !query.Until.IsZero() {
+		db = db.Where("created_at < ?", query.Until)
+	}
+	if query.Symbol != "" {
+		db = db.Where("symbol = ?", query.Symbol)
+	}
+	if query.SignalID != nil {
+		db = db.Where("signal_id = ?", *query.SignalID)
+	}
+	if query.Offset > 0 {
+		db = db.Offset(query.Offset)
+	}
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
+	}
+	var orders []*model.Order
+	if err := db.Order("created_at").Order("id").Find(&orders).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find orders")
+	}
+	return orders, nil
+}
+
+func (r *orderRepositoryImpl) FindExecutedOrders(ctx context.Context, since time.Time) ([]*model.Order, error) {
+	executions := r.db.Model(&model.Execution{}).Select("order_id")
+	if !since.IsZero() {
+		executions = executions.Where("execution_time >= ?", since)
+	}
+	var orders []*model.Order
+	result := preloadExecutions(r.db.WithContext(ctx)).
+		Where("order_id IN (?)", executions).
+		Order("id").
+		Find(&orders)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "failed to find executed orders")
+	}
+	return orders, nil
+}
+
+func (r *orderRepositoryImpl) UpdateStatus(ctx context.Context, order *model.Order, status model.OrderStatus) error {
+	if err := order.OrderStatus.ValidateTransition(status); err != nil {
+		return errors.Wrapf(err, "order %s", order.OrderID)
+	}
+	if order.OrderStatus == status {
+		return nil
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Order{}).
+			Where("order_id = ? AND version = ?", order.OrderID, order.Version).
+			Updates(map[string]any{"order_status": status, "version": gorm.Expr("version + 1")})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.Wrapf(repository.ErrOrderConflict, "order %s version %d", order.OrderID, order.Version)
+		}
+		return tx.Create(&model.OrderStatusTransition{OrderID: order.OrderID, FromStatus: order.OrderStatus, ToStatus: status, Version: order.Version + 1}).Error
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderConflict) {
+			return err
+		}
+		return errors.Wrap(err, "failed to update order status")
+	}
+	order.OrderStatus = status
+	order.Version++
+	return nil
+}
+
+func (r *orderRepositoryImpl) FindStatusTransitions(ctx context.Context, orderID string) ([]*model.OrderStatusTransition, error) {
+	var transitions []*model.OrderStatusTransition
+	result := r.db.WithContext(ctx).Where("order_id = ?", orderID).Order("id").Find(&transitions)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "failed to find order status transitions")
+	}
+	return transitions, nil
+}
+
+func (r *orderRepositoryImpl) SaveExecutions(ctx context.Context, executions []*model.Execution) error {
+	if len(executions) == 0 {
+		return nil
+	}
+	if err := upsertExecutions(r.db.WithContext(ctx), executions); err != nil {
+		return errors.Wrap(err, "failed to save executions")
+	}
+	return nil
+}
+
+func (r *orderRepositoryImpl) SaveExecution(ctx context.Context, order *model.Order, execution *model.Execution) error {
+	execution.OrderID = order.OrderID
+	if err := upsertExecutions(r.db.WithContext(ctx), []*model.Execution{execution}); err != nil {
+		return errors.Wrap(err, "failed to save execution")
+	}
+
+	i := slices.IndexFunc(order.Executions, func(e model.Execution) bool { return e.ExecutionID == execution.ExecutionID })
+	if i >= 0 {
+		order.Executions[i] = *execution
+	} else {
+		order.Executions = append(order.Executions, *execution)
+	}
+	slices.SortStableFunc(order.Executions, func(a, b model.Execution) int {
+		return a.ExecutionTime.Compare(b.ExecutionTime)
+	})
+	return nil
+}
+
+func (r *orderRepositoryImpl) FindExecutions(ctx context.Context, orderID string) ([]*model.Execution, error) {
+	var executions []*model.Execution
+	result := r.db.WithContext(ctx).Where("order_id = ?", orderID).Order("execution_time").Order("id").Find(&executions)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "failed to find executions")
+	}
+	return executions, nil
+}
+
+// upsertExecutions は約定を ExecutionID で upsert する (手数料などは最新の値で更新する)
+func upsertExecutions(db *gorm.DB, executions []*model.Execution) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "execution_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"execution_time", "execution_price", "execution_quantity", "commission", "updated_at"}),
+	}).Create(executions).Error
+}
+
+// preloadExecutions は注文の約定を約定日時の順に読み込む
+func preloadExecutions(db *gorm.DB) *gorm.DB {
+	return db.Preload("Executions", func(db *gorm.DB) *gorm.DB { return db.Order("execution_time").Order("id") })
+}
@@ -29,7 +29,7 @@ func (r *positionRepositoryImpl) Save(ctx context.Context, position *model.Posit
 
 func (r *positionRepositoryImpl) FindBySymbol(ctx context.Context, symbol string) (*model.Position, error) {
 	var position model.Position
-	result := r.db.WithContext(ctx).Where("symbol = ?", symbol).First(&position)
+	result := r.db.WithContext(ctx).Preload("Lots", orderLots).Where("symbol = ?", symbol).First(&position)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -41,9 +41,55 @@ func (r *positionRepositoryImpl) FindBySymbol(ctx context.Context, symbol string
 
 func (r *positionRepositoryImpl) FindAll(ctx context.Context) ([]*model.Position, error) {
 	var positions []*model.Position
-	result := r.db.WithContext(ctx).Find(&positions)
+	result := r.db.WithContext(ctx).Preload("Lots", orderLots).Order("symbol").Order("id").Find(&positions)
 	if result.Error != nil {
 		return nil, errors.Wrap(result.Error, "failed to find all positions")
 	}
 	return positions, nil
 }
+
+func (r *positionRepositoryImpl) ReplaceAll(ctx context.Context, positions []*model.Position) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 台帳は約定から作り直すため、論理削除せずに物理削除する (ロットの約定IDは一意)
+		if err := tx.Unscoped().Where("1 = 1").Delete(&model.PositionLot{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("1 = 1").Delete(&model.Position{}).Error; err != nil {
+			return err
+		}
+		if len(positions) == 0 {
+			return nil
+		}
+		return tx.Create(positions).Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to replace positions")
+	}
+	return nil
+}
+
+func (r *positionRepositoryImpl) FindLots(ctx context.Context, query repository.PositionLotQuery) ([]*model.PositionLot, error) {
+	db := r.db.WithContext(ctx)
+	if query.Symbol != "" {
+		db = db.Where("symbol = ?", query.Symbol)
+	}
+	if query.AccountType != "" {
+		db = db.Where("account_type = ?", query.AccountType)
+	}
+	if query.IsMargin != nil {
+		db = db.Where("is_margin = ?", *query.IsMargin)
+	}
+	if !query.OpenedBefore.IsZero() {
+		db = db.Where("opened_at < ?", query.OpenedBefore)
+	}
+	var lots []*model.PositionLot
+	if err := orderLots(db).Find(&lots).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find position lots")
+	}
+	return lots, nil
+}
+
+// orderLots はロットを建てた日時の古い順に並べる
+func orderLots(db *gorm.DB) *gorm.DB {
+	return db.Order("opened_at").Order("id")
+}
@@ -6,9 +6,11 @@ import (
 	"context"
 	"stock-bot/domain/model"
 	"stock-bot/domain/repository"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type signalRepositoryImpl struct {
@@ -27,6 +29,25 @@ func (r *signalRepositoryImpl) Save(ctx context.Context, signal *model.Signal) e
 	return nil
 }
 
+func (r *signalRepositoryImpl) SaveBatch(ctx context.Context, signals []*model.Signal, file *model.SignalFile) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(signals) > 0 {
+			if err := tx.Create(signals).Error; err != nil {
+				return err
+			}
+		}
+		if file == nil {
+			return nil
+		}
+		// 同じハッシュのファイルが既に記録されていれば何もしない
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(file).Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to save signal batch")
+	}
+	return nil
+}
+
 func (r *signalRepositoryImpl) FindByID(ctx context.Context, id uint) (*model.Signal, error) {
 	var signal model.Signal
 	result := r.db.WithContext(ctx).First(&signal, id)
@@ -47,3 +68,81 @@ func (r *signalRepositoryImpl) FindBySymbol(ctx context.Context, symbol string)
 	}
 	return signals, nil
 }
+
+func (r *signalRepositoryImpl) FindByFileHash(ctx context.Context, fileHash string) ([]*model.Signal, error) {
+	var signals []*model.Signal
+	result := r.db.WithContext(ctx).Where("file_hash = ?", fileHash).Order("id").Find(&signals)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "failed to find signals by file hash")
+	}
+	return signals, nil
+}
+
+func (r *signalRepositoryImpl) IsFileProcessed(ctx context.Context, fileHash string) (bool, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&model.SignalFile{}).Where("file_hash = ?", fileHash).Count(&count)
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to check signal file status")
+	}
+	return count > 0, nil
+}
+
+func (r *signalRepositoryImpl) ExistsByRecordKey(ctx context.Context, recordKey string) (bool, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Model(&model.Signal{}).Where("record_key = ?", recordKey).Count(&count)
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed to check signal record key")
+	}
+	return count > 0, nil
+}
+
+func (r *signalRepositoryImpl) FindRecent(ctx context.Context, symbol string, limit int) ([]*model.Signal, error) {
+	var signals []*model.Signal
+	query := r.db.WithContext(ctx).Order("generated_at DESC").Order("id DESC")
+	if symbol != "" {
+		query = query.Where("symbol = ?", symbol)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	result := query.Find(&signals)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "failed to find recent signals")
+	}
+	return signals, nil
+}
+
+func (r *signalRepositoryImpl) FindUnconsumed(ctx context.Context) ([]*model.Signal, error) {
+	var signals []*model.Signal
+	result := r.db.WithContext(ctx).Where("consumed_at IS NULL").Order("id").Find(&signals)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "failed to find unconsumed signals")
+	}
+	return signals, nil
+}
+
+func (r *signalRepositoryImpl) FindConsumed(ctx context.Context, since, until time.Time) ([]*model.Signal, error) {
+	var signals []*model.Signal
+	result := r.db.WithContext(ctx).
+		Where("consumed_at >= ? AND consumed_at < ?", since, until).
+		Order("consumed_at").Order("id").
+		Find(&signals)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "failed to find consumed signals")
+	}
+	return signals, nil
+}
+
+func (r *signalRepositoryImpl) MarkConsumed(ctx context.Context, id uint, consumedAt time.Time) error {
+	// 既に処理済みのシグナルは更新しない
+	result := r.db.WithContext(ctx).Model(&model.Signal{}).
+		Where("id = ? AND consumed_at IS NULL", id).
+		Update("consumed_at", consumedAt)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed to mark signal as consumed")
+	}
+	if result.RowsAffected == 0 {
+		return errors.Newf("signal %d not found or already consumed", id)
+	}
+	return nil
+}
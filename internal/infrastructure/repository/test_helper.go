@@ -70,7 +70,7 @@ func SetupTestDatabase(t *testing.T) (*gorm.DB, func(), error) {
 	}
 
 	// テストに必要なテーブルのマイグレーションを実行
-	err = db.AutoMigrate(&model.Order{}, &model.Position{}, &model.Signal{}, &model.StockMaster{}, &model.StockMarketMaster{}, &model.TickRule{}, &model.TickLevel{})
+	err = db.AutoMigrate(&model.Order{}, &model.Execution{}, &model.OrderStatusTransition{}, &model.Position{}, &model.PositionLot{}, &model.Signal{}, &model.SignalFile{}, &model.StockMaster{}, &model.StockMarketMaster{}, &model.TickRule{}, &model.TickLevel{}, &model.MarginMaster{}, &model.StockIssueRegulation{}, &model.OperationStatus{}, &model.MasterSyncRun{}, &model.StockMasterHistory{}, &model.News{}, &model.StockFundamental{}, &model.SecuritiesFinanceBalance{}, &model.CreditBalance{}, &model.MarginPremiumRecord{}, &model.BalanceSnapshot{}, &model.TradingEvent{})
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
@@ -0,0 +1,49 @@
+// internal/infrastructure/repository/tests/balance_repository_impl_test.go
+
+package tests
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	domainrepo "stock-bot/domain/repository"
+	"stock-bot/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalanceRepositoryImpl(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewBalanceRepository(db)
+	ctx := context.Background()
+	base := time.Date(2025, 12, 26, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: スナップショットを保存し、新しい順に取得できること", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			err := repo.SaveSnapshot(ctx, &model.BalanceSnapshot{TakenAt: base.Add(time.Duration(i) * time.Hour), MaintenanceRate: float64(40 - i)})
+			assert.NoError(t, err)
+		}
+
+		snapshots, err := repo.FindSnapshots(ctx, domainrepo.BalanceSnapshotQuery{})
+		assert.NoError(t, err)
+		if assert.Len(t, snapshots, 3) {
+			assert.Equal(t, 38.0, snapshots[0].MaintenanceRate)
+		}
+
+		snapshots, err = repo.FindSnapshots(ctx, domainrepo.BalanceSnapshotQuery{Since: base.Add(time.Hour), Limit: 1})
+		assert.NoError(t, err)
+		if assert.Len(t, snapshots, 1) {
+			assert.True(t, snapshots[0].TakenAt.Equal(base.Add(2*time.Hour)))
+		}
+
+		snapshots, err = repo.FindSnapshots(ctx, domainrepo.BalanceSnapshotQuery{Until: base.Add(2 * time.Hour)})
+		assert.NoError(t, err)
+		assert.Len(t, snapshots, 2)
+	})
+}
@@ -0,0 +1,66 @@
+// internal/infrastructure/repository/tests/fundamental_repository_impl_test.go
+
+package tests
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"stock-bot/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFundamentalRepositoryImpl(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewFundamentalRepository(db)
+	ctx := context.Background()
+
+	t.Run("正常系: 同じ銘柄・日付のスナップショットは上書きされること", func(t *testing.T) {
+		err := repo.UpsertFundamentals(ctx, []*model.StockFundamental{
+			{IssueCode: "7203", SnapshotDate: "20251225", EPS: 240, FetchedAt: time.Now()},
+			{IssueCode: "7203", SnapshotDate: "20251226", EPS: 250, FetchedAt: time.Now()},
+		})
+		assert.NoError(t, err)
+
+		err = repo.UpsertFundamentals(ctx, []*model.StockFundamental{
+			{IssueCode: "7203", SnapshotDate: "20251226", EPS: 260, DividendPerShare: 75, FetchedAt: time.Now()},
+		})
+		assert.NoError(t, err)
+
+		fundamental, err := repo.FindFundamental(ctx, "7203", "20251226")
+		assert.NoError(t, err)
+		if assert.NotNil(t, fundamental) {
+			assert.Equal(t, 260.0, fundamental.EPS)
+			assert.Equal(t, 75.0, fundamental.DividendPerShare)
+		}
+
+		var count int64
+		db.Model(&model.StockFundamental{}).Where("issue_code = ?", "7203").Count(&count)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("正常系: 最新のスナップショットを取得できること", func(t *testing.T) {
+		fundamental, err := repo.FindLatestFundamental(ctx, "7203")
+		assert.NoError(t, err)
+		if assert.NotNil(t, fundamental) {
+			assert.Equal(t, "20251226", fundamental.SnapshotDate)
+		}
+	})
+
+	t.Run("正常系: 存在しない場合は nil を返すこと", func(t *testing.T) {
+		fundamental, err := repo.FindFundamental(ctx, "7203", "20240101")
+		assert.NoError(t, err)
+		assert.Nil(t, fundamental)
+
+		fundamental, err = repo.FindLatestFundamental(ctx, "0000")
+		assert.NoError(t, err)
+		assert.Nil(t, fundamental)
+	})
+}
@@ -0,0 +1,85 @@
+// internal/infrastructure/repository/tests/margin_info_repository_impl_test.go
+
+package tests
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	domainrepo "stock-bot/domain/repository"
+	"stock-bot/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarginInfoRepositoryImpl(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewMarginInfoRepository(db)
+	ctx := context.Background()
+
+	t.Run("正常系: 同じ銘柄・日付の証金残は上書きされ、新しい順に取得できること", func(t *testing.T) {
+		err := repo.UpsertSecuritiesFinanceBalances(ctx, []*model.SecuritiesFinanceBalance{
+			{IssueCode: "7203", RecordDate: "20251225", LoanBalance: 100000, FetchedAt: time.Now()},
+			{IssueCode: "7203", RecordDate: "20251226", LoanBalance: 110000, Preliminary: true, FetchedAt: time.Now()},
+		})
+		assert.NoError(t, err)
+
+		// 速報値を確報値で上書きする
+		err = repo.UpsertSecuritiesFinanceBalances(ctx, []*model.SecuritiesFinanceBalance{
+			{IssueCode: "7203", RecordDate: "20251226", LoanBalance: 120000, Preliminary: false, FetchedAt: time.Now()},
+		})
+		assert.NoError(t, err)
+
+		balances, err := repo.FindSecuritiesFinanceBalances(ctx, domainrepo.MarginInfoQuery{IssueCode: "7203"})
+		assert.NoError(t, err)
+		if assert.Len(t, balances, 2) {
+			assert.Equal(t, "20251226", balances[0].RecordDate)
+			assert.Equal(t, int64(120000), balances[0].LoanBalance)
+			assert.False(t, balances[0].Preliminary)
+		}
+
+		balances, err = repo.FindSecuritiesFinanceBalances(ctx, domainrepo.MarginInfoQuery{IssueCode: "7203", Since: "20251226"})
+		assert.NoError(t, err)
+		assert.Len(t, balances, 1)
+	})
+
+	t.Run("正常系: 信用残を件数を指定して取得できること", func(t *testing.T) {
+		err := repo.UpsertCreditBalances(ctx, []*model.CreditBalance{
+			{IssueCode: "7203", RecordDate: "20251212", LongBalance: 500000, FetchedAt: time.Now()},
+			{IssueCode: "7203", RecordDate: "20251219", LongBalance: 520000, FetchedAt: time.Now()},
+			{IssueCode: "6758", RecordDate: "20251219", LongBalance: 300000, FetchedAt: time.Now()},
+		})
+		assert.NoError(t, err)
+
+		balances, err := repo.FindCreditBalances(ctx, domainrepo.MarginInfoQuery{IssueCode: "7203", Limit: 1})
+		assert.NoError(t, err)
+		if assert.Len(t, balances, 1) {
+			assert.Equal(t, int64(520000), balances[0].LongBalance)
+		}
+	})
+
+	t.Run("正常系: 最新の逆日歩を取得し、存在しない場合は nil を返すこと", func(t *testing.T) {
+		err := repo.UpsertMarginPremiums(ctx, []*model.MarginPremiumRecord{
+			{IssueCode: "7203", RecordDate: "20251225", Premium: 0.05, FetchedAt: time.Now()},
+			{IssueCode: "7203", RecordDate: "20251226", Premium: 0.10, FetchedAt: time.Now()},
+		})
+		assert.NoError(t, err)
+
+		premium, err := repo.FindLatestMarginPremium(ctx, "7203")
+		assert.NoError(t, err)
+		if assert.NotNil(t, premium) {
+			assert.Equal(t, "20251226", premium.RecordDate)
+			assert.Equal(t, 0.10, premium.Premium)
+		}
+
+		premium, err = repo.FindLatestMarginPremium(ctx, "0000")
+		assert.NoError(t, err)
+		assert.Nil(t, premium)
+	})
+}
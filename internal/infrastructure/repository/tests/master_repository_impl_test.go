@@ -4,8 +4,10 @@ package tests
 import (
 	"context"
 	"stock-bot/domain/model"
+	domainrepo "stock-bot/domain/repository"
 	"stock-bot/internal/infrastructure/repository"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -104,4 +106,262 @@ func TestMasterRepositoryImpl_FindByIssueCode(t *testing.T) {
 	})
 }
 
+func TestMasterRepositoryImpl_FindTickRule(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewMasterRepository(db)
+
+	t.Run("正常系: 呼値の段階が上限値の昇順で取得できること", func(t *testing.T) {
+		ctx := context.Background()
+		err := repo.UpsertTickRules(ctx, []*model.TickRule{{
+			TickUnitNumber: "101",
+			ApplicableDate: "20140722",
+			TickLevels: []model.TickLevel{
+				{LowerPrice: 3000, UpperPrice: 5000, TickValue: 5},
+				{LowerPrice: 0, UpperPrice: 3000, TickValue: 1},
+			},
+		}})
+		assert.NoError(t, err)
+
+		tickRule, err := repo.FindTickRule(ctx, "101")
+		assert.NoError(t, err)
+		if assert.NotNil(t, tickRule) && assert.Len(t, tickRule.TickLevels, 2) {
+			assert.Equal(t, 3000.0, tickRule.TickLevels[0].UpperPrice)
+			assert.Equal(t, 5000.0, tickRule.TickLevels[1].UpperPrice)
+		}
+	})
+
+	t.Run("正常系: 存在しない単位番号を指定した場合nilが返ること", func(t *testing.T) {
+		tickRule, err := repo.FindTickRule(context.Background(), "999")
+		assert.NoError(t, err)
+		assert.Nil(t, tickRule)
+	})
+}
+
+func TestMasterRepositoryImpl_FindStockMasters(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewMasterRepository(db)
+	ctx := context.Background()
+
+	err = repo.UpsertStockMasters(ctx, []*model.StockMaster{
+		{IssueCode: "7203", IssueName: "トヨタ自動車", IssueNameKana: "トヨタジドウシャ", MarketCode: "00", IndustryCode: "3700", IndustryName: "輸送用機器", TradingUnit: 100},
+		{IssueCode: "7267", IssueName: "本田技研工業", IssueNameKana: "ホンダギケンコウギョウ", MarketCode: "00", IndustryCode: "3700", IndustryName: "輸送用機器", TradingUnit: 100},
+		{IssueCode: "6758", IssueName: "ソニーグループ", IssueNameKana: "ソニーグループ", MarketCode: "00", IndustryCode: "3650", IndustryName: "電気機器", TradingUnit: 100},
+		{IssueCode: "1320", IssueName: "iFreeETF 日経225", IssueNameKana: "アイフリーイーティーエフ", MarketCode: "00", IndustryCode: "9999", IndustryName: "ETF", TradingUnit: 1},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	t.Run("正常系: 業種で絞り込み、銘柄コード順にページングできること", func(t *testing.T) {
+		stocks, total, err := repo.FindStockMasters(ctx, domainrepo.StockMasterQuery{IndustryCode: "3700", Limit: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		if assert.Len(t, stocks, 1) {
+			assert.Equal(t, "7203", stocks[0].IssueCode)
+		}
+
+		stocks, _, err = repo.FindStockMasters(ctx, domainrepo.StockMasterQuery{IndustryCode: "3700", Offset: 1, Limit: 1})
+		assert.NoError(t, err)
+		if assert.Len(t, stocks, 1) {
+			assert.Equal(t, "7267", stocks[0].IssueCode)
+		}
+	})
+
+	t.Run("正常系: 銘柄名（カナ）の部分一致と売買単位で絞り込めること", func(t *testing.T) {
+		stocks, total, err := repo.FindStockMasters(ctx, domainrepo.StockMasterQuery{Keyword: "ホンダ"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		if assert.Len(t, stocks, 1) {
+			assert.Equal(t, "7267", stocks[0].IssueCode)
+		}
+
+		stocks, _, err = repo.FindStockMasters(ctx, domainrepo.StockMasterQuery{TradingUnit: 1})
+		assert.NoError(t, err)
+		if assert.Len(t, stocks, 1) {
+			assert.Equal(t, "1320", stocks[0].IssueCode)
+		}
+	})
+
+	t.Run("正常系: LIKEの特殊文字はそのまま検索されること", func(t *testing.T) {
+		stocks, total, err := repo.FindStockMasters(ctx, domainrepo.StockMasterQuery{Keyword: "%"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+		assert.Empty(t, stocks)
+	})
+
+	t.Run("正常系: 業種ごとの銘柄数が取得できること", func(t *testing.T) {
+		counts, err := repo.CountStocksByIndustry(ctx)
+		assert.NoError(t, err)
+		if assert.Len(t, counts, 3) {
+			assert.Equal(t, "3650", counts[0].IndustryCode)
+			assert.Equal(t, int64(1), counts[0].Count)
+			assert.Equal(t, "3700", counts[1].IndustryCode)
+			assert.Equal(t, "輸送用機器", counts[1].IndustryName)
+			assert.Equal(t, int64(2), counts[1].Count)
+		}
+	})
+}
+
 // go test -v ./internal/infrastructure/repository/tests/master_repository_impl_test.go
+
+func TestMasterRepositoryImpl_SyncStockMasters(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewMasterRepository(db)
+	ctx := context.Background()
+
+	counts, err := repo.SyncStockMasters(ctx, []*model.StockMaster{
+		{IssueCode: "7203", IssueName: "トヨタ自動車", TradingUnit: 100},
+		{IssueCode: "9984", IssueName: "ソフトバンクグループ", TradingUnit: 100},
+	}, true, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, model.MasterSyncCounts{Inserted: 2}, counts)
+
+	t.Run("正常系: 変更された銘柄のみ更新し、含まれない銘柄を論理削除すること", func(t *testing.T) {
+		counts, err := repo.SyncStockMasters(ctx, []*model.StockMaster{
+			{IssueCode: "7203", IssueName: "トヨタ自動車", TradingUnit: 100},
+			{IssueCode: "6758", IssueName: "ソニーグループ", TradingUnit: 100},
+		}, true, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, model.MasterSyncCounts{Inserted: 1, Unchanged: 1, Deleted: 1}, counts)
+
+		// 論理削除された銘柄は見つからない扱いになる
+		deleted, err := repo.FindByIssueCode(ctx, "9984", "StockMaster")
+		assert.NoError(t, err)
+		assert.Nil(t, deleted)
+
+		var stored model.StockMaster
+		if assert.NoError(t, db.Where("issue_code = ?", "9984").First(&stored).Error) {
+			assert.NotNil(t, stored.DeletedAt)
+		}
+	})
+
+	t.Run("正常系: 論理削除された銘柄が再び含まれた場合は復活すること", func(t *testing.T) {
+		counts, err := repo.SyncStockMasters(ctx, []*model.StockMaster{
+			{IssueCode: "9984", IssueName: "ソフトバンクグループ", TradingUnit: 100},
+		}, false, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, model.MasterSyncCounts{Inserted: 1}, counts)
+
+		restored, err := repo.FindByIssueCode(ctx, "9984", "StockMaster")
+		assert.NoError(t, err)
+		if assert.NotNil(t, restored) {
+			assert.Nil(t, restored.(*model.StockMaster).DeletedAt)
+		}
+	})
+}
+
+func TestMasterRepositoryImpl_SyncStockMastersRecordsHistory(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewMasterRepository(db)
+	ctx := context.Background()
+
+	_, err = repo.SyncStockMasters(ctx, []*model.StockMaster{
+		{IssueCode: "7203", IssueName: "トヨタ自動車", TradingUnit: 100, UpperLimit: 3500, LowerLimit: 2500},
+	}, false, 1)
+	assert.NoError(t, err)
+
+	t.Run("正常系: 変わった項目の履歴を同期の実行履歴IDと共に保存すること", func(t *testing.T) {
+		counts, err := repo.SyncStockMasters(ctx, []*model.StockMaster{
+			{IssueCode: "7203", IssueName: "トヨタ自動車", TradingUnit: 100, UpperLimit: 4000, LowerLimit: 3000},
+		}, false, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, model.MasterSyncCounts{Updated: 1}, counts)
+
+		var histories []model.StockMasterHistory
+		assert.NoError(t, db.Where("issue_code = ?", "7203").Order("field").Find(&histories).Error)
+		if assert.Len(t, histories, 2) {
+			assert.Equal(t, "lower_limit", histories[0].Field)
+			assert.Equal(t, "2500", histories[0].OldValue)
+			assert.Equal(t, "3000", histories[0].NewValue)
+			assert.Equal(t, "upper_limit", histories[1].Field)
+			assert.Equal(t, uint(2), histories[1].SyncRunID)
+		}
+	})
+}
+
+func TestMasterRepositoryImpl_MasterSyncRuns(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewMasterRepository(db)
+	ctx := context.Background()
+
+	t.Run("正常系: 実行履歴を作成・更新し、新しい順に取得できること", func(t *testing.T) {
+		older := &model.MasterSyncRun{Scope: "full", Trigger: model.MasterSyncTriggerStartup, Status: model.MasterSyncRunning, StartedAt: time.Now().Add(-time.Hour)}
+		assert.NoError(t, repo.CreateMasterSyncRun(ctx, older))
+		assert.NotZero(t, older.ID)
+
+		finishedAt := time.Now().Add(-50 * time.Minute)
+		older.Status = model.MasterSyncSucceeded
+		older.FinishedAt = &finishedAt
+		older.Stocks = model.MasterSyncCounts{Inserted: 10, Deleted: 1}
+		assert.NoError(t, repo.UpdateMasterSyncRun(ctx, older))
+
+		newer := &model.MasterSyncRun{Scope: "full", Trigger: model.MasterSyncTriggerManual, Status: model.MasterSyncRunning, StartedAt: time.Now()}
+		assert.NoError(t, repo.CreateMasterSyncRun(ctx, newer))
+
+		runs, err := repo.FindMasterSyncRuns(ctx, 10)
+		assert.NoError(t, err)
+		if assert.Len(t, runs, 2) {
+			assert.Equal(t, newer.ID, runs[0].ID)
+			assert.Equal(t, model.MasterSyncSucceeded, runs[1].Status)
+			assert.Equal(t, model.MasterSyncCounts{Inserted: 10, Deleted: 1}, runs[1].Stocks)
+			assert.NotNil(t, runs[1].FinishedAt)
+		}
+
+		runs, err = repo.FindMasterSyncRuns(ctx, 1)
+		assert.NoError(t, err)
+		assert.Len(t, runs, 1)
+	})
+}
+
+func TestMasterRepositoryImpl_SyncMarginMasters(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewMasterRepository(db)
+	ctx := context.Background()
+
+	_, err = repo.SyncMarginMasters(ctx, []*model.MarginMaster{
+		{IssueCode: "7203", ListingMarket: "00", ChangeDate: "20251201", CollateralRate: 30, CashMarginRate: 30},
+	})
+	assert.NoError(t, err)
+
+	t.Run("正常系: 内容が変わった行を更新すること", func(t *testing.T) {
+		counts, err := repo.SyncMarginMasters(ctx, []*model.MarginMaster{
+			{IssueCode: "7203", ListingMarket: "00", ChangeDate: "20251222", CollateralRate: 50, CashMarginRate: 50},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, model.MasterSyncCounts{Updated: 1}, counts)
+
+		var stored model.MarginMaster
+		assert.NoError(t, db.Where("issue_code = ? AND listing_market = ?", "7203", "00").First(&stored).Error)
+		assert.Equal(t, 50.0, stored.CollateralRate)
+	})
+}
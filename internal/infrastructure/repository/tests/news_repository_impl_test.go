@@ -0,0 +1,89 @@
+// internal/infrastructure/repository/tests/news_repository_impl_test.go
+
+package tests
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	domainrepo "stock-bot/domain/repository"
+	"stock-bot/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewsRepositoryImpl(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewNewsRepository(db)
+	ctx := context.Background()
+	base := time.Date(2025, 12, 25, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 保存済みのニュースIDは重複して保存しないこと", func(t *testing.T) {
+		saved, err := repo.SaveNews(ctx, []*model.News{
+			{ID: "N1", PublishedAt: base, Categories: []string{"TDNET"}, Symbols: []string{"7203"}, Headline: "決算短信"},
+			{ID: "N2", PublishedAt: base.Add(time.Hour), Categories: []string{"IR"}, Symbols: []string{"7203", "6758"}, Headline: "業績予想の修正"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, saved)
+
+		assert.NoError(t, repo.SaveBody(ctx, "N1", "本文です", base.Add(2*time.Hour)))
+
+		saved, err = repo.SaveNews(ctx, []*model.News{
+			{ID: "N1", PublishedAt: base, Symbols: []string{"7203"}, Headline: "上書きされない"},
+			{ID: "N3", PublishedAt: base.Add(2 * time.Hour), Symbols: []string{"6758"}, Headline: "新製品"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, saved)
+
+		news, err := repo.FindByID(ctx, "N1")
+		assert.NoError(t, err)
+		if assert.NotNil(t, news) {
+			assert.Equal(t, "決算短信", news.Headline)
+			assert.Equal(t, "本文です", news.Body)
+			assert.NotNil(t, news.BodyFetchedAt)
+			assert.Equal(t, []string{"TDNET"}, news.Categories)
+		}
+	})
+
+	t.Run("正常系: 関連銘柄と日時で絞り込み、新しい順に取得できること", func(t *testing.T) {
+		news, err := repo.FindNews(ctx, domainrepo.NewsQuery{Symbol: "7203"})
+		assert.NoError(t, err)
+		if assert.Len(t, news, 2) {
+			assert.Equal(t, "N2", news[0].ID)
+			assert.Equal(t, "N1", news[1].ID)
+		}
+
+		news, err = repo.FindNews(ctx, domainrepo.NewsQuery{Symbol: "6758", Since: base.Add(90 * time.Minute)})
+		assert.NoError(t, err)
+		if assert.Len(t, news, 1) {
+			assert.Equal(t, "N3", news[0].ID)
+		}
+
+		news, err = repo.FindNews(ctx, domainrepo.NewsQuery{Limit: 1})
+		assert.NoError(t, err)
+		if assert.Len(t, news, 1) {
+			assert.Equal(t, "N3", news[0].ID)
+		}
+	})
+
+	t.Run("正常系: 本文が未取得のニュースを取得できること", func(t *testing.T) {
+		news, err := repo.FindWithoutBody(ctx, 10)
+		assert.NoError(t, err)
+		if assert.Len(t, news, 2) {
+			assert.Equal(t, "N3", news[0].ID)
+			assert.Equal(t, "N2", news[1].ID)
+		}
+	})
+
+	t.Run("正常系: 存在しないIDの場合は nil を返すこと", func(t *testing.T) {
+		news, err := repo.FindByID(ctx, "missing")
+		assert.NoError(t, err)
+		assert.Nil(t, news)
+	})
+}
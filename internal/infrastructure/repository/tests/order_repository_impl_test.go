@@ -4,11 +4,15 @@ package tests
 
 import (
 	"context"
+	"fmt"
 	"stock-bot/domain/model"
+	domainrepo "stock-bot/domain/repository"
 	"stock-bot/internal/infrastructure/repository"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 )
 
 func TestOrderRepositoryImpl_Save(t *testing.T) {
@@ -126,4 +130,284 @@ func TestOrderRepositoryImpl_FindByStatus(t *testing.T) {
 	})
 }
 
+func TestOrderRepositoryImpl_FindExecutedOrders(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewOrderRepository(db)
+
+	t.Run("正常系: 約定がある注文だけを約定とともに取得できること", func(t *testing.T) {
+		ctx := context.Background()
+		base := time.Date(2025, 12, 26, 9, 0, 0, 0, time.UTC)
+		executed := &model.Order{
+			OrderID:     "test-order-5",
+			Symbol:      "7203",
+			TradeType:   model.TradeTypeBuy,
+			OrderType:   model.OrderTypeMarket,
+			Quantity:    200,
+			OrderStatus: model.OrderStatusFilled,
+			Executions: []model.Execution{
+				{ExecutionID: "exec-2", ExecutionTime: base.Add(time.Minute), ExecutionPrice: 3010, ExecutionQuantity: 100},
+				{ExecutionID: "exec-1", ExecutionTime: base, ExecutionPrice: 3000, ExecutionQuantity: 100},
+			},
+		}
+		pending := &model.Order{
+			OrderID:     "test-order-6",
+			Symbol:      "6758",
+			TradeType:   model.TradeTypeBuy,
+			OrderType:   model.OrderTypeLimit,
+			Quantity:    100,
+			Price:       13000,
+			OrderStatus: model.OrderStatusNew,
+		}
+		assert.NoError(t, repo.Save(ctx, executed))
+		assert.NoError(t, repo.Save(ctx, pending))
+
+		orders, err := repo.FindExecutedOrders(ctx, time.Time{})
+		assert.NoError(t, err)
+		if assert.Len(t, orders, 1) {
+			assert.Equal(t, "test-order-5", orders[0].OrderID)
+			if assert.Len(t, orders[0].Executions, 2) {
+				// 約定日時の順に読み込まれる
+				assert.Equal(t, "exec-1", orders[0].Executions[0].ExecutionID)
+			}
+		}
+
+		orders, err = repo.FindExecutedOrders(ctx, base.Add(time.Hour))
+		assert.NoError(t, err)
+		assert.Empty(t, orders)
+	})
+}
+
+func TestOrderRepositoryImpl_FindOrders(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewOrderRepository(db)
+
+	t.Run("正常系: 発注日時で絞り込んだ注文を約定とともに古い順に取得できること", func(t *testing.T) {
+		ctx := context.Background()
+		base := time.Date(2025, 12, 26, 9, 0, 0, 0, time.UTC)
+		for i, id := range []string{"test-order-10", "test-order-11", "test-order-12"} {
+			order := &model.Order{
+				Model:       gorm.Model{CreatedAt: base.Add(time.Duration(i) * time.Hour)},
+				OrderID:     id,
+				Symbol:      "7203",
+				TradeType:   model.TradeTypeBuy,
+				OrderType:   model.OrderTypeMarket,
+				Quantity:    100,
+				OrderStatus: model.OrderStatusNew,
+			}
+			if i == 1 {
+				order.Executions = []model.Execution{{ExecutionID: "exec-10", ExecutionTime: base.Add(time.Hour), ExecutionPrice: 3000, ExecutionQuantity: 100}}
+			}
+			assert.NoError(t, repo.Save(ctx, order))
+		}
+
+		orders, err := repo.FindOrders(ctx, domainrepo.OrderQuery{Since: base.Add(time.Hour), Until: base.Add(3 * time.Hour)})
+		assert.NoError(t, err)
+		if assert.Len(t, orders, 2) {
+			assert.Equal(t, "test-order-11", orders[0].OrderID)
+			assert.Len(t, orders[0].Executions, 1)
+			assert.Equal(t, "test-order-12", orders[1].OrderID)
+		}
+
+		orders, err = repo.FindOrders(ctx, domainrepo.OrderQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, orders, 3)
+	})
+
+	t.Run("正常系: 銘柄とシグナルで絞り込み、ページングできること", func(t *testing.T) {
+		ctx := context.Background()
+		signalID := uint(42)
+		for i, symbol := range []string{"6758", "6758", "6758", "9984"} {
+			order := &model.Order{
+				OrderID:     fmt.Sprintf("test-order-2%d", i),
+				Symbol:      symbol,
+				TradeType:   model.TradeTypeBuy,
+				OrderType:   model.OrderTypeMarket,
+				Quantity:    100,
+				OrderStatus: model.OrderStatusNew,
+			}
+			if i != 1 {
+				order.SignalID = &signalID
+			}
+			assert.NoError(t, repo.Save(ctx, order))
+		}
+
+		orders, err := repo.FindOrders(ctx, domainrepo.OrderQuery{Symbol: "6758", SignalID: &signalID})
+		assert.NoError(t, err)
+		if assert.Len(t, orders, 2) {
+			assert.Equal(t, "test-order-20", orders[0].OrderID)
+			assert.Equal(t, "test-order-22", orders[1].OrderID)
+		}
+
+		orders, err = repo.FindOrders(ctx, domainrepo.OrderQuery{Symbol: "6758", Offset: 1, Limit: 1})
+		assert.NoError(t, err)
+		if assert.Len(t, orders, 1) {
+			assert.Equal(t, "test-order-21", orders[0].OrderID)
+		}
+	})
+}
+
+func TestOrderRepositoryImpl_UpdateStatus(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewOrderRepository(db)
+
+	t.Run("正常系: 状態を更新し、古いバージョンでの更新は競合になること", func(t *testing.T) {
+		ctx := context.Background()
+		order := &model.Order{
+			OrderID:     "test-order-30",
+			Symbol:      "7203",
+			TradeType:   model.TradeTypeBuy,
+			OrderType:   model.OrderTypeLimit,
+			Quantity:    100,
+			Price:       3000,
+			OrderStatus: model.OrderStatusNew,
+		}
+		assert.NoError(t, repo.Save(ctx, order))
+		stale, err := repo.FindByID(ctx, "test-order-30")
+		assert.NoError(t, err)
+
+		err = repo.UpdateStatus(ctx, order, model.OrderStatusPartiallyFilled)
+		assert.NoError(t, err)
+		assert.Equal(t, model.OrderStatusPartiallyFilled, order.OrderStatus)
+		assert.Equal(t, 1, order.Version)
+
+		err = repo.UpdateStatus(ctx, stale, model.OrderStatusCanceled)
+		assert.ErrorIs(t, err, domainrepo.ErrOrderConflict)
+		assert.Equal(t, model.OrderStatusNew, stale.OrderStatus)
+
+		retrieved, err := repo.FindByID(ctx, "test-order-30")
+		assert.NoError(t, err)
+		assert.Equal(t, model.OrderStatusPartiallyFilled, retrieved.OrderStatus)
+		assert.Equal(t, 1, retrieved.Version)
+	})
+
+	t.Run("正常系: 状態遷移を検証し、履歴に記録すること", func(t *testing.T) {
+		ctx := context.Background()
+		order := &model.Order{
+			OrderID:     "test-order-31",
+			Symbol:      "7203",
+			TradeType:   model.TradeTypeSell,
+			OrderType:   model.OrderTypeMarket,
+			Quantity:    100,
+			OrderStatus: model.OrderStatusNew,
+		}
+		assert.NoError(t, repo.Save(ctx, order))
+
+		assert.NoError(t, repo.UpdateStatus(ctx, order, model.OrderStatusPartiallyFilled))
+		assert.NoError(t, repo.UpdateStatus(ctx, order, model.OrderStatusPartiallyFilled)) // 同じ状態は何もしない
+		assert.NoError(t, repo.UpdateStatus(ctx, order, model.OrderStatusFilled))
+		assert.Equal(t, 2, order.Version)
+
+		// 完了した注文の状態は戻せない
+		err := repo.UpdateStatus(ctx, order, model.OrderStatusPartiallyFilled)
+		assert.ErrorIs(t, err, model.ErrInvalidOrderTransition)
+		assert.Equal(t, model.OrderStatusFilled, order.OrderStatus)
+
+		transitions, err := repo.FindStatusTransitions(ctx, "test-order-31")
+		assert.NoError(t, err)
+		if assert.Len(t, transitions, 3) {
+			assert.Equal(t, model.OrderStatus(""), transitions[0].FromStatus)
+			assert.Equal(t, model.OrderStatusNew, transitions[0].ToStatus)
+			assert.Equal(t, model.OrderStatusNew, transitions[1].FromStatus)
+			assert.Equal(t, model.OrderStatusPartiallyFilled, transitions[1].ToStatus)
+			assert.Equal(t, model.OrderStatusFilled, transitions[2].ToStatus)
+			assert.Equal(t, 2, transitions[2].Version)
+		}
+	})
+
+	t.Run("異常系: 存在しない注文は競合になること", func(t *testing.T) {
+		err := repo.UpdateStatus(context.Background(), &model.Order{OrderID: "non-existent-order", OrderStatus: model.OrderStatusNew}, model.OrderStatusFilled)
+		assert.ErrorIs(t, err, domainrepo.ErrOrderConflict)
+	})
+}
+
+func TestOrderRepositoryImpl_SaveExecutions(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewOrderRepository(db)
+
+	t.Run("正常系: 約定を ExecutionID で upsert できること", func(t *testing.T) {
+		ctx := context.Background()
+		executedAt := time.Date(2025, 12, 26, 9, 0, 0, 0, time.UTC)
+		order := &model.Order{
+			OrderID:     "test-order-7",
+			Symbol:      "7203",
+			TradeType:   model.TradeTypeBuy,
+			OrderType:   model.OrderTypeMarket,
+			Quantity:    100,
+			OrderStatus: model.OrderStatusFilled,
+		}
+		assert.NoError(t, repo.Save(ctx, order))
+
+		err := repo.SaveExecutions(ctx, []*model.Execution{
+			{OrderID: "test-order-7", ExecutionID: "20251226-test-order-7-1", ExecutionTime: executedAt, ExecutionPrice: 3000, ExecutionQuantity: 100},
+		})
+		assert.NoError(t, err)
+		// 手数料が確定したら同じ約定を更新する
+		err = repo.SaveExecutions(ctx, []*model.Execution{
+			{OrderID: "test-order-7", ExecutionID: "20251226-test-order-7-1", ExecutionTime: executedAt, ExecutionPrice: 3000, ExecutionQuantity: 100, Commission: 55},
+		})
+		assert.NoError(t, err)
+
+		orders, err := repo.FindExecutedOrders(ctx, time.Time{})
+		assert.NoError(t, err)
+		if assert.Len(t, orders, 1) && assert.Len(t, orders[0].Executions, 1) {
+			assert.Equal(t, 55.0, orders[0].Executions[0].Commission)
+		}
+	})
+
+	t.Run("正常系: 注文の約定を保存し、注文の約定にも反映されること", func(t *testing.T) {
+		ctx := context.Background()
+		executedAt := time.Date(2025, 12, 26, 9, 0, 0, 0, time.UTC)
+		order := &model.Order{
+			OrderID:     "test-order-8",
+			Symbol:      "7203",
+			TradeType:   model.TradeTypeBuy,
+			OrderType:   model.OrderTypeMarket,
+			Quantity:    200,
+			OrderStatus: model.OrderStatusNew,
+		}
+		assert.NoError(t, repo.Save(ctx, order))
+
+		assert.NoError(t, repo.SaveExecution(ctx, order, &model.Execution{ExecutionID: "test-order-8-2", ExecutionTime: executedAt.Add(time.Minute), ExecutionPrice: 3001, ExecutionQuantity: 100}))
+		assert.NoError(t, repo.SaveExecution(ctx, order, &model.Execution{ExecutionID: "test-order-8-1", ExecutionTime: executedAt, ExecutionPrice: 3000, ExecutionQuantity: 100}))
+		// 同じ約定は置き換える
+		assert.NoError(t, repo.SaveExecution(ctx, order, &model.Execution{ExecutionID: "test-order-8-1", ExecutionTime: executedAt, ExecutionPrice: 3000, ExecutionQuantity: 100, Commission: 55}))
+		if assert.Len(t, order.Executions, 2) {
+			assert.Equal(t, "test-order-8-1", order.Executions[0].ExecutionID)
+			assert.Equal(t, 55.0, order.Executions[0].Commission)
+		}
+
+		executions, err := repo.FindExecutions(ctx, "test-order-8")
+		assert.NoError(t, err)
+		if assert.Len(t, executions, 2) {
+			assert.Equal(t, "test-order-8-1", executions[0].ExecutionID)
+			assert.Equal(t, "test-order-8", executions[0].OrderID)
+			assert.Equal(t, 55.0, executions[0].Commission)
+		}
+
+		retrieved, err := repo.FindByID(ctx, "test-order-8")
+		assert.NoError(t, err)
+		assert.Len(t, retrieved.Executions, 2)
+	})
+}
+
 // go test -v ./internal/infrastructure/repository/tests/order_repository_impl_test.go
@@ -5,8 +5,10 @@ package tests
 import (
 	"context"
 	"stock-bot/domain/model"
+	domainrepo "stock-bot/domain/repository"
 	"stock-bot/internal/infrastructure/repository"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -108,4 +110,72 @@ func TestPositionRepositoryImpl_FindAll(t *testing.T) {
 	})
 }
 
+func TestPositionRepositoryImpl_ReplaceAll(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewPositionRepository(db)
+	ctx := context.Background()
+	openedAt := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: ポジションとロットを置き換え、ロットを条件で検索できること", func(t *testing.T) {
+		err := repo.Save(ctx, &model.Position{Symbol: "9999", PositionType: model.PositionTypeLong, Quantity: 100})
+		assert.NoError(t, err)
+
+		err = repo.ReplaceAll(ctx, []*model.Position{
+			{Symbol: "7203", AccountType: model.AccountTypeSpecific, PositionType: model.PositionTypeLong, AveragePrice: 2050, Quantity: 200, Lots: []model.PositionLot{
+				{Symbol: "7203", AccountType: model.AccountTypeSpecific, PositionType: model.PositionTypeLong, OrderID: "1", ExecutionID: "e1", OpenedAt: openedAt, Price: 2000, OpenQuantity: 100, Quantity: 100},
+				{Symbol: "7203", AccountType: model.AccountTypeSpecific, PositionType: model.PositionTypeLong, OrderID: "2", ExecutionID: "e2", OpenedAt: openedAt.AddDate(0, 0, 1), Price: 2100, OpenQuantity: 100, Quantity: 100},
+			}},
+			{Symbol: "7203", AccountType: model.AccountTypeNISA, PositionType: model.PositionTypeLong, AveragePrice: 1900, Quantity: 100, Lots: []model.PositionLot{
+				{Symbol: "7203", AccountType: model.AccountTypeNISA, PositionType: model.PositionTypeLong, OrderID: "3", ExecutionID: "e3", OpenedAt: openedAt, Price: 1900, OpenQuantity: 100, Quantity: 100},
+			}},
+		})
+		assert.NoError(t, err)
+
+		positions, err := repo.FindAll(ctx)
+		assert.NoError(t, err)
+		if assert.Len(t, positions, 2) {
+			assert.Len(t, positions[0].Lots, 2)
+			assert.Equal(t, "e1", positions[0].Lots[0].ExecutionID)
+		}
+
+		lots, err := repo.FindLots(ctx, domainrepo.PositionLotQuery{Symbol: "7203", AccountType: model.AccountTypeSpecific})
+		assert.NoError(t, err)
+		if assert.Len(t, lots, 2) {
+			assert.Equal(t, "e1", lots[0].ExecutionID)
+			assert.Equal(t, "e2", lots[1].ExecutionID)
+		}
+
+		lots, err = repo.FindLots(ctx, domainrepo.PositionLotQuery{OpenedBefore: openedAt.Add(time.Hour)})
+		assert.NoError(t, err)
+		assert.Len(t, lots, 2)
+	})
+
+	t.Run("正常系: 同じ約定のロットで置き換え直せること", func(t *testing.T) {
+		err := repo.ReplaceAll(ctx, []*model.Position{
+			{Symbol: "7203", AccountType: model.AccountTypeSpecific, PositionType: model.PositionTypeLong, AveragePrice: 2100, Quantity: 100, Lots: []model.PositionLot{
+				{Symbol: "7203", AccountType: model.AccountTypeSpecific, PositionType: model.PositionTypeLong, OrderID: "2", ExecutionID: "e2", OpenedAt: openedAt.AddDate(0, 0, 1), Price: 2100, OpenQuantity: 100, Quantity: 100},
+			}},
+		})
+		assert.NoError(t, err)
+
+		lots, err := repo.FindLots(ctx, domainrepo.PositionLotQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, lots, 1)
+	})
+
+	t.Run("正常系: 空の台帳で置き換えるとすべて削除されること", func(t *testing.T) {
+		err := repo.ReplaceAll(ctx, nil)
+		assert.NoError(t, err)
+
+		positions, err := repo.FindAll(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, positions)
+	})
+}
+
 // go test -v ./internal/infrastructure/repository/tests/position_repository_impl_test.go
@@ -114,4 +114,184 @@ func TestSignalRepositoryImpl_FindBySymbol(t *testing.T) {
 	})
 }
 
+func TestSignalRepositoryImpl_SignalFileProcessing(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewSignalRepository(db)
+
+	t.Run("正常系: ファイルハッシュでシグナルを取得し、処理済みを記録できること", func(t *testing.T) {
+		ctx := context.Background()
+		fileHash := "d2a84f4b8b650937ec8f73cd8be2c74add5a911ba64df27458ed8229da804a26"
+		readAt := time.Now()
+		for _, symbol := range []string{"7203", "9984"} {
+			err := repo.Save(ctx, &model.Signal{
+				Symbol:      symbol,
+				SignalType:  model.SignalTypeBuy,
+				GeneratedAt: readAt,
+				SourceFile:  "./signals/test_signal.bin",
+				FileHash:    fileHash,
+				ReadAt:      readAt,
+			})
+			assert.NoError(t, err)
+		}
+
+		signals, err := repo.FindByFileHash(ctx, fileHash)
+		assert.NoError(t, err)
+		assert.Len(t, signals, 2)
+		assert.Equal(t, "./signals/test_signal.bin", signals[0].SourceFile)
+
+		processed, err := repo.IsFileProcessed(ctx, fileHash)
+		assert.NoError(t, err)
+		assert.False(t, processed)
+
+		file := &model.SignalFile{FileHash: fileHash, Path: "./signals/test_signal.bin", RecordCount: 2, ProcessedAt: time.Now()}
+		assert.NoError(t, repo.SaveBatch(ctx, nil, file))
+		// 二重に記録してもエラーにならないこと
+		assert.NoError(t, repo.SaveBatch(ctx, nil, file))
+
+		processed, err = repo.IsFileProcessed(ctx, fileHash)
+		assert.NoError(t, err)
+		assert.True(t, processed)
+	})
+
+	t.Run("正常系: シグナルとファイルの処理済み記録を1つのトランザクションで保存できること", func(t *testing.T) {
+		ctx := context.Background()
+		fileHash := "5f0c3b6b2ad6c3f1f2d7f1b0f0b2c8f9a4c51e0b3f3e9c1a6d8b2e7f4a1c9d3e"
+		readAt := time.Now()
+		file := &model.SignalFile{FileHash: fileHash, Path: "./signals/batch.bin", RecordCount: 2, ProcessedAt: readAt}
+		signals := []*model.Signal{
+			{Symbol: "7203", SignalType: model.SignalTypeBuy, GeneratedAt: readAt, FileHash: fileHash, ReadAt: readAt},
+			{Symbol: "6758", SignalType: model.SignalTypeSell, GeneratedAt: readAt, FileHash: fileHash, ReadAt: readAt},
+		}
+		assert.NoError(t, repo.SaveBatch(ctx, signals, file))
+		assert.NotZero(t, signals[0].ID)
+		assert.NotZero(t, signals[1].ID)
+
+		saved, err := repo.FindByFileHash(ctx, fileHash)
+		assert.NoError(t, err)
+		assert.Len(t, saved, 2)
+		processed, err := repo.IsFileProcessed(ctx, fileHash)
+		assert.NoError(t, err)
+		assert.True(t, processed)
+	})
+
+	t.Run("異常系: 一部のシグナルを保存できない場合は、シグナルも処理済み記録も保存しないこと", func(t *testing.T) {
+		ctx := context.Background()
+		existing, err := repo.FindBySymbol(ctx, "7203")
+		if !assert.NoError(t, err) || !assert.NotEmpty(t, existing) {
+			return
+		}
+		fileHash := "9e1d4c7a2b8f3e6d0c5a1b9f7e2d4c8a6b3f0e1d9c7a5b2f8e4d6c0a3b1f7e9d"
+		readAt := time.Now()
+		file := &model.SignalFile{FileHash: fileHash, Path: "./signals/broken.bin", RecordCount: 2, ProcessedAt: readAt}
+		signals := []*model.Signal{
+			{Symbol: "8306", SignalType: model.SignalTypeBuy, GeneratedAt: readAt, FileHash: fileHash, ReadAt: readAt},
+			// 既存のシグナルと主キーが重複するため保存できない
+			{ID: existing[0].ID, Symbol: "9984", SignalType: model.SignalTypeBuy, GeneratedAt: readAt, FileHash: fileHash, ReadAt: readAt},
+		}
+		assert.Error(t, repo.SaveBatch(ctx, signals, file))
+
+		saved, err := repo.FindByFileHash(ctx, fileHash)
+		assert.NoError(t, err)
+		assert.Empty(t, saved)
+		processed, err := repo.IsFileProcessed(ctx, fileHash)
+		assert.NoError(t, err)
+		assert.False(t, processed)
+	})
+
+	t.Run("正常系: 重複判定キーでシグナルの存在を確認できること", func(t *testing.T) {
+		ctx := context.Background()
+		recordKey := "20251222:6758:BUY"
+
+		exists, err := repo.ExistsByRecordKey(ctx, recordKey)
+		assert.NoError(t, err)
+		assert.False(t, exists)
+
+		err = repo.Save(ctx, &model.Signal{Symbol: "6758", SignalType: model.SignalTypeBuy, GeneratedAt: time.Now(), RecordKey: recordKey})
+		assert.NoError(t, err)
+
+		exists, err = repo.ExistsByRecordKey(ctx, recordKey)
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
+
 // go test -v ./internal/infrastructure/repository/tests/signal_repository_impl_test.go
+
+func TestSignalRepositoryImpl_PushedSignals(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewSignalRepository(db)
+
+	t.Run("正常系: 新しい順に取得でき、銘柄と件数で絞り込めること", func(t *testing.T) {
+		ctx := context.Background()
+		base := time.Now().Add(-time.Hour)
+		for i, symbol := range []string{"7203", "9984", "7203"} {
+			err := repo.Save(ctx, &model.Signal{
+				Symbol:      symbol,
+				SignalType:  model.SignalTypeBuy,
+				GeneratedAt: base.Add(time.Duration(i) * time.Minute),
+				Source:      model.SignalSourceHTTP,
+			})
+			assert.NoError(t, err)
+		}
+
+		signals, err := repo.FindRecent(ctx, "", 2)
+		assert.NoError(t, err)
+		assert.Len(t, signals, 2)
+		assert.Equal(t, "7203", signals[0].Symbol)
+		assert.Equal(t, "9984", signals[1].Symbol)
+
+		signals, err = repo.FindRecent(ctx, "7203", 10)
+		assert.NoError(t, err)
+		assert.Len(t, signals, 2)
+	})
+
+	t.Run("正常系: 未処理のシグナルを取得し、処理済みを一度だけ記録できること", func(t *testing.T) {
+		ctx := context.Background()
+		pending, err := repo.FindUnconsumed(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, pending, 3)
+
+		err = repo.MarkConsumed(ctx, pending[0].ID, time.Now())
+		assert.NoError(t, err)
+		err = repo.MarkConsumed(ctx, pending[0].ID, time.Now())
+		assert.Error(t, err)
+
+		pending, err = repo.FindUnconsumed(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, pending, 2)
+	})
+
+	t.Run("正常系: 期間内に処理したシグナルを処理した順に取得できること", func(t *testing.T) {
+		ctx := context.Background()
+		pending, err := repo.FindUnconsumed(ctx)
+		assert.NoError(t, err)
+		if !assert.Len(t, pending, 2) {
+			return
+		}
+		base := time.Date(2025, 12, 26, 9, 0, 0, 0, time.UTC)
+		assert.NoError(t, repo.MarkConsumed(ctx, pending[0].ID, base.Add(time.Hour)))
+		assert.NoError(t, repo.MarkConsumed(ctx, pending[1].ID, base))
+
+		signals, err := repo.FindConsumed(ctx, base, base.Add(time.Hour))
+		assert.NoError(t, err)
+		if assert.Len(t, signals, 1) {
+			assert.Equal(t, pending[1].ID, signals[0].ID)
+		}
+
+		signals, err = repo.FindConsumed(ctx, base, base.Add(2*time.Hour))
+		assert.NoError(t, err)
+		if assert.Len(t, signals, 2) {
+			assert.Equal(t, pending[0].ID, signals[1].ID)
+		}
+	})
+}
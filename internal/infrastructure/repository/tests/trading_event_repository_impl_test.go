@@ -0,0 +1,50 @@
+// internal/infrastructure/repository/tests/trading_event_repository_impl_test.go
+
+package tests
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	domainrepo "stock-bot/domain/repository"
+	"stock-bot/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTradingEventRepositoryImpl(t *testing.T) {
+	db, cleanup, err := repository.SetupTestDatabase(t)
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	defer cleanup()
+
+	repo := repository.NewTradingEventRepository(db)
+	ctx := context.Background()
+	base := time.Date(2025, 12, 26, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: イベントを保存し、期間で絞り込んで古い順に取得できること", func(t *testing.T) {
+		signalID := uint(10)
+		events := []*model.TradingEvent{
+			{OccurredAt: base.Add(2 * time.Hour), Type: model.TradingEventRiskBlocked, Symbol: "7203", SignalID: &signalID, Message: "fresh news"},
+			{OccurredAt: base, Type: model.TradingEventOrderRejected, Symbol: "6758", Message: "insufficient buying power"},
+			{OccurredAt: base.Add(24 * time.Hour), Type: model.TradingEventOrderRejected, Symbol: "9984"},
+		}
+		for _, e := range events {
+			assert.NoError(t, repo.Save(ctx, e))
+		}
+
+		found, err := repo.FindEvents(ctx, domainrepo.TradingEventQuery{Since: base, Until: base.Add(24 * time.Hour)})
+		assert.NoError(t, err)
+		if assert.Len(t, found, 2) {
+			assert.Equal(t, "6758", found[0].Symbol)
+			assert.Equal(t, "7203", found[1].Symbol)
+			assert.Equal(t, signalID, *found[1].SignalID)
+		}
+
+		found, err = repo.FindEvents(ctx, domainrepo.TradingEventQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, found, 3)
+	})
+}
@@ -0,0 +1,41 @@
+// internal/infrastructure/repository/trading_event_repository_impl.go
+package repository
+
+import (
+	"context"
+	"stock-bot/domain/model"
+	"stock-bot/domain/repository"
+
+	"github.com/cockroachdb/errors"
+	"gorm.io/gorm"
+)
+
+type tradingEventRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTradingEventRepository(db *gorm.DB) repository.TradingEventRepository {
+	return &tradingEventRepositoryImpl{db: db}
+}
+
+func (r *tradingEventRepositoryImpl) Save(ctx context.Context, event *model.TradingEvent) error {
+	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+		return errors.Wrap(err, "failed to save trading event")
+	}
+	return nil
+}
+
+func (r *tradingEventRepositoryImpl) FindEvents(ctx context.Context, query repository.TradingEventQuery) ([]*model.TradingEvent, error) {
+	db := r.db.WithContext(ctx)
+	if !query.Since.IsZero() {
+		db = db.Where("occurred_at >= ?", query.Since)
+	}
+	if !query.Until.IsZero() {
+		db = db.Where("occurred_at < ?", query.Until)
+	}
+	var events []*model.TradingEvent
+	if err := db.Order("occurred_at").Order("id").Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to find trading events")
+	}
+	return events, nil
+}
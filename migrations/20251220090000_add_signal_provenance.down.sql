@@ -0,0 +1,11 @@
+-- add_signal_provenance.down.sql
+
+DROP INDEX IF EXISTS idx_orders_signal_id;
+ALTER TABLE orders DROP COLUMN IF EXISTS signal_id;
+
+DROP TABLE IF EXISTS signal_files;
+
+DROP INDEX IF EXISTS idx_signals_file_hash;
+ALTER TABLE signals DROP COLUMN IF EXISTS read_at;
+ALTER TABLE signals DROP COLUMN IF EXISTS file_hash;
+ALTER TABLE signals DROP COLUMN IF EXISTS source_file;
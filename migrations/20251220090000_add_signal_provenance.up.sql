@@ -0,0 +1,18 @@
+-- add_signal_provenance.up.sql
+
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS source_file TEXT;
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS file_hash VARCHAR(255);
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS read_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_signals_file_hash ON signals(file_hash);
+
+CREATE TABLE IF NOT EXISTS signal_files (
+    file_hash VARCHAR(255) PRIMARY KEY,
+    path TEXT,
+    record_count BIGINT,
+    processed_at TIMESTAMPTZ
+);
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS signal_id BIGINT;
+
+CREATE INDEX IF NOT EXISTS idx_orders_signal_id ON orders(signal_id);
@@ -0,0 +1,4 @@
+-- add_signal_record_key.down.sql
+
+DROP INDEX IF EXISTS idx_signals_record_key;
+ALTER TABLE signals DROP COLUMN IF EXISTS record_key;
@@ -0,0 +1,5 @@
+-- add_signal_record_key.up.sql
+
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS record_key VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_signals_record_key ON signals(record_key);
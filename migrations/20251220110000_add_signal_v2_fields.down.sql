@@ -0,0 +1,6 @@
+-- add_signal_v2_fields.down.sql
+
+ALTER TABLE signals DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE signals DROP COLUMN IF EXISTS weight;
+ALTER TABLE signals DROP COLUMN IF EXISTS target_price;
+ALTER TABLE signals DROP COLUMN IF EXISTS stop_price;
@@ -0,0 +1,6 @@
+-- add_signal_v2_fields.up.sql
+
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS stop_price DOUBLE PRECISION;
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS target_price DOUBLE PRECISION;
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS weight DOUBLE PRECISION;
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
@@ -0,0 +1,6 @@
+-- add_signal_source.down.sql
+
+DROP INDEX IF EXISTS idx_signals_consumed_at;
+DROP INDEX IF EXISTS idx_signals_source;
+ALTER TABLE signals DROP COLUMN IF EXISTS consumed_at;
+ALTER TABLE signals DROP COLUMN IF EXISTS source;
@@ -0,0 +1,11 @@
+-- add_signal_source.up.sql
+
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS source VARCHAR(16);
+ALTER TABLE signals ADD COLUMN IF NOT EXISTS consumed_at TIMESTAMPTZ;
+
+-- 既存のシグナルはすべてファイルから読み込まれ、読み込み時に処理済み
+UPDATE signals SET source = 'FILE' WHERE source IS NULL;
+UPDATE signals SET consumed_at = COALESCE(read_at, generated_at) WHERE consumed_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_signals_source ON signals(source);
+CREATE INDEX IF NOT EXISTS idx_signals_consumed_at ON signals(consumed_at);
@@ -0,0 +1,4 @@
+-- add_stock_master_tick_unit.down.sql
+
+ALTER TABLE stock_masters DROP COLUMN IF EXISTS next_tick_unit_number;
+ALTER TABLE stock_masters DROP COLUMN IF EXISTS tick_unit_number;
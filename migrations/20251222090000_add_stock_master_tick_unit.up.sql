@@ -0,0 +1,4 @@
+-- add_stock_master_tick_unit.up.sql
+
+ALTER TABLE stock_masters ADD COLUMN IF NOT EXISTS tick_unit_number VARCHAR(255);
+ALTER TABLE stock_masters ADD COLUMN IF NOT EXISTS next_tick_unit_number VARCHAR(255);
@@ -0,0 +1,18 @@
+-- add_full_master_sync.down.sql
+
+DROP TABLE IF EXISTS operation_statuses;
+DROP TABLE IF EXISTS stock_issue_regulations;
+DROP TABLE IF EXISTS margin_masters;
+
+DELETE FROM stock_market_masters;
+ALTER TABLE stock_market_masters DROP COLUMN IF EXISTS delisting_date;
+ALTER TABLE stock_market_masters DROP COLUMN IF EXISTS margin_eligibility;
+ALTER TABLE stock_market_masters DROP COLUMN IF EXISTS next_tick_unit_number;
+ALTER TABLE stock_market_masters DROP COLUMN IF EXISTS tick_unit_number;
+ALTER TABLE stock_market_masters DROP COLUMN IF EXISTS trading_unit;
+ALTER TABLE stock_market_masters DROP COLUMN IF EXISTS lower_limit;
+ALTER TABLE stock_market_masters DROP COLUMN IF EXISTS upper_limit;
+ALTER TABLE stock_market_masters DROP CONSTRAINT IF EXISTS stock_market_masters_pkey;
+ALTER TABLE stock_market_masters ALTER COLUMN listing_market DROP NOT NULL;
+ALTER TABLE stock_market_masters ALTER COLUMN issue_code DROP NOT NULL;
+ALTER TABLE stock_market_masters ADD COLUMN IF NOT EXISTS id BIGSERIAL PRIMARY KEY;
@@ -0,0 +1,66 @@
+-- add_full_master_sync.up.sql
+
+-- 株式銘柄市場マスタは銘柄コードと上場市場の組で一意とする
+-- (これまでこのテーブルには書き込んでいないため、既存の行は破棄する)
+DELETE FROM stock_market_masters;
+ALTER TABLE stock_market_masters DROP COLUMN IF EXISTS id;
+ALTER TABLE stock_market_masters ALTER COLUMN issue_code SET NOT NULL;
+ALTER TABLE stock_market_masters ALTER COLUMN listing_market SET NOT NULL;
+ALTER TABLE stock_market_masters ADD PRIMARY KEY (issue_code, listing_market);
+ALTER TABLE stock_market_masters ADD COLUMN IF NOT EXISTS upper_limit DOUBLE PRECISION;
+ALTER TABLE stock_market_masters ADD COLUMN IF NOT EXISTS lower_limit DOUBLE PRECISION;
+ALTER TABLE stock_market_masters ADD COLUMN IF NOT EXISTS trading_unit BIGINT;
+ALTER TABLE stock_market_masters ADD COLUMN IF NOT EXISTS tick_unit_number VARCHAR(255);
+ALTER TABLE stock_market_masters ADD COLUMN IF NOT EXISTS next_tick_unit_number VARCHAR(255);
+ALTER TABLE stock_market_masters ADD COLUMN IF NOT EXISTS margin_eligibility VARCHAR(255);
+ALTER TABLE stock_market_masters ADD COLUMN IF NOT EXISTS delisting_date VARCHAR(255);
+
+CREATE TABLE IF NOT EXISTS margin_masters (
+    issue_code VARCHAR(255) NOT NULL,
+    listing_market VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    change_date VARCHAR(255),
+    collateral_rate DOUBLE PRECISION,
+    cash_margin_rate DOUBLE PRECISION,
+    PRIMARY KEY (issue_code, listing_market)
+);
+CREATE INDEX IF NOT EXISTS idx_margin_masters_deleted_at ON margin_masters(deleted_at);
+
+CREATE TABLE IF NOT EXISTS stock_issue_regulations (
+    issue_code VARCHAR(255) NOT NULL,
+    listing_market VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    trading_halt VARCHAR(8),
+    cash_buy VARCHAR(8),
+    cash_sell VARCHAR(8),
+    margin_new_buy VARCHAR(8),
+    margin_new_sell VARCHAR(8),
+    general_margin_new_buy VARCHAR(8),
+    general_margin_new_sell VARCHAR(8),
+    pre_adjustment BOOLEAN,
+    same_day_settlement BOOLEAN,
+    concentrated_margin_category VARCHAR(8),
+    PRIMARY KEY (issue_code, listing_market)
+);
+CREATE INDEX IF NOT EXISTS idx_stock_issue_regulations_deleted_at ON stock_issue_regulations(deleted_at);
+
+CREATE TABLE IF NOT EXISTS operation_statuses (
+    kind VARCHAR(64) NOT NULL,
+    operation_category VARCHAR(255) NOT NULL,
+    operation_unit VARCHAR(255) NOT NULL,
+    target_business VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    business_day_flag VARCHAR(255),
+    status VARCHAR(255),
+    business_status VARCHAR(255),
+    event_name VARCHAR(255),
+    estimated_time VARCHAR(255),
+    PRIMARY KEY (kind, operation_category, operation_unit, target_business)
+);
+CREATE INDEX IF NOT EXISTS idx_operation_statuses_deleted_at ON operation_statuses(deleted_at);
@@ -0,0 +1,4 @@
+-- add_master_sync_runs.down.sql
+
+DROP TABLE IF EXISTS stock_master_histories;
+DROP TABLE IF EXISTS master_sync_runs;
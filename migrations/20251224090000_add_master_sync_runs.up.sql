@@ -0,0 +1,52 @@
+-- add_master_sync_runs.up.sql
+
+-- マスタデータ同期の実行履歴
+CREATE TABLE IF NOT EXISTS master_sync_runs (
+    id BIGSERIAL PRIMARY KEY,
+    scope VARCHAR(255),
+    trigger VARCHAR(255),
+    status VARCHAR(255),
+    started_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ,
+    error TEXT,
+    stocks_inserted BIGINT NOT NULL DEFAULT 0,
+    stocks_updated BIGINT NOT NULL DEFAULT 0,
+    stocks_unchanged BIGINT NOT NULL DEFAULT 0,
+    stocks_deleted BIGINT NOT NULL DEFAULT 0,
+    stock_markets_inserted BIGINT NOT NULL DEFAULT 0,
+    stock_markets_updated BIGINT NOT NULL DEFAULT 0,
+    stock_markets_unchanged BIGINT NOT NULL DEFAULT 0,
+    stock_markets_deleted BIGINT NOT NULL DEFAULT 0,
+    tick_rules_inserted BIGINT NOT NULL DEFAULT 0,
+    tick_rules_updated BIGINT NOT NULL DEFAULT 0,
+    tick_rules_unchanged BIGINT NOT NULL DEFAULT 0,
+    tick_rules_deleted BIGINT NOT NULL DEFAULT 0,
+    margin_masters_inserted BIGINT NOT NULL DEFAULT 0,
+    margin_masters_updated BIGINT NOT NULL DEFAULT 0,
+    margin_masters_unchanged BIGINT NOT NULL DEFAULT 0,
+    margin_masters_deleted BIGINT NOT NULL DEFAULT 0,
+    regulations_inserted BIGINT NOT NULL DEFAULT 0,
+    regulations_updated BIGINT NOT NULL DEFAULT 0,
+    regulations_unchanged BIGINT NOT NULL DEFAULT 0,
+    regulations_deleted BIGINT NOT NULL DEFAULT 0,
+    operation_statuses_inserted BIGINT NOT NULL DEFAULT 0,
+    operation_statuses_updated BIGINT NOT NULL DEFAULT 0,
+    operation_statuses_unchanged BIGINT NOT NULL DEFAULT 0,
+    operation_statuses_deleted BIGINT NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_master_sync_runs_status ON master_sync_runs(status);
+CREATE INDEX IF NOT EXISTS idx_master_sync_runs_started_at ON master_sync_runs(started_at);
+
+-- 銘柄マスタの変更履歴 (名称・市場・売買単位・値幅制限・呼値の単位番号)
+CREATE TABLE IF NOT EXISTS stock_master_histories (
+    id BIGSERIAL PRIMARY KEY,
+    sync_run_id BIGINT,
+    issue_code VARCHAR(255),
+    field VARCHAR(255),
+    old_value VARCHAR(255),
+    new_value VARCHAR(255),
+    changed_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_stock_master_histories_sync_run_id ON stock_master_histories(sync_run_id);
+CREATE INDEX IF NOT EXISTS idx_stock_master_histories_issue_code ON stock_master_histories(issue_code);
+CREATE INDEX IF NOT EXISTS idx_stock_master_histories_changed_at ON stock_master_histories(changed_at);
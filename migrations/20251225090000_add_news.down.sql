@@ -0,0 +1,3 @@
+-- add_news.down.sql
+
+DROP TABLE IF EXISTS news;
@@ -0,0 +1,19 @@
+-- add_news.up.sql
+
+-- ニュース (適時開示などを含む)。ニュースID単位で重複なく保存する
+CREATE TABLE IF NOT EXISTS news (
+    id VARCHAR(255) PRIMARY KEY,
+    published_at TIMESTAMPTZ,
+    categories JSONB,
+    genres JSONB,
+    symbols JSONB,
+    headline TEXT,
+    body TEXT,
+    body_fetched_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_news_published_at ON news(published_at);
+CREATE INDEX IF NOT EXISTS idx_news_body_fetched_at ON news(body_fetched_at);
+-- 関連銘柄での絞り込み (symbols @> '["7203"]') 用
+CREATE INDEX IF NOT EXISTS idx_news_symbols ON news USING GIN (symbols);
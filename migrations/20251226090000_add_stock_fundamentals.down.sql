@@ -0,0 +1,3 @@
+-- add_stock_fundamentals.down.sql
+
+DROP TABLE IF EXISTS stock_fundamentals;
@@ -0,0 +1,27 @@
+-- add_stock_fundamentals.up.sql
+
+-- 銘柄の投資指標 (BPS, EPS, 配当など) の日次スナップショット
+CREATE TABLE IF NOT EXISTS stock_fundamentals (
+    id BIGSERIAL PRIMARY KEY,
+    issue_code VARCHAR(255),
+    snapshot_date VARCHAR(8),
+    bps DOUBLE PRECISION,
+    eps DOUBLE PRECISION,
+    roe DOUBLE PRECISION,
+    reported_per DOUBLE PRECISION,
+    reported_pbr DOUBLE PRECISION,
+    earnings_yield DOUBLE PRECISION,
+    reported_dividend_yield DOUBLE PRECISION,
+    dividend_per_share DOUBLE PRECISION,
+    ex_dividend_date VARCHAR(8),
+    interim_ex_dividend_date VARCHAR(8),
+    last_ex_rights_date VARCHAR(8),
+    year_high DOUBLE PRECISION,
+    year_high_date VARCHAR(8),
+    year_low DOUBLE PRECISION,
+    year_low_date VARCHAR(8),
+    fetched_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stock_fundamentals_issue_code_snapshot_date ON stock_fundamentals(issue_code, snapshot_date);
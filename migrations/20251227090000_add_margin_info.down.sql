@@ -0,0 +1,5 @@
+-- add_margin_info.down.sql
+
+DROP TABLE IF EXISTS margin_premium_records;
+DROP TABLE IF EXISTS credit_balances;
+DROP TABLE IF EXISTS securities_finance_balances;
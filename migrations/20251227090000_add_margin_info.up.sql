@@ -0,0 +1,57 @@
+-- add_margin_info.up.sql
+
+-- 証金残 (日次)
+CREATE TABLE IF NOT EXISTS securities_finance_balances (
+    id BIGSERIAL PRIMARY KEY,
+    issue_code VARCHAR(255),
+    record_date VARCHAR(8),
+    preliminary BOOLEAN,
+    loan_balance BIGINT,
+    loan_change BIGINT,
+    loan_new BIGINT,
+    loan_repaid BIGINT,
+    stock_loan_balance BIGINT,
+    stock_loan_change BIGINT,
+    stock_loan_new BIGINT,
+    stock_loan_repaid BIGINT,
+    net_balance BIGINT,
+    net_change BIGINT,
+    loan_ratio DOUBLE PRECISION,
+    turnover_days DOUBLE PRECISION,
+    fetched_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_securities_finance_balances_issue_code_record_date ON securities_finance_balances(issue_code, record_date);
+
+-- 信用残 (週次)
+CREATE TABLE IF NOT EXISTS credit_balances (
+    id BIGSERIAL PRIMARY KEY,
+    issue_code VARCHAR(255),
+    record_date VARCHAR(8),
+    long_balance BIGINT,
+    long_change BIGINT,
+    short_balance BIGINT,
+    short_change BIGINT,
+    ratio DOUBLE PRECISION,
+    standard_long_balance BIGINT,
+    standard_short_balance BIGINT,
+    negotiable_long_balance BIGINT,
+    negotiable_short_balance BIGINT,
+    fetched_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_credit_balances_issue_code_record_date ON credit_balances(issue_code, record_date);
+
+-- 逆日歩 (取得日ごと)
+CREATE TABLE IF NOT EXISTS margin_premium_records (
+    id BIGSERIAL PRIMARY KEY,
+    issue_code VARCHAR(255),
+    record_date VARCHAR(8),
+    premium DOUBLE PRECISION,
+    fetched_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_margin_premium_records_issue_code_record_date ON margin_premium_records(issue_code, record_date);
@@ -0,0 +1,3 @@
+-- add_balance_snapshots.down.sql
+
+DROP TABLE IF EXISTS balance_snapshots;
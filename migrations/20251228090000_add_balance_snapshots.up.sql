@@ -0,0 +1,18 @@
+-- add_balance_snapshots.up.sql
+
+-- 残高と委託保証金率の定期的なスナップショット
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    id BIGSERIAL PRIMARY KEY,
+    taken_at TIMESTAMPTZ,
+    cash_buying_power DOUBLE PRECISION,
+    margin_buying_power DOUBLE PRECISION,
+    withdrawable_cash DOUBLE PRECISION,
+    deposited_margin DOUBLE PRECISION,
+    position_value DOUBLE PRECISION,
+    valuation_profit_loss DOUBLE PRECISION,
+    maintenance_rate DOUBLE PRECISION,
+    margin_call_surplus DOUBLE PRECISION,
+    has_margin_call BOOLEAN,
+    created_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_balance_snapshots_taken_at ON balance_snapshots(taken_at);
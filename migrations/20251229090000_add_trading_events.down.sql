@@ -0,0 +1,4 @@
+-- add_trading_events.down.sql
+
+DROP INDEX IF EXISTS idx_orders_created_at;
+DROP TABLE IF EXISTS trading_events;
@@ -0,0 +1,17 @@
+-- add_trading_events.up.sql
+
+-- 発注の拒否とリスク管理による見送りの記録 (日次レポートで集計する)
+CREATE TABLE IF NOT EXISTS trading_events (
+    id BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMPTZ,
+    type VARCHAR(32),
+    symbol VARCHAR(16),
+    signal_id BIGINT,
+    message TEXT,
+    created_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_trading_events_occurred_at ON trading_events(occurred_at);
+CREATE INDEX IF NOT EXISTS idx_trading_events_type ON trading_events(type);
+
+-- 日次レポートで発注日時により注文を絞り込むため
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON orders(created_at);
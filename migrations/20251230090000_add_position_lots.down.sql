@@ -0,0 +1,10 @@
+-- add_position_lots.down.sql
+
+DROP TABLE IF EXISTS position_lots;
+
+DROP INDEX IF EXISTS idx_positions_account_type;
+ALTER TABLE positions DROP COLUMN IF EXISTS is_margin;
+ALTER TABLE positions DROP COLUMN IF EXISTS account_type;
+
+DROP INDEX IF EXISTS idx_orders_account_type;
+ALTER TABLE orders DROP COLUMN IF EXISTS account_type;
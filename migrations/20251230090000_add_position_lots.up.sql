@@ -0,0 +1,41 @@
+-- add_position_lots.up.sql
+
+-- 注文の口座区分 (譲渡益課税区分)。既存の注文はボットが特定口座で発注したもの
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS account_type VARCHAR(16) DEFAULT 'SPECIFIC';
+CREATE INDEX IF NOT EXISTS idx_orders_account_type ON orders(account_type);
+
+-- ポジションは銘柄・口座区分・現物/信用ごとに約定から作成する
+ALTER TABLE positions ADD COLUMN IF NOT EXISTS account_type VARCHAR(16) DEFAULT 'SPECIFIC';
+ALTER TABLE positions ADD COLUMN IF NOT EXISTS is_margin BOOLEAN NOT NULL DEFAULT false;
+CREATE INDEX IF NOT EXISTS idx_positions_account_type ON positions(account_type);
+
+-- 約定ごとの未決済の建玉 (税務上の取得ロット)
+CREATE TABLE IF NOT EXISTS position_lots (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    position_id BIGINT,
+    symbol VARCHAR(255),
+    account_type VARCHAR(16),
+    is_margin BOOLEAN NOT NULL DEFAULT false,
+    position_type VARCHAR(255),
+    order_id VARCHAR(255),
+    execution_id VARCHAR(255) UNIQUE,
+    opened_at TIMESTAMPTZ,
+    price DOUBLE PRECISION,
+    open_quantity BIGINT,
+    quantity BIGINT,
+    commission DOUBLE PRECISION,
+    CONSTRAINT fk_positions_lots
+        FOREIGN KEY(position_id)
+        REFERENCES positions(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_position_lots_deleted_at ON position_lots(deleted_at);
+CREATE INDEX IF NOT EXISTS idx_position_lots_position_id ON position_lots(position_id);
+CREATE INDEX IF NOT EXISTS idx_position_lots_symbol ON position_lots(symbol);
+CREATE INDEX IF NOT EXISTS idx_position_lots_account_type ON position_lots(account_type);
+CREATE INDEX IF NOT EXISTS idx_position_lots_order_id ON position_lots(order_id);
+CREATE INDEX IF NOT EXISTS idx_position_lots_opened_at ON position_lots(opened_at);
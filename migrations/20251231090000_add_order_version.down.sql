@@ -0,0 +1,4 @@
+-- add_order_version.down.sql
+
+DROP INDEX IF EXISTS idx_executions_order_id_execution_time;
+ALTER TABLE orders DROP COLUMN IF EXISTS version;
@@ -0,0 +1,7 @@
+-- add_order_version.up.sql
+
+-- 注文の状態更新の楽観ロックに使用するバージョン
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 0;
+
+-- 注文の約定を約定日時の順に読み込むため
+CREATE INDEX IF NOT EXISTS idx_executions_order_id_execution_time ON executions(order_id, execution_time);
@@ -0,0 +1,3 @@
+-- add_order_status_transitions.down.sql
+
+DROP TABLE IF EXISTS order_status_transitions;
@@ -0,0 +1,12 @@
+-- add_order_status_transitions.up.sql
+
+-- 注文の状態遷移の履歴 (注文の保存時と状態の更新時に記録する)
+CREATE TABLE IF NOT EXISTS order_status_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    order_id VARCHAR(255),
+    from_status VARCHAR(255),
+    to_status VARCHAR(255),
+    version BIGINT,
+    created_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_order_status_transitions_order_id ON order_status_transitions(order_id);